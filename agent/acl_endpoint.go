@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"strings"
 
+	"gopkg.in/square/go-jose.v2/jwt"
+
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/lib"
@@ -49,6 +51,31 @@ func (s *HTTPHandlers) ACLBootstrap(resp http.ResponseWriter, req *http.Request)
 	return &aclBootstrapResponse{ID: out.SecretID, ACLToken: out}, nil
 }
 
+// ACLBootstrapRotate issues a new management token to replace an existing
+// one, which will expire after the requested TTL.
+func (s *HTTPHandlers) ACLBootstrapRotate(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if s.checkACLDisabled() {
+		return nil, aclDisabled
+	}
+
+	args := structs.ACLBootstrapRotateRequest{
+		Datacenter: s.agent.config.Datacenter,
+	}
+
+	if req.ContentLength > 0 {
+		if err := decodeBody(req.Body, &args); err != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Request decoding failed: %v", err)}
+		}
+	}
+	s.parseToken(req, &args.Token)
+
+	var out structs.ACLToken
+	if err := s.agent.RPC("ACL.BootstrapTokenRotate", &args, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (s *HTTPHandlers) ACLReplicationStatus(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	if s.checkACLDisabled() {
 		return nil, aclDisabled
@@ -959,6 +986,61 @@ func (s *HTTPHandlers) ACLLogin(resp http.ResponseWriter, req *http.Request) (in
 	return &out, nil
 }
 
+// aclLoginValidationResponse adds the unverified contents of the bearer token,
+// when it is a JWT, to the result of validating it.
+type aclLoginValidationResponse struct {
+	structs.ACLLoginValidation
+	JWTHeader map[string]interface{} `json:",omitempty"`
+	JWTClaims map[string]interface{} `json:",omitempty"`
+}
+
+// ACLLoginValidate checks a bearer token against an auth method without
+// logging in, to explain why a login is rejected.
+func (s *HTTPHandlers) ACLLoginValidate(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if s.checkACLDisabled() {
+		return nil, aclDisabled
+	}
+
+	args := &structs.ACLLoginRequest{
+		Datacenter: s.agent.config.Datacenter,
+		Auth:       &structs.ACLLoginParams{},
+	}
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+	if err := s.parseEntMeta(req, &args.Auth.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+
+	if err := s.rewordUnknownEnterpriseFieldError(lib.DecodeJSON(req.Body, &args.Auth)); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Failed to decode request body: %v", err)}
+	}
+
+	var out aclLoginValidationResponse
+	if err := s.agent.RPC("ACL.ValidateLogin", args, &out.ACLLoginValidation); err != nil {
+		return nil, err
+	}
+
+	// The claims are decoded without verifying the signature purely so that
+	// they can be compared against the auth method's configuration.
+	if parsed, err := jwt.ParseSigned(args.Auth.BearerToken); err == nil {
+		if len(parsed.Headers) > 0 {
+			h := parsed.Headers[0]
+			out.JWTHeader = map[string]interface{}{"alg": h.Algorithm}
+			if h.KeyID != "" {
+				out.JWTHeader["kid"] = h.KeyID
+			}
+			for k, v := range h.ExtraHeaders {
+				out.JWTHeader[string(k)] = v
+			}
+		}
+		if err := parsed.UnsafeClaimsWithoutVerification(&out.JWTClaims); err != nil {
+			out.JWTClaims = nil
+		}
+	}
+
+	return &out, nil
+}
+
 func (s *HTTPHandlers) ACLLogout(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	if s.checkACLDisabled() {
 		return nil, aclDisabled
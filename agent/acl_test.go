@@ -3,6 +3,7 @@ package agent
 import (
 	"fmt"
 	"io"
+	"net"
 	"testing"
 	"time"
 
@@ -95,6 +96,10 @@ func (a *TestACLAgent) ResolveToken(secretID string) (acl.Authorizer, error) {
 	return authz, err
 }
 
+func (a *TestACLAgent) CheckTokenSource(string, net.IP) error {
+	return nil
+}
+
 func (a *TestACLAgent) ResolveTokenAndDefaultMeta(secretID string, entMeta *acl.EnterpriseMeta, authzContext *acl.AuthorizerContext) (resolver.Result, error) {
 	authz, err := a.ResolveToken(secretID)
 	if err != nil {
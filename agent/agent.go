@@ -180,6 +180,10 @@ type delegate interface {
 	// default partition and namespace from the token.
 	ResolveTokenAndDefaultMeta(token string, entMeta *acl.EnterpriseMeta, authzContext *acl.AuthorizerContext) (resolver.Result, error)
 
+	// CheckTokenSource returns a permission denied error if the token is bound
+	// to CIDR blocks which do not contain source.
+	CheckTokenSource(token string, source net.IP) error
+
 	RPC(method string, args interface{}, reply interface{}) error
 	SnapshotRPC(args *structs.SnapshotRequest, in io.Reader, out io.Writer, replyFn structs.SnapshotReplyFn) error
 	Shutdown() error
@@ -345,6 +349,10 @@ type Agent struct {
 	// server to be pushed out to Envoy.
 	proxyConfig *proxycfg.Manager
 
+	// outlierEjections tracks the outlier ejections reported by local proxies
+	// whose checks are being refreshed.
+	outlierEjections outlierEjections
+
 	// serviceManager is the manager for combining local service registrations with
 	// the centrally configured proxy/service defaults.
 	serviceManager *ServiceManager
@@ -647,6 +655,7 @@ func (a *Agent) Start(ctx context.Context) error {
 		},
 		TLSConfigurator:       a.tlsConfigurator,
 		IntentionDefaultAllow: intentionDefaultAllow,
+		NodeMeta:              a.config.NodeMeta,
 	})
 	if err != nil {
 		return err
@@ -1515,6 +1524,11 @@ func (a *Agent) ShutdownAgent() error {
 		}
 	}
 
+	// Stop auditing now that the server is no longer handling requests
+	if err := a.baseDeps.AuditLogger.Close(); err != nil {
+		a.logger.Warn("could not close audit log", "error", err)
+	}
+
 	pidErr := a.deletePid()
 	if pidErr != nil {
 		a.logger.Warn("could not delete pid file", "error", pidErr)
@@ -4069,6 +4083,8 @@ func (a *Agent) registerCache() {
 		TestOverrideCAChangeInitialDelay: a.config.ConnectTestCALeafRootChangeSpread,
 	})
 
+	a.cache.RegisterType(cachetype.ConnectCAJWTName, &cachetype.ConnectCAJWT{RPC: a})
+
 	a.cache.RegisterType(cachetype.IntentionMatchName, &cachetype.IntentionMatch{RPC: a})
 
 	a.cache.RegisterType(cachetype.IntentionUpstreamsName, &cachetype.IntentionUpstreams{RPC: a})
@@ -4111,6 +4127,7 @@ func (a *Agent) registerCache() {
 		&cachetype.FederationStateListMeshGateways{RPC: a})
 
 	a.cache.RegisterType(cachetype.TrustBundleListName, &cachetype.TrustBundles{Client: a.rpcClientPeering})
+	a.cache.RegisterType(cachetype.PeeringListName, &cachetype.Peerings{Client: a.rpcClientPeering})
 
 	a.cache.RegisterType(cachetype.PeeredUpstreamsName, &cachetype.PeeredUpstreams{RPC: a})
 
@@ -4228,6 +4245,7 @@ func (a *Agent) proxyDataSources() proxycfg.DataSources {
 		InternalServiceDump:             proxycfgglue.CacheInternalServiceDump(a.cache),
 		LeafCertificate:                 proxycfgglue.CacheLeafCertificate(a.cache),
 		PeeredUpstreams:                 proxycfgglue.CachePeeredUpstreams(a.cache),
+		PeeringList:                     proxycfgglue.CachePeeringList(a.cache),
 		PreparedQuery:                   proxycfgglue.CachePrepraredQuery(a.cache),
 		ResolvedServiceConfig:           proxycfgglue.CacheResolvedServiceConfig(a.cache),
 		ServiceList:                     proxycfgglue.CacheServiceList(a.cache),
@@ -4255,6 +4273,7 @@ func (a *Agent) proxyDataSources() proxycfg.DataSources {
 		sources.Intentions = proxycfgglue.ServerIntentions(deps)
 		sources.IntentionUpstreams = proxycfgglue.ServerIntentionUpstreams(deps)
 		sources.PeeredUpstreams = proxycfgglue.ServerPeeredUpstreams(deps)
+		sources.PeeringList = proxycfgglue.ServerPeeringList(deps)
 		sources.ServiceList = proxycfgglue.ServerServiceList(deps, proxycfgglue.CacheServiceList(a.cache))
 		sources.TrustBundle = proxycfgglue.ServerTrustBundle(deps)
 		sources.TrustBundleList = proxycfgglue.ServerTrustBundleList(deps)
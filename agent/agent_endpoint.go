@@ -1574,11 +1574,129 @@ func (s *HTTPHandlers) AgentConnectCALeafCert(resp http.ResponseWriter, req *htt
 	return reply, nil
 }
 
+// AgentConnectCAJWT returns a JWT-SVID for a service, valid for the audiences
+// given by the "aud" query parameters. JWT-SVIDs are cached by the agent and
+// re-issued once half of their lifetime has passed.
+func (s *HTTPHandlers) AgentConnectCAJWT(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	// Get the service name. Note that this is the name of the service,
+	// not the ID of the service instance.
+	serviceName := strings.TrimPrefix(req.URL.Path, "/v1/agent/connect/ca/jwt/")
+	if serviceName == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Missing service name"}
+	}
+
+	args := structs.JWTSVIDRequest{
+		Service:  serviceName, // Need name not ID
+		Audience: req.URL.Query()["aud"],
+	}
+	if len(args.Audience) == 0 {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Missing audience"}
+	}
+	if ttl := req.URL.Query().Get("ttl"); ttl != "" {
+		dur, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Invalid ttl: %v", err)}
+		}
+		args.TTL = dur
+	}
+
+	if err := s.parseEntMetaNoWildcard(req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	// Check ACLs here too so that JWT-SVIDs cached for a token aren't served
+	// after it loses access to the service.
+	var authzContext acl.AuthorizerContext
+	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(serviceName, &authzContext); err != nil {
+		return nil, err
+	}
+
+	if !s.validateRequestPartition(resp, &args.EnterpriseMeta) {
+		return nil, nil
+	}
+
+	raw, m, err := s.agent.cache.Get(req.Context(), cachetype.ConnectCAJWTName, &args)
+	if err != nil {
+		return nil, err
+	}
+	defer setCacheMeta(resp, &m)
+
+	reply, ok := raw.(*structs.IssuedJWT)
+	if !ok {
+		// This should never happen, but we want to protect against panics
+		return nil, fmt.Errorf("internal error: response type not correct")
+	}
+	return reply, nil
+}
+
+// AgentConnectOutlierEvents reflects the outlier detection events of a local
+// proxy in the catalog. The body is a stream of events in the format Envoy
+// writes to its outlier detection event log, one JSON object per line.
+//
+// PUT /v1/agent/connect/outlier-events/:proxy_id
+func (s *HTTPHandlers) AgentConnectOutlierEvents(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	proxyID := strings.TrimPrefix(req.URL.Path, "/v1/agent/connect/outlier-events/")
+	sid := structs.NewServiceID(proxyID, nil)
+	if sid.ID == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Missing proxy ID"}
+	}
+
+	// Get the provided token, if any, and vet against any ACL policies.
+	var token string
+	s.parseToken(req, &token)
+
+	if err := s.parseEntMetaNoWildcard(req, &sid.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+
+	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &sid.EnterpriseMeta, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sid.Normalize()
+
+	if !s.validateRequestPartition(resp, &sid.EnterpriseMeta) {
+		return nil, nil
+	}
+
+	if err := s.agent.vetServiceUpdateWithAuthorizer(authz, sid); err != nil {
+		return nil, err
+	}
+
+	if s.agent.State.Service(sid) == nil {
+		return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: fmt.Sprintf("Unknown proxy service ID %q", sid)}
+	}
+
+	dec := json.NewDecoder(req.Body)
+	for dec.More() {
+		var event OutlierEvent
+		if err := dec.Decode(&event); err != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Request decode failed: %v", err)}
+		}
+		if event.Action != outlierEjectAction && event.Action != outlierUnejectAction {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Unsupported outlier event action: %q", event.Action)}
+		}
+		if err := s.agent.ReportOutlierEvent(sid, event, token); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
 // AgentConnectAuthorize
 //
 // POST /v1/agent/connect/authorize
 //
-// NOTE: This endpoint treats any L7 intentions as DENY.
+// NOTE: This endpoint treats any L7 intentions as DENY unless the request
+// includes the HTTP request to evaluate them against.
 //
 // Note: when this logic changes, consider if the Intention.Check RPC method
 // also needs to be updated.
@@ -6660,8 +6660,12 @@ func TestAgentConnectCALeafCert_aclServiceWrite(t *testing.T) {
 }
 
 func createACLTokenWithServicePolicy(t *testing.T, srv *HTTPHandlers, policy string) string {
+	return createACLTokenWithServicePolicyName(t, srv, "service-test-write", policy)
+}
+
+func createACLTokenWithServicePolicyName(t *testing.T, srv *HTTPHandlers, name, policy string) string {
 	policyReq := &structs.ACLPolicy{
-		Name:  "service-test-write",
+		Name:  name,
 		Rules: fmt.Sprintf(`service "test" { policy = "%v" }`, policy),
 	}
 
@@ -6672,7 +6676,7 @@ func createACLTokenWithServicePolicy(t *testing.T, srv *HTTPHandlers, policy str
 
 	tokenReq := &structs.ACLToken{
 		Description: "token-for-test",
-		Policies:    []structs.ACLTokenPolicyLink{{Name: "service-test-write"}},
+		Policies:    []structs.ACLTokenPolicyLink{{Name: name}},
 	}
 
 	req, _ = http.NewRequest("PUT", "/v1/acl/token?token=root", jsonReader(tokenReq))
@@ -7423,6 +7427,169 @@ func requireLeafValidUnderCA(t *testing.T, issued *structs.IssuedCert, ca *struc
 	require.NoError(t, err)
 }
 
+func TestAgentConnectCAJWT(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, TestACLConfig())
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+	testrpc.WaitForActiveCARoot(t, a.RPC, "dc1", nil)
+
+	writeToken := createACLTokenWithServicePolicy(t, a.srv, "write")
+	readToken := createACLTokenWithServicePolicyName(t, a.srv, "service-test-read", "read")
+
+	t.Run("service write", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/connect/ca/jwt/test?aud=db&aud=cache&ttl=2m&token="+writeToken, nil)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, "body: %s", resp.Body.String())
+		require.Equal(t, "MISS", resp.Header().Get("X-Cache"))
+
+		var issued structs.IssuedJWT
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&issued))
+		require.Equal(t, "test", issued.Service)
+		require.Equal(t, []string{"db", "cache"}, issued.Audience)
+		require.NotEmpty(t, issued.Token)
+		require.WithinDuration(t, time.Now().Add(2*time.Minute), issued.ExpiresAt, 10*time.Second)
+
+		// The JWT-SVID is cached for the same request.
+		resp = httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, "body: %s", resp.Body.String())
+		require.Equal(t, "HIT", resp.Header().Get("X-Cache"))
+
+		var cached structs.IssuedJWT
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&cached))
+		require.Equal(t, issued.Token, cached.Token)
+	})
+
+	t.Run("service read", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/connect/ca/jwt/test?aud=db&token="+readToken, nil)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusForbidden, resp.Code)
+	})
+
+	t.Run("no audience", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/connect/ca/jwt/test?token="+writeToken, nil)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, resp.Body.String(), "Missing audience")
+	})
+}
+
+func TestAgentConnectOutlierEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	testrpc.WaitForActiveCARoot(t, a.RPC, "dc1", nil)
+
+	var roots structs.IndexedCARoots
+	require.NoError(t, a.RPC("ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+
+	// Register an instance of the upstream on another node.
+	var out struct{}
+	require.NoError(t, a.RPC("Catalog.Register", &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "db-node",
+		Address:    "10.1.2.3",
+		Service: &structs.NodeService{
+			ID:      "db-1",
+			Service: "db",
+			Port:    8080,
+			Connect: structs.ServiceConnect{Native: true},
+		},
+	}, &out))
+
+	require.NoError(t, a.addServiceFromSource(&structs.NodeService{
+		Kind:    structs.ServiceKindConnectProxy,
+		ID:      "web-proxy",
+		Service: "web-proxy",
+		Port:    21000,
+		Proxy: structs.ConnectProxyConfig{
+			DestinationServiceName: "web",
+			Upstreams: structs.Upstreams{
+				{
+					DestinationName: "db",
+					LocalBindPort:   9191,
+					Config: map[string]interface{}{
+						"passive_health_check": map[string]interface{}{
+							"max_failures":     5,
+							"report_ejections": true,
+						},
+					},
+				},
+			},
+		},
+	}, nil, false, "", ConfigSourceLocal))
+
+	checkID := types.CheckID(structs.OutlierEjectionCheckPrefix + a.Config.NodeName + "/web-proxy/db-1")
+	ejectionCheck := func(t require.TestingT) *structs.HealthCheck {
+		var checks structs.IndexedHealthChecks
+		require.NoError(t, a.RPC("Health.ServiceChecks", &structs.ServiceSpecificRequest{
+			Datacenter:  "dc1",
+			ServiceName: "db",
+		}, &checks))
+		for _, chk := range checks.HealthChecks {
+			if chk.CheckID == checkID {
+				return chk
+			}
+		}
+		return nil
+	}
+	sendEvent := func(t require.TestingT, action string) {
+		body := fmt.Sprintf(`{"type":"CONSECUTIVE_5XX","cluster_name":"db.default.dc1.internal.%s","upstream_url":"10.1.2.3:8080","action":%q}`, roots.TrustDomain, action)
+		req, _ := http.NewRequest("PUT", "/v1/agent/connect/outlier-events/web-proxy", strings.NewReader(body+"\n"))
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, "body: %s", resp.Body.String())
+	}
+
+	retry.Run(t, func(r *retry.R) {
+		sendEvent(r, "EJECT")
+		chk := ejectionCheck(r)
+		require.NotNil(r, chk)
+		require.Equal(r, api.HealthWarning, chk.Status)
+		require.Equal(r, "db-node", chk.Node)
+		require.Equal(r, "db-1", chk.ServiceID)
+		require.Equal(r, outlierEjectionCheckTTL, chk.Definition.TTL)
+		require.Contains(r, chk.Output, "CONSECUTIVE_5XX")
+	})
+
+	sendEvent(t, "UNEJECT")
+	require.Nil(t, ejectionCheck(t))
+
+	a.outlierEjections.Lock()
+	require.Empty(t, a.outlierEjections.refreshing)
+	a.outlierEjections.Unlock()
+
+	t.Run("unsupported action", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/v1/agent/connect/outlier-events/web-proxy", strings.NewReader(`{"action":"DEGRADE"}`))
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, resp.Body.String(), "Unsupported outlier event action")
+	})
+
+	t.Run("unknown proxy", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/v1/agent/connect/outlier-events/nope", strings.NewReader(""))
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
 func TestAgentConnectAuthorize_badBody(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -7657,6 +7824,119 @@ func TestAgentConnectAuthorize_deny(t *testing.T) {
 	assert.Contains(t, obj.Reason, "Matched")
 }
 
+func TestAgentConnectAuthorize_L7(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	target := "db"
+
+	for _, entry := range []structs.ConfigEntry{
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     target,
+			Protocol: "http",
+		},
+		&structs.ServiceIntentionsConfigEntry{
+			Kind: structs.ServiceIntentions,
+			Name: target,
+			Sources: []*structs.SourceIntention{
+				{
+					Name: "web",
+					Permissions: []*structs.IntentionPermission{
+						{
+							Action: structs.IntentionActionDeny,
+							HTTP: &structs.IntentionHTTPPermission{
+								PathPrefix: "/admin",
+							},
+						},
+						{
+							Action: structs.IntentionActionAllow,
+							HTTP: &structs.IntentionHTTPPermission{
+								PathRegex: "/v[0-9]+/.*",
+								Methods:   []string{"GET"},
+								Header: []structs.IntentionHTTPHeaderPermission{
+									{Name: "x-debug", Present: true, Invert: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		req := structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Entry:      entry,
+		}
+		var reply bool
+		require.NoError(t, a.RPC("ConfigEntry.Apply", &req, &reply))
+	}
+
+	cases := []struct {
+		name       string
+		http       *structs.ConnectAuthorizeHTTPRequest
+		authorized bool
+		reason     string
+	}{
+		{
+			name:   "no request",
+			reason: "Matched L7 intention:",
+		},
+		{
+			name:       "allowed",
+			http:       &structs.ConnectAuthorizeHTTPRequest{Method: "GET", Path: "/v1/users"},
+			authorized: true,
+			reason:     "Matched L7 intention permission",
+		},
+		{
+			name:   "denied by permission",
+			http:   &structs.ConnectAuthorizeHTTPRequest{Method: "GET", Path: "/admin/users"},
+			reason: "Matched L7 intention permission",
+		},
+		{
+			name: "inverted header falls through",
+			http: &structs.ConnectAuthorizeHTTPRequest{
+				Method: "GET",
+				Path:   "/v1/users",
+				Header: map[string][]string{"X-Debug": {"1"}},
+			},
+			authorized: true,
+			reason:     "Default behavior",
+		},
+		{
+			name:       "no permission matches",
+			http:       &structs.ConnectAuthorizeHTTPRequest{Method: "POST", Path: "/v1/users"},
+			authorized: true,
+			reason:     "Default behavior",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := &structs.ConnectAuthorizeRequest{
+				Target:        target,
+				ClientCertURI: connect.TestSpiffeIDService(t, "web").URI().String(),
+				HTTP:          tc.http,
+			}
+			req, _ := http.NewRequest("POST", "/v1/agent/connect/authorize", jsonReader(args))
+			resp := httptest.NewRecorder()
+			a.srv.h.ServeHTTP(resp, req)
+			require.Equal(t, 200, resp.Code)
+
+			obj := &connectAuthorizeResp{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(obj))
+			require.Equal(t, tc.authorized, obj.Authorized)
+			require.Contains(t, obj.Reason, tc.reason)
+		})
+	}
+}
+
 // Test when there is an intention allowing service with a different trust
 // domain. We allow this because migration between trust domains shouldn't cause
 // an outage even if we have stale info about current trusted domains. It's safe
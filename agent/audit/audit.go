@@ -0,0 +1,244 @@
+// Package audit records a structured event for every API request handled by
+// the agent, and every RPC request handled by a server, to one or more sinks.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hashicorp/consul/logging"
+)
+
+const (
+	// EventTypeHTTP identifies events for requests to the HTTP API.
+	EventTypeHTTP = "http"
+
+	// EventTypeRPC identifies events for RPC requests handled by a server.
+	EventTypeRPC = "rpc"
+
+	SinkTypeFile = "file"
+
+	SinkFormatJSON = "json"
+
+	DeliveryGuaranteeBestEffort = "best-effort"
+)
+
+// Config is the audit logging configuration of an agent.
+type Config struct {
+	// Enabled turns on audit logging.
+	Enabled bool
+
+	// Sinks are the destinations that audit events are written to.
+	Sinks []SinkConfig
+
+	// Filter limits which requests produce audit events.
+	Filter FilterConfig
+}
+
+// SinkConfig configures a single destination for audit events.
+type SinkConfig struct {
+	Name              string
+	Type              string
+	Format            string
+	DeliveryGuarantee string
+
+	// Path is the file that events are written to. Rotated files are named
+	// after it with the time of their creation inserted before the extension.
+	Path string
+
+	// Mode is the permission set on the files, 0640 if unset.
+	Mode os.FileMode
+
+	RotateBytes    int
+	RotateDuration time.Duration
+	RotateMaxFiles int
+}
+
+// FilterConfig limits which requests produce audit events.
+//
+// Paths are matched against the endpoint of the event, which is the URL path
+// of an HTTP request or the method name (e.g. "ACL.TokenRead") of an RPC
+// request. A path ending in "*" matches any endpoint starting with the rest of
+// it. Methods are matched case-insensitively against the HTTP method of an
+// HTTP request, or against "read" or "write" for an RPC request.
+//
+// When an include list is empty all requests are included. Exclusions are
+// applied after inclusions.
+type FilterConfig struct {
+	IncludePaths   []string
+	ExcludePaths   []string
+	IncludeMethods []string
+	ExcludeMethods []string
+}
+
+// Event is the record of a single request.
+type Event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"request_id"`
+
+	// AccessorID is the accessor of the token used for the request, if it
+	// could be resolved.
+	AccessorID string `json:"accessor_id,omitempty"`
+
+	// TokenHash is the hash of the secret of the token used for the request.
+	// The secret itself is never recorded.
+	TokenHash string `json:"token_hash,omitempty"`
+
+	Endpoint string `json:"endpoint"`
+	Method   string `json:"method"`
+
+	// Query is the query string of an HTTP request, with any tokens replaced
+	// by their hash.
+	Query string `json:"query,omitempty"`
+
+	// Status is the response code of an HTTP request. It is not set for RPC
+	// requests.
+	Status int `json:"status,omitempty"`
+
+	// Error is the error returned by an RPC request, or the reason an HTTP
+	// request failed.
+	Error string `json:"error,omitempty"`
+
+	SourceIP  string  `json:"source_ip,omitempty"`
+	ElapsedMS float64 `json:"elapsed_ms"`
+}
+
+// HashSecret returns the value recorded in audit events in place of secret,
+// so that requests made with the same token can be correlated without the
+// secret being written to the sinks.
+func HashSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(secret))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Logger writes audit events to the configured sinks. A nil Logger, or one
+// created from a disabled Config, discards all events.
+type Logger struct {
+	logger hclog.Logger
+	filter FilterConfig
+
+	lock  sync.Mutex
+	sinks []io.WriteCloser
+}
+
+// New creates the Logger described by config, opening all of its sinks.
+func New(config Config, logger hclog.Logger) (*Logger, error) {
+	l := &Logger{logger: logger, filter: config.Filter}
+	if !config.Enabled {
+		return l, nil
+	}
+
+	for _, sink := range config.Sinks {
+		if sink.Type != SinkTypeFile {
+			l.Close()
+			return nil, fmt.Errorf("audit sink %q: unsupported type %q", sink.Name, sink.Type)
+		}
+		file, err := logging.NewLogFile(sink.Path, sink.Mode, sink.RotateDuration, sink.RotateBytes, sink.RotateMaxFiles)
+		if err != nil {
+			l.Close()
+			return nil, fmt.Errorf("audit sink %q: %w", sink.Name, err)
+		}
+		l.sinks = append(l.sinks, file)
+	}
+	return l, nil
+}
+
+// Enabled returns whether events passed to Log are written anywhere.
+func (l *Logger) Enabled() bool {
+	if l == nil {
+		return false
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return len(l.sinks) > 0
+}
+
+// Log writes event to every sink if it passes the configured filters. Write
+// failures are logged rather than returned as delivery is best-effort.
+func (l *Logger) Log(event *Event) {
+	if l == nil || !l.filter.allows(event) {
+		return
+	}
+
+	buf, err := json.Marshal(event)
+	if err != nil {
+		l.logger.Error("failed to encode audit event", "error", err)
+		return
+	}
+	buf = append(buf, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, sink := range l.sinks {
+		if _, err := sink.Write(buf); err != nil {
+			l.logger.Error("failed to write audit event", "error", err)
+		}
+	}
+}
+
+// Close closes all sinks. Events logged afterwards are discarded.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var result error
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	l.sinks = nil
+	return result
+}
+
+func (f FilterConfig) allows(event *Event) bool {
+	if len(f.IncludePaths) > 0 && !matchPath(f.IncludePaths, event.Endpoint) {
+		return false
+	}
+	if matchPath(f.ExcludePaths, event.Endpoint) {
+		return false
+	}
+	if len(f.IncludeMethods) > 0 && !matchMethod(f.IncludeMethods, event.Method) {
+		return false
+	}
+	return !matchMethod(f.ExcludeMethods, event.Method)
+}
+
+func matchPath(patterns []string, endpoint string) bool {
+	for _, pattern := range patterns {
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if strings.HasPrefix(endpoint, prefix) {
+				return true
+			}
+		} else if pattern == endpoint {
+			return true
+		}
+	}
+	return false
+}
+
+func matchMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
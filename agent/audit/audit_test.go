@@ -0,0 +1,104 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func readEvents(t *testing.T, dir string) []Event {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(dir, "audit-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestLogger_Log(t *testing.T) {
+	dir := testutil.TempDir(t, "audit")
+
+	l, err := New(Config{
+		Enabled: true,
+		Sinks: []SinkConfig{{
+			Name:              "test",
+			Type:              SinkTypeFile,
+			Format:            SinkFormatJSON,
+			DeliveryGuarantee: DeliveryGuaranteeBestEffort,
+			Path:              filepath.Join(dir, "audit.json"),
+		}},
+		Filter: FilterConfig{
+			IncludePaths:   []string{"/v1/kv/*", "ACL.*"},
+			ExcludePaths:   []string{"/v1/kv/private/*"},
+			ExcludeMethods: []string{"get", "read"},
+		},
+	}, hclog.NewNullLogger())
+	require.NoError(t, err)
+	require.True(t, l.Enabled())
+
+	l.Log(&Event{Type: EventTypeHTTP, Endpoint: "/v1/kv/foo", Method: "PUT", Status: 200})
+	l.Log(&Event{Type: EventTypeHTTP, Endpoint: "/v1/kv/foo", Method: "GET", Status: 200})
+	l.Log(&Event{Type: EventTypeHTTP, Endpoint: "/v1/kv/private/foo", Method: "PUT", Status: 200})
+	l.Log(&Event{Type: EventTypeHTTP, Endpoint: "/v1/catalog/nodes", Method: "PUT", Status: 200})
+	l.Log(&Event{Type: EventTypeRPC, Endpoint: "ACL.TokenSet", Method: "write"})
+	l.Log(&Event{Type: EventTypeRPC, Endpoint: "ACL.TokenRead", Method: "read"})
+	require.NoError(t, l.Close())
+
+	// Events are discarded once the logger is closed.
+	l.Log(&Event{Type: EventTypeHTTP, Endpoint: "/v1/kv/bar", Method: "PUT", Status: 200})
+
+	events := readEvents(t, dir)
+	require.Len(t, events, 2)
+	require.Equal(t, "/v1/kv/foo", events[0].Endpoint)
+	require.Equal(t, "ACL.TokenSet", events[1].Endpoint)
+}
+
+func TestLogger_Disabled(t *testing.T) {
+	var nilLogger *Logger
+	require.False(t, nilLogger.Enabled())
+	nilLogger.Log(&Event{})
+	require.NoError(t, nilLogger.Close())
+
+	dir := testutil.TempDir(t, "audit")
+	l, err := New(Config{
+		Sinks: []SinkConfig{{Type: SinkTypeFile, Path: filepath.Join(dir, "audit.json")}},
+	}, hclog.NewNullLogger())
+	require.NoError(t, err)
+	require.False(t, l.Enabled())
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestHashSecret(t *testing.T) {
+	require.Empty(t, HashSecret(""))
+
+	secret := "2e8a43ae-9a48-4b1f-8b2c-0dc36bbd1a5e"
+	hash := HashSecret(secret)
+	require.True(t, strings.HasPrefix(hash, "sha256:"))
+	require.NotContains(t, hash, secret)
+	require.Equal(t, hash, HashSecret(secret))
+	require.NotEqual(t, hash, HashSecret("other"))
+}
@@ -0,0 +1,62 @@
+package cachetype
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/consul/agent/cache"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// Recommended name for registration.
+const ConnectCAJWTName = "connect-ca-jwt"
+
+// ConnectCAJWT supports fetching JWT-SVIDs for services. JWT-SVIDs can't be
+// watched for changes; the request's MaxAge makes the cache sign a new one
+// once half of the lifetime of the cached one has passed.
+type ConnectCAJWT struct {
+	RPC RPC
+}
+
+func (c *ConnectCAJWT) RegisterOptions() cache.RegisterOptions {
+	return cache.RegisterOptions{
+		Refresh:          false,
+		SupportsBlocking: false,
+		// Unused JWT-SVIDs are evicted once they are guaranteed to have
+		// expired.
+		LastGetTTL: structs.MaxJWTSVIDTTL,
+	}
+}
+
+func (c *ConnectCAJWT) Fetch(opts cache.FetchOptions, req cache.Request) (cache.FetchResult, error) {
+	var result cache.FetchResult
+
+	// The request should be a JWTSVIDRequest.
+	reqReal, ok := req.(*structs.JWTSVIDRequest)
+	if !ok {
+		return result, fmt.Errorf(
+			"Internal cache failure: request wrong type: %T", req)
+	}
+
+	// Lightweight copy this object so that manipulating QueryOptions doesn't race.
+	dup := *reqReal
+	reqReal = &dup
+
+	// Any server can sign, so there's no point in hitting the leader.
+	reqReal.AllowStale = true
+
+	// Fetch
+	var reply structs.IssuedJWT
+	if err := c.RPC.RPC("ConnectCA.SignJWT", reqReal, &reply); err != nil {
+		return result, err
+	}
+
+	result.Value = &reply
+
+	// This is a purely synthetic index to keep the caching happy; every
+	// fetch returns a new token.
+	result.Index = 1
+	if opts.LastResult != nil {
+		result.Index = opts.LastResult.Index + 1
+	}
+	return result, nil
+}
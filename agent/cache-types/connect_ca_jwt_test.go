@@ -0,0 +1,62 @@
+package cachetype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/cache"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestConnectCAJWT(t *testing.T) {
+	rpc := TestRPC(t)
+	typ := &ConnectCAJWT{RPC: rpc}
+
+	// Expect the proper RPC call. This also sets the expected value
+	// since that is return-by-pointer in the arguments.
+	var resp *structs.IssuedJWT
+	rpc.On("RPC", "ConnectCA.SignJWT", mock.Anything, mock.Anything).Return(nil).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(*structs.JWTSVIDRequest)
+			require.True(t, req.AllowStale)
+			require.Equal(t, "web", req.Service)
+			require.Equal(t, []string{"db"}, req.Audience)
+
+			reply := args.Get(2).(*structs.IssuedJWT)
+			reply.Token = "token"
+			reply.Service = req.Service
+			reply.Audience = req.Audience
+			resp = reply
+		})
+
+	req := &structs.JWTSVIDRequest{Service: "web", Audience: []string{"db"}, TTL: time.Minute}
+	result, err := typ.Fetch(cache.FetchOptions{}, req)
+	require.NoError(t, err)
+	require.Equal(t, cache.FetchResult{
+		Value: resp,
+		Index: 1,
+	}, result)
+	require.False(t, req.AllowStale)
+
+	// Every fetch signs a new token.
+	result2, err := typ.Fetch(cache.FetchOptions{LastResult: &result}, req)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), result2.Index)
+
+	rpc.AssertExpectations(t)
+}
+
+func TestConnectCAJWT_badReqType(t *testing.T) {
+	rpc := TestRPC(t)
+	typ := &ConnectCAJWT{RPC: rpc}
+
+	// Fetch
+	_, err := typ.Fetch(cache.FetchOptions{}, cache.TestRequest(
+		t, cache.RequestInfo{Key: "foo", MinIndex: 64}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "wrong type")
+	rpc.AssertExpectations(t)
+}
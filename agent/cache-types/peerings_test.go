@@ -0,0 +1,62 @@
+package cachetype
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/consul/agent/cache"
+	"github.com/hashicorp/consul/proto/pbpeering"
+)
+
+type fakePeeringLister struct {
+	req  *pbpeering.PeeringListRequest
+	resp *pbpeering.PeeringListResponse
+}
+
+func (f *fakePeeringLister) PeeringList(_ context.Context, req *pbpeering.PeeringListRequest, _ ...grpc.CallOption) (*pbpeering.PeeringListResponse, error) {
+	f.req = req
+	return f.resp, nil
+}
+
+func TestPeerings(t *testing.T) {
+	client := &fakePeeringLister{
+		resp: &pbpeering.PeeringListResponse{
+			Index: 48,
+			Peerings: []*pbpeering.Peering{
+				{
+					Name:                "peer1",
+					ID:                  "8ac403cf-6834-412f-9dfe-0ac6e69bd89f",
+					PeerServerAddresses: []string{"1.2.3.4"},
+				},
+			},
+		},
+	}
+	typ := &Peerings{Client: client}
+
+	// Fetch and assert against the result.
+	result, err := typ.Fetch(cache.FetchOptions{}, &PeeringListRequest{
+		Request: &pbpeering.PeeringListRequest{
+			Partition: "default",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "default", client.req.Partition)
+	require.Equal(t, cache.FetchResult{
+		Value: client.resp,
+		Index: 48,
+	}, result)
+}
+
+func TestPeerings_badReqType(t *testing.T) {
+	client := pbpeering.NewPeeringServiceClient(nil)
+	typ := &Peerings{Client: client}
+
+	// Fetch
+	_, err := typ.Fetch(cache.FetchOptions{}, cache.TestRequest(
+		t, cache.RequestInfo{Key: "foo", MinIndex: 64}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "wrong type")
+}
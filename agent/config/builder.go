@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/memberlist"
 	"golang.org/x/time/rate"
 
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/connect/ca"
@@ -695,6 +696,10 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 			"private_key":           "PrivateKey",
 			"root_cert":             "RootCert",
 			"intermediate_cert_ttl": "IntermediateCertTTL",
+			"pkcs11_lib":            "PKCS11Lib",
+			"pkcs11_token_label":    "PKCS11TokenLabel",
+			"pkcs11_pin_file":       "PKCS11PinFile",
+			"pkcs11_key_label":      "PKCS11KeyLabel",
 
 			// Vault CA config
 			"address":                    "Address",
@@ -714,6 +719,9 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 			"existing_arn":   "ExistingARN",
 			"delete_on_exit": "DeleteOnExit",
 
+			// File CA config
+			"root_cert_file": "RootCertFile",
+
 			// Common CA config
 			"leaf_cert_ttl":      "LeafCertTTL",
 			"csr_max_per_second": "CSRMaxPerSecond",
@@ -935,6 +943,7 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		AdvertiseAddrLAN:          advertiseAddrLAN,
 		AdvertiseAddrWAN:          advertiseAddrWAN,
 		AdvertiseReconnectTimeout: b.durationVal("advertise_reconnect_timeout", c.AdvertiseReconnectTimeout),
+		Audit:                     b.auditVal(c.Audit),
 		BindAddr:                  bindAddr,
 		Bootstrap:                 boolVal(c.Bootstrap),
 		BootstrapExpect:           intVal(c.BootstrapExpect),
@@ -1400,6 +1409,7 @@ func (b *builder) validate(rt RuntimeConfig) error {
 		structs.ConsulCAProvider: true,
 		structs.VaultCAProvider:  true,
 		structs.AWSCAProvider:    true,
+		structs.FileCAProvider:   true,
 	}
 	if _, ok := validCAProviders[rt.ConnectCAProvider]; !ok {
 		return fmt.Errorf("%s is not a valid CA provider", rt.ConnectCAProvider)
@@ -1417,6 +1427,10 @@ func (b *builder) validate(rt RuntimeConfig) error {
 			if _, err := ca.ParseAWSCAConfig(rt.ConnectCAConfig); err != nil {
 				return err
 			}
+		case structs.FileCAProvider:
+			if _, err := ca.ParseFileCAConfig(rt.ConnectCAConfig); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1475,6 +1489,10 @@ func (b *builder) validate(rt RuntimeConfig) error {
 		return err
 	}
 
+	if err := validateAudit(rt.Audit); err != nil {
+		return err
+	}
+
 	if err := validateRemoteScriptsChecks(rt); err != nil {
 		// TODO: make this an error in a future version
 		b.warn(err.Error())
@@ -2273,6 +2291,69 @@ func (b *builder) makeAddrs(pri []net.Addr, sec []*net.IPAddr, port int) []net.A
 	return x
 }
 
+func (b *builder) auditVal(raw Audit) audit.Config {
+	val := audit.Config{
+		Enabled: boolVal(raw.Enabled),
+		Filter: audit.FilterConfig{
+			IncludePaths:   raw.Filter.IncludePaths,
+			ExcludePaths:   raw.Filter.ExcludePaths,
+			IncludeMethods: raw.Filter.IncludeMethods,
+			ExcludeMethods: raw.Filter.ExcludeMethods,
+		},
+	}
+
+	names := make([]string, 0, len(raw.Sinks))
+	for name := range raw.Sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		sink := raw.Sinks[name]
+		var mode os.FileMode
+		if m := stringVal(sink.Mode); m != "" {
+			v, err := strconv.ParseUint(m, 8, 32)
+			if err != nil {
+				b.err = multierror.Append(b.err, fmt.Errorf("audit.sink[%s].mode: invalid file mode %q", name, m))
+			}
+			mode = os.FileMode(v)
+		}
+		val.Sinks = append(val.Sinks, audit.SinkConfig{
+			Name:              name,
+			Type:              stringValWithDefault(sink.Type, audit.SinkTypeFile),
+			Format:            stringValWithDefault(sink.Format, audit.SinkFormatJSON),
+			DeliveryGuarantee: stringValWithDefault(sink.DeliveryGuarantee, audit.DeliveryGuaranteeBestEffort),
+			Path:              stringVal(sink.Path),
+			Mode:              mode,
+			RotateBytes:       intVal(sink.RotateBytes),
+			RotateDuration:    b.durationVal(fmt.Sprintf("audit.sink[%s].rotate_duration", name), sink.RotateDuration),
+			RotateMaxFiles:    intVal(sink.RotateMaxFiles),
+		})
+	}
+	return val
+}
+
+func validateAudit(cfg audit.Config) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	for _, sink := range cfg.Sinks {
+		switch {
+		case sink.Type != audit.SinkTypeFile:
+			return fmt.Errorf("audit.sink[%s].type must be %q", sink.Name, audit.SinkTypeFile)
+		case sink.Format != audit.SinkFormatJSON:
+			return fmt.Errorf("audit.sink[%s].format must be %q", sink.Name, audit.SinkFormatJSON)
+		case sink.DeliveryGuarantee != audit.DeliveryGuaranteeBestEffort:
+			return fmt.Errorf("audit.sink[%s].delivery_guarantee must be %q", sink.Name, audit.DeliveryGuaranteeBestEffort)
+		case sink.Path == "":
+			return fmt.Errorf("audit.sink[%s].path is required", sink.Name)
+		case sink.RotateDuration == 0 && sink.RotateBytes == 0:
+			return fmt.Errorf("audit.sink[%s] requires at least one of rotate_duration or rotate_bytes", sink.Name)
+		}
+	}
+	return nil
+}
+
 func (b *builder) autoConfigVal(raw AutoConfigRaw, agentPartition string) AutoConfig {
 	var val AutoConfig
 
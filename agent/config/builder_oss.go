@@ -50,9 +50,6 @@ func validateEnterpriseConfigKeys(config *Config) []error {
 		add("acl.tokens.managed_service_provider")
 		config.ACL.Tokens.ManagedServiceProvider = nil
 	}
-	if boolVal(config.Audit.Enabled) || len(config.Audit.Sinks) > 0 {
-		add("audit")
-	}
 	if config.LicensePath != nil {
 		add("license_path")
 		config.LicensePath = nil
@@ -782,6 +782,15 @@ type ConfigEntries struct {
 type Audit struct {
 	Enabled *bool                `mapstructure:"enabled"`
 	Sinks   map[string]AuditSink `mapstructure:"sink"`
+	Filter  AuditFilter          `mapstructure:"filter"`
+}
+
+// AuditFilter limits which requests are audited
+type AuditFilter struct {
+	IncludePaths   []string `mapstructure:"include_paths"`
+	ExcludePaths   []string `mapstructure:"exclude_paths"`
+	IncludeMethods []string `mapstructure:"include_methods"`
+	ExcludeMethods []string `mapstructure:"exclude_methods"`
 }
 
 // AuditSink can be provided multiple times to define pipelines for auditing
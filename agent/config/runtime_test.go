@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/consul"
@@ -4606,6 +4607,50 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 		expectedErr: "auto_config.enabled cannot be set to true for server agents",
 	})
 
+	run(t, testCase{
+		desc: "audit sink without rotation",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		hcl: []string{`
+			audit {
+				enabled = true
+				sink "main" {
+					path = "/tmp/audit.json"
+				}
+			}
+		`},
+		json: []string{`
+			{
+				"audit": {
+					"enabled": true,
+					"sink": {
+						"main": {
+							"path": "/tmp/audit.json"
+						}
+					}
+				}
+			}`},
+		expectedErr: "audit.sink[main] requires at least one of rotate_duration or rotate_bytes",
+	})
+
+	run(t, testCase{
+		desc: "audit sink invalid mode",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		hcl: []string{`
+			audit {
+				sink "main" {
+					path = "/tmp/audit.json"
+					mode = "rw"
+				}
+			}
+		`},
+		json:        []string{`{ "audit": { "sink": { "main": { "path": "/tmp/audit.json", "mode": "rw" } } } }`},
+		expectedErr: `audit.sink[main].mode: invalid file mode "rw"`,
+	})
+
 	run(t, testCase{
 		desc: "auto config tls not enabled",
 		args: []string{
@@ -5718,12 +5763,32 @@ func TestLoad_FullConfig(t *testing.T) {
 			ACLPolicyTTL:     1123 * time.Second,
 			ACLRoleTTL:       9876 * time.Second,
 		},
-		ACLEnableKeyListPolicy:           true,
-		ACLInitialManagementToken:        "3820e09a",
-		ACLTokenReplication:              true,
-		AdvertiseAddrLAN:                 ipAddr("17.99.29.16"),
-		AdvertiseAddrWAN:                 ipAddr("78.63.37.19"),
-		AdvertiseReconnectTimeout:        0 * time.Second,
+		ACLEnableKeyListPolicy:    true,
+		ACLInitialManagementToken: "3820e09a",
+		ACLTokenReplication:       true,
+		AdvertiseAddrLAN:          ipAddr("17.99.29.16"),
+		AdvertiseAddrWAN:          ipAddr("78.63.37.19"),
+		AdvertiseReconnectTimeout: 0 * time.Second,
+		Audit: audit.Config{
+			Enabled: true,
+			Sinks: []audit.SinkConfig{{
+				Name:              "My sink",
+				Type:              "file",
+				Format:            "json",
+				DeliveryGuarantee: "best-effort",
+				Path:              "/tmp/audit/audit.json",
+				Mode:              0600,
+				RotateBytes:       25165824,
+				RotateDuration:    24 * time.Hour,
+				RotateMaxFiles:    15,
+			}},
+			Filter: audit.FilterConfig{
+				IncludePaths:   []string{"/v1/acl/*", "ACL.*"},
+				ExcludePaths:   []string{"/v1/acl/token/self"},
+				IncludeMethods: []string{"PUT", "write"},
+				ExcludeMethods: []string{"GET"},
+			},
+		},
 		AutopilotCleanupDeadServers:      true,
 		AutopilotDisableUpgradeMigration: true,
 		AutopilotLastContactThreshold:    12705 * time.Second,
@@ -50,6 +50,22 @@ advertise_addr_wan = "78.63.37.19"
 advertise_reconnect_timeout = "0s"
 audit = {
     enabled = true
+    sink "My sink" {
+        type = "file"
+        format = "json"
+        path = "/tmp/audit/audit.json"
+        delivery_guarantee = "best-effort"
+        mode = "0600"
+        rotate_duration = "24h"
+        rotate_max_files = 15
+        rotate_bytes = 25165824
+    }
+    filter {
+        include_paths = ["/v1/acl/*", "ACL.*"]
+        exclude_paths = ["/v1/acl/token/self"]
+        include_methods = ["PUT", "write"]
+        exclude_methods = ["GET"]
+    }
 }
 auto_config = {
     enabled = false
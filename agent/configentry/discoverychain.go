@@ -14,15 +14,20 @@ type DiscoveryChainSet struct {
 	Resolvers     map[structs.ServiceID]*structs.ServiceResolverConfigEntry
 	Services      map[structs.ServiceID]*structs.ServiceConfigEntry
 	ProxyDefaults map[string]*structs.ProxyConfigEntry
+
+	// SamenessGroups are the sameness groups of the partition the chain is
+	// evaluated in, keyed by name.
+	SamenessGroups map[string]*structs.SamenessGroupConfigEntry
 }
 
 func NewDiscoveryChainSet() *DiscoveryChainSet {
 	return &DiscoveryChainSet{
-		Routers:       make(map[structs.ServiceID]*structs.ServiceRouterConfigEntry),
-		Splitters:     make(map[structs.ServiceID]*structs.ServiceSplitterConfigEntry),
-		Resolvers:     make(map[structs.ServiceID]*structs.ServiceResolverConfigEntry),
-		Services:      make(map[structs.ServiceID]*structs.ServiceConfigEntry),
-		ProxyDefaults: make(map[string]*structs.ProxyConfigEntry),
+		Routers:        make(map[structs.ServiceID]*structs.ServiceRouterConfigEntry),
+		Splitters:      make(map[structs.ServiceID]*structs.ServiceSplitterConfigEntry),
+		Resolvers:      make(map[structs.ServiceID]*structs.ServiceResolverConfigEntry),
+		Services:       make(map[structs.ServiceID]*structs.ServiceConfigEntry),
+		ProxyDefaults:  make(map[string]*structs.ProxyConfigEntry),
+		SamenessGroups: make(map[string]*structs.SamenessGroupConfigEntry),
 	}
 }
 
@@ -61,6 +66,24 @@ func (e *DiscoveryChainSet) GetProxyDefaults(partition string) *structs.ProxyCon
 	return nil
 }
 
+func (e *DiscoveryChainSet) GetSamenessGroup(name string) *structs.SamenessGroupConfigEntry {
+	if e.SamenessGroups != nil {
+		return e.SamenessGroups[name]
+	}
+	return nil
+}
+
+// GetDefaultSamenessGroup returns the sameness group used for failover by
+// services that don't define a failover policy, if any.
+func (e *DiscoveryChainSet) GetDefaultSamenessGroup() *structs.SamenessGroupConfigEntry {
+	for _, group := range e.SamenessGroups {
+		if group.DefaultForFailover {
+			return group
+		}
+	}
+	return nil
+}
+
 // AddRouters adds router configs. Convenience function for testing.
 func (e *DiscoveryChainSet) AddRouters(entries ...*structs.ServiceRouterConfigEntry) {
 	if e.Routers == nil {
@@ -111,6 +134,16 @@ func (e *DiscoveryChainSet) AddProxyDefaults(entries ...*structs.ProxyConfigEntr
 	}
 }
 
+// AddSamenessGroups adds sameness-group configs. Convenience function for testing.
+func (e *DiscoveryChainSet) AddSamenessGroups(entries ...*structs.SamenessGroupConfigEntry) {
+	if e.SamenessGroups == nil {
+		e.SamenessGroups = make(map[string]*structs.SamenessGroupConfigEntry)
+	}
+	for _, entry := range entries {
+		e.SamenessGroups[entry.Name] = entry
+	}
+}
+
 // AddEntries adds generic configs. Convenience function for testing. Panics on
 // operator error.
 func (e *DiscoveryChainSet) AddEntries(entries ...structs.ConfigEntry) {
@@ -129,6 +162,8 @@ func (e *DiscoveryChainSet) AddEntries(entries ...structs.ConfigEntry) {
 				panic("the only supported proxy-defaults name is '" + structs.ProxyConfigGlobal + "'")
 			}
 			e.AddProxyDefaults(entry.(*structs.ProxyConfigEntry))
+		case structs.SamenessGroup:
+			e.AddSamenessGroups(entry.(*structs.SamenessGroupConfigEntry))
 		default:
 			panic("unhandled config entry kind: " + entry.GetKind())
 		}
@@ -138,7 +173,7 @@ func (e *DiscoveryChainSet) AddEntries(entries ...structs.ConfigEntry) {
 // IsEmpty returns true if there are no config entries at all in the response.
 // You should prefer this over IsChainEmpty() in most cases.
 func (e *DiscoveryChainSet) IsEmpty() bool {
-	return e.IsChainEmpty() && len(e.Services) == 0 && len(e.ProxyDefaults) == 0
+	return e.IsChainEmpty() && len(e.Services) == 0 && len(e.ProxyDefaults) == 0 && len(e.SamenessGroups) == 0
 }
 
 // IsChainEmpty returns true if there are no service-routers,
@@ -2,6 +2,7 @@ package ca
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/x509"
 	"fmt"
 
@@ -41,14 +42,17 @@ func validateSetIntermediate(intermediatePEM, rootPEM string, spiffeID *connect.
 }
 
 func validateIntermediateSignedByPrivateKey(intermediatePEM string, privateKey string) error {
-	intermediate, err := connect.ParseCert(intermediatePEM)
+	privKey, err := connect.ParseSigner(privateKey)
 	if err != nil {
-		return fmt.Errorf("error parsing intermediate PEM: %v", err)
+		return err
 	}
+	return validateIntermediateSignedBySigner(intermediatePEM, privKey)
+}
 
-	privKey, err := connect.ParseSigner(privateKey)
+func validateIntermediateSignedBySigner(intermediatePEM string, privKey crypto.Signer) error {
+	intermediate, err := connect.ParseCert(intermediatePEM)
 	if err != nil {
-		return err
+		return fmt.Errorf("error parsing intermediate PEM: %v", err)
 	}
 
 	// Compare the two keys to make sure they match.
@@ -0,0 +1,399 @@
+//go:build cgo
+// +build cgo
+
+package ca
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+// pkcs11Supported is true as the PKCS#11 library is loaded using cgo.
+const pkcs11Supported = true
+
+// pkcs11Modules holds the PKCS#11 libraries which have been loaded and
+// initialized, keyed by path. A library can only be initialized once per
+// process, so it is shared by every provider using it and never finalized.
+var pkcs11Modules = struct {
+	sync.Mutex
+	m map[string]*pkcs11.Ctx
+}{m: make(map[string]*pkcs11.Ctx)}
+
+func loadPKCS11Module(lib string) (*pkcs11.Ctx, error) {
+	pkcs11Modules.Lock()
+	defer pkcs11Modules.Unlock()
+
+	if ctx, ok := pkcs11Modules.m[lib]; ok {
+		return ctx, nil
+	}
+
+	ctx := pkcs11.New(lib)
+	if ctx == nil {
+		return nil, fmt.Errorf("error loading PKCS#11 library %q", lib)
+	}
+	if err := ctx.Initialize(); err != nil && !isPKCS11Error(err, pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		return nil, fmt.Errorf("error initializing PKCS#11 library %q: %w", lib, err)
+	}
+	pkcs11Modules.m[lib] = ctx
+	return ctx, nil
+}
+
+func isPKCS11Error(err error, code uint) bool {
+	var e pkcs11.Error
+	return errors.As(err, &e) && uint(e) == code
+}
+
+// pkcs11Token is a logged in session with a PKCS#11 token. Private keys are
+// generated inside the token and only ever used through it.
+type pkcs11Token struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+
+	// lock serializes use of the session, which PKCS#11 does not allow to be
+	// used concurrently.
+	lock sync.Mutex
+}
+
+// openPKCS11Token loads the given PKCS#11 library and opens a session with
+// the token with the given label, logging in with the pin.
+func openPKCS11Token(lib, tokenLabel, pin string) (*pkcs11Token, error) {
+	ctx, err := loadPKCS11Module(lib)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, fmt.Errorf("error listing PKCS#11 slots: %w", err)
+	}
+
+	var slot uint
+	found := false
+	for _, s := range slots {
+		info, err := ctx.GetTokenInfo(s)
+		if err != nil {
+			return nil, fmt.Errorf("error reading PKCS#11 token info for slot %d: %w", s, err)
+		}
+		if strings.TrimRight(info.Label, " \x00") == tokenLabel {
+			slot = s
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("PKCS#11 token %q not found", tokenLabel)
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("error opening PKCS#11 session: %w", err)
+	}
+
+	// Logins are shared by all sessions with a token, so another provider
+	// using the same token may already have logged in.
+	err = ctx.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil && !isPKCS11Error(err, pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		ctx.CloseSession(session)
+		return nil, fmt.Errorf("error logging in to PKCS#11 token %q: %w", tokenLabel, err)
+	}
+
+	return &pkcs11Token{ctx: ctx, session: session}, nil
+}
+
+// Close closes the session with the token.
+func (t *pkcs11Token) Close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.ctx.CloseSession(t.session)
+}
+
+// Signer returns the private key with the given label, or nil if the token
+// doesn't have one.
+func (t *pkcs11Token) Signer(label string) (crypto.Signer, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	priv, ok, err := t.findObject(pkcs11.CKO_PRIVATE_KEY, label)
+	if err != nil || !ok {
+		return nil, err
+	}
+	pubObj, ok, err := t.findObject(pkcs11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("PKCS#11 token has no public key for private key %q", label)
+	}
+
+	pub, err := t.publicKey(pubObj)
+	if err != nil {
+		return nil, fmt.Errorf("error reading public key %q: %w", label, err)
+	}
+	return &pkcs11Signer{token: t, key: priv, pub: pub}, nil
+}
+
+// GenerateSigner generates a private key with the given label, type and size
+// inside the token. The key is marked as sensitive and non-extractable.
+func (t *pkcs11Token) GenerateSigner(label, keyType string, keyBits int) (crypto.Signer, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	pubTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	privTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+
+	var mech uint
+	switch strings.ToLower(keyType) {
+	case "ec":
+		oid, ok := pkcs11CurveOIDs[keyBits]
+		if !ok {
+			return nil, fmt.Errorf("unsupported ECDSA key size %d", keyBits)
+		}
+		params, err := asn1.Marshal(oid)
+		if err != nil {
+			return nil, err
+		}
+		mech = pkcs11.CKM_EC_KEY_PAIR_GEN
+		pubTemplate = append(pubTemplate, pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params))
+	case "rsa":
+		mech = pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN
+		pubTemplate = append(pubTemplate,
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS_BITS, keyBits),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, []byte{1, 0, 1}),
+		)
+	default:
+		return nil, fmt.Errorf("unknown private key type requested: %s", keyType)
+	}
+
+	pubObj, priv, err := t.ctx.GenerateKeyPair(t.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(mech, nil)}, pubTemplate, privTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error generating private key %q: %w", label, err)
+	}
+
+	pub, err := t.publicKey(pubObj)
+	if err != nil {
+		return nil, fmt.Errorf("error reading public key %q: %w", label, err)
+	}
+	return &pkcs11Signer{token: t, key: priv, pub: pub}, nil
+}
+
+// findObject returns the object with the given class and label. The caller
+// must hold the lock.
+func (t *pkcs11Token) findObject(class uint, label string) (pkcs11.ObjectHandle, bool, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := t.ctx.FindObjectsInit(t.session, template); err != nil {
+		return 0, false, fmt.Errorf("error searching PKCS#11 token: %w", err)
+	}
+	objs, _, err := t.ctx.FindObjects(t.session, 2)
+	if finalErr := t.ctx.FindObjectsFinal(t.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("error searching PKCS#11 token: %w", err)
+	}
+
+	switch len(objs) {
+	case 0:
+		return 0, false, nil
+	case 1:
+		return objs[0], true, nil
+	default:
+		return 0, false, fmt.Errorf("PKCS#11 token has more than one key labelled %q", label)
+	}
+}
+
+// publicKey reads the public key object obj from the token. The caller must
+// hold the lock.
+func (t *pkcs11Token) publicKey(obj pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	attrs, err := t.ctx.GetAttributeValue(t.session, obj, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Attribute values are native byte order integers, so compare them to ones
+	// encoded in the same way rather than decoding them.
+	keyType := attrs[0].Value
+	switch {
+	case bytes.Equal(keyType, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC).Value):
+		attrs, err := t.ctx.GetAttributeValue(t.session, obj, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return parsePKCS11ECPoint(attrs[0].Value, attrs[1].Value)
+
+	case bytes.Equal(keyType, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA).Value):
+		attrs, err := t.ctx.GetAttributeValue(t.session, obj, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+		e := new(big.Int).SetBytes(attrs[1].Value)
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("RSA public exponent is too large")
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(attrs[0].Value),
+			E: int(e.Int64()),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type")
+	}
+}
+
+// pkcs11Signer is a crypto.Signer for a private key held in a PKCS#11 token.
+type pkcs11Signer struct {
+	token *pkcs11Token
+	key   pkcs11.ObjectHandle
+	pub   crypto.PublicKey
+}
+
+// Public implements crypto.Signer.
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.pub
+}
+
+// Sign implements crypto.Signer. ECDSA signatures are returned ASN.1 encoded
+// and RSA signatures use PKCS #1 v1.5, as produced by the standard library
+// signers.
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var mech uint
+	var data []byte
+	switch s.pub.(type) {
+	case *ecdsa.PublicKey:
+		mech = pkcs11.CKM_ECDSA
+		data = digest
+	case *rsa.PublicKey:
+		if _, ok := opts.(*rsa.PSSOptions); ok {
+			return nil, fmt.Errorf("RSA-PSS signatures are not supported")
+		}
+		prefix, ok := pkcs1DigestInfoPrefixes[opts.HashFunc()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported hash function %v", opts.HashFunc())
+		}
+		mech = pkcs11.CKM_RSA_PKCS
+		data = append(append([]byte{}, prefix...), digest...)
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", s.pub)
+	}
+
+	s.token.lock.Lock()
+	defer s.token.lock.Unlock()
+
+	err := s.token.ctx.SignInit(s.token.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mech, nil)}, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("error signing with PKCS#11 token: %w", err)
+	}
+	sig, err := s.token.ctx.Sign(s.token.session, data)
+	if err != nil {
+		return nil, fmt.Errorf("error signing with PKCS#11 token: %w", err)
+	}
+
+	if mech == pkcs11.CKM_ECDSA {
+		return marshalECDSASignature(sig)
+	}
+	return sig, nil
+}
+
+var pkcs11CurveOIDs = map[int]asn1.ObjectIdentifier{
+	224: {1, 3, 132, 0, 33},
+	256: {1, 2, 840, 10045, 3, 1, 7},
+	384: {1, 3, 132, 0, 34},
+	521: {1, 3, 132, 0, 35},
+}
+
+var pkcs11Curves = map[int]elliptic.Curve{
+	224: elliptic.P224(),
+	256: elliptic.P256(),
+	384: elliptic.P384(),
+	521: elliptic.P521(),
+}
+
+// pkcs1DigestInfoPrefixes are the DER encoded DigestInfo prefixes which
+// PKCS #1 v1.5 signatures wrap around the digest. CKM_RSA_PKCS expects the
+// caller to add them.
+var pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// parsePKCS11ECPoint returns the ECDSA public key for the given CKA_EC_PARAMS
+// and CKA_EC_POINT attribute values.
+func parsePKCS11ECPoint(params, point []byte) (*ecdsa.PublicKey, error) {
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params, &oid); err != nil {
+		return nil, fmt.Errorf("error parsing EC parameters: %w", err)
+	}
+
+	var curve elliptic.Curve
+	for bits, c := range pkcs11CurveOIDs {
+		if c.Equal(oid) {
+			curve = pkcs11Curves[bits]
+		}
+	}
+	if curve == nil {
+		return nil, fmt.Errorf("unsupported curve %s", oid)
+	}
+
+	// CKA_EC_POINT should be a DER encoded octet string, but some tokens
+	// return the raw point.
+	var raw []byte
+	if rest, err := asn1.Unmarshal(point, &raw); err != nil || len(rest) > 0 {
+		raw = point
+	}
+
+	x, y := elliptic.Unmarshal(curve, raw)
+	if x == nil {
+		return nil, fmt.Errorf("error parsing EC point")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// marshalECDSASignature converts a CKM_ECDSA signature, which is the
+// concatenation of r and s, to the ASN.1 encoding used by crypto/ecdsa.
+func marshalECDSASignature(sig []byte) ([]byte, error) {
+	if len(sig) == 0 || len(sig)%2 != 0 {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d", len(sig))
+	}
+	n := len(sig) / 2
+	return asn1.Marshal(struct {
+		R, S *big.Int
+	}{
+		R: new(big.Int).SetBytes(sig[:n]),
+		S: new(big.Int).SetBytes(sig[n:]),
+	})
+}
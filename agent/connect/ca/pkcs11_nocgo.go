@@ -0,0 +1,31 @@
+//go:build !cgo
+// +build !cgo
+
+package ca
+
+import (
+	"crypto"
+)
+
+// pkcs11Supported is false as the PKCS#11 library can't be loaded without cgo.
+const pkcs11Supported = false
+
+// pkcs11Token is not supported without cgo, which is needed to load the
+// PKCS#11 library.
+type pkcs11Token struct{}
+
+func openPKCS11Token(_, _, _ string) (*pkcs11Token, error) {
+	return nil, errPKCS11Unsupported
+}
+
+func (t *pkcs11Token) Close() error {
+	return errPKCS11Unsupported
+}
+
+func (t *pkcs11Token) Signer(_ string) (crypto.Signer, error) {
+	return nil, errPKCS11Unsupported
+}
+
+func (t *pkcs11Token) GenerateSigner(_, _ string, _ int) (crypto.Signer, error) {
+	return nil, errPKCS11Unsupported
+}
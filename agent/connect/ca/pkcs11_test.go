@@ -0,0 +1,206 @@
+//go:build cgo
+// +build cgo
+
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+)
+
+func TestPKCS11_ECDSAEncoding(t *testing.T) {
+	for bits, curve := range pkcs11Curves {
+		t.Run(fmt.Sprintf("P-%d", bits), func(t *testing.T) {
+			key, err := ecdsa.GenerateKey(curve, rand.Reader)
+			require.NoError(t, err)
+
+			params, err := asn1.Marshal(pkcs11CurveOIDs[bits])
+			require.NoError(t, err)
+			point := elliptic.Marshal(curve, key.X, key.Y)
+			wrapped, err := asn1.Marshal(point)
+			require.NoError(t, err)
+
+			for _, p := range [][]byte{wrapped, point} {
+				pub, err := parsePKCS11ECPoint(params, p)
+				require.NoError(t, err)
+				require.True(t, key.PublicKey.Equal(pub))
+			}
+
+			// CKM_ECDSA signatures are r and s padded to the size of the curve.
+			digest := sha256.Sum256([]byte("hello"))
+			r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+			require.NoError(t, err)
+			size := (curve.Params().BitSize + 7) / 8
+			raw := make([]byte, 2*size)
+			r.FillBytes(raw[:size])
+			s.FillBytes(raw[size:])
+
+			sig, err := marshalECDSASignature(raw)
+			require.NoError(t, err)
+			require.True(t, ecdsa.VerifyASN1(&key.PublicKey, digest[:], sig))
+		})
+	}
+}
+
+var softHSMToken struct {
+	once sync.Once
+	lib  string
+	err  error
+}
+
+// testSoftHSMConfig returns the config for the Consul provider to use a new
+// key in a SoftHSM token. The test is skipped if SoftHSM isn't installed;
+// SOFTHSM2_LIB can be set to the path of its library.
+func testSoftHSMConfig(t *testing.T) map[string]interface{} {
+	t.Helper()
+
+	lib := os.Getenv("SOFTHSM2_LIB")
+	if lib == "" {
+		for _, path := range []string{
+			"/usr/lib/softhsm/libsofthsm2.so",
+			"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+			"/usr/local/lib/softhsm/libsofthsm2.so",
+			"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+		} {
+			if _, err := os.Stat(path); err == nil {
+				lib = path
+				break
+			}
+		}
+	}
+	if lib == "" {
+		t.Skip("SoftHSM not found")
+	}
+	if _, err := exec.LookPath("softhsm2-util"); err != nil {
+		t.Skip("softhsm2-util not found")
+	}
+
+	// SoftHSM reads its config and tokens when the library is initialized,
+	// and the library stays initialized, so every test shares one token
+	// created up front.
+	softHSMToken.once.Do(func() {
+		dir, err := os.MkdirTemp("", "consul-softhsm")
+		if err != nil {
+			softHSMToken.err = err
+			return
+		}
+		conf := filepath.Join(dir, "softhsm2.conf")
+		contents := fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", dir)
+		if err := os.WriteFile(conf, []byte(contents), 0600); err != nil {
+			softHSMToken.err = err
+			return
+		}
+		if err := os.Setenv("SOFTHSM2_CONF", conf); err != nil {
+			softHSMToken.err = err
+			return
+		}
+
+		out, err := exec.Command("softhsm2-util", "--init-token", "--free",
+			"--label", "consul", "--pin", "1234", "--so-pin", "5678").CombinedOutput()
+		if err != nil {
+			softHSMToken.err = fmt.Errorf("error initializing token: %v: %s", err, out)
+			return
+		}
+		softHSMToken.lib = lib
+	})
+	require.NoError(t, softHSMToken.err)
+
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(pinFile, []byte("1234\n"), 0600))
+
+	label, err := connect.CompactUID()
+	require.NoError(t, err)
+	return map[string]interface{}{
+		"PKCS11Lib":        softHSMToken.lib,
+		"PKCS11TokenLabel": "consul",
+		"PKCS11PinFile":    pinFile,
+		"PKCS11KeyLabel":   label,
+	}
+}
+
+func TestConsulCAProvider_PKCS11(t *testing.T) {
+	for _, keyType := range []struct {
+		name string
+		bits int
+	}{
+		{"ec", 256},
+		{"rsa", 2048},
+	} {
+		t.Run(keyType.name, func(t *testing.T) {
+			conf := testConsulCAConfig()
+			hsmConfig := testSoftHSMConfig(t)
+			for k, v := range hsmConfig {
+				conf.Config[k] = v
+			}
+			conf.Config["PrivateKeyType"] = keyType.name
+			conf.Config["PrivateKeyBits"] = keyType.bits
+			delegate := newMockDelegate(t, conf)
+
+			provider := TestConsulProvider(t, delegate)
+			t.Cleanup(provider.Stop)
+			require.NoError(t, provider.Configure(testProviderConfig(conf)))
+			root, err := provider.GenerateRoot()
+			require.NoError(t, err)
+
+			// The private key is never written to the state store.
+			state, err := delegate.ProviderState(provider.id)
+			require.NoError(t, err)
+			require.Empty(t, state.PrivateKey)
+			require.True(t, strings.HasPrefix(state.PrivateKeyLabel, hsmConfig["PKCS11KeyLabel"].(string)+"-"))
+
+			// A new root gets a new key, even with the same key label.
+			other := TestConsulProvider(t, newMockDelegate(t, conf))
+			t.Cleanup(other.Stop)
+			require.NoError(t, other.Configure(testProviderConfig(conf)))
+			otherRoot, err := other.GenerateRoot()
+			require.NoError(t, err)
+			otherState, err := other.Delegate.ProviderState(other.id)
+			require.NoError(t, err)
+			require.NotEqual(t, state.PrivateKeyLabel, otherState.PrivateKeyLabel)
+			require.NotEqual(t, root.PEM, otherRoot.PEM)
+
+			spiffeService := &connect.SpiffeIDService{
+				Host:       connect.TestClusterID + ".consul",
+				Namespace:  "default",
+				Datacenter: "dc1",
+				Service:    "foo",
+			}
+			raw, _ := connect.TestCSR(t, spiffeService)
+			csr, err := connect.ParseCSR(raw)
+			require.NoError(t, err)
+			leafPEM, err := provider.Sign(csr)
+			require.NoError(t, err)
+			require.NoError(t, connect.ValidateLeaf(root.PEM, leafPEM, nil))
+
+			// A secondary uses its own key.
+			secondaryConf := testConsulCAConfig()
+			for k, v := range testSoftHSMConfig(t) {
+				secondaryConf.Config[k] = v
+			}
+			secondaryConf.Config["PrivateKeyType"] = keyType.name
+			secondaryConf.Config["PrivateKeyBits"] = keyType.bits
+			secondary := TestConsulProvider(t, newMockDelegate(t, secondaryConf))
+			t.Cleanup(secondary.Stop)
+			cfg := testProviderConfig(secondaryConf)
+			cfg.IsPrimary = false
+			cfg.Datacenter = "dc2"
+			require.NoError(t, secondary.Configure(cfg))
+
+			testSignIntermediateCrossDC(t, provider, secondary)
+		})
+	}
+}
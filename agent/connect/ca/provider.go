@@ -209,3 +209,12 @@ type RootResult struct {
 type NeedsStop interface {
 	Stop()
 }
+
+// NeedsReload is an optional interface for CAs whose certificates are managed
+// outside of Consul, and so can change without Consul requesting it. A value
+// is sent on ReloadCh when the provider has detected new certificates. The
+// leader then configures a new instance of the provider and rotates to its
+// certificates.
+type NeedsReload interface {
+	ReloadCh() <-chan struct{}
+}
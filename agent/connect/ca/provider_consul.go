@@ -2,6 +2,7 @@ package ca
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/rand"
 	"crypto/sha256"
 	"crypto/x509"
@@ -11,6 +12,8 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +41,10 @@ type ConsulProvider struct {
 	spiffeID  *connect.SpiffeIDSigning
 	logger    hclog.Logger
 
+	// token is the PKCS#11 token holding the private key, if one is
+	// configured.
+	token *pkcs11Token
+
 	// testState is only used to test Consul leader's handling of providers that
 	// need to persist state. Consul provider actually manages it's state directly
 	// in the FSM since it is highly sensitive not (root private keys) not just
@@ -73,6 +80,30 @@ func (c *ConsulProvider) Configure(cfg ProviderConfig) error {
 	}
 	c.config = config
 	c.id = hexStringHash(fmt.Sprintf("%s,%s,%s,%d,%v", config.PrivateKey, config.RootCert, config.PrivateKeyType, config.PrivateKeyBits, cfg.IsPrimary))
+	if config.PKCS11Lib != "" {
+		// Keys in different tokens are different keys, so they need separate
+		// state even when the rest of the config matches.
+		c.id = hexStringHash(fmt.Sprintf("%s,pkcs11:%s,%s,%s", c.id, config.PKCS11Lib, config.PKCS11TokenLabel, config.PKCS11KeyLabel))
+
+		if c.token == nil {
+			// The PIN is read from a file on the leader so that it isn't
+			// stored in the CA configuration.
+			var pin string
+			if config.PKCS11PinFile != "" {
+				raw, err := os.ReadFile(config.PKCS11PinFile)
+				if err != nil {
+					return fmt.Errorf("error reading PKCS#11 PIN file: %w", err)
+				}
+				pin = strings.TrimSpace(string(raw))
+			}
+
+			token, err := openPKCS11Token(config.PKCS11Lib, config.PKCS11TokenLabel, pin)
+			if err != nil {
+				return err
+			}
+			c.token = token
+		}
+	}
 	c.clusterID = cfg.ClusterID
 	c.isPrimary = cfg.IsPrimary
 	c.spiffeID = connect.SpiffeIDSigningForCluster(c.clusterID)
@@ -165,7 +196,13 @@ func (c *ConsulProvider) GenerateRoot() (RootResult, error) {
 
 	// Generate a private key if needed
 	newState := *providerState
-	if c.config.PrivateKey == "" {
+	if c.token != nil {
+		_, label, err := c.tokenSigner(c.config.RootCert == "")
+		if err != nil {
+			return RootResult{}, err
+		}
+		newState.PrivateKeyLabel = label
+	} else if c.config.PrivateKey == "" {
 		_, pk, err := connect.GeneratePrivateKeyWithConfig(c.config.PrivateKeyType, c.config.PrivateKeyBits)
 		if err != nil {
 			return RootResult{}, err
@@ -177,12 +214,17 @@ func (c *ConsulProvider) GenerateRoot() (RootResult, error) {
 
 	// Generate the root CA if necessary
 	if c.config.RootCert == "" {
+		signer, err := c.signer(&newState)
+		if err != nil {
+			return RootResult{}, err
+		}
+
 		nextSerial, err := c.incrementAndGetNextSerialNumber()
 		if err != nil {
 			return RootResult{}, fmt.Errorf("error computing next serial number: %v", err)
 		}
 
-		ca, err := c.generateCA(newState.PrivateKey, nextSerial, c.config.RootCertTTL)
+		ca, err := c.generateCA(signer, nextSerial, c.config.RootCertTTL)
 		if err != nil {
 			return RootResult{}, fmt.Errorf("error generating CA: %v", err)
 		}
@@ -217,7 +259,13 @@ func (c *ConsulProvider) GenerateIntermediateCSR() (string, error) {
 	}
 
 	// Create a new private key and CSR.
-	signer, pk, err := connect.GeneratePrivateKeyWithConfig(c.config.PrivateKeyType, c.config.PrivateKeyBits)
+	newState := *providerState
+	var signer crypto.Signer
+	if c.token != nil {
+		signer, newState.PrivateKeyLabel, err = c.tokenSigner(true)
+	} else {
+		signer, newState.PrivateKey, err = connect.GeneratePrivateKeyWithConfig(c.config.PrivateKeyType, c.config.PrivateKeyBits)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -228,8 +276,6 @@ func (c *ConsulProvider) GenerateIntermediateCSR() (string, error) {
 	}
 
 	// Write the new provider state to the store.
-	newState := *providerState
-	newState.PrivateKey = pk
 	args := &structs.CARequest{
 		Op:            structs.CAOpSetProviderState,
 		ProviderState: &newState,
@@ -256,7 +302,11 @@ func (c *ConsulProvider) SetIntermediate(intermediatePEM, rootPEM string) error
 	if err = validateSetIntermediate(intermediatePEM, rootPEM, c.spiffeID); err != nil {
 		return err
 	}
-	if err := validateIntermediateSignedByPrivateKey(intermediatePEM, providerState.PrivateKey); err != nil {
+	signer, err := c.signer(providerState)
+	if err != nil {
+		return err
+	}
+	if err := validateIntermediateSignedBySigner(intermediatePEM, signer); err != nil {
 		return err
 	}
 
@@ -293,8 +343,11 @@ func (c *ConsulProvider) GenerateIntermediate() (string, error) {
 	return c.ActiveIntermediate()
 }
 
-// Remove the state store entry for this provider instance.
+// Remove the state store entry for this provider instance. Keys in a PKCS#11
+// token are left in place.
 func (c *ConsulProvider) Cleanup(_ bool, _ map[string]interface{}) error {
+	c.Stop()
+
 	// This method only gets called for final cleanup. Therefore we don't
 	// need to worry about the case where a ca config update is made to
 	// change the cert ttls but leaving the private key and root cert the
@@ -325,18 +378,12 @@ func (c *ConsulProvider) Sign(csr *x509.CertificateRequest) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if providerState.PrivateKey == "" {
-		return "", ErrNotInitialized
-	}
 
 	// Create the keyId for the cert from the signing private key.
-	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	signer, err := c.signer(providerState)
 	if err != nil {
 		return "", err
 	}
-	if signer == nil {
-		return "", ErrNotInitialized
-	}
 	keyId, err := connect.KeyId(signer.Public())
 	if err != nil {
 		return "", err
@@ -429,7 +476,7 @@ func (c *ConsulProvider) SignIntermediate(csr *x509.CertificateRequest) (string,
 	}
 
 	// Get the signing private key.
-	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	signer, err := c.signer(providerState)
 	if err != nil {
 		return "", err
 	}
@@ -510,9 +557,9 @@ func (c *ConsulProvider) CrossSignCA(cert *x509.Certificate) (string, error) {
 		return "", err
 	}
 
-	privKey, err := connect.ParseSigner(providerState.PrivateKey)
+	privKey, err := c.signer(providerState)
 	if err != nil {
-		return "", fmt.Errorf("error parsing private key %q: %s", providerState.PrivateKey, err)
+		return "", err
 	}
 
 	rootCA, err := connect.ParseCert(providerState.RootCert)
@@ -597,13 +644,81 @@ func (c *ConsulProvider) incrementAndGetNextSerialNumber() (uint64, error) {
 	return raw.(uint64), nil
 }
 
-// generateCA makes a new root CA using the current private key
-func (c *ConsulProvider) generateCA(privateKey string, sn uint64, rootCertTTL time.Duration) (string, error) {
-	privKey, err := connect.ParseSigner(privateKey)
+// signer returns the private key for the given state, which is either held in
+// the state itself or in the configured PKCS#11 token.
+func (c *ConsulProvider) signer(providerState *structs.CAConsulProviderState) (crypto.Signer, error) {
+	if providerState.PrivateKeyLabel != "" {
+		if c.token == nil {
+			return nil, fmt.Errorf("private key %q is held in a PKCS#11 token but no token is configured",
+				providerState.PrivateKeyLabel)
+		}
+		signer, err := c.token.Signer(providerState.PrivateKeyLabel)
+		if err != nil {
+			return nil, err
+		}
+		if signer == nil {
+			return nil, fmt.Errorf("private key %q not found in PKCS#11 token", providerState.PrivateKeyLabel)
+		}
+		return signer, nil
+	}
+
+	if providerState.PrivateKey == "" {
+		return nil, ErrNotInitialized
+	}
+	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	if signer == nil {
+		return nil, ErrNotInitialized
+	}
+	return signer, nil
+}
+
+// tokenSigner returns a private key in the PKCS#11 token for a new root or
+// intermediate, along with its label. If generate is true a new key is
+// generated, labelled with PKCS11KeyLabel and a unique suffix so that every
+// root or intermediate gets its own key. Otherwise the existing key labelled
+// PKCS11KeyLabel is used.
+func (c *ConsulProvider) tokenSigner(generate bool) (crypto.Signer, string, error) {
+	if !generate {
+		label := c.config.PKCS11KeyLabel
+		signer, err := c.token.Signer(label)
+		if err != nil {
+			return nil, "", err
+		}
+		if signer == nil {
+			return nil, "", fmt.Errorf("private key %q not found in PKCS#11 token", label)
+		}
+		return signer, label, nil
+	}
+
+	uid, err := connect.CompactUID()
 	if err != nil {
-		return "", fmt.Errorf("error parsing private key %q: %s", privateKey, err)
+		return nil, "", err
 	}
+	label := fmt.Sprintf("%s-%s", c.config.PKCS11KeyLabel, uid)
+
+	c.logger.Info("generating CA private key in PKCS#11 token", "label", label)
+	signer, err := c.token.GenerateSigner(label, c.config.PrivateKeyType, c.config.PrivateKeyBits)
+	if err != nil {
+		return nil, "", err
+	}
+	return signer, label, nil
+}
+
+// Stop closes the session with the PKCS#11 token, if one is configured.
+func (c *ConsulProvider) Stop() {
+	if c.token == nil {
+		return
+	}
+	if err := c.token.Close(); err != nil {
+		c.logger.Warn("failed to close PKCS#11 session", "error", err)
+	}
+}
 
+// generateCA makes a new root CA using the given private key
+func (c *ConsulProvider) generateCA(privKey crypto.Signer, sn uint64, rootCertTTL time.Duration) (string, error) {
 	// The URI (SPIFFE compatible) for the cert
 	id := connect.SpiffeIDSigningForCluster(c.clusterID)
 	keyId, err := connect.KeyId(privKey.Public())
@@ -1,6 +1,7 @@
 package ca
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +10,9 @@ import (
 	"github.com/mitchellh/mapstructure"
 )
 
+var errPKCS11Unsupported = errors.New("PKCS#11 tokens are only supported by Consul " +
+	"binaries built with cgo enabled, which release binaries are not")
+
 func ParseConsulCAConfig(raw map[string]interface{}) (*structs.ConsulCAProviderConfig, error) {
 	config := defaultConsulCAProviderConfig()
 	decodeConf := &mapstructure.DecoderConfig{
@@ -26,7 +30,7 @@ func ParseConsulCAConfig(raw map[string]interface{}) (*structs.ConsulCAProviderC
 		return nil, fmt.Errorf("error decoding config: %s", err)
 	}
 
-	if config.PrivateKey == "" && config.RootCert != "" {
+	if config.PrivateKey == "" && config.PKCS11Lib == "" && config.RootCert != "" {
 		return nil, fmt.Errorf("must provide a private key when providing a root cert")
 	}
 
@@ -38,6 +42,10 @@ func ParseConsulCAConfig(raw map[string]interface{}) (*structs.ConsulCAProviderC
 		return nil, err
 	}
 
+	if config.PKCS11Lib != "" && !pkcs11Supported {
+		return nil, errPKCS11Unsupported
+	}
+
 	return &config, nil
 }
 
@@ -510,3 +510,65 @@ func TestConsulCAProvider_MigrateOldID(t *testing.T) {
 		})
 	}
 }
+
+func TestConsulCAProvider_PKCS11Config(t *testing.T) {
+	cases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"missing lib": {
+			config: map[string]interface{}{"PKCS11TokenLabel": "consul"},
+			err:    "must provide the PKCS11Lib",
+		},
+		"pin file without lib": {
+			config: map[string]interface{}{"PKCS11PinFile": "/pin"},
+			err:    "must provide the PKCS11Lib",
+		},
+		"missing token label": {
+			config: map[string]interface{}{"PKCS11Lib": "/lib.so", "PKCS11KeyLabel": "ca"},
+			err:    "must provide the PKCS11TokenLabel",
+		},
+		"missing key label": {
+			config: map[string]interface{}{"PKCS11Lib": "/lib.so", "PKCS11TokenLabel": "consul"},
+			err:    "must provide the PKCS11KeyLabel",
+		},
+		"private key": {
+			config: map[string]interface{}{
+				"PKCS11Lib":        "/lib.so",
+				"PKCS11TokenLabel": "consul",
+				"PKCS11KeyLabel":   "ca",
+				"PrivateKey":       "key",
+			},
+			err: "cannot provide a private key",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseConsulCAConfig(tc.config)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+
+	// A root cert can be given without a private key, since the key is in
+	// the token.
+	config, err := ParseConsulCAConfig(map[string]interface{}{
+		"PKCS11Lib":        "/lib.so",
+		"PKCS11TokenLabel": "consul",
+		"PKCS11KeyLabel":   "ca",
+		"RootCert":         "cert",
+	})
+	if !pkcs11Supported {
+		require.Equal(t, errPKCS11Unsupported, err)
+		return
+	}
+	require.NoError(t, err)
+	require.Equal(t, &structs.ConsulCAProviderConfig{
+		CommonCAProviderConfig: defaultCommonConfig(),
+		RootCert:               "cert",
+		PKCS11Lib:              "/lib.so",
+		PKCS11TokenLabel:       "consul",
+		PKCS11KeyLabel:         "ca",
+	}, config)
+}
@@ -0,0 +1,463 @@
+package ca
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+)
+
+// FileCAPollInterval is how often the file provider checks its certificate
+// and key files for changes.
+var FileCAPollInterval = 10 * time.Second
+
+// FileProvider implements Provider using a signing certificate and key read
+// from disk, for example an intermediate issued by an offline root managed
+// outside of Consul. The files are polled for changes, and once a complete and
+// valid replacement has been written the provider signals on ReloadCh so that
+// the leader can rotate to it.
+//
+// As the root key is not available to Consul the provider can only be used in
+// the primary datacenter, and cannot cross-sign other roots.
+type FileProvider struct {
+	logger hclog.Logger
+
+	config   *structs.FileCAProviderConfig
+	spiffeID *connect.SpiffeIDSigning
+
+	// files is the material loaded when the provider was configured. It is
+	// never replaced; a change on disk is picked up by configuring a new
+	// provider instance.
+	files *fileCAFiles
+
+	reloadCh chan struct{}
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// fileCAFiles is the parsed content of the files used by the file provider.
+type fileCAFiles struct {
+	rootPEM         string
+	intermediatePEM string
+	cert            *x509.Certificate
+	signer          crypto.Signer
+
+	// hash is the hash of the raw file contents, used to detect changes.
+	hash string
+}
+
+// NewFileProvider returns a new FileProvider
+func NewFileProvider(logger hclog.Logger) *FileProvider {
+	return &FileProvider{
+		logger:   logger,
+		reloadCh: make(chan struct{}, 1),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Configure implements Provider
+func (p *FileProvider) Configure(cfg ProviderConfig) error {
+	if !cfg.IsPrimary {
+		return fmt.Errorf("the file CA provider can only be used in the primary datacenter")
+	}
+
+	config, err := ParseFileCAConfig(cfg.RawConfig)
+	if err != nil {
+		return err
+	}
+
+	files, err := loadFileCAFiles(config)
+	if err != nil {
+		return err
+	}
+
+	p.config = config
+	p.spiffeID = connect.SpiffeIDSigningForCluster(cfg.ClusterID)
+	p.files = files
+
+	go p.watch()
+	return nil
+}
+
+// watch polls the configured files until the provider is stopped, signalling
+// on reloadCh once for each new set of valid contents.
+func (p *FileProvider) watch() {
+	notified := p.files.hash
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-time.After(FileCAPollInterval):
+		}
+
+		hash, err := hashFileCAFiles(p.config)
+		if err != nil || hash == notified {
+			// Files may be briefly missing while config management replaces
+			// them; the next poll will pick up the result.
+			continue
+		}
+		notified = hash
+
+		if _, err := loadFileCAFiles(p.config); err != nil {
+			// The files may have been only partially written, in which case
+			// the rest of the write will change the hash again.
+			p.logger.Warn("ignoring changed CA files until they are valid", "error", err)
+			continue
+		}
+
+		p.logger.Info("CA files have changed, requesting a reload",
+			"cert_file", p.config.CertFile,
+			"root_cert_file", p.config.RootCertFile,
+		)
+		select {
+		case p.reloadCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ReloadCh implements NeedsReload
+func (p *FileProvider) ReloadCh() <-chan struct{} {
+	return p.reloadCh
+}
+
+// State implements Provider
+func (p *FileProvider) State() (map[string]string, error) {
+	return nil, nil
+}
+
+// GenerateRoot implements Provider
+func (p *FileProvider) GenerateRoot() (RootResult, error) {
+	if p.files == nil {
+		return RootResult{}, ErrNotInitialized
+	}
+	return RootResult{PEM: p.files.rootPEM}, nil
+}
+
+// ActiveIntermediate implements Provider
+func (p *FileProvider) ActiveIntermediate() (string, error) {
+	if p.files == nil {
+		return "", ErrNotInitialized
+	}
+	return p.files.intermediatePEM, nil
+}
+
+// GenerateIntermediate implements Provider. The intermediate can only be
+// replaced by writing new files, so this returns the one already loaded.
+func (p *FileProvider) GenerateIntermediate() (string, error) {
+	return p.ActiveIntermediate()
+}
+
+// GenerateIntermediateCSR implements Provider
+func (p *FileProvider) GenerateIntermediateCSR() (string, error) {
+	return "", fmt.Errorf("the file CA provider can only be used in the primary datacenter")
+}
+
+// SetIntermediate implements Provider
+func (p *FileProvider) SetIntermediate(_, _ string) error {
+	return fmt.Errorf("the file CA provider can only be used in the primary datacenter")
+}
+
+// Sign implements Provider
+func (p *FileProvider) Sign(csr *x509.CertificateRequest) (string, error) {
+	connect.HackSANExtensionForCSR(csr)
+
+	if p.files == nil {
+		return "", ErrNotInitialized
+	}
+
+	keyId, err := connect.KeyId(p.files.signer.Public())
+	if err != nil {
+		return "", err
+	}
+	subjectKeyID, err := connect.KeyId(csr.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	sn, err := fileCASerialNumber()
+	if err != nil {
+		return "", err
+	}
+
+	effectiveNow := time.Now().Add(-1 * CertificateTimeDriftBuffer)
+	template := x509.Certificate{
+		SerialNumber:          sn,
+		URIs:                  csr.URIs,
+		Signature:             csr.Signature,
+		SignatureAlgorithm:    connect.SigAlgoForKey(p.files.signer),
+		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
+		PublicKey:             csr.PublicKey,
+		BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageDataEncipherment |
+			x509.KeyUsageKeyAgreement |
+			x509.KeyUsageDigitalSignature |
+			x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageClientAuth,
+			x509.ExtKeyUsageServerAuth,
+		},
+		NotAfter:       effectiveNow.Add(p.config.LeafCertTTL),
+		NotBefore:      effectiveNow,
+		AuthorityKeyId: keyId,
+		SubjectKeyId:   subjectKeyID,
+		DNSNames:       csr.DNSNames,
+		IPAddresses:    csr.IPAddresses,
+	}
+
+	return p.createCertificate(&template, csr.PublicKey)
+}
+
+// SignIntermediate implements Provider
+func (p *FileProvider) SignIntermediate(csr *x509.CertificateRequest) (string, error) {
+	if p.files == nil {
+		return "", ErrNotInitialized
+	}
+
+	if err := validateSignIntermediate(csr, p.spiffeID); err != nil {
+		return "", err
+	}
+
+	subjectKeyID, err := connect.KeyId(csr.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	sn, err := fileCASerialNumber()
+	if err != nil {
+		return "", err
+	}
+
+	effectiveNow := time.Now().Add(-1 * CertificateTimeDriftBuffer)
+	template := x509.Certificate{
+		SerialNumber:          sn,
+		DNSNames:              csr.DNSNames,
+		EmailAddresses:        csr.EmailAddresses,
+		IPAddresses:           csr.IPAddresses,
+		URIs:                  csr.URIs,
+		ExtraExtensions:       csr.ExtraExtensions,
+		Subject:               csr.Subject,
+		Signature:             csr.Signature,
+		SignatureAlgorithm:    connect.SigAlgoForKey(p.files.signer),
+		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
+		PublicKey:             csr.PublicKey,
+		BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign |
+			x509.KeyUsageCRLSign |
+			x509.KeyUsageDigitalSignature,
+		IsCA:           true,
+		MaxPathLenZero: true,
+		NotAfter:       effectiveNow.Add(p.config.IntermediateCertTTL),
+		NotBefore:      effectiveNow,
+		SubjectKeyId:   subjectKeyID,
+	}
+
+	return p.createCertificate(&template, csr.PublicKey)
+}
+
+func (p *FileProvider) createCertificate(template *x509.Certificate, pub interface{}) (string, error) {
+	bs, err := x509.CreateCertificate(rand.Reader, template, p.files.cert, pub, p.files.signer)
+	if err != nil {
+		return "", fmt.Errorf("error generating certificate: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: bs}); err != nil {
+		return "", fmt.Errorf("error encoding certificate: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// CrossSignCA implements Provider
+func (p *FileProvider) CrossSignCA(_ *x509.Certificate) (string, error) {
+	return "", errors.New("the file CA provider does not support cross-signing")
+}
+
+// SupportsCrossSigning implements Provider
+func (p *FileProvider) SupportsCrossSigning() (bool, error) {
+	return false, nil
+}
+
+// Cleanup implements Provider
+func (p *FileProvider) Cleanup(_ bool, _ map[string]interface{}) error {
+	p.Stop()
+	return nil
+}
+
+// Stop implements NeedsStop
+func (p *FileProvider) Stop() {
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+	})
+}
+
+func (p *FileProvider) PrimaryUsesIntermediate() {}
+
+// loadFileCAFiles reads and validates the files named in config. The signing
+// certificate must be a CA that chains to the root, and must match the key.
+func loadFileCAFiles(config *structs.FileCAProviderConfig) (*fileCAFiles, error) {
+	rootPEM, err := os.ReadFile(config.RootCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading root_cert_file: %w", err)
+	}
+	certPEM, err := os.ReadFile(config.CertFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cert_file: %w", err)
+	}
+	keyPEM, err := os.ReadFile(config.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading key_file: %w", err)
+	}
+
+	root, err := connect.ParseCert(string(rootPEM))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing root_cert_file: %w", err)
+	}
+	if !root.IsCA {
+		return nil, fmt.Errorf("root_cert_file is not a CA certificate")
+	}
+
+	certs, err := parsePEMCerts(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing cert_file: %w", err)
+	}
+	cert := certs[0]
+	if !cert.IsCA {
+		return nil, fmt.Errorf("cert_file is not a CA certificate")
+	}
+
+	signer, err := connect.ParseSigner(string(keyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing key_file: %w", err)
+	}
+	if err := validateIntermediateSignedByPrivateKey(string(certPEM), string(keyPEM)); err != nil {
+		return nil, fmt.Errorf("cert_file does not match key_file: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	intermediates := x509.NewCertPool()
+	for _, c := range certs[1:] {
+		intermediates.AddCert(c)
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not verify cert_file against root_cert_file: %w", err)
+	}
+
+	return &fileCAFiles{
+		rootPEM:         lib.EnsureTrailingNewline(string(rootPEM)),
+		intermediatePEM: lib.EnsureTrailingNewline(string(certPEM)),
+		cert:            cert,
+		signer:          signer,
+		hash:            hashFileCAContents(rootPEM, certPEM, keyPEM),
+	}, nil
+}
+
+func hashFileCAFiles(config *structs.FileCAProviderConfig) (string, error) {
+	var contents [][]byte
+	for _, path := range []string{config.RootCertFile, config.CertFile, config.KeyFile} {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		contents = append(contents, b)
+	}
+	return hashFileCAContents(contents...), nil
+}
+
+func hashFileCAContents(contents ...[]byte) string {
+	h := sha256.New()
+	for _, b := range contents {
+		h.Write(b)
+		// Separate the files so that moving bytes between them is a change.
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func parsePEMCerts(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("PEM-block should be CERTIFICATE type")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM-encoded data found")
+	}
+	return certs, nil
+}
+
+func fileCASerialNumber() (*big.Int, error) {
+	sn, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("error generating serial number: %w", err)
+	}
+	return sn, nil
+}
+
+// ParseFileCAConfig parses and validates file CA Provider configuration.
+func ParseFileCAConfig(raw map[string]interface{}) (*structs.FileCAProviderConfig, error) {
+	config := structs.FileCAProviderConfig{
+		CommonCAProviderConfig: defaultCommonConfig(),
+	}
+
+	decodeConf := &mapstructure.DecoderConfig{
+		DecodeHook:       structs.ParseDurationFunc(),
+		Result:           &config,
+		WeaklyTypedInput: true,
+	}
+
+	decoder, err := mapstructure.NewDecoder(decodeConf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("error decoding config: %s", err)
+	}
+
+	if config.CertFile == "" {
+		return nil, fmt.Errorf("must provide the CertFile")
+	}
+	if config.KeyFile == "" {
+		return nil, fmt.Errorf("must provide the KeyFile")
+	}
+	if config.RootCertFile == "" {
+		return nil, fmt.Errorf("must provide the RootCertFile")
+	}
+
+	if err := config.CommonCAProviderConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
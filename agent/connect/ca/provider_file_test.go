@@ -0,0 +1,177 @@
+package ca
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func testFileProvider(t *testing.T, rawConfig map[string]interface{}) *FileProvider {
+	t.Helper()
+
+	provider := NewFileProvider(hclog.NewNullLogger())
+	t.Cleanup(provider.Stop)
+	require.NoError(t, provider.Configure(ProviderConfig{
+		ClusterID:  connect.TestClusterID,
+		Datacenter: "dc1",
+		IsPrimary:  true,
+		RawConfig:  rawConfig,
+	}))
+	return provider
+}
+
+func TestFileCAProvider_Sign(t *testing.T) {
+	root := connect.TestCA(t, nil)
+	config := WriteTestFileCAFiles(t, testutil.TempDir(t, "ca"), root)
+	provider := testFileProvider(t, config)
+
+	result, err := provider.GenerateRoot()
+	require.NoError(t, err)
+	require.Equal(t, root.RootCert, result.PEM)
+
+	intermediatePEM, err := provider.GenerateIntermediate()
+	require.NoError(t, err)
+	requireTrailingNewline(t, intermediatePEM)
+
+	spiffeService := &connect.SpiffeIDService{
+		Host:       connect.TestClusterID + ".consul",
+		Namespace:  "default",
+		Datacenter: "dc1",
+		Service:    "foo",
+	}
+	raw, _ := connect.TestCSR(t, spiffeService)
+	csr, err := connect.ParseCSR(raw)
+	require.NoError(t, err)
+
+	leafPEM, err := provider.Sign(csr)
+	require.NoError(t, err)
+	require.NoError(t, connect.ValidateLeaf(root.RootCert, leafPEM, []string{intermediatePEM}))
+
+	leaf, err := connect.ParseCert(leafPEM)
+	require.NoError(t, err)
+	require.Equal(t, spiffeService.URI(), leaf.URIs[0])
+	require.True(t, time.Until(leaf.NotAfter) <= 72*time.Hour)
+
+	t.Run("sign intermediate", func(t *testing.T) {
+		secondary := &connect.SpiffeIDSigning{ClusterID: connect.TestClusterID, Domain: "consul"}
+		raw, _ := connect.TestCSR(t, secondary)
+		csr, err := connect.ParseCSR(raw)
+		require.NoError(t, err)
+
+		certPEM, err := provider.SignIntermediate(csr)
+		require.NoError(t, err)
+
+		cert, err := connect.ParseCert(certPEM)
+		require.NoError(t, err)
+		require.True(t, cert.IsCA)
+		require.True(t, cert.MaxPathLenZero)
+		require.NoError(t, connect.ValidateLeaf(root.RootCert, certPEM, []string{intermediatePEM}))
+	})
+
+	t.Run("secondary", func(t *testing.T) {
+		provider := NewFileProvider(hclog.NewNullLogger())
+		err := provider.Configure(ProviderConfig{
+			ClusterID:  connect.TestClusterID,
+			Datacenter: "dc2",
+			RawConfig:  config,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "only be used in the primary datacenter")
+	})
+}
+
+func TestFileCAProvider_InvalidFiles(t *testing.T) {
+	root := connect.TestCA(t, nil)
+	config := WriteTestFileCAFiles(t, testutil.TempDir(t, "ca"), root)
+	other := WriteTestFileCAFiles(t, testutil.TempDir(t, "ca"), connect.TestCA(t, nil))
+
+	withFile := func(key string, from map[string]interface{}) map[string]interface{} {
+		c := make(map[string]interface{})
+		for k, v := range config {
+			c[k] = v
+		}
+		c[key] = from[key]
+		return c
+	}
+
+	cases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"missing cert file": {
+			config: withFile("CertFile", map[string]interface{}{}),
+			err:    "must provide the CertFile",
+		},
+		"key does not match": {
+			config: withFile("KeyFile", other),
+			err:    "cert_file does not match key_file",
+		},
+		"different root": {
+			config: withFile("RootCertFile", other),
+			err:    "could not verify cert_file against root_cert_file",
+		},
+		"root is not a CA": {
+			config: withFile("RootCertFile", map[string]interface{}{"RootCertFile": config["KeyFile"]}),
+			err:    "error parsing root_cert_file",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			provider := NewFileProvider(hclog.NewNullLogger())
+			err := provider.Configure(ProviderConfig{
+				ClusterID: connect.TestClusterID,
+				IsPrimary: true,
+				RawConfig: tc.config,
+			})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestFileCAProvider_Reload(t *testing.T) {
+	orig := FileCAPollInterval
+	FileCAPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { FileCAPollInterval = orig })
+
+	dir := testutil.TempDir(t, "ca")
+	root := connect.TestCA(t, nil)
+	config := WriteTestFileCAFiles(t, dir, root)
+	provider := testFileProvider(t, config)
+
+	select {
+	case <-provider.ReloadCh():
+		t.Fatal("reload requested without a change")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// A partially written change is ignored.
+	require.NoError(t, os.WriteFile(config["KeyFile"].(string), []byte("invalid"), 0600))
+	select {
+	case <-provider.ReloadCh():
+		t.Fatal("reload requested for invalid files")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	WriteTestFileCAFiles(t, dir, root)
+	select {
+	case <-provider.ReloadCh():
+	case <-time.After(5 * time.Second):
+		t.Fatal("reload not requested")
+	}
+
+	// The provider keeps using the files it was configured with.
+	intermediatePEM, err := provider.ActiveIntermediate()
+	require.NoError(t, err)
+	reloaded := testFileProvider(t, config)
+	newIntermediatePEM, err := reloaded.ActiveIntermediate()
+	require.NoError(t, err)
+	require.NotEqual(t, intermediatePEM, newIntermediatePEM)
+}
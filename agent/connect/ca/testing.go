@@ -1,19 +1,28 @@
 package ca
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/mitchellh/go-testing-interface"
 
 	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/sdk/freeport"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
 )
@@ -82,6 +91,73 @@ func TestConsulProvider(t testing.T, d ConsulProviderStateDelegate) *ConsulProvi
 	return provider
 }
 
+// WriteTestFileCAFiles writes root.CARoot's certificate, and a new
+// intermediate signed by it along with the intermediate's key, to dir. It
+// returns the configuration for a FileProvider using the files. Calling it
+// again with the same dir replaces the files.
+func WriteTestFileCAFiles(t testing.T, dir string, root *structs.CARoot) map[string]interface{} {
+	rootCert, err := connect.ParseCert(root.RootCert)
+	if err != nil {
+		t.Fatalf("error parsing root cert: %s", err)
+	}
+	rootSigner, err := connect.ParseSigner(root.SigningKey)
+	if err != nil {
+		t.Fatalf("error parsing root key: %s", err)
+	}
+
+	signer, keyPEM, err := connect.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+	keyID, err := connect.KeyId(signer.Public())
+	if err != nil {
+		t.Fatalf("error generating key ID: %s", err)
+	}
+	sn, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatalf("error generating serial number: %s", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          sn,
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("Test Intermediate %d", sn)},
+		BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign |
+			x509.KeyUsageCRLSign |
+			x509.KeyUsageDigitalSignature,
+		IsCA:         true,
+		MaxPathLen:   1,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		SubjectKeyId: keyID,
+	}
+	bs, err := x509.CreateCertificate(rand.Reader, &template, rootCert, signer.Public(), rootSigner)
+	if err != nil {
+		t.Fatalf("error generating intermediate certificate: %s", err)
+	}
+	var certPEM bytes.Buffer
+	if err := pem.Encode(&certPEM, &pem.Block{Type: "CERTIFICATE", Bytes: bs}); err != nil {
+		t.Fatalf("error encoding intermediate certificate: %s", err)
+	}
+
+	config := map[string]interface{}{
+		"CertFile":     filepath.Join(dir, "intermediate.pem"),
+		"KeyFile":      filepath.Join(dir, "intermediate-key.pem"),
+		"RootCertFile": filepath.Join(dir, "root.pem"),
+	}
+	files := map[string]string{
+		"CertFile":     certPEM.String(),
+		"KeyFile":      keyPEM,
+		"RootCertFile": root.RootCert,
+	}
+	for key, contents := range files {
+		if err := os.WriteFile(config[key].(string), []byte(contents), 0600); err != nil {
+			t.Fatalf("error writing %s: %s", key, err)
+		}
+	}
+	return config
+}
+
 // SkipIfVaultNotPresent skips the test if the vault binary is not in PATH.
 //
 // These tests may be skipped in CI. They are run as part of a separate
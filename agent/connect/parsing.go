@@ -111,6 +111,19 @@ func CalculateCertFingerprint(cert []byte) string {
 	return HexString(hash[:])
 }
 
+// ParsePublicKey parses a PKIX public key from a PEM-encoded value.
+func ParsePublicKey(pemValue string) (crypto.PublicKey, error) {
+	// The _ result below is not an error but the remaining PEM bytes.
+	block, _ := pem.Decode([]byte(pemValue))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM-encoded data found")
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("first PEM-block should be PUBLIC KEY type")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
 // ParseSigner parses a crypto.Signer from a PEM-encoded key. The private key
 // is expected to be the first block in the PEM value.
 func ParseSigner(pemValue string) (crypto.Signer, error) {
@@ -80,6 +80,13 @@ func PeeredServiceSNI(service, namespace, partition, peerName, trustDomain strin
 	return dotJoin(service, namespace, partition, peerName, external, trustDomain)
 }
 
+// PeeringServerSNI returns the SNI used to route the peering control plane
+// traffic of the given peering through mesh gateways. The ID is the one of the
+// peering on the accepting side.
+func PeeringServerSNI(peeringID string) string {
+	return dotJoin(peeringID, "server", "peering", external)
+}
+
 func dotJoin(parts ...string) string {
 	return strings.Join(parts, ".")
 }
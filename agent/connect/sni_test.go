@@ -170,6 +170,11 @@ func TestPeeredServiceSNI(t *testing.T) {
 		PeeredServiceSNI("api", "billing", "", "webstuff", testTrustDomainSuffix1))
 }
 
+func TestPeeringServerSNI(t *testing.T) {
+	require.Equal(t, "c4b3b1b4-1d08-4b7f-8c54-7b2c3ba3ef2a.server.peering.external",
+		PeeringServerSNI("c4b3b1b4-1d08-4b7f-8c54-7b2c3ba3ef2a"))
+}
+
 func TestQuerySNI(t *testing.T) {
 	require.Equal(t, "magicquery.default.foo.query."+testTrustDomain1,
 		QuerySNI("magicquery", "foo", testTrustDomain1))
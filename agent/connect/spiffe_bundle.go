@@ -0,0 +1,43 @@
+package connect
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// SPIFFEBundleUseX509SVID marks the keys of a SPIFFE bundle which verify
+	// X.509-SVIDs. They hold the root certificates.
+	SPIFFEBundleUseX509SVID = "x509-svid"
+
+	// SPIFFEBundleUseJWTSVID marks the keys of a SPIFFE bundle which verify
+	// JWT-SVIDs.
+	SPIFFEBundleUseJWTSVID = "jwt-svid"
+)
+
+// SPIFFEBundleKey returns the JSON Web Key for the public key with the given
+// use in a SPIFFE bundle.
+func SPIFFEBundleKey(use string, pub crypto.PublicKey) (api.SPIFFEBundleKey, error) {
+	key := api.SPIFFEBundleKey{Use: use}
+	switch pub := pub.(type) {
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		key.KeyType = "EC"
+		key.Curve = pub.Curve.Params().Name
+		key.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		key.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	case *rsa.PublicKey:
+		key.KeyType = "RSA"
+		key.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		key.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	default:
+		return key, fmt.Errorf("unsupported key type %T", pub)
+	}
+	return key, nil
+}
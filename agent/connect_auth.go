@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/cache"
 	cachetype "github.com/hashicorp/consul/agent/cache-types"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib/stringslice"
 )
 
 // TODO(rb/intentions): this should move back into the agent endpoint since
@@ -19,7 +22,8 @@ import (
 // a separate agent method here because we need to re-use this both in our own
 // HTTP API authz endpoint and in the gRPX xDS/ext_authz API for envoy.
 //
-// NOTE: This treats any L7 intentions as DENY.
+// NOTE: This treats any L7 intentions as DENY unless the request includes the
+// HTTP request to evaluate their permissions against.
 //
 // The ACL token and the auth request are provided and the auth decision (true
 // means authorized) and reason string are returned.
@@ -130,11 +134,89 @@ func (a *Agent) ConnectAuthorize(token string,
 			return auth, reason, &meta, nil
 		}
 
-		// This is an L7 intention, so DENY.
-		reason = fmt.Sprintf("Matched L7 intention: %s", ixnMatch.String())
-		return false, reason, &meta, nil
+		// This is an L7 intention. Without a request to evaluate its
+		// permissions against, DENY.
+		if req.HTTP == nil {
+			reason = fmt.Sprintf("Matched L7 intention: %s", ixnMatch.String())
+			return false, reason, &meta, nil
+		}
+
+		// The first matching permission decides. If none match the request
+		// falls through to the default behavior, as it does in Envoy.
+		for _, perm := range ixnMatch.Permissions {
+			if perm.HTTP == nil || !intentionHTTPPermissionMatches(perm.HTTP, req.HTTP) {
+				continue
+			}
+			reason = fmt.Sprintf("Matched L7 intention permission: %s", ixnMatch.String())
+			return perm.Action == structs.IntentionActionAllow, reason, &meta, nil
+		}
 	}
 
 	reason = "Default behavior configured by ACLs"
 	return authz.IntentionDefaultAllow(nil) == acl.Allow, reason, &meta, nil
 }
+
+// intentionHTTPPermissionMatches returns whether the request matches all of
+// the conditions of the permission.
+func intentionHTTPPermissionMatches(perm *structs.IntentionHTTPPermission, req *structs.ConnectAuthorizeHTTPRequest) bool {
+	switch {
+	case perm.PathExact != "":
+		if req.Path != perm.PathExact {
+			return false
+		}
+	case perm.PathPrefix != "":
+		if !strings.HasPrefix(req.Path, perm.PathPrefix) {
+			return false
+		}
+	case perm.PathRegex != "":
+		if !regexpFullMatch(perm.PathRegex, req.Path) {
+			return false
+		}
+	}
+
+	if len(perm.Methods) > 0 && !stringslice.Contains(perm.Methods, req.Method) {
+		return false
+	}
+
+	header := http.Header(req.Header)
+	for _, hdr := range perm.Header {
+		if !intentionHTTPHeaderPermissionMatches(hdr, header) {
+			return false
+		}
+	}
+	return true
+}
+
+// intentionHTTPHeaderPermissionMatches returns whether the header matches
+// the permission. Multiple values of a header are joined with commas, as
+// Envoy does.
+func intentionHTTPHeaderPermissionMatches(perm structs.IntentionHTTPHeaderPermission, header http.Header) bool {
+	values := header.Values(perm.Name)
+	value := strings.Join(values, ",")
+
+	var match bool
+	switch {
+	case perm.Present:
+		match = len(values) > 0
+	case perm.Exact != "":
+		match = len(values) > 0 && value == perm.Exact
+	case perm.Prefix != "":
+		match = len(values) > 0 && strings.HasPrefix(value, perm.Prefix)
+	case perm.Suffix != "":
+		match = len(values) > 0 && strings.HasSuffix(value, perm.Suffix)
+	case perm.Regex != "":
+		match = len(values) > 0 && regexpFullMatch(perm.Regex, value)
+	}
+	return match != perm.Invert
+}
+
+// regexpFullMatch returns whether the regular expression matches all of s.
+// Invalid expressions never match; they are rejected when the config entry is
+// written.
+func regexpFullMatch(expr, s string) bool {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return false
+	}
+	return re.MatchString(s)
+}
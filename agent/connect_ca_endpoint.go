@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbpeering"
 )
 
 // GET /v1/connect/ca/roots
@@ -51,6 +54,51 @@ func (s *HTTPHandlers) ConnectCARoots(resp http.ResponseWriter, req *http.Reques
 	return nil, nil
 }
 
+// spiffeBundleRefreshHint is how often consumers of the SPIFFE bundle are
+// told to check for a new one.
+const spiffeBundleRefreshHint = 5 * time.Minute
+
+// GET /v1/connect/ca/bundle
+func (s *HTTPHandlers) ConnectCABundle(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.IndexedCARoots
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC("ConnectCA.Roots", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	trustBundle := &pbpeering.PeeringTrustBundle{TrustDomain: reply.TrustDomain}
+	for _, root := range reply.Roots {
+		trustBundle.RootPEMs = append(trustBundle.RootPEMs, root.RootCert)
+	}
+	bundle, err := trustBundle.SPIFFEBundle(reply.Index, spiffeBundleRefreshHint)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwtKeys structs.IndexedCAJWTKeys
+	if err := s.agent.RPC("ConnectCA.JWTKeys", &args, &jwtKeys); err != nil {
+		return nil, err
+	}
+	for _, jwtKey := range jwtKeys.Keys {
+		pub, err := connect.ParsePublicKey(jwtKey.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing JWT-SVID key: %w", err)
+		}
+		key, err := connect.SPIFFEBundleKey(connect.SPIFFEBundleUseJWTSVID, pub)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding JWT-SVID key: %w", err)
+		}
+		key.KeyID = jwtKey.ID
+		bundle.Keys = append(bundle.Keys, key)
+	}
+	return bundle, nil
+}
+
 // /v1/connect/ca/configuration
 func (s *HTTPHandlers) ConnectCAConfiguration(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	switch req.Method {
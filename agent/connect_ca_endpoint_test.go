@@ -2,9 +2,13 @@ package agent
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -17,6 +21,7 @@ import (
 
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
 )
 
 func TestConnectCARoots_empty(t *testing.T) {
@@ -69,6 +74,67 @@ func TestConnectCARoots_list(t *testing.T) {
 	}
 }
 
+func TestConnectCABundle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	// Add an RSA root alongside the EC one the agent bootstraps.
+	ca2 := connect.TestCAConfigSetWithKeyType(t, a, nil, "rsa", 2048)
+
+	req, _ := http.NewRequest("GET", "/v1/connect/ca/bundle", nil)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.ConnectCABundle(resp, req)
+	require.NoError(t, err)
+
+	bundle := obj.(*api.SPIFFEBundle)
+	require.NotZero(t, bundle.Sequence)
+	require.Equal(t, 300, bundle.RefreshHint)
+	require.Len(t, bundle.Keys, 3)
+
+	for _, key := range bundle.Keys {
+		if key.Use == "jwt-svid" {
+			require.Equal(t, "EC", key.KeyType)
+			require.Equal(t, "P-256", key.Curve)
+			require.NotEmpty(t, key.KeyID)
+			require.Empty(t, key.Certificates)
+			continue
+		}
+
+		require.Equal(t, "x509-svid", key.Use)
+		require.Len(t, key.Certificates, 1)
+		der, err := base64.StdEncoding.DecodeString(key.Certificates[0])
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+
+		switch pub := cert.PublicKey.(type) {
+		case *ecdsa.PublicKey:
+			require.Equal(t, "EC", key.KeyType)
+			require.Equal(t, "P-256", key.Curve)
+			x, err := base64.RawURLEncoding.DecodeString(key.X)
+			require.NoError(t, err)
+			require.Equal(t, pub.X, new(big.Int).SetBytes(x))
+			require.Len(t, x, 32)
+		case *rsa.PublicKey:
+			require.Equal(t, "RSA", key.KeyType)
+			require.Equal(t, "AQAB", key.E)
+			n, err := base64.RawURLEncoding.DecodeString(key.N)
+			require.NoError(t, err)
+			require.Equal(t, pub.N, new(big.Int).SetBytes(n))
+			require.Equal(t, ca2.RootCert, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+		default:
+			t.Fatalf("unexpected key type %T", pub)
+		}
+	}
+}
+
 func TestConnectCAConfig(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -2,6 +2,7 @@ package consul
 
 import (
 	"fmt"
+	"net"
 	"sort"
 	"sync"
 	"time"
@@ -106,6 +107,10 @@ func (id *missingIdentity) IsLocal() bool {
 	return false
 }
 
+func (id *missingIdentity) BoundCIDRList() []string {
+	return nil
+}
+
 func (id *missingIdentity) EnterpriseMetadata() *acl.EnterpriseMeta {
 	return structs.DefaultEnterpriseMetaInDefaultPartition()
 }
@@ -1047,6 +1052,44 @@ func (r *ACLResolver) ResolveToken(token string) (resolver.Result, error) {
 	return resolver.Result{Authorizer: acl.NewChainedAuthorizer(chain), ACLIdentity: identity}, nil
 }
 
+// CheckTokenSource returns a permission denied error if the token is bound to
+// a set of CIDR blocks and source is not within any of them. Tokens which
+// cannot be resolved are not rejected here so that the error returned when
+// the token is actually used is unchanged.
+func (r *ACLResolver) CheckTokenSource(token string, source net.IP) error {
+	if source == nil || !r.ACLsEnabled() {
+		return nil
+	}
+
+	if token == "" {
+		token = anonymousToken
+	}
+
+	if _, _, ok := r.resolveLocallyManagedToken(token); ok {
+		return nil
+	}
+
+	identity, err := r.resolveIdentityFromToken(token)
+	if err != nil || identity == nil {
+		return nil
+	}
+
+	cidrs := identity.BoundCIDRList()
+	if len(cidrs) == 0 {
+		return nil
+	}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if network.Contains(source) {
+			return nil
+		}
+	}
+	return acl.PermissionDenied("token with AccessorID '%s' cannot be used from %s", identity.ID(), source)
+}
+
 func (r *ACLResolver) ACLsEnabled() bool {
 	// Whether we desire ACLs to be enabled according to configuration
 	if !r.config.ACLsEnabled {
@@ -30,9 +30,17 @@ const (
 	// aclBootstrapReset is the file name to create in the data dir. It's only contents
 	// should be the reset index
 	aclBootstrapReset = "acl-bootstrap-reset"
+
+	// aclBootstrapRotateDefaultTTL is how long a rotated out management token
+	// remains valid when the request does not specify an expiration.
+	aclBootstrapRotateDefaultTTL = time.Hour
 )
 
 var ACLEndpointSummaries = []prometheus.SummaryDefinition{
+	{
+		Name: []string{"acl", "bootstrap", "rotate"},
+		Help: "",
+	},
 	{
 		Name: []string{"acl", "token", "clone"},
 		Help: "",
@@ -246,6 +254,110 @@ func (a *ACL) BootstrapTokens(args *structs.DCSpecificRequest, reply *structs.AC
 	return nil
 }
 
+// BootstrapTokenRotate issues a new global management token to replace an
+// existing one, which is given an expiration time instead of being deleted
+// so that anything still using it can be moved over without downtime.
+func (a *ACL) BootstrapTokenRotate(args *structs.ACLBootstrapRotateRequest, reply *structs.ACLToken) error {
+	if err := a.aclPreCheck(); err != nil {
+		return err
+	}
+
+	// Global management tokens are always global and so are written in the
+	// primary datacenter.
+	args.Datacenter = a.srv.config.PrimaryDatacenter
+
+	if done, err := a.srv.ForwardRPC("ACL.BootstrapTokenRotate", args, reply); done {
+		return err
+	}
+
+	defer metrics.MeasureSince([]string{"acl", "bootstrap", "rotate"}, time.Now())
+
+	var authzContext acl.AuthorizerContext
+	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, nil, &authzContext)
+	if err != nil {
+		return err
+	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
+		return err
+	}
+
+	state := a.srv.fsm.State()
+	entMeta := structs.DefaultEnterpriseMetaInDefaultPartition()
+
+	var previous *structs.ACLToken
+	if args.AccessorID == "" {
+		_, previous, err = state.ACLTokenGetBySecret(nil, args.Token, entMeta)
+	} else {
+		_, previous, err = state.ACLTokenGetByAccessor(nil, args.AccessorID, entMeta)
+	}
+	if err != nil {
+		return err
+	} else if previous == nil || previous.IsExpired(time.Now()) {
+		return acl.ErrNotFound
+	}
+
+	if previous.Local {
+		return fmt.Errorf("Cannot rotate a local token")
+	}
+
+	isManagement := false
+	for _, link := range previous.Policies {
+		if link.ID == structs.ACLPolicyGlobalManagementID {
+			isManagement = true
+			break
+		}
+	}
+	if !isManagement {
+		return fmt.Errorf("Token %q is not linked to the global-management policy", previous.AccessorID)
+	}
+
+	ttl := args.ExpirationTTL
+	if ttl == 0 {
+		ttl = aclBootstrapRotateDefaultTTL
+		if ttl > a.srv.config.ACLTokenMaxExpirationTTL {
+			ttl = a.srv.config.ACLTokenMaxExpirationTTL
+		} else if ttl < a.srv.config.ACLTokenMinExpirationTTL {
+			ttl = a.srv.config.ACLTokenMinExpirationTTL
+		}
+	}
+
+	writer := a.srv.aclTokenWriter()
+
+	// Check the expiration up front so a bad TTL doesn't leave behind a new
+	// management token without touching the previous one.
+	if err := writer.ValidateExpiresIn(ttl); err != nil {
+		return err
+	}
+
+	token, err := writer.Create(&structs.ACLToken{
+		Description: "Bootstrap Token (Global Management)",
+		Policies: []structs.ACLTokenPolicyLink{
+			{
+				ID: structs.ACLPolicyGlobalManagementID,
+			},
+		},
+		Local:          false,
+		EnterpriseMeta: *entMeta,
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	previous, err = writer.Expire(previous.AccessorID, ttl)
+	if err != nil {
+		return fmt.Errorf("Created token %q but failed to expire the previous token: %w", token.AccessorID, err)
+	}
+
+	a.logger.Info("ACL bootstrap token rotated",
+		"accessor_id", token.AccessorID,
+		"previous_accessor_id", previous.AccessorID,
+		"previous_expiration_time", previous.ExpirationTime,
+		"requested_by", authz.AccessorID(),
+	)
+
+	*reply = *token
+	return nil
+}
+
 func (a *ACL) TokenRead(args *structs.ACLTokenGetRequest, reply *structs.ACLTokenResponse) error {
 	if err := a.aclPreCheck(); err != nil {
 		return err
@@ -469,6 +581,7 @@ func (a *ACL) TokenClone(args *structs.ACLTokenSetRequest, reply *structs.ACLTok
 		Local:             token.Local,
 		Description:       token.Description,
 		ExpirationTime:    token.ExpirationTime,
+		BoundCIDRs:        token.BoundCIDRs,
 		EnterpriseMeta:    args.ACLToken.EnterpriseMeta,
 	}
 
@@ -1652,6 +1765,12 @@ func (a *ACL) BindingRuleSet(args *structs.ACLBindingRuleSetRequest, reply *stru
 		return fmt.Errorf("Invalid Binding Rule: invalid BindName")
 	}
 
+	boundCIDRs, err := auth.NormalizeBoundCIDRs(rule.BoundCIDRs)
+	if err != nil {
+		return fmt.Errorf("Invalid Binding Rule: %v", err)
+	}
+	rule.BoundCIDRs = boundCIDRs
+
 	req := &structs.ACLBindingRuleBatchSetRequest{
 		BindingRules: structs.ACLBindingRules{rule},
 	}
@@ -2063,6 +2182,70 @@ func (a *ACL) Login(args *structs.ACLLoginRequest, reply *structs.ACLToken) erro
 	return err
 }
 
+// ValidateLogin checks a bearer token against an auth method the same way as
+// Login, but instead of creating a token it reports why the login would be
+// rejected or what the resulting token would be linked to.
+func (a *ACL) ValidateLogin(args *structs.ACLLoginRequest, reply *structs.ACLLoginValidation) error {
+	if err := a.aclPreCheck(); err != nil {
+		return err
+	}
+
+	if !a.srv.LocalTokensEnabled() {
+		return errAuthMethodsRequireTokenReplication
+	}
+
+	if args.Auth == nil {
+		return fmt.Errorf("Invalid Login request: Missing auth parameters")
+	}
+
+	if err := a.srv.validateEnterpriseRequest(&args.Auth.EnterpriseMeta, false); err != nil {
+		return err
+	}
+
+	if done, err := a.srv.ForwardRPC("ACL.ValidateLogin", args, reply); done {
+		return err
+	}
+
+	var authzContext acl.AuthorizerContext
+	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Auth.EnterpriseMeta, &authzContext)
+	if err != nil {
+		return err
+	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
+		return err
+	}
+
+	authMethod, validator, err := a.srv.loadAuthMethod(args.Auth.AuthMethod, &args.Auth.EnterpriseMeta)
+	if err != nil {
+		return err
+	}
+
+	verifiedIdentity, err := validator.ValidateLogin(context.Background(), args.Auth.BearerToken)
+	if err != nil {
+		*reply = structs.ACLLoginValidation{Error: err.Error()}
+		return nil
+	}
+
+	result := structs.ACLLoginValidation{
+		ProjectedVars: verifiedIdentity.ProjectedVars,
+	}
+
+	bindings, err := a.srv.aclBinder().Bind(authMethod, verifiedIdentity)
+	switch {
+	case err != nil:
+		result.Error = err.Error()
+	case bindings.None():
+		result.Error = "no binding rules matched the verified identity"
+	default:
+		result.Valid = true
+		result.Roles = bindings.Roles
+		result.ServiceIdentities = bindings.ServiceIdentities
+		result.NodeIdentities = bindings.NodeIdentities
+	}
+
+	*reply = result
+	return nil
+}
+
 func (a *ACL) Logout(args *structs.ACLLogoutRequest, reply *bool) error {
 	if err := a.aclPreCheck(); err != nil {
 		return err
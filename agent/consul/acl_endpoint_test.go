@@ -3,6 +3,7 @@ package consul
 import (
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -73,6 +74,96 @@ func TestACLEndpoint_BootstrapTokens(t *testing.T) {
 	require.Equal(t, out.CreateIndex, out.ModifyIndex)
 }
 
+func TestACLEndpoint_BootstrapTokenRotate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	_, srv, codec := testACLServerWithConfig(t, func(c *Config) {
+		c.ACLTokenMinExpirationTTL = 10 * time.Millisecond
+		c.ACLTokenMaxExpirationTTL = 2 * time.Hour
+	}, false)
+	waitForLeaderEstablishment(t, srv)
+
+	state := srv.fsm.State()
+
+	t.Run("rotate requesting token", func(t *testing.T) {
+		req := structs.ACLBootstrapRotateRequest{
+			Datacenter:    "dc1",
+			ExpirationTTL: 10 * time.Minute,
+			WriteRequest:  structs.WriteRequest{Token: TestDefaultInitialManagementToken},
+		}
+		var out structs.ACLToken
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ACL.BootstrapTokenRotate", &req, &out))
+		require.Equal(t, 36, len(out.AccessorID))
+		require.True(t, strings.HasPrefix(out.Description, "Bootstrap Token"))
+		require.Len(t, out.Policies, 1)
+		require.Equal(t, structs.ACLPolicyGlobalManagementID, out.Policies[0].ID)
+		require.False(t, out.HasExpirationTime())
+
+		_, previous, err := state.ACLTokenGetBySecret(nil, TestDefaultInitialManagementToken, nil)
+		require.NoError(t, err)
+		require.NotNil(t, previous)
+		require.True(t, previous.HasExpirationTime())
+		require.WithinDuration(t, time.Now().Add(10*time.Minute), *previous.ExpirationTime, time.Minute)
+
+		// Rotating again with a longer TTL must not extend the expiration.
+		req.ExpirationTTL = time.Hour
+		var out2 structs.ACLToken
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ACL.BootstrapTokenRotate", &req, &out2))
+		_, again, err := state.ACLTokenGetBySecret(nil, TestDefaultInitialManagementToken, nil)
+		require.NoError(t, err)
+		require.Equal(t, *previous.ExpirationTime, *again.ExpirationTime)
+	})
+
+	t.Run("rotate by accessor", func(t *testing.T) {
+		managementToken, err := upsertTestToken(codec, TestDefaultInitialManagementToken, "dc1", func(t *structs.ACLToken) {
+			t.Policies = []structs.ACLTokenPolicyLink{{ID: structs.ACLPolicyGlobalManagementID}}
+		})
+		require.NoError(t, err)
+
+		req := structs.ACLBootstrapRotateRequest{
+			Datacenter:   "dc1",
+			AccessorID:   managementToken.AccessorID,
+			WriteRequest: structs.WriteRequest{Token: TestDefaultInitialManagementToken},
+		}
+		var out structs.ACLToken
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ACL.BootstrapTokenRotate", &req, &out))
+		require.NotEqual(t, managementToken.AccessorID, out.AccessorID)
+
+		_, previous, err := state.ACLTokenGetByAccessor(nil, managementToken.AccessorID, nil)
+		require.NoError(t, err)
+		require.True(t, previous.HasExpirationTime())
+		require.WithinDuration(t, time.Now().Add(aclBootstrapRotateDefaultTTL), *previous.ExpirationTime, time.Minute)
+	})
+
+	t.Run("not a management token", func(t *testing.T) {
+		tok, err := upsertTestToken(codec, TestDefaultInitialManagementToken, "dc1", nil)
+		require.NoError(t, err)
+
+		req := structs.ACLBootstrapRotateRequest{
+			Datacenter:   "dc1",
+			AccessorID:   tok.AccessorID,
+			WriteRequest: structs.WriteRequest{Token: TestDefaultInitialManagementToken},
+		}
+		var out structs.ACLToken
+		err = msgpackrpc.CallWithCodec(codec, "ACL.BootstrapTokenRotate", &req, &out)
+		testutil.RequireErrorContains(t, err, "is not linked to the global-management policy")
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		req := structs.ACLBootstrapRotateRequest{
+			Datacenter:    "dc1",
+			ExpirationTTL: 24 * time.Hour,
+			WriteRequest:  structs.WriteRequest{Token: TestDefaultInitialManagementToken},
+		}
+		var out structs.ACLToken
+		err := msgpackrpc.CallWithCodec(codec, "ACL.BootstrapTokenRotate", &req, &out)
+		testutil.RequireErrorContains(t, err, "ExpirationTime cannot be more than")
+	})
+}
+
 func TestACLEndpoint_ReplicationStatus(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -1541,6 +1632,61 @@ func TestACLEndpoint_TokenSet_anon(t *testing.T) {
 
 }
 
+func TestACLEndpoint_TokenSet_BoundCIDRs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	token, err := upsertTestToken(codec, TestDefaultInitialManagementToken, "dc1", func(token *structs.ACLToken) {
+		token.BoundCIDRs = []string{"10.1.2.3/8", "192.168.0.1"}
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.0/8", "192.168.0.1/32"}, token.BoundCIDRs)
+
+	t.Run("resolver", func(t *testing.T) {
+		require.NoError(t, srv.ACLResolver.CheckTokenSource(token.SecretID, net.ParseIP("10.20.30.40")))
+		require.NoError(t, srv.ACLResolver.CheckTokenSource(token.SecretID, net.ParseIP("192.168.0.1")))
+
+		err := srv.ACLResolver.CheckTokenSource(token.SecretID, net.ParseIP("192.168.0.2"))
+		require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+
+		// Tokens without bound CIDRs may be used from anywhere.
+		require.NoError(t, srv.ACLResolver.CheckTokenSource(TestDefaultInitialManagementToken, net.ParseIP("192.168.0.2")))
+	})
+
+	t.Run("rpc", func(t *testing.T) {
+		req := structs.ACLTokenGetRequest{
+			Datacenter:  "dc1",
+			TokenID:     token.SecretID,
+			TokenIDType: structs.ACLTokenSecret,
+			QueryOptions: structs.QueryOptions{
+				Token:      token.SecretID,
+				SourceAddr: "10.20.30.40",
+			},
+		}
+		var resp structs.ACLTokenResponse
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ACL.TokenRead", &req, &resp))
+		require.Equal(t, token.AccessorID, resp.Token.AccessorID)
+
+		req.SourceAddr = "192.168.0.2"
+		err := msgpackrpc.CallWithCodec(codec, "ACL.TokenRead", &req, &resp)
+		require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+	})
+
+	t.Run("invalid cidr", func(t *testing.T) {
+		_, err := upsertTestToken(codec, TestDefaultInitialManagementToken, "dc1", func(token *structs.ACLToken) {
+			token.BoundCIDRs = []string{"10.0.0.0/40"}
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not a valid CIDR block")
+	})
+}
+
 func TestACLEndpoint_TokenDelete(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -4690,6 +4836,90 @@ func TestACLEndpoint_Login(t *testing.T) {
 	})
 }
 
+func TestACLEndpoint_ValidateLogin(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	endpoint := ACL{srv: srv}
+
+	testSessionID := testauth.StartSession()
+	defer testauth.ResetSession(testSessionID)
+
+	testauth.InstallSessionToken(
+		testSessionID,
+		"fake-web", // no rules
+		"default", "web", "abc123",
+	)
+	testauth.InstallSessionToken(
+		testSessionID,
+		"fake-db", // 1 rule (service)
+		"default", "db", "def456",
+	)
+
+	method, err := upsertTestAuthMethod(codec, TestDefaultInitialManagementToken, "dc1", testSessionID)
+	require.NoError(t, err)
+
+	_, err = upsertTestBindingRule(
+		codec, TestDefaultInitialManagementToken, "dc1", method.Name,
+		"serviceaccount.namespace==default and serviceaccount.name==db",
+		structs.BindingRuleBindTypeService,
+		"method-${serviceaccount.name}",
+	)
+	require.NoError(t, err)
+
+	newRequest := func(bearerToken, token string) *structs.ACLLoginRequest {
+		return &structs.ACLLoginRequest{
+			Auth: &structs.ACLLoginParams{
+				AuthMethod:  method.Name,
+				BearerToken: bearerToken,
+			},
+			Datacenter:   "dc1",
+			WriteRequest: structs.WriteRequest{Token: token},
+		}
+	}
+
+	t.Run("permission denied", func(t *testing.T) {
+		var resp structs.ACLLoginValidation
+		err := endpoint.ValidateLogin(newRequest("fake-db", ""), &resp)
+		require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+	})
+
+	t.Run("invalid method token", func(t *testing.T) {
+		var resp structs.ACLLoginValidation
+		require.NoError(t, endpoint.ValidateLogin(newRequest("invalid", TestDefaultInitialManagementToken), &resp))
+		require.False(t, resp.Valid)
+		require.NotEmpty(t, resp.Error)
+	})
+
+	t.Run("no binding rules match", func(t *testing.T) {
+		var resp structs.ACLLoginValidation
+		require.NoError(t, endpoint.ValidateLogin(newRequest("fake-web", TestDefaultInitialManagementToken), &resp))
+		require.False(t, resp.Valid)
+		require.Equal(t, "no binding rules matched the verified identity", resp.Error)
+		require.Equal(t, "web", resp.ProjectedVars["serviceaccount.name"])
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		var resp structs.ACLLoginValidation
+		require.NoError(t, endpoint.ValidateLogin(newRequest("fake-db", TestDefaultInitialManagementToken), &resp))
+		require.True(t, resp.Valid)
+		require.Empty(t, resp.Error)
+		require.Len(t, resp.ServiceIdentities, 1)
+		require.Equal(t, "method-db", resp.ServiceIdentities[0].ServiceName)
+
+		// No token should have been created.
+		_, tokens, err := srv.fsm.State().ACLTokenList(nil, true, true, "", "", method.Name, nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, tokens)
+	})
+}
+
 func TestACLEndpoint_Login_with_MaxTokenTTL(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
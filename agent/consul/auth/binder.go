@@ -40,6 +40,7 @@ type Bindings struct {
 	Roles             []structs.ACLTokenRoleLink
 	ServiceIdentities []*structs.ACLServiceIdentity
 	NodeIdentities    []*structs.ACLNodeIdentity
+	BoundCIDRs        []string
 	EnterpriseMeta    acl.EnterpriseMeta
 }
 
@@ -83,6 +84,17 @@ func (b *Binder) Bind(authMethod *structs.ACLAuthMethod, verifiedIdentity *authm
 		return &bindings, nil
 	}
 
+	// A token may be used from any of the CIDR blocks of the rules that
+	// matched. A matching rule without CIDR blocks is unrestricted, so it
+	// makes the token usable from anywhere.
+	for _, rule := range matchingRules {
+		if len(rule.BoundCIDRs) == 0 {
+			bindings.BoundCIDRs = nil
+			break
+		}
+		bindings.BoundCIDRs = append(bindings.BoundCIDRs, rule.BoundCIDRs...)
+	}
+
 	// Compute role, service identity, or node identity names by interpolating
 	// the identity's projected variables into the rule BindName templates.
 	for _, rule := range matchingRules {
@@ -161,6 +161,91 @@ func TestBinder_ServiceIdentities_Success(t *testing.T) {
 	}, result.ServiceIdentities)
 }
 
+func TestBinder_BoundCIDRs(t *testing.T) {
+	store := testStateStore(t)
+	binder := &Binder{store: store}
+
+	authMethod := &structs.ACLAuthMethod{
+		Name: "test-auth-method",
+		Type: "testing",
+	}
+	require.NoError(t, store.ACLAuthMethodSet(0, authMethod))
+
+	bindingRules := structs.ACLBindingRules{
+		{
+			ID:         generateID(t),
+			Selector:   "tier==web",
+			BindType:   structs.BindingRuleBindTypeService,
+			BindName:   "web",
+			BoundCIDRs: []string{"10.0.0.0/8"},
+			AuthMethod: authMethod.Name,
+		},
+		{
+			ID:         generateID(t),
+			BindType:   structs.BindingRuleBindTypeService,
+			BindName:   "metrics",
+			BoundCIDRs: []string{"192.168.0.0/16"},
+			AuthMethod: authMethod.Name,
+		},
+		{
+			ID:         generateID(t),
+			Selector:   "tier==db",
+			BindType:   structs.BindingRuleBindTypeService,
+			BindName:   "db",
+			BoundCIDRs: []string{"172.16.0.0/12"},
+			AuthMethod: authMethod.Name,
+		},
+	}
+	require.NoError(t, store.ACLBindingRuleBatchSet(0, bindingRules))
+
+	result, err := binder.Bind(&structs.ACLAuthMethod{}, &authmethod.Identity{
+		SelectableFields: map[string]string{
+			"tier": "web",
+		},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"10.0.0.0/8", "192.168.0.0/16"}, result.BoundCIDRs)
+}
+
+func TestBinder_BoundCIDRs_UnrestrictedRule(t *testing.T) {
+	store := testStateStore(t)
+	binder := &Binder{store: store}
+
+	authMethod := &structs.ACLAuthMethod{
+		Name: "test-auth-method",
+		Type: "testing",
+	}
+	require.NoError(t, store.ACLAuthMethodSet(0, authMethod))
+
+	bindingRules := structs.ACLBindingRules{
+		{
+			ID:         generateID(t),
+			Selector:   "tier==web",
+			BindType:   structs.BindingRuleBindTypeService,
+			BindName:   "web",
+			BoundCIDRs: []string{"10.0.0.0/8"},
+			AuthMethod: authMethod.Name,
+		},
+		{
+			ID:         generateID(t),
+			Selector:   "tier==web",
+			BindType:   structs.BindingRuleBindTypeService,
+			BindName:   "web-admin",
+			AuthMethod: authMethod.Name,
+		},
+	}
+	require.NoError(t, store.ACLBindingRuleBatchSet(0, bindingRules))
+
+	result, err := binder.Bind(&structs.ACLAuthMethod{}, &authmethod.Identity{
+		SelectableFields: map[string]string{
+			"tier": "web",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.ServiceIdentities, 2)
+	require.Empty(t, result.BoundCIDRs)
+}
+
 func TestBinder_ServiceIdentities_NameValidation(t *testing.T) {
 	store := testStateStore(t)
 	binder := &Binder{store: store}
@@ -3,6 +3,8 @@ package auth
 import (
 	"errors"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-memdb"
@@ -124,16 +126,8 @@ func (w *TokenWriter) Create(token *structs.ACLToken, fromLogin bool) (*structs.
 			return nil, errors.New("ExpirationTime cannot be before CreateTime")
 		}
 
-		expiresIn := token.ExpirationTime.Sub(token.CreateTime)
-
-		if expiresIn > w.MaxExpirationTTL {
-			return nil, fmt.Errorf("ExpirationTime cannot be more than %s in the future (was %s)",
-				w.MaxExpirationTTL, expiresIn)
-		}
-
-		if expiresIn < w.MinExpirationTTL {
-			return nil, fmt.Errorf("ExpirationTime cannot be less than %s in the future (was %s)",
-				w.MinExpirationTTL, expiresIn)
+		if err := w.ValidateExpiresIn(token.ExpirationTime.Sub(token.CreateTime)); err != nil {
+			return nil, err
 		}
 	}
 
@@ -213,6 +207,65 @@ func (w *TokenWriter) Update(token *structs.ACLToken) (*structs.ACLToken, error)
 	return w.write(token, match, false)
 }
 
+// Expire schedules the expiration of an existing token ttl from now. Unlike
+// Update this is permitted to change the expiration time, but never to push
+// back an expiration time which is already set.
+func (w *TokenWriter) Expire(accessorID string, ttl time.Duration) (*structs.ACLToken, error) {
+	if err := w.ValidateExpiresIn(ttl); err != nil {
+		return nil, err
+	}
+
+	_, match, err := w.Store.ACLTokenGetByAccessor(nil, accessorID, nil)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("Failed acl token lookup by accessor: %w", err)
+	case match == nil || match.IsExpired(time.Now()):
+		return nil, fmt.Errorf("Cannot find token %q", accessorID)
+	}
+
+	if err := w.checkCanWriteToken(match); err != nil {
+		return nil, err
+	}
+
+	expirationTime := time.Now().Add(ttl)
+	if match.HasExpirationTime() && match.ExpirationTime.Before(expirationTime) {
+		return match, nil
+	}
+
+	token := match.Clone()
+	token.ExpirationTime = &expirationTime
+	token.SetHash(true)
+
+	if _, err := w.RaftApply(structs.ACLTokenSetRequestType, &structs.ACLTokenBatchSetRequest{
+		Tokens: structs.ACLTokens{token},
+	}); err != nil {
+		return nil, fmt.Errorf("Failed to apply token write request: %w", err)
+	}
+
+	w.ACLCache.RemoveIdentityWithSecretToken(token.SecretID)
+
+	_, updatedToken, err := w.Store.ACLTokenGetByAccessor(nil, token.AccessorID, nil)
+	if err != nil || updatedToken == nil {
+		return nil, errors.New("Failed to retrieve token after update")
+	}
+	return updatedToken, nil
+}
+
+// ValidateExpiresIn checks that a token expiring the given duration from now
+// falls within the configured bounds.
+func (w *TokenWriter) ValidateExpiresIn(expiresIn time.Duration) error {
+	if expiresIn > w.MaxExpirationTTL {
+		return fmt.Errorf("ExpirationTime cannot be more than %s in the future (was %s)",
+			w.MaxExpirationTTL, expiresIn)
+	}
+
+	if expiresIn < w.MinExpirationTTL {
+		return fmt.Errorf("ExpirationTime cannot be less than %s in the future (was %s)",
+			w.MinExpirationTTL, expiresIn)
+	}
+	return nil
+}
+
 // Delete the ACL token with the given SecretID from the state store.
 func (w *TokenWriter) Delete(secretID string, fromLogout bool) error {
 	_, token, err := w.Store.ACLTokenGetBySecret(nil, secretID, nil)
@@ -306,6 +359,12 @@ func (w *TokenWriter) write(token, existing *structs.ACLToken, fromLogin bool) (
 	}
 	token.NodeIdentities = nodeIdentities
 
+	boundCIDRs, err := NormalizeBoundCIDRs(token.BoundCIDRs)
+	if err != nil {
+		return nil, err
+	}
+	token.BoundCIDRs = boundCIDRs
+
 	if token.Rules != "" {
 		return nil, errors.New("Rules cannot be specified for this token")
 	}
@@ -447,3 +506,37 @@ func (w *TokenWriter) normalizeNodeIdentities(nodeIDs structs.ACLNodeIdentities)
 	}
 	return nodeIDs.Deduplicate(), nil
 }
+
+// NormalizeBoundCIDRs checks that each of the given values is a CIDR block or
+// an IP address, and returns them as CIDR blocks with duplicates removed. A
+// bare IP address is treated as a block containing only that address.
+func NormalizeBoundCIDRs(cidrs []string) ([]string, error) {
+	var normalized []string
+	seen := make(map[string]struct{})
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("Bound CIDR %q is not a valid CIDR block or IP address", cidr)
+			}
+			if ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("Bound CIDR %q is not a valid CIDR block or IP address", cidr)
+		}
+
+		block := network.String()
+		if _, ok := seen[block]; ok {
+			continue
+		}
+		seen[block] = struct{}{}
+		normalized = append(normalized, block)
+	}
+	return normalized, nil
+}
@@ -362,6 +362,53 @@ func TestTokenWriter_NodeIdentities(t *testing.T) {
 	}
 }
 
+func TestTokenWriter_BoundCIDRs(t *testing.T) {
+	aclCache := &MockACLCache{}
+	aclCache.On("RemoveIdentityWithSecretToken", mock.Anything)
+
+	store := testStateStore(t)
+
+	writer := buildTokenWriter(store, aclCache)
+
+	testCases := map[string]struct {
+		input         []string
+		output        []string
+		errorContains string
+	}{
+		"invalid cidr": {
+			input:         []string{"10.0.0.0/33"},
+			errorContains: "not a valid CIDR block",
+		},
+		"invalid ip": {
+			input:         []string{"not-an-ip"},
+			errorContains: "not a valid CIDR block",
+		},
+		"cidrs are normalized": {
+			input:  []string{"10.1.2.3/8", "192.168.0.1", "2001:db8::1"},
+			output: []string{"10.0.0.0/8", "192.168.0.1/32", "2001:db8::1/128"},
+		},
+		"duplicate cidrs are removed": {
+			input:  []string{"10.0.0.0/8", "10.0.0.1/8"},
+			output: []string{"10.0.0.0/8"},
+		},
+	}
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			updated, err := writer.Create(&structs.ACLToken{
+				NodeIdentities: []*structs.ACLNodeIdentity{{NodeName: "web", Datacenter: "dc1"}},
+				BoundCIDRs:     tc.input,
+			}, false)
+			if tc.errorContains == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.output, updated.BoundCIDRs)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorContains)
+			}
+		})
+	}
+}
+
 func TestTokenWriter_Create_Expiration(t *testing.T) {
 	aclCache := &MockACLCache{}
 	aclCache.On("RemoveIdentityWithSecretToken", mock.Anything)
@@ -187,6 +187,7 @@ func Types() []string {
 // ParseConfig parses the config block for a auth method.
 func ParseConfig(rawConfig map[string]interface{}, out interface{}) error {
 	decodeConf := &mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
 		Result:           out,
 		WeaklyTypedInput: true,
 		ErrorUnused:      true,
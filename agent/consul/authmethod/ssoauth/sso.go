@@ -130,8 +130,10 @@ type Config struct {
 	// just for type=jwt
 	JWKSURL              string        `json:",omitempty"`
 	JWKSCACert           string        `json:",omitempty"`
+	JWKSCacheTTL         time.Duration `json:",omitempty"`
 	JWTValidationPubKeys []string      `json:",omitempty"`
 	BoundIssuer          string        `json:",omitempty"`
+	BoundIssuers         []string      `json:",omitempty"`
 	ExpirationLeeway     time.Duration `json:",omitempty"`
 	NotBeforeLeeway      time.Duration `json:",omitempty"`
 	ClockSkewLeeway      time.Duration `json:",omitempty"`
@@ -154,8 +156,10 @@ func (c *Config) convertForLibrary(methodType string) *oidcauth.Config {
 		// just for type=jwt
 		JWKSURL:              c.JWKSURL,
 		JWKSCACert:           c.JWKSCACert,
+		JWKSCacheTTL:         c.JWKSCacheTTL,
 		JWTValidationPubKeys: c.JWTValidationPubKeys,
 		BoundIssuer:          c.BoundIssuer,
+		BoundIssuers:         c.BoundIssuers,
 		ExpirationLeeway:     c.ExpirationLeeway,
 		NotBeforeLeeway:      c.NotBeforeLeeway,
 		ClockSkewLeeway:      c.ClockSkewLeeway,
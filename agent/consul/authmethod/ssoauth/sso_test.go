@@ -57,6 +57,14 @@ func TestJWT_NewValidator(t *testing.T) {
 			method.Config["JWKSURL"] = oidcServer.Addr() + "/certs"
 			method.Config["JWKSCACert"] = oidcServer.CACert()
 		}), ""},
+		"jwt - jwks with cache ttl and leeways": {makeAuthMethod("jwt", func(method AM) {
+			method.Config["JWKSURL"] = oidcServer.Addr() + "/certs"
+			method.Config["JWKSCACert"] = oidcServer.CACert()
+			method.Config["JWKSCacheTTL"] = "10m"
+			method.Config["BoundIssuers"] = []string{"https://legit.issuer.internal/", "https://other.issuer.internal/"}
+			method.Config["ExpirationLeeway"] = "30s"
+			method.Config["NotBeforeLeeway"] = "30s"
+		}), ""},
 		"normal jwt - oidc discovery": {makeAuthMethod("jwt", func(method AM) {
 			method.Config["OIDCDiscoveryURL"] = oidcServer.Addr()
 			method.Config["OIDCDiscoveryCACert"] = oidcServer.CACert()
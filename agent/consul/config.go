@@ -399,6 +399,11 @@ type Config struct {
 	// PeeringEnabled enables cluster peering.
 	PeeringEnabled bool
 
+	// PeeringStreamStatusInterval is the frequency with which the leader
+	// persists the status of its peering replication streams, so that they
+	// remain readable after a leadership change.
+	PeeringStreamStatusInterval time.Duration
+
 	// Embedded Consul Enterprise specific configuration
 	*EnterpriseConfig
 }
@@ -515,7 +520,8 @@ func DefaultConfig() *Config {
 		DefaultQueryTime:         300 * time.Second,
 		MaxQueryTime:             600 * time.Second,
 
-		PeeringEnabled: true,
+		PeeringEnabled:              true,
+		PeeringStreamStatusInterval: 30 * time.Second,
 
 		EnterpriseConfig: DefaultEnterpriseConfig(),
 	}
@@ -533,10 +533,10 @@ func (c *ConfigEntry) ResolveServiceConfig(args *structs.ServiceConfigRequest, r
 }
 
 func gateWriteToSecondary(targetDC, localDC, primaryDC, kind string) error {
-	// ExportedServices entries are gated from interactions from secondary DCs
-	// because non-default partitions cannot be created in secondaries
-	// and services cannot be exported to another datacenter.
-	if kind != structs.ExportedServices {
+	// ExportedServices and ImportedServices entries are gated from interactions
+	// from secondary DCs because non-default partitions cannot be created in
+	// secondaries and services cannot be exported to another datacenter.
+	if kind != structs.ExportedServices && kind != structs.ImportedServices {
 		return nil
 	}
 	if localDC == "" {
@@ -550,10 +550,10 @@ func gateWriteToSecondary(targetDC, localDC, primaryDC, kind string) error {
 
 	switch {
 	case targetDC == "" && localDC != primaryDC:
-		return fmt.Errorf("exported-services writes in secondary datacenters must target the primary datacenter explicitly.")
+		return fmt.Errorf("%s writes in secondary datacenters must target the primary datacenter explicitly.", kind)
 
 	case targetDC != "" && targetDC != primaryDC:
-		return fmt.Errorf("exported-services writes must not target secondary datacenters.")
+		return fmt.Errorf("%s writes must not target secondary datacenters.", kind)
 
 	}
 	return nil
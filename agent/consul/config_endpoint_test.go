@@ -1221,6 +1221,144 @@ func TestConfigEntry_ResolveServiceConfig_TransparentProxy(t *testing.T) {
 	}
 }
 
+func TestConfigEntry_ResolveServiceConfig_AccessLogs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	proxyDefaults := &structs.ProxyConfigEntry{
+		Kind: structs.ProxyDefaults,
+		Name: structs.ProxyConfigGlobal,
+		AccessLogs: structs.AccessLogsConfig{
+			Enabled:    true,
+			TextFormat: "%START_TIME%",
+		},
+	}
+
+	tt := []struct {
+		name    string
+		entries []structs.ConfigEntry
+		expect  structs.AccessLogsConfig
+	}{
+		{
+			name:    "from proxy-defaults",
+			entries: []structs.ConfigEntry{proxyDefaults},
+			expect:  proxyDefaults.AccessLogs,
+		},
+		{
+			name: "service-defaults overrides proxy-defaults",
+			entries: []structs.ConfigEntry{
+				proxyDefaults,
+				&structs.ServiceConfigEntry{
+					Kind: structs.ServiceDefaults,
+					Name: "foo",
+					AccessLogs: &structs.AccessLogsConfig{
+						Enabled: false,
+						Type:    structs.StdErrLogSinkType,
+					},
+				},
+			},
+			expect: structs.AccessLogsConfig{
+				Enabled: false,
+				Type:    structs.StdErrLogSinkType,
+			},
+		},
+		{
+			name: "service-defaults disables proxy-defaults",
+			entries: []structs.ConfigEntry{
+				proxyDefaults,
+				&structs.ServiceConfigEntry{
+					Kind:       structs.ServiceDefaults,
+					Name:       "foo",
+					AccessLogs: &structs.AccessLogsConfig{Enabled: false},
+				},
+			},
+			expect: structs.AccessLogsConfig{},
+		},
+		{
+			name: "empty service-defaults inherits proxy-defaults",
+			entries: []structs.ConfigEntry{
+				proxyDefaults,
+				&structs.ServiceConfigEntry{
+					Kind:     structs.ServiceDefaults,
+					Name:     "foo",
+					Protocol: "tcp",
+				},
+			},
+			expect: proxyDefaults.AccessLogs,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			dir1, s1 := testServer(t)
+			defer os.RemoveAll(dir1)
+			defer s1.Shutdown()
+
+			codec := rpcClient(t, s1)
+			defer codec.Close()
+
+			idx := uint64(1)
+			for _, conf := range tc.entries {
+				require.NoError(t, s1.fsm.State().EnsureConfigEntry(idx, conf))
+				idx++
+			}
+
+			args := structs.ServiceConfigRequest{
+				Name:       "foo",
+				Datacenter: "dc1",
+			}
+			var out structs.ServiceConfigResponse
+			require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.ResolveServiceConfig", &args, &out))
+			require.Equal(t, tc.expect, out.AccessLogs)
+		})
+	}
+}
+
+func TestConfigEntry_ResolveServiceConfig_TracingAndMetrics(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	proxyDefaults := &structs.ProxyConfigEntry{
+		Kind: structs.ProxyDefaults,
+		Name: structs.ProxyConfigGlobal,
+		Tracing: structs.TracingConfig{
+			Provider:           structs.OpenTelemetryTracingProvider,
+			CollectorAddress:   "otel-collector:4317",
+			SamplingPercentage: 25,
+			CustomTags: []structs.TracingCustomTag{
+				{Tag: "team", Literal: "payments"},
+			},
+		},
+		Metrics: structs.MetricsConfig{
+			StatsdURL:          "udp://127.0.0.1:8125",
+			StatsFlushInterval: "10s",
+		},
+	}
+	require.NoError(t, s1.fsm.State().EnsureConfigEntry(1, proxyDefaults))
+
+	args := structs.ServiceConfigRequest{
+		Name:       "foo",
+		Datacenter: "dc1",
+	}
+	var out structs.ServiceConfigResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.ResolveServiceConfig", &args, &out))
+	require.Equal(t, proxyDefaults.Tracing, out.Tracing)
+	require.Equal(t, proxyDefaults.Metrics, out.Metrics)
+}
+
 func TestConfigEntry_ResolveServiceConfig_Upstreams(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -99,8 +99,8 @@ func (s *Server) reconcileLocalConfig(ctx context.Context, configs []structs.Con
 
 	var merr error
 	for i, entry := range configs {
-		// Exported services only apply to the primary datacenter.
-		if entry.GetKind() == structs.ExportedServices {
+		// Exported and imported services only apply to the primary datacenter.
+		if entry.GetKind() == structs.ExportedServices || entry.GetKind() == structs.ImportedServices {
 			continue
 		}
 		req := structs.ConfigEntryRequest{
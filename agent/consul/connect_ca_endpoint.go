@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 
+	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
@@ -208,3 +209,90 @@ func (s *ConnectCA) SignIntermediate(
 
 	return nil
 }
+
+// SignJWT signs a JWT-SVID for a service. JWT-SVIDs are restricted to the
+// requested audience and are short lived, so they are meant to be requested
+// for each use.
+func (s *ConnectCA) SignJWT(
+	args *structs.JWTSVIDRequest,
+	reply *structs.IssuedJWT) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.SignJWT", args, reply); done {
+		return err
+	}
+
+	var authzContext acl.AuthorizerContext
+	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	if err != nil {
+		return err
+	}
+	if err := s.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(args.Service, &authzContext); err != nil {
+		return err
+	}
+
+	if args.Service == "" {
+		return fmt.Errorf("Must provide a service name")
+	}
+	if len(args.Audience) == 0 {
+		return fmt.Errorf("Must provide at least one audience")
+	}
+	ttl := args.TTL
+	if ttl == 0 {
+		ttl = structs.DefaultJWTSVIDTTL
+	}
+	if ttl < 0 || ttl > structs.MaxJWTSVIDTTL {
+		return fmt.Errorf("TTL must be between 0 and %s", structs.MaxJWTSVIDTTL)
+	}
+
+	_, config, err := s.srv.fsm.State().CAConfig(nil)
+	if err != nil {
+		return err
+	}
+	if config == nil || config.ClusterID == "" {
+		return fmt.Errorf("CA is uninitialized and unable to sign JWT-SVIDs yet: no cluster ID")
+	}
+
+	id := &connect.SpiffeIDService{
+		Host:       connect.SpiffeIDSigningForCluster(config.ClusterID).Host(),
+		Partition:  args.PartitionOrDefault(),
+		Namespace:  args.NamespaceOrDefault(),
+		Datacenter: s.srv.config.Datacenter,
+		Service:    args.Service,
+	}
+	token, err := s.srv.signJWTSVID(id, args.Audience, ttl)
+	if err != nil {
+		return err
+	}
+	*reply = *token
+	return nil
+}
+
+// JWTKeys returns the public keys JWT-SVIDs issued by the datacenter are
+// signed with.
+func (s *ConnectCA) JWTKeys(
+	args *structs.DCSpecificRequest,
+	reply *structs.IndexedCAJWTKeys) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.JWTKeys", args, reply); done {
+		return err
+	}
+
+	keys, err := s.srv.connectJWTKeys()
+	if err != nil {
+		return err
+	}
+	reply.Keys = keys
+	s.srv.setQueryMeta(&reply.QueryMeta, args.Token)
+	return nil
+}
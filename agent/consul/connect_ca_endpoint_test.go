@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2/jwt"
 
 	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
 
@@ -1170,3 +1171,172 @@ func TestConnectCASignValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectCASignJWT(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = "root"
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	webToken := createToken(t, codec, `service "web" { policy = "write" }`)
+
+	// The signing key is generated once the CA has been initialized.
+	var keys structs.IndexedCAJWTKeys
+	retry.Run(t, func(r *retry.R) {
+		require.NoError(r, msgpackrpc.CallWithCodec(codec, "ConnectCA.JWTKeys",
+			&structs.DCSpecificRequest{Datacenter: "dc1"}, &keys))
+		require.Len(r, keys.Keys, 1)
+	})
+	block, _ := pem.Decode([]byte(keys.Keys[0].PublicKey))
+	require.NotNil(t, block)
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		args := &structs.JWTSVIDRequest{
+			Datacenter:   "dc1",
+			Service:      "web",
+			Audience:     []string{"db"},
+			TTL:          time.Minute,
+			QueryOptions: structs.QueryOptions{Token: webToken},
+		}
+		var reply structs.IssuedJWT
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWT", args, &reply))
+		require.Equal(t, "web", reply.Service)
+		require.Equal(t, []string{"db"}, reply.Audience)
+
+		token, err := jwt.ParseSigned(reply.Token)
+		require.NoError(t, err)
+		require.Len(t, token.Headers, 1)
+		require.Equal(t, keys.Keys[0].ID, token.Headers[0].KeyID)
+		require.Equal(t, "ES256", token.Headers[0].Algorithm)
+
+		var claims jwt.Claims
+		require.NoError(t, token.Claims(pub, &claims))
+		require.NoError(t, claims.Validate(jwt.Expected{
+			Subject:  reply.ServiceURI,
+			Audience: jwt.Audience{"db"},
+			Time:     time.Now(),
+		}))
+		require.WithinDuration(t, time.Now().Add(time.Minute), claims.Expiry.Time(), 5*time.Second)
+
+		id, err := connect.ParseCertURIFromString(reply.ServiceURI)
+		require.NoError(t, err)
+		serviceID, ok := id.(*connect.SpiffeIDService)
+		require.True(t, ok)
+		require.Equal(t, "web", serviceID.Service)
+		require.Equal(t, "dc1", serviceID.Datacenter)
+	})
+
+	tests := []struct {
+		name    string
+		args    *structs.JWTSVIDRequest
+		wantErr string
+	}{
+		{
+			name:    "no service:write",
+			args:    &structs.JWTSVIDRequest{Service: "db", Audience: []string{"web"}},
+			wantErr: "Permission denied",
+		},
+		{
+			name:    "no audience",
+			args:    &structs.JWTSVIDRequest{Service: "web"},
+			wantErr: "Must provide at least one audience",
+		},
+		{
+			name:    "TTL too long",
+			args:    &structs.JWTSVIDRequest{Service: "web", Audience: []string{"db"}, TTL: 2 * time.Hour},
+			wantErr: "TTL must be between",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.Datacenter = "dc1"
+			tt.args.Token = webToken
+			var reply structs.IssuedJWT
+			err := msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWT", tt.args, &reply)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestConnectCAJWTKeys_RotateWithRoot(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, s1 := testServer(t)
+	codec := rpcClient(t, s1)
+	testrpc.WaitForActiveCARoot(t, s1.RPC, "dc1", nil)
+
+	getKeys := func(t require.TestingT) []structs.CAJWTKey {
+		var keys structs.IndexedCAJWTKeys
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.JWTKeys",
+			&structs.DCSpecificRequest{Datacenter: "dc1"}, &keys))
+		return keys.Keys
+	}
+	signedKeyID := func(t *testing.T) string {
+		args := &structs.JWTSVIDRequest{Datacenter: "dc1", Service: "web", Audience: []string{"db"}}
+		var reply structs.IssuedJWT
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWT", args, &reply))
+		token, err := jwt.ParseSigned(reply.Token)
+		require.NoError(t, err)
+		return token.Headers[0].KeyID
+	}
+	rotateRoot := func(t *testing.T) {
+		_, newKey, err := connect.GeneratePrivateKey()
+		require.NoError(t, err)
+		args := &structs.CARequest{
+			Datacenter: "dc1",
+			Config: &structs.CAConfiguration{
+				Provider: "consul",
+				Config:   map[string]interface{}{"PrivateKey": newKey},
+			},
+		}
+		var reply interface{}
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationSet", args, &reply))
+	}
+
+	var first []structs.CAJWTKey
+	retry.Run(t, func(r *retry.R) {
+		first = getKeys(r)
+		require.Len(r, first, 1)
+	})
+	require.Equal(t, first[0].ID, signedKeyID(t))
+
+	// The new key is used for signing, and the old one is still published
+	// so that tokens signed with it can be verified until they expire.
+	rotateRoot(t)
+	var second []structs.CAJWTKey
+	retry.Run(t, func(r *retry.R) {
+		second = getKeys(r)
+		require.Len(r, second, 2)
+	})
+	require.Equal(t, first[0], second[1])
+	require.Equal(t, second[0].ID, signedKeyID(t))
+
+	// Only the previous key is kept.
+	rotateRoot(t)
+	retry.Run(t, func(r *retry.R) {
+		third := getKeys(r)
+		require.Len(r, third, 2)
+		require.Equal(r, second[0], third[1])
+	})
+}
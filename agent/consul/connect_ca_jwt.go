@@ -0,0 +1,220 @@
+package consul
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// connectJWTSigningKeys holds the keys JWT-SVIDs are signed with. It is kept
+// in the system metadata, which is not exposed outside of the servers, as a
+// single entry so that it is always updated atomically.
+type connectJWTSigningKeys struct {
+	// RootID is the ID of the active CA root when PrivateKey was generated.
+	RootID string
+
+	// PrivateKey is the key new JWT-SVIDs are signed with.
+	PrivateKey string
+
+	// PreviousPrivateKey is the key that was replaced by PrivateKey. Its
+	// public key is still published until the next rotation so that
+	// JWT-SVIDs signed with it remain valid until they expire.
+	PreviousPrivateKey string `json:",omitempty"`
+}
+
+// runConnectJWTSigningKeyRotation generates a new JWT-SVID signing key each
+// time the active CA root changes, including when the CA is first
+// initialized.
+func (s *Server) runConnectJWTSigningKeyRotation(ctx context.Context) error {
+	retryLoopBackoff(ctx, func() error {
+		state := s.fsm.State()
+		ws := memdb.NewWatchSet()
+		ws.Add(state.AbandonCh())
+		_, root, err := state.CARootActive(ws)
+		if err != nil {
+			return err
+		}
+		if root != nil {
+			if err := s.rotateConnectJWTSigningKey(root.ID); err != nil {
+				return err
+			}
+		}
+
+		ws.WatchCtx(ctx)
+		return nil
+	}, func(err error) {
+		s.logger.Error("error rotating JWT-SVID signing key", "error", err)
+	})
+	return nil
+}
+
+// rotateConnectJWTSigningKey generates a new JWT-SVID signing key unless the
+// current one was generated for the CA root with the given ID. The replaced
+// key is kept as the previous key.
+func (s *Server) rotateConnectJWTSigningKey(rootID string) error {
+	keys, err := s.getConnectJWTSigningKeys()
+	if err != nil {
+		return err
+	}
+	if keys != nil && keys.RootID == rootID {
+		return nil
+	}
+
+	_, pk, err := connect.GeneratePrivateKeyWithConfig("ec", 256)
+	if err != nil {
+		return fmt.Errorf("error generating JWT-SVID signing key: %w", err)
+	}
+	next := connectJWTSigningKeys{RootID: rootID, PrivateKey: pk}
+	if keys != nil {
+		next.PreviousPrivateKey = keys.PrivateKey
+	}
+	raw, err := json.Marshal(next)
+	if err != nil {
+		return err
+	}
+	if err := s.setSystemMetadataKey(structs.SystemMetadataConnectJWTSigningKey, string(raw)); err != nil {
+		return fmt.Errorf("error storing JWT-SVID signing key: %w", err)
+	}
+	s.logger.Info("generated JWT-SVID signing key", "root_id", rootID)
+	return nil
+}
+
+// getConnectJWTSigningKeys returns the JWT-SVID signing keys, or nil if the
+// leader hasn't generated them yet.
+func (s *Server) getConnectJWTSigningKeys() (*connectJWTSigningKeys, error) {
+	raw, err := s.getSystemMetadata(structs.SystemMetadataConnectJWTSigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("error reading JWT-SVID signing key: %w", err)
+	}
+	if raw == "" {
+		return nil, nil
+	}
+	var keys connectJWTSigningKeys
+	if err := json.Unmarshal([]byte(raw), &keys); err != nil {
+		return nil, fmt.Errorf("error decoding JWT-SVID signing key: %w", err)
+	}
+	return &keys, nil
+}
+
+// connectJWTSigningKey returns the key JWT-SVIDs are signed with, or nil if
+// the leader hasn't generated it yet.
+func (s *Server) connectJWTSigningKey() (*ecdsa.PrivateKey, error) {
+	keys, err := s.getConnectJWTSigningKeys()
+	if err != nil || keys == nil {
+		return nil, err
+	}
+	return parseConnectJWTSigningKey(keys.PrivateKey)
+}
+
+func parseConnectJWTSigningKey(pk string) (*ecdsa.PrivateKey, error) {
+	signer, err := connect.ParseSigner(pk)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing JWT-SVID signing key: %w", err)
+	}
+	key, ok := signer.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("JWT-SVID signing key is not an EC key")
+	}
+	return key, nil
+}
+
+// connectJWTKeyID returns the ID of the public key, which is its JWK
+// thumbprint as defined in RFC 7638.
+func connectJWTKeyID(pub crypto.PublicKey) (string, error) {
+	jwk := jose.JSONWebKey{Key: pub}
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// connectJWTKeys returns the public keys JWT-SVIDs are signed with: the
+// current key followed by the previous one, if there is one.
+func (s *Server) connectJWTKeys() ([]structs.CAJWTKey, error) {
+	keys, err := s.getConnectJWTSigningKeys()
+	if err != nil || keys == nil {
+		return nil, err
+	}
+
+	var result []structs.CAJWTKey
+	for _, pk := range []string{keys.PrivateKey, keys.PreviousPrivateKey} {
+		if pk == "" {
+			continue
+		}
+		key, err := parseConnectJWTSigningKey(pk)
+		if err != nil {
+			return nil, err
+		}
+		kid, err := connectJWTKeyID(key.Public())
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, structs.CAJWTKey{
+			ID:        kid,
+			PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		})
+	}
+	return result, nil
+}
+
+// signJWTSVID signs a JWT-SVID with the given subject, audience and lifetime.
+func (s *Server) signJWTSVID(id *connect.SpiffeIDService, audience []string, ttl time.Duration) (*structs.IssuedJWT, error) {
+	key, err := s.connectJWTSigningKey()
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("CA is uninitialized and unable to sign JWT-SVIDs yet: no signing key")
+	}
+	kid, err := connectJWTKeyID(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: key, KeyID: kid}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	subject := id.URI().String()
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Subject:  subject,
+		Audience: jwt.Audience(audience),
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(expiresAt),
+	}).CompactSerialize()
+	if err != nil {
+		return nil, fmt.Errorf("error signing JWT-SVID: %w", err)
+	}
+
+	return &structs.IssuedJWT{
+		Token:      token,
+		Service:    id.Service,
+		ServiceURI: subject,
+		Audience:   audience,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/mitchellh/hashstructure"
 	"github.com/mitchellh/mapstructure"
 
+	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/configentry"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
@@ -368,6 +369,10 @@ func (c *compiler) determineIfDefaultChain() bool {
 	if !node.Resolver.Default {
 		return false
 	}
+	if node.Resolver.Failover != nil {
+		// Default resolvers can still fail over to a default sameness group.
+		return false
+	}
 
 	target := c.loadedTargets[node.Resolver.Target]
 
@@ -703,6 +708,45 @@ func (c *compiler) newTarget(service, serviceSubset, namespace, partition, datac
 	return t
 }
 
+// newPeerTarget returns a target for a service imported from a cluster peer.
+// Peer targets are never rewritten by resolvers in this cluster.
+func (c *compiler) newPeerTarget(service, namespace, partition, peer string) *structs.DiscoveryTarget {
+	t := structs.NewPeerDiscoveryTarget(
+		service,
+		defaultIfEmpty(namespace, c.evaluateInNamespace),
+		defaultIfEmpty(partition, c.evaluateInPartition),
+		peer,
+	)
+
+	// The SNI of imported services is only known once their endpoints are
+	// fetched, so only the name is set here.
+	t.Name = connect.PeeredServiceSNI(t.Service, t.Namespace, t.Partition, t.Peer, c.evaluateInTrustDomain)
+
+	prev, ok := c.loadedTargets[t.ID]
+	if ok {
+		return prev
+	}
+	c.loadedTargets[t.ID] = t
+	return t
+}
+
+// samenessGroupFailoverTargets returns a failover target for every member of
+// the sameness group, in order, except for the partition of the target itself.
+func (c *compiler) samenessGroupFailoverTargets(target *structs.DiscoveryTarget, group *structs.SamenessGroupConfigEntry) []*structs.DiscoveryTarget {
+	var targets []*structs.DiscoveryTarget
+	for _, member := range group.Members {
+		if member.Peer != "" {
+			targets = append(targets, c.newPeerTarget(target.Service, target.Namespace, target.Partition, member.Peer))
+			continue
+		}
+		if acl.EqualPartitions(member.Partition, target.Partition) {
+			continue // don't failover to yourself
+		}
+		targets = append(targets, c.newTarget(target.Service, "", target.Namespace, member.Partition, target.Datacenter))
+	}
+	return targets
+}
+
 func (c *compiler) rewriteTarget(t *structs.DiscoveryTarget, service, serviceSubset, partition, namespace, datacenter string) *structs.DiscoveryTarget {
 	var (
 		service2       = t.Service
@@ -936,9 +980,10 @@ RESOLVE_AGAIN:
 		Type: structs.DiscoveryGraphNodeTypeResolver,
 		Name: target.ID,
 		Resolver: &structs.DiscoveryResolver{
-			Default:        resolver.IsDefault(),
-			Target:         target.ID,
-			ConnectTimeout: connectTimeout,
+			Default:              resolver.IsDefault(),
+			Target:               target.ID,
+			ConnectTimeout:       connectTimeout,
+			PrioritizeByLocality: resolver.PrioritizeByLocality,
 		},
 		LoadBalancer: resolver.LoadBalancer,
 	}
@@ -1018,6 +1063,11 @@ RESOLVE_AGAIN:
 	// reasonably if there is some sort of graph loop below.
 	c.recordNode(node)
 
+	// Determine which failover definitions apply.
+	var (
+		failoverTargets []*structs.DiscoveryTarget
+		samenessGroup   *structs.SamenessGroupConfigEntry
+	)
 	if len(resolver.Failover) > 0 {
 		f := resolver.Failover
 
@@ -1027,25 +1077,12 @@ RESOLVE_AGAIN:
 			failover, ok = f["*"]
 		}
 
-		if ok {
-			// Determine which failover definitions apply.
-			var failoverTargets []*structs.DiscoveryTarget
-			if len(failover.Datacenters) > 0 {
-				for _, dc := range failover.Datacenters {
-					// Rewrite the target as per the failover policy.
-					failoverTarget := c.rewriteTarget(
-						target,
-						failover.Service,
-						failover.ServiceSubset,
-						target.Partition,
-						failover.Namespace,
-						dc,
-					)
-					if failoverTarget.ID != target.ID { // don't failover to yourself
-						failoverTargets = append(failoverTargets, failoverTarget)
-					}
-				}
-			} else {
+		if ok && failover.SamenessGroup != "" {
+			// A missing sameness group is not an error: the chain just
+			// doesn't fail over until the group is created.
+			samenessGroup = c.entries.GetSamenessGroup(failover.SamenessGroup)
+		} else if ok && len(failover.Datacenters) > 0 {
+			for _, dc := range failover.Datacenters {
 				// Rewrite the target as per the failover policy.
 				failoverTarget := c.rewriteTarget(
 					target,
@@ -1053,30 +1090,60 @@ RESOLVE_AGAIN:
 					failover.ServiceSubset,
 					target.Partition,
 					failover.Namespace,
-					"",
+					dc,
 				)
 				if failoverTarget.ID != target.ID { // don't failover to yourself
 					failoverTargets = append(failoverTargets, failoverTarget)
 				}
 			}
+		} else if ok {
+			// Rewrite the target as per the failover policy.
+			failoverTarget := c.rewriteTarget(
+				target,
+				failover.Service,
+				failover.ServiceSubset,
+				target.Partition,
+				failover.Namespace,
+				"",
+			)
+			if failoverTarget.ID != target.ID { // don't failover to yourself
+				failoverTargets = append(failoverTargets, failoverTarget)
+			}
+		}
+	} else if !target.External {
+		// Services without a failover policy of their own fail over to the
+		// default sameness group, if there is one.
+		samenessGroup = c.entries.GetDefaultSamenessGroup()
+	}
 
-			// If we filtered everything out then no point in having a failover.
-			if len(failoverTargets) > 0 {
-				df := &structs.DiscoveryFailover{}
-				node.Resolver.Failover = df
+	if samenessGroup != nil {
+		failoverTargets = c.samenessGroupFailoverTargets(target, samenessGroup)
+	}
 
-				// Take care of doing any redirects or configuration loading
-				// related to targets by cheating a bit and recursing into
-				// ourselves.
-				for _, target := range failoverTargets {
-					failoverResolveNode, err := c.getResolverNode(target, true)
-					if err != nil {
-						return nil, err
-					}
-					failoverTarget := failoverResolveNode.Resolver.Target
-					df.Targets = append(df.Targets, failoverTarget)
-				}
+	// If we filtered everything out then no point in having a failover.
+	if len(failoverTargets) > 0 {
+		df := &structs.DiscoveryFailover{}
+		node.Resolver.Failover = df
+
+		for _, target := range failoverTargets {
+			if target.Peer != "" {
+				// Imported services have no resolver of their own in this
+				// cluster, so there is nothing to recurse into.
+				target.ConnectTimeout = connectTimeout
+				c.retainedTargets[target.ID] = struct{}{}
+				df.Targets = append(df.Targets, target.ID)
+				continue
+			}
+
+			// Take care of doing any redirects or configuration loading
+			// related to targets by cheating a bit and recursing into
+			// ourselves.
+			failoverResolveNode, err := c.getResolverNode(target, true)
+			if err != nil {
+				return nil, err
 			}
+			failoverTarget := failoverResolveNode.Resolver.Target
+			df.Targets = append(df.Targets, failoverTarget)
 		}
 	}
 
@@ -46,6 +46,9 @@ func TestCompile(t *testing.T) {
 		"service and subset failover":                      testcase_ServiceAndSubsetFailover(),
 		"datacenter failover":                              testcase_DatacenterFailover(),
 		"datacenter failover with mesh gateways":           testcase_DatacenterFailover_WithMeshGateways(),
+		"sameness group failover":                          testcase_SamenessGroupFailover(),
+		"default sameness group failover":                  testcase_DefaultSamenessGroupFailover(),
+		"missing sameness group failover":                  testcase_MissingSamenessGroupFailover(),
 		"noop split to resolver with default subset":       testcase_NoopSplit_WithDefaultSubset(),
 		"resolver with default subset":                     testcase_Resolve_WithDefaultSubset(),
 		"default resolver with external sni":               testcase_DefaultResolver_ExternalSNI(),
@@ -100,6 +103,10 @@ func TestCompile(t *testing.T) {
 				require.NoError(t, entry.Normalize())
 				require.NoError(t, entry.Validate())
 			}
+			for _, entry := range tc.entries.SamenessGroups {
+				require.NoError(t, entry.Normalize())
+				require.NoError(t, entry.Validate())
+			}
 
 			req := CompileRequest{
 				ServiceName:           "main",
@@ -1370,6 +1377,135 @@ func testcase_DatacenterFailover_WithMeshGateways() compileTestCase {
 	return compileTestCase{entries: entries, expect: expect}
 }
 
+func testcase_SamenessGroupFailover() compileTestCase {
+	entries := newEntries()
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind:           "service-resolver",
+			Name:           "main",
+			ConnectTimeout: 33 * time.Second,
+			Failover: map[string]structs.ServiceResolverFailover{
+				"*": {SamenessGroup: "group"},
+			},
+		},
+	)
+	entries.AddSamenessGroups(
+		&structs.SamenessGroupConfigEntry{
+			Name: "group",
+			Members: []structs.SamenessGroupMember{
+				{Partition: "default"},
+				{Peer: "cluster-01"},
+				{Peer: "cluster-02"},
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "tcp",
+		StartNode: "resolver:main.default.default.dc1",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 33 * time.Second,
+					Target:         "main.default.default.dc1",
+					Failover: &structs.DiscoveryFailover{
+						Targets: []string{
+							"main.default.default.external.cluster-01",
+							"main.default.default.external.cluster-02",
+						},
+					},
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1": targetWithConnectTimeout(
+				newTarget("main", "", "default", "default", "dc1", nil),
+				33*time.Second,
+			),
+			"main.default.default.external.cluster-01": targetWithConnectTimeout(
+				newPeerTarget("main", "default", "default", "cluster-01"),
+				33*time.Second,
+			),
+			"main.default.default.external.cluster-02": targetWithConnectTimeout(
+				newPeerTarget("main", "default", "default", "cluster-02"),
+				33*time.Second,
+			),
+		},
+	}
+	return compileTestCase{entries: entries, expect: expect}
+}
+
+func testcase_DefaultSamenessGroupFailover() compileTestCase {
+	entries := newEntries()
+	entries.AddSamenessGroups(
+		&structs.SamenessGroupConfigEntry{
+			Name:               "group",
+			DefaultForFailover: true,
+			Members: []structs.SamenessGroupMember{
+				{Peer: "cluster-01"},
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "tcp",
+		StartNode: "resolver:main.default.default.dc1",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+					Failover: &structs.DiscoveryFailover{
+						Targets: []string{"main.default.default.external.cluster-01"},
+					},
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1":                 newTarget("main", "", "default", "default", "dc1", nil),
+			"main.default.default.external.cluster-01": newPeerTarget("main", "default", "default", "cluster-01"),
+		},
+	}
+	return compileTestCase{entries: entries, expect: expect}
+}
+
+func testcase_MissingSamenessGroupFailover() compileTestCase {
+	entries := newEntries()
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "main",
+			Failover: map[string]structs.ServiceResolverFailover{
+				"*": {SamenessGroup: "group"},
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "tcp",
+		StartNode: "resolver:main.default.default.dc1",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1": newTarget("main", "", "default", "default", "dc1", nil),
+		},
+	}
+	return compileTestCase{entries: entries, expect: expect}
+}
+
 func testcase_NoopSplit_WithDefaultSubset() compileTestCase {
 	entries := newEntries()
 	setServiceProtocol(entries, "main", "http")
@@ -2802,6 +2938,13 @@ func newTarget(service, serviceSubset, namespace, partition, datacenter string,
 	return t
 }
 
+func newPeerTarget(service, namespace, partition, peer string) *structs.DiscoveryTarget {
+	t := structs.NewPeerDiscoveryTarget(service, namespace, partition, peer)
+	t.Name = connect.PeeredServiceSNI(service, namespace, partition, peer, "trustdomain.consul")
+	t.ConnectTimeout = 5 * time.Second // default
+	return t
+}
+
 func targetWithConnectTimeout(t *structs.DiscoveryTarget, connectTimeout time.Duration) *structs.DiscoveryTarget {
 	t.ConnectTimeout = connectTimeout
 	return t
@@ -141,6 +141,8 @@ func init() {
 	registerCommand(structs.PeeringTerminateByIDType, (*FSM).applyPeeringTerminate)
 	registerCommand(structs.PeeringTrustBundleWriteType, (*FSM).applyPeeringTrustBundleWrite)
 	registerCommand(structs.PeeringTrustBundleDeleteType, (*FSM).applyPeeringTrustBundleDelete)
+	registerCommand(structs.PeeringSecretsWriteType, (*FSM).applyPeeringSecretsWrite)
+	registerCommand(structs.PeeringStreamStatusWriteType, (*FSM).applyPeeringStreamStatusWrite)
 }
 
 func (c *FSM) applyRegister(buf []byte, index uint64) interface{} {
@@ -699,9 +701,36 @@ func (c *FSM) applyPeeringWrite(buf []byte, index uint64) interface{} {
 	defer metrics.MeasureSinceWithLabels([]string{"fsm", "peering"}, time.Now(),
 		[]metrics.Label{{Name: "op", Value: "write"}})
 
+	if req.SecretsRequest != nil {
+		return c.state.PeeringWriteWithSecrets(index, req.Peering, req.SecretsRequest)
+	}
 	return c.state.PeeringWrite(index, req.Peering)
 }
 
+func (c *FSM) applyPeeringSecretsWrite(buf []byte, index uint64) interface{} {
+	var req pbpeering.PeeringSecretsWriteRequest
+	if err := structs.DecodeProto(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode peering secrets write request: %v", err))
+	}
+
+	defer metrics.MeasureSinceWithLabels([]string{"fsm", "peering_secrets"}, time.Now(),
+		[]metrics.Label{{Name: "op", Value: "write"}})
+
+	return c.state.PeeringSecretsWrite(index, &req)
+}
+
+func (c *FSM) applyPeeringStreamStatusWrite(buf []byte, index uint64) interface{} {
+	var req pbpeering.PeeringStreamStatusWriteRequest
+	if err := structs.DecodeProto(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode peering stream status write request: %v", err))
+	}
+
+	defer metrics.MeasureSinceWithLabels([]string{"fsm", "peering_stream_status"}, time.Now(),
+		[]metrics.Label{{Name: "op", Value: "write"}})
+
+	return c.state.PeeringStreamStatusWrite(index, &req)
+}
+
 // TODO(peering): replace with deferred deletion since this operation
 // should involve cleanup of data associated with the peering.
 func (c *FSM) applyPeeringDelete(buf []byte, index uint64) interface{} {
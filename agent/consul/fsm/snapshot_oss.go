@@ -38,6 +38,8 @@ func init() {
 	registerRestorer(structs.FreeVirtualIPRequestType, restoreFreeVirtualIP)
 	registerRestorer(structs.PeeringWriteType, restorePeering)
 	registerRestorer(structs.PeeringTrustBundleWriteType, restorePeeringTrustBundle)
+	registerRestorer(structs.PeeringSecretsWriteType, restorePeeringSecrets)
+	registerRestorer(structs.PeeringStreamStatusWriteType, restorePeeringStreamStatus)
 }
 
 func persistOSS(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) error {
@@ -95,6 +97,12 @@ func persistOSS(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) err
 	if err := s.persistPeeringTrustBundles(sink, encoder); err != nil {
 		return err
 	}
+	if err := s.persistPeeringSecrets(sink, encoder); err != nil {
+		return err
+	}
+	if err := s.persistPeeringStreamStatuses(sink, encoder); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -582,6 +590,42 @@ func (s *snapshot) persistPeeringTrustBundles(sink raft.SnapshotSink, encoder *c
 	return nil
 }
 
+func (s *snapshot) persistPeeringSecrets(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	secrets, err := s.state.PeeringSecrets()
+	if err != nil {
+		return err
+	}
+
+	for entry := secrets.Next(); entry != nil; entry = secrets.Next() {
+		if _, err := sink.Write([]byte{byte(structs.PeeringSecretsWriteType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(entry.(*pbpeering.PeeringSecrets)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *snapshot) persistPeeringStreamStatuses(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	statuses, err := s.state.PeeringStreamStatuses()
+	if err != nil {
+		return err
+	}
+
+	for entry := statuses.Next(); entry != nil; entry = statuses.Next() {
+		if _, err := sink.Write([]byte{byte(structs.PeeringStreamStatusWriteType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(entry.(*pbpeering.PeeringStreamStatus)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func restoreRegistration(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
 	var req structs.RegisterRequest
 	if err := decoder.Decode(&req); err != nil {
@@ -906,3 +950,25 @@ func restorePeeringTrustBundle(header *SnapshotHeader, restore *state.Restore, d
 	}
 	return nil
 }
+
+func restorePeeringSecrets(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req pbpeering.PeeringSecrets
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if err := restore.PeeringSecrets(&req); err != nil {
+		return err
+	}
+	return nil
+}
+
+func restorePeeringStreamStatus(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req pbpeering.PeeringStreamStatus
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if err := restore.PeeringStreamStatus(&req); err != nil {
+		return err
+	}
+	return nil
+}
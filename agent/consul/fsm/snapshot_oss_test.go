@@ -489,6 +489,30 @@ func TestFSM_SnapshotRestore_OSS(t *testing.T) {
 		RootPEMs:    []string{"qux certificate bundle"},
 	}))
 
+	// Peering Secrets
+	require.NoError(t, fsm.state.PeeringSecretsWrite(33, &pbpeering.PeeringSecretsWriteRequest{
+		PeeringID: "1fabcd52-1d46-49b0-b1d8-71559aee47f5",
+		Request: &pbpeering.PeeringSecretsWriteRequest_GenerateToken{
+			GenerateToken: &pbpeering.PeeringSecretsWriteRequest_GenerateTokenRequest{
+				EstablishmentSecret: "389bbcdf-1c31-47d6-ae96-f2a3f4c45f84",
+			},
+		},
+	}))
+
+	// Peering Stream Statuses
+	require.NoError(t, fsm.state.PeeringStreamStatusWrite(34, &pbpeering.PeeringStreamStatusWriteRequest{
+		Statuses: []*pbpeering.PeeringStreamStatus{
+			{
+				PeeringID: "1fabcd52-1d46-49b0-b1d8-71559aee47f5",
+				Status: &pbpeering.StreamStatus{
+					LastErrorMessage:         "stream ended unexpectedly",
+					ImportedServices:         []string{"api"},
+					ReceivedResourceVersions: map[string]uint64{"exported-service": 3},
+				},
+			},
+		},
+	}))
+
 	// Snapshot
 	snap, err := fsm.Snapshot()
 	require.NoError(t, err)
@@ -807,6 +831,20 @@ func TestFSM_SnapshotRestore_OSS(t *testing.T) {
 	require.Len(t, ptbRestored.RootPEMs, 1)
 	require.Equal(t, "qux certificate bundle", ptbRestored.RootPEMs[0])
 
+	// Verify peering secrets are restored
+	secretsRestored, err := fsm2.state.PeeringSecretsRead(nil, "1fabcd52-1d46-49b0-b1d8-71559aee47f5")
+	require.NoError(t, err)
+	require.NotNil(t, secretsRestored)
+	require.Equal(t, "389bbcdf-1c31-47d6-ae96-f2a3f4c45f84", secretsRestored.GetEstablishment().GetSecretID())
+
+	// Verify peering stream statuses are restored
+	_, streamStatusRestored, err := fsm2.state.PeeringStreamStatusRead(nil, "1fabcd52-1d46-49b0-b1d8-71559aee47f5")
+	require.NoError(t, err)
+	require.NotNil(t, streamStatusRestored)
+	require.Equal(t, "stream ended unexpectedly", streamStatusRestored.LastErrorMessage)
+	require.Equal(t, []string{"api"}, streamStatusRestored.ImportedServices)
+	require.Equal(t, map[string]uint64{"exported-service": 3}, streamStatusRestored.ReceivedResourceVersions)
+
 	// Snapshot
 	snap, err = fsm2.Snapshot()
 	require.NoError(t, err)
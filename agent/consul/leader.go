@@ -321,6 +321,8 @@ func (s *Server) establishLeadership(ctx context.Context) error {
 
 	s.startDeferredDeletion(ctx)
 
+	s.startServiceRolloutController(ctx)
+
 	if err := s.startConnectLeader(ctx); err != nil {
 		return err
 	}
@@ -356,6 +358,8 @@ func (s *Server) revokeLeadership() {
 
 	s.stopConfigReplication()
 
+	s.stopServiceRolloutController()
+
 	s.stopACLReplication()
 
 	s.stopPeeringStreamSync()
@@ -51,6 +51,7 @@ func (s *Server) startConnectLeader(ctx context.Context) error {
 	s.leaderRoutineManager.Start(ctx, caRootMetricRoutineName, rootCAExpiryMonitor(s).Monitor)
 	s.leaderRoutineManager.Start(ctx, caSigningMetricRoutineName, signingCAExpiryMonitor(s).Monitor)
 	s.leaderRoutineManager.Start(ctx, virtualIPCheckRoutineName, s.runVirtualIPVersionCheck)
+	s.leaderRoutineManager.Start(ctx, connectJWTKeyRotationRoutineName, s.runConnectJWTSigningKeyRotation)
 
 	return s.startIntentionConfigEntryMigration(ctx)
 }
@@ -63,6 +64,7 @@ func (s *Server) stopConnectLeader() {
 	s.leaderRoutineManager.Stop(caRootMetricRoutineName)
 	s.leaderRoutineManager.Stop(caSigningMetricRoutineName)
 	s.leaderRoutineManager.Stop(virtualIPCheckRoutineName)
+	s.leaderRoutineManager.Stop(connectJWTKeyRotationRoutineName)
 }
 
 func (s *Server) runCARootPruning(ctx context.Context) error {
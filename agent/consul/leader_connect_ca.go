@@ -333,6 +333,7 @@ func (c *CAManager) Start(ctx context.Context) {
 func (c *CAManager) Stop() {
 	c.leaderRoutineManager.Stop(secondaryCARootWatchRoutineName)
 	c.leaderRoutineManager.Stop(intermediateCertRenewWatchRoutineName)
+	c.leaderRoutineManager.Stop(caProviderReloadWatchRoutineName)
 	c.leaderRoutineManager.Stop(backgroundCAInitializationRoutineName)
 
 	if provider, _ := c.getCAProvider(); provider != nil {
@@ -350,6 +351,8 @@ func (c *CAManager) startPostInitializeRoutines(ctx context.Context) {
 	// Start the Connect secondary DC actions if enabled.
 	if c.serverConf.Datacenter != c.serverConf.PrimaryDatacenter {
 		c.leaderRoutineManager.Start(ctx, secondaryCARootWatchRoutineName, c.secondaryCARootWatch)
+	} else {
+		c.leaderRoutineManager.Start(ctx, caProviderReloadWatchRoutineName, c.runProviderReloadWatch)
 	}
 
 	c.leaderRoutineManager.Start(ctx, intermediateCertRenewWatchRoutineName, c.runRenewIntermediate)
@@ -459,6 +462,8 @@ func (c *CAManager) newProvider(conf *structs.CAConfiguration) (ca.Provider, err
 		return ca.NewVaultProvider(logger), nil
 	case structs.AWSCAProvider:
 		return ca.NewAWSProvider(logger), nil
+	case structs.FileCAProvider:
+		return ca.NewFileProvider(logger), nil
 	default:
 		if c.providerShim != nil {
 			return c.providerShim, nil
@@ -869,7 +874,7 @@ func (c *CAManager) UpdateConfiguration(args *structs.CARequest) (reterr error)
 		c.logger.Info("Secondary CA provider config updated")
 		return nil
 	}
-	if err := c.primaryUpdateRootCA(newProvider, args, config); err != nil {
+	if err := c.primaryUpdateRootCA(newProvider, args, config, false); err != nil {
 		cleanupNewProvider()
 		return err
 	}
@@ -889,7 +894,14 @@ type ValidateConfigUpdater interface {
 	ValidateConfigUpdate(previous, next map[string]interface{}) error
 }
 
-func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.CARequest, config *structs.CAConfiguration) error {
+// primaryUpdateRootCA rotates the primary datacenter to the root of
+// newProvider, or just updates the config if the root is unchanged.
+//
+// reload is set when the new provider was configured by reloadProvider after
+// its externally managed certificates changed. The old provider is not able to
+// cross-sign a root it didn't create, so the root is then rotated without
+// cross-signing.
+func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.CARequest, config *structs.CAConfiguration, reload bool) error {
 	providerRoot, err := newProvider.GenerateRoot()
 	if err != nil {
 		return fmt.Errorf("error generating CA root certificate: %v", err)
@@ -925,8 +937,14 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 			return err
 		}
 
-		// If the config has been committed, update the local provider instance
+		// If the config has been committed, update the local provider instance.
+		// The file provider watches its files until it is stopped, so stop the
+		// old instance now that it has been replaced.
+		oldProvider, _ := c.getCAProvider()
 		c.setCAProvider(newProvider, newActiveRoot)
+		if fileProvider, ok := oldProvider.(*ca.FileProvider); ok {
+			fileProvider.Stop()
+		}
 		c.logger.Info("CA provider config updated")
 		return nil
 	}
@@ -965,7 +983,12 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 		if err != nil {
 			return fmt.Errorf("CA provider error: %s", err)
 		}
-		if !canXSign && !args.Config.ForceWithoutCrossSigning {
+		forceWithoutCrossSigning := args.Config.ForceWithoutCrossSigning
+		if !canXSign && reload {
+			c.logger.Warn("CA provider reloaded with a new root certificate, skipping cross-signing")
+			forceWithoutCrossSigning = true
+		}
+		if !canXSign && !forceWithoutCrossSigning {
 			return errors.New("The current CA Provider does not support cross-signing. " +
 				"You can try again with ForceWithoutCrossSigningSet but this may cause " +
 				"disruption - see documentation for more.")
@@ -976,7 +999,7 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 
 		// If ForceWithoutCrossSigning wasn't set, attempt to have the old CA generate a
 		// cross-signed intermediate.
-		if canXSign && !args.Config.ForceWithoutCrossSigning {
+		if canXSign && !forceWithoutCrossSigning {
 			// Have the old provider cross-sign the new root
 			xcCert, err := oldProvider.CrossSignCA(newRoot)
 			if err != nil {
@@ -1176,6 +1199,16 @@ func (c *CAManager) RenewIntermediate(ctx context.Context, isPrimary bool) error
 		return nil
 	}
 
+	// Providers whose certificates are managed externally can't issue a new
+	// intermediate themselves; one will be picked up by the reload watch once
+	// it has been replaced.
+	if _, ok := provider.(ca.NeedsReload); ok {
+		c.logger.Warn("intermediate certificate is more than half way to expiry and must be replaced by its issuer",
+			"expires", intermediateCert.NotAfter,
+		)
+		return nil
+	}
+
 	// Enough time has passed, go ahead with getting a new intermediate.
 	renewalFunc := c.primaryRenewIntermediate
 	if !isPrimary {
@@ -1204,6 +1237,135 @@ func (c *CAManager) RenewIntermediate(ctx context.Context, isPrimary bool) error
 	return nil
 }
 
+// runProviderReloadWatch waits for the CA provider to report that its
+// externally managed certificates have changed, and then reloads it.
+func (c *CAManager) runProviderReloadWatch(ctx context.Context) error {
+	for {
+		// The provider is replaced whenever the CA configuration changes, so
+		// look up its reload channel on every iteration.
+		var reloadCh <-chan struct{}
+		if provider, _ := c.getCAProvider(); provider != nil {
+			if r, ok := provider.(ca.NeedsReload); ok {
+				reloadCh = r.ReloadCh()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-reloadCh:
+			// A failed reload isn't retried as the files are unlikely to fix
+			// themselves. The next change will trigger another attempt.
+			if err := c.reloadProvider(); err != nil {
+				c.logger.Error("failed to reload CA provider",
+					"routine", caProviderReloadWatchRoutineName,
+					"error", err,
+				)
+			}
+		case <-time.After(caProviderReloadRecheckInterval):
+		}
+	}
+}
+
+// caProviderReloadRecheckInterval is how often runProviderReloadWatch checks
+// whether the provider has been replaced.
+var caProviderReloadRecheckInterval = time.Minute
+
+// reloadProvider configures a new instance of the current CA provider from the
+// stored configuration and rotates to its certificates. If it has the same
+// root as the active one only the leaf signing cert is rotated, otherwise the
+// root is rotated as it would be for a configuration change. This is only run
+// in the primary datacenter.
+func (c *CAManager) reloadProvider() error {
+	if _, err := c.setState(caStateReconfig, true); err != nil {
+		return err
+	}
+	defer c.setState(caStateInitialized, false)
+
+	state := c.delegate.State()
+	_, config, err := state.CAConfig(nil)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return fmt.Errorf("local CA not initialized yet")
+	}
+	_, activeRoot, err := state.CARootActive(nil)
+	if err != nil {
+		return err
+	}
+	if activeRoot == nil {
+		return fmt.Errorf("local CA not initialized yet")
+	}
+
+	newProvider, err := c.newProvider(config)
+	if err != nil {
+		return fmt.Errorf("could not initialize provider: %v", err)
+	}
+	pCfg := ca.ProviderConfig{
+		ClusterID:  config.ClusterID,
+		Datacenter: c.serverConf.Datacenter,
+		IsPrimary:  true,
+		RawConfig:  config.Config,
+		State:      config.State,
+	}
+	if err := newProvider.Configure(pCfg); err != nil {
+		return fmt.Errorf("error configuring provider: %v", err)
+	}
+
+	// Stop the new provider unless it replaces the current one.
+	replaced := false
+	defer func() {
+		if needsStop, ok := newProvider.(ca.NeedsStop); ok && !replaced {
+			needsStop.Stop()
+		}
+	}()
+
+	providerRoot, err := newProvider.GenerateRoot()
+	if err != nil {
+		return fmt.Errorf("error generating CA root certificate: %v", err)
+	}
+	newRoot, err := newCARoot(providerRoot.PEM, config.Provider, config.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	if newRoot.ID != activeRoot.ID {
+		newConfig := *config
+		args := &structs.CARequest{Config: &newConfig}
+		if err := c.primaryUpdateRootCA(newProvider, args, config, true); err != nil {
+			return err
+		}
+		replaced = true
+		return nil
+	}
+
+	newActiveRoot := activeRoot.Clone()
+	intermediatePEM, err := newProvider.GenerateIntermediate()
+	if err != nil {
+		return fmt.Errorf("error generating new intermediate cert: %v", err)
+	}
+	if intermediatePEM == c.getLeafSigningCertFromRoot(newActiveRoot) {
+		return nil
+	}
+	if err := setLeafSigningCert(newActiveRoot, intermediatePEM); err != nil {
+		return err
+	}
+	if err := c.persistNewRootAndConfig(newProvider, newActiveRoot, nil); err != nil {
+		return err
+	}
+
+	oldProvider, _ := c.getCAProvider()
+	c.setCAProvider(newProvider, newActiveRoot)
+	replaced = true
+	if needsStop, ok := oldProvider.(ca.NeedsStop); ok {
+		needsStop.Stop()
+	}
+
+	c.logger.Info("CA provider reloaded with new intermediate certificate", "provider", config.Provider)
+	return nil
+}
+
 // secondaryCARootWatch maintains a blocking query to the primary datacenter's
 // ConnectCA.Roots endpoint to monitor when it needs to request a new signed
 // intermediate certificate.
@@ -1622,6 +1784,8 @@ func providerPrettyName(provider string) string {
 		return "Vault"
 	case "aws-pca":
 		return "Aws-Pca"
+	case "file":
+		return "File"
 	case "provider-name":
 		return "Provider-Name"
 	default:
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -963,6 +964,104 @@ func TestCAManager_Initialize_Vault_WithExternalTrustedCA(t *testing.T) {
 	})
 }
 
+func TestCAManager_FileProvider_Reload(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	dir := testutil.TempDir(t, "ca")
+	root := connect.TestCA(t, nil)
+	caConfig := ca.WriteTestFileCAFiles(t, dir, root)
+
+	_, s1 := testServerWithConfig(t, func(c *Config) {
+		c.CAConfig = &structs.CAConfiguration{
+			Provider: structs.FileCAProvider,
+			Config:   caConfig,
+		}
+	})
+	testrpc.WaitForActiveCARoot(t, s1.RPC, "dc1", nil)
+	codec := rpcClient(t, s1)
+
+	getRoots := func(t *testing.T) structs.IndexedCARoots {
+		var roots structs.IndexedCARoots
+		err := msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{}, &roots)
+		require.NoError(t, err)
+		return roots
+	}
+
+	var origLeaf string
+	testutil.RunStep(t, "sign with the intermediate from disk", func(t *testing.T) {
+		roots := getRoots(t)
+		require.Len(t, roots.Roots, 1)
+		require.Equal(t, root.RootCert, roots.Roots[0].RootCert)
+		require.Len(t, roots.Roots[0].IntermediateCerts, 1)
+
+		origLeaf = getLeafCert(t, codec, roots.TrustDomain, "dc1")
+		verifyLeafCert(t, roots.Active(), origLeaf)
+	})
+
+	testutil.RunStep(t, "reload with unchanged files", func(t *testing.T) {
+		require.NoError(t, s1.caManager.reloadProvider())
+		require.Len(t, getRoots(t).Roots[0].IntermediateCerts, 1)
+	})
+
+	testutil.RunStep(t, "reload with a new intermediate", func(t *testing.T) {
+		ca.WriteTestFileCAFiles(t, dir, root)
+		require.NoError(t, s1.caManager.reloadProvider())
+
+		roots := getRoots(t)
+		require.Len(t, roots.Roots, 1)
+		require.Len(t, roots.Roots[0].IntermediateCerts, 2)
+
+		intermediatePEM, err := os.ReadFile(caConfig["CertFile"].(string))
+		require.NoError(t, err)
+		leaf := getLeafCert(t, codec, roots.TrustDomain, "dc1")
+		require.Contains(t, leaf, string(intermediatePEM))
+		verifyLeafCert(t, roots.Active(), leaf)
+		verifyLeafCert(t, roots.Active(), origLeaf)
+	})
+
+	testutil.RunStep(t, "reload with a new root", func(t *testing.T) {
+		newRoot := connect.TestCA(t, nil)
+		ca.WriteTestFileCAFiles(t, dir, newRoot)
+		require.NoError(t, s1.caManager.reloadProvider())
+
+		roots := getRoots(t)
+		require.Len(t, roots.Roots, 2)
+		require.Equal(t, newRoot.RootCert, roots.Active().RootCert)
+		// The file provider can't cross-sign the new root.
+		require.Len(t, roots.Active().IntermediateCerts, 1)
+		verifyLeafCert(t, roots.Active(), getLeafCert(t, codec, roots.TrustDomain, "dc1"))
+
+		_, config, err := s1.fsm.State().CAConfig(nil)
+		require.NoError(t, err)
+		require.False(t, config.ForceWithoutCrossSigning)
+	})
+
+	testutil.RunStep(t, "config update with the same root", func(t *testing.T) {
+		oldProvider, _ := s1.caManager.getCAProvider()
+
+		config := make(map[string]interface{})
+		for k, v := range caConfig {
+			config[k] = v
+		}
+		config["LeafCertTTL"] = "24h"
+		req := &structs.CARequest{
+			Op: structs.CAOpSetConfig,
+			Config: &structs.CAConfiguration{
+				Provider: structs.FileCAProvider,
+				Config:   config,
+			},
+		}
+		var resp error
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationSet", req, &resp))
+		require.Len(t, getRoots(t).Roots, 2)
+
+		newProvider, _ := s1.caManager.getCAProvider()
+		require.NotSame(t, oldProvider, newProvider)
+	})
+}
+
 // renewLeafSigningCert mimics RenewIntermediate. This is unfortunate, but
 // necessary for now as there is no easy way to invoke that logic unconditionally.
 // Currently, it requires patching values and polling for the operation to
@@ -10,6 +10,7 @@ import (
 
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
+	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/go-multierror"
@@ -20,6 +21,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/grpc-external/services/peerstream"
 	"github.com/hashicorp/consul/agent/structs"
@@ -42,6 +44,7 @@ var LeaderPeeringMetrics = []prometheus.GaugeDefinition{
 func (s *Server) startPeeringStreamSync(ctx context.Context) {
 	s.leaderRoutineManager.Start(ctx, peeringStreamsRoutineName, s.runPeeringSync)
 	s.leaderRoutineManager.Start(ctx, peeringStreamsMetricsRoutineName, s.runPeeringMetrics)
+	s.leaderRoutineManager.Start(ctx, peeringStreamsStatusRoutineName, s.runPeeringStreamStatusPersist)
 }
 
 func (s *Server) runPeeringMetrics(ctx context.Context) error {
@@ -97,6 +100,99 @@ func (s *Server) emitPeeringMetricsOnce(logger hclog.Logger, metricsImpl *metric
 	return nil
 }
 
+// peeringStreamStatusActivityIntervals is the number of persist intervals
+// after which a status is rewritten even if only its activity timestamps
+// changed. Heartbeats update those timestamps all the time, so comparing them
+// would write every status on every interval.
+const peeringStreamStatusActivityIntervals = 10
+
+// runPeeringStreamStatusPersist periodically writes the in-memory status of
+// this leader's replication streams to the state store. Only the statuses
+// that changed since the last write are persisted.
+func (s *Server) runPeeringStreamStatusPersist(ctx context.Context) error {
+	ticker := time.NewTicker(s.config.PeeringStreamStatusInterval)
+	defer ticker.Stop()
+
+	logger := s.logger.Named(logging.Peering)
+	persisted := make(map[string]persistedStreamStatus)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.persistPeeringStreamStatusesOnce(persisted, time.Now()); err != nil {
+				logger.Error("error persisting peering stream status", "error", err)
+			}
+		}
+	}
+}
+
+// persistedStreamStatus is the last status persisted for a peering, without
+// its activity timestamps, along with when it was persisted.
+type persistedStreamStatus struct {
+	status *pbpeering.StreamStatus
+	at     time.Time
+}
+
+func (s *Server) persistPeeringStreamStatusesOnce(persisted map[string]persistedStreamStatus, now time.Time) error {
+	_, peers, err := s.fsm.State().PeeringList(nil, *structs.NodeEnterpriseMetaInPartition(structs.WildcardSpecifier))
+	if err != nil {
+		return err
+	}
+
+	var (
+		req             pbpeering.PeeringStreamStatusWriteRequest
+		current         = make(map[string]persistedStreamStatus)
+		activityTimeout = peeringStreamStatusActivityIntervals * s.config.PeeringStreamStatusInterval
+	)
+	for _, peer := range peers {
+		status, found := s.peerStreamServer.StreamStatus(peer.ID)
+		if !found {
+			continue
+		}
+
+		pbStatus := status.ToProto()
+		compared := withoutStreamActivity(pbStatus)
+		last, ok := persisted[peer.ID]
+		if ok && proto.Equal(compared, last.status) && now.Sub(last.at) < activityTimeout {
+			current[peer.ID] = last
+			continue
+		}
+		current[peer.ID] = persistedStreamStatus{status: compared, at: now}
+
+		pbStatus.UpdatedAt = structs.TimeToProto(now.UTC())
+		req.Statuses = append(req.Statuses, &pbpeering.PeeringStreamStatus{
+			PeeringID: peer.ID,
+			Status:    pbStatus,
+		})
+	}
+
+	if len(req.Statuses) > 0 {
+		if _, err := s.raftApplyProtobuf(structs.PeeringStreamStatusWriteType, &req); err != nil {
+			return fmt.Errorf("failed to write peering stream status: %w", err)
+		}
+	}
+
+	for id := range persisted {
+		delete(persisted, id)
+	}
+	for id, status := range current {
+		persisted[id] = status
+	}
+	return nil
+}
+
+// withoutStreamActivity returns a copy of the status without the timestamps of
+// the last heartbeat, receive and send.
+func withoutStreamActivity(status *pbpeering.StreamStatus) *pbpeering.StreamStatus {
+	out := proto.Clone(status).(*pbpeering.StreamStatus)
+	out.LastHeartbeat = nil
+	out.LastReceive = nil
+	out.LastSend = nil
+	return out
+}
+
 func (s *Server) runPeeringSync(ctx context.Context) error {
 	logger := s.logger.Named("peering-syncer")
 	cancelFns := make(map[string]context.CancelFunc)
@@ -118,6 +214,7 @@ func (s *Server) stopPeeringStreamSync() {
 	// will be a no-op when not started
 	s.leaderRoutineManager.Stop(peeringStreamsRoutineName)
 	s.leaderRoutineManager.Stop(peeringStreamsMetricsRoutineName)
+	s.leaderRoutineManager.Stop(peeringStreamsStatusRoutineName)
 }
 
 // syncPeeringsAndBlock is a long-running goroutine that is responsible for watching
@@ -260,26 +357,9 @@ func (s *Server) syncPeeringsAndBlock(ctx context.Context, logger hclog.Logger,
 func (s *Server) establishStream(ctx context.Context, logger hclog.Logger, peer *pbpeering.Peering, cancelFns map[string]context.CancelFunc) error {
 	logger = logger.With("peer_name", peer.Name, "peer_id", peer.ID)
 
-	tlsOption := grpc.WithInsecure()
-	if len(peer.PeerCAPems) > 0 {
-		var haveCerts bool
-		pool := x509.NewCertPool()
-		for _, pem := range peer.PeerCAPems {
-			if !pool.AppendCertsFromPEM([]byte(pem)) {
-				return fmt.Errorf("failed to parse PEM %s", pem)
-			}
-			if len(pem) > 0 {
-				haveCerts = true
-			}
-		}
-		if !haveCerts {
-			return fmt.Errorf("failed to build cert pool from peer CA pems")
-		}
-		cfg := tls.Config{
-			ServerName: peer.PeerServerName,
-			RootCAs:    pool,
-		}
-		tlsOption = grpc.WithTransportCredentials(credentials.NewTLS(&cfg))
+	pool, err := peerCertPool(peer)
+	if err != nil {
+		return err
 	}
 
 	// Create a ring buffer to cycle through peer addresses in the retry loop below.
@@ -299,6 +379,8 @@ func (s *Server) establishStream(ctx context.Context, logger hclog.Logger, peer
 		return fmt.Errorf("failed to register stream: %v", err)
 	}
 
+	var gatewayAttempt int
+
 	// Establish a stream-specific retry so that retrying stream/conn errors isn't dependent on state store changes.
 	go retryLoopBackoff(retryCtx, func() error {
 		// Try a new address on each iteration by advancing the ring buffer on errors.
@@ -310,7 +392,18 @@ func (s *Server) establishStream(ctx context.Context, logger hclog.Logger, peer
 			return fmt.Errorf("peer server address type %T is not a string", buffer.Value)
 		}
 
-		logger.Trace("dialing peer", "addr", addr)
+		// The mesh config entry is checked on every attempt so that enabling
+		// or disabling peering through mesh gateways applies when the stream
+		// is re-established.
+		addr, tlsOption, throughGateways, err := s.peerDialTarget(peer, pool, addr, gatewayAttempt)
+		if err != nil {
+			return err
+		}
+		if throughGateways {
+			gatewayAttempt++
+		}
+
+		logger.Trace("dialing peer", "addr", addr, "through_mesh_gateways", throughGateways)
 		conn, err := grpc.DialContext(retryCtx, addr,
 			// TODO(peering): use a grpc.WithStatsHandler here?)
 			tlsOption,
@@ -337,12 +430,20 @@ func (s *Server) establishStream(ctx context.Context, logger hclog.Logger, peer
 			return fmt.Errorf("expected PeerID to be non empty; the wrong end of peering is being dialed")
 		}
 
+		// The secret is read on every attempt since the peer rotates it over
+		// the stream.
+		secrets, err := s.fsm.State().PeeringSecretsRead(nil, peer.ID)
+		if err != nil {
+			return fmt.Errorf("failed to read peering secrets: %w", err)
+		}
+
 		streamReq := peerstream.HandleStreamRequest{
-			LocalID:   peer.ID,
-			RemoteID:  peer.PeerID,
-			PeerName:  peer.Name,
-			Partition: peer.Partition,
-			Stream:    stream,
+			LocalID:        peer.ID,
+			RemoteID:       peer.PeerID,
+			PeerName:       peer.Name,
+			Partition:      peer.Partition,
+			StreamSecretID: secrets.GetStream().GetActiveSecretID(),
+			Stream:         stream,
 		}
 		err = s.peerStreamServer.HandleStream(streamReq)
 		// A nil error indicates that the peering was deleted and the stream needs to be gracefully shutdown.
@@ -365,6 +466,96 @@ func (s *Server) establishStream(ctx context.Context, logger hclog.Logger, peer
 	return nil
 }
 
+// peerCertPool returns the pool of CA certificates used to verify the servers
+// of the peer, or nil if the peer does not use TLS on its gRPC port.
+func peerCertPool(peer *pbpeering.Peering) (*x509.CertPool, error) {
+	if len(peer.PeerCAPems) == 0 {
+		return nil, nil
+	}
+
+	var haveCerts bool
+	pool := x509.NewCertPool()
+	for _, pem := range peer.PeerCAPems {
+		if !pool.AppendCertsFromPEM([]byte(pem)) {
+			return nil, fmt.Errorf("failed to parse PEM %s", pem)
+		}
+		if len(pem) > 0 {
+			haveCerts = true
+		}
+	}
+	if !haveCerts {
+		return nil, fmt.Errorf("failed to build cert pool from peer CA pems")
+	}
+	return pool, nil
+}
+
+// peerDialTarget returns the address to dial to reach the peer server at addr
+// along with the matching transport credentials. When peering through mesh
+// gateways is enabled, one of the local mesh gateways is dialed instead, picked
+// based on the attempt number, and the returned bool is true.
+func (s *Server) peerDialTarget(peer *pbpeering.Peering, pool *x509.CertPool, addr string, attempt int) (string, grpc.DialOption, bool, error) {
+	tlsOption := grpc.WithInsecure()
+	if pool != nil {
+		tlsOption = grpc.WithTransportCredentials(credentials.NewTLS(peeringTLSConfig(pool, peer.PeerServerName, peer.PeerServerName)))
+	}
+
+	throughGateways, err := peerThroughMeshGateways(s.fsm.State())
+	if err != nil {
+		return "", nil, false, err
+	}
+	if !throughGateways {
+		return addr, tlsOption, false, nil
+	}
+
+	if pool == nil {
+		return "", nil, false, fmt.Errorf("peering through mesh gateways requires the peer to use TLS on its gRPC port")
+	}
+	gateways, err := meshGatewayAddresses(s.fsm.State(), false)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	// The local mesh gateway routes the connection to the peer based on the
+	// SNI while the certificate presented by the peer is still verified
+	// against its server name.
+	sni := connect.PeeringServerSNI(peer.PeerID)
+	tlsOption = grpc.WithTransportCredentials(credentials.NewTLS(peeringTLSConfig(pool, peer.PeerServerName, sni)))
+	return gateways[attempt%len(gateways)], tlsOption, true, nil
+}
+
+// peeringTLSConfig returns the TLS configuration used to dial a peer whose
+// certificate is valid for serverName. When the SNI differs from the server
+// name, the verification of the certificate is done against the server name.
+func peeringTLSConfig(pool *x509.CertPool, serverName, sni string) *tls.Config {
+	cfg := &tls.Config{
+		ServerName: sni,
+		RootCAs:    pool,
+	}
+	if sni == serverName {
+		return cfg
+	}
+
+	// The default verification is disabled since it checks the certificate
+	// against the SNI, VerifyConnection verifies it against the server name.
+	cfg.InsecureSkipVerify = true
+	cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return fmt.Errorf("peer did not present a certificate")
+		}
+		opts := x509.VerifyOptions{
+			DNSName:       serverName,
+			Roots:         pool,
+			Intermediates: x509.NewCertPool(),
+		}
+		for _, cert := range cs.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		_, err := cs.PeerCertificates[0].Verify(opts)
+		return err
+	}
+	return cfg
+}
+
 func (s *Server) startPeeringDeferredDeletion(ctx context.Context) {
 	s.leaderRoutineManager.Start(ctx, peeringDeletionRoutineName, s.runPeeringDeletions)
 }
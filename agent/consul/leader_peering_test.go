@@ -2,6 +2,8 @@ package consul
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -14,11 +16,13 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/proto/pbpeering"
 	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/testrpc"
 	"github.com/hashicorp/consul/types"
@@ -109,7 +113,7 @@ func testLeader_PeeringSync_Lifecycle_ClientDeletion(t *testing.T, enableTLS boo
 	require.True(t, p.ShouldDial())
 
 	// We maintain a pointer to the peering on the write so that we can get the ID without needing to re-query the state store.
-	require.NoError(t, s2.fsm.State().PeeringWrite(1000, p))
+	dialerPeeringWrite(t, s2, 1000, p, token)
 
 	retry.Run(t, func(r *retry.R) {
 		status, found := s2.peerStreamServer.StreamStatus(p.ID)
@@ -222,7 +226,7 @@ func testLeader_PeeringSync_Lifecycle_ServerDeletion(t *testing.T, enableTLS boo
 	require.True(t, p.ShouldDial())
 
 	// We maintain a pointer to the peering on the write so that we can get the ID without needing to re-query the state store.
-	require.NoError(t, s2.fsm.State().PeeringWrite(1000, p))
+	dialerPeeringWrite(t, s2, 1000, p, token)
 
 	retry.Run(t, func(r *retry.R) {
 		status, found := s2.peerStreamServer.StreamStatus(p.ID)
@@ -230,6 +234,13 @@ func testLeader_PeeringSync_Lifecycle_ServerDeletion(t *testing.T, enableTLS boo
 		require.True(r, status.Connected)
 	})
 
+	// The server peer must also have accepted the stream before it can terminate it.
+	retry.Run(t, func(r *retry.R) {
+		status, found := s1.peerStreamServer.StreamStatus(p.PeerID)
+		require.True(r, found)
+		require.True(r, status.Connected)
+	})
+
 	// Delete the peering from the server peer to trigger the termination sequence.
 	deleted := &pbpeering.Peering{
 		ID:        s1PeerID,
@@ -493,7 +504,7 @@ func TestLeader_Peering_DialerReestablishesConnectionOnError(t *testing.T) {
 		PeerServerAddresses: token.ServerAddresses,
 	}
 	require.True(t, p.ShouldDial())
-	require.NoError(t, dialingServer.fsm.State().PeeringWrite(1000, p))
+	dialerPeeringWrite(t, dialingServer, 1000, p, token)
 
 	// Wait for the stream to be connected.
 	retry.Run(t, func(r *retry.R) {
@@ -682,7 +693,7 @@ func TestLeader_Peering_ImportedExportedServicesCount(t *testing.T) {
 	require.True(t, p.ShouldDial())
 
 	lastIdx++
-	require.NoError(t, s2.fsm.State().PeeringWrite(lastIdx, p))
+	dialerPeeringWrite(t, s2, lastIdx, p, token)
 
 	/// add services to S1 to be synced to S2
 	lastIdx++
@@ -1037,6 +1048,84 @@ func TestLeader_PeeringMetrics_emitPeeringMetrics(t *testing.T) {
 	})
 }
 
+func TestLeader_Peering_PersistStreamStatus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	_, s1 := testServerWithConfig(t, func(c *Config) {
+		c.NodeName = "s1.dc1"
+		c.Datacenter = "dc1"
+		c.TLSConfig.Domain = "consul"
+	})
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Write a peering that is not dialed by this server so that the only
+	// stream status is the one simulated below.
+	peerID := generateUUID()
+	require.NoError(t, s1.fsm.State().PeeringWrite(1, &pbpeering.Peering{
+		ID:     peerID,
+		Name:   "my-peer-s2",
+		PeerID: generateUUID(),
+	}))
+
+	mst, err := s1.peeringServer.Tracker.Connected(peerID)
+	require.NoError(t, err)
+	mst.TrackExportedService(structs.ServiceName{Name: "a-service"})
+
+	persisted := make(map[string]persistedStreamStatus)
+	now := time.Now()
+
+	testutil.RunStep(t, "status is persisted", func(t *testing.T) {
+		require.NoError(t, s1.persistPeeringStreamStatusesOnce(persisted, now))
+
+		_, status, err := s1.fsm.State().PeeringStreamStatusRead(nil, peerID)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		require.Equal(t, []string{"a-service"}, status.ExportedServices)
+		require.NotNil(t, status.UpdatedAt)
+	})
+
+	testutil.RunStep(t, "unchanged status is not rewritten", func(t *testing.T) {
+		before, _, err := s1.fsm.State().PeeringStreamStatusRead(nil, peerID)
+		require.NoError(t, err)
+
+		// Heartbeats alone don't cause a write.
+		mst.TrackRecvHeartbeat()
+		now = now.Add(s1.config.PeeringStreamStatusInterval)
+		require.NoError(t, s1.persistPeeringStreamStatusesOnce(persisted, now))
+
+		after, _, err := s1.fsm.State().PeeringStreamStatusRead(nil, peerID)
+		require.NoError(t, err)
+		require.Equal(t, before, after)
+	})
+
+	testutil.RunStep(t, "changed status is rewritten", func(t *testing.T) {
+		mst.TrackExportedService(structs.ServiceName{Name: "b-service"})
+
+		now = now.Add(s1.config.PeeringStreamStatusInterval)
+		require.NoError(t, s1.persistPeeringStreamStatusesOnce(persisted, now))
+
+		_, status, err := s1.fsm.State().PeeringStreamStatusRead(nil, peerID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a-service", "b-service"}, status.ExportedServices)
+		require.NotNil(t, status.LastHeartbeat)
+	})
+
+	testutil.RunStep(t, "activity is rewritten less often", func(t *testing.T) {
+		_, before, err := s1.fsm.State().PeeringStreamStatusRead(nil, peerID)
+		require.NoError(t, err)
+
+		mst.TrackRecvHeartbeat()
+		now = now.Add(peeringStreamStatusActivityIntervals * s1.config.PeeringStreamStatusInterval)
+		require.NoError(t, s1.persistPeeringStreamStatusesOnce(persisted, now))
+
+		_, after, err := s1.fsm.State().PeeringStreamStatusRead(nil, peerID)
+		require.NoError(t, err)
+		require.True(t, after.UpdatedAt.AsTime().After(before.UpdatedAt.AsTime()))
+	})
+}
+
 // Test that the leader doesn't start its peering deletion routing when
 // peering is disabled.
 func TestLeader_Peering_NoDeletionWhenPeeringDisabled(t *testing.T) {
@@ -1122,3 +1211,72 @@ func TestLeader_Peering_NoEstablishmentWhenPeeringDisabled(t *testing.T) {
 		return found
 	}, 7*time.Second, 1*time.Second, "peering should not have been established")
 }
+
+func TestLeader_Peering_TLSConfigThroughMeshGateways(t *testing.T) {
+	cert, err := tls.LoadX509KeyPair("../../test/hostname/Bob.crt", "../../test/hostname/Bob.key")
+	require.NoError(t, err)
+	caPEM, err := ioutil.ReadFile("../../test/hostname/CertAuth.crt")
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(caPEM))
+
+	// The SNI set by the dialer is the one the mesh gateways route on, it
+	// does not match the certificate presented by the servers of the peer.
+	sni := connect.PeeringServerSNI("6d942ff2-6a78-46f4-a52f-915e26c48797")
+
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			if hello.ServerName != sni {
+				return nil, fmt.Errorf("unexpected SNI %q", hello.ServerName)
+			}
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	dial := func(serverName string) error {
+		conn, err := tls.Dial("tcp", ln.Addr().String(), peeringTLSConfig(pool, serverName, sni))
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	require.NoError(t, dial("server.dc1.consul"))
+	require.Error(t, dial("server.dc2.consul"))
+}
+
+// dialerPeeringWrite simulates the dialing side of peering establishment by
+// exchanging the token's establishment secret with the accepting cluster and
+// storing the resulting stream secret alongside the peering.
+func dialerPeeringWrite(t *testing.T, s *Server, idx uint64, p *pbpeering.Peering, token structs.PeeringToken) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	secret, err := s.peeringBackend.ExchangeSecret(ctx, p, token.EstablishmentSecret)
+	require.NoError(t, err)
+
+	require.NoError(t, s.fsm.State().PeeringWriteWithSecrets(idx, p, &pbpeering.PeeringSecretsWriteRequest{
+		PeeringID: p.ID,
+		Request: &pbpeering.PeeringSecretsWriteRequest_StoreStreamSecret{
+			StoreStreamSecret: &pbpeering.PeeringSecretsWriteRequest_StoreStreamSecretRequest{
+				ActiveStreamSecret: secret,
+			},
+		},
+	}))
+}
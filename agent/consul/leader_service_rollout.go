@@ -0,0 +1,414 @@
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-bexpr"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// serviceRolloutInterval is how often the rollouts are analyzed and
+	// moved to their next step.
+	serviceRolloutInterval = 10 * time.Second
+
+	// rolloutMetricsQueryTimeout bounds the duration of a metrics query.
+	rolloutMetricsQueryTimeout = 10 * time.Second
+)
+
+// RolloutMetricsProvider evaluates the metrics query of a service-rollout
+// config entry.
+type RolloutMetricsProvider interface {
+	Query(ctx context.Context, q *structs.RolloutMetricsQuery) (float64, error)
+}
+
+func newRolloutMetricsProviders() map[string]RolloutMetricsProvider {
+	return map[string]RolloutMetricsProvider{
+		structs.RolloutMetricsProviderPrometheus: &prometheusRolloutMetrics{
+			client: &http.Client{Timeout: rolloutMetricsQueryTimeout},
+		},
+	}
+}
+
+func (s *Server) startServiceRolloutController(ctx context.Context) {
+	// Config entries are written in the primary datacenter and replicated
+	// to the others.
+	if s.config.PrimaryDatacenter != s.config.Datacenter {
+		return
+	}
+	s.leaderRoutineManager.Start(ctx, serviceRolloutRoutineName, s.runServiceRollouts)
+}
+
+func (s *Server) stopServiceRolloutController() {
+	s.leaderRoutineManager.Stop(serviceRolloutRoutineName)
+}
+
+func (s *Server) runServiceRollouts(ctx context.Context) error {
+	ticker := time.NewTicker(serviceRolloutInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.reconcileServiceRollouts(ctx, time.Now().UTC()); err != nil {
+				s.logger.Error("error reconciling service rollouts", "error", err)
+			}
+		}
+	}
+}
+
+func (s *Server) reconcileServiceRollouts(ctx context.Context, now time.Time) error {
+	_, entries, err := s.fsm.State().ConfigEntriesByKind(nil, structs.ServiceRollout, structs.WildcardEnterpriseMetaInPartition(structs.WildcardSpecifier))
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		rollout, ok := entry.(*structs.ServiceRolloutConfigEntry)
+		if !ok {
+			continue
+		}
+		if err := s.reconcileServiceRollout(ctx, rollout, now); err != nil {
+			s.logger.Warn("error reconciling service rollout",
+				"service", rollout.Name,
+				"error", err,
+			)
+
+			status := rollout.Status
+			status.Message = err.Error()
+			if err := s.updateServiceRolloutStatus(rollout, status); err != nil {
+				s.logger.Error("error updating service rollout status",
+					"service", rollout.Name,
+					"error", err,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileServiceRollout moves a rollout forward by at most one step. The
+// current step is held as long as the analysis can't run, and the rollout
+// is rolled back as soon as the analysis fails.
+func (s *Server) reconcileServiceRollout(ctx context.Context, rollout *structs.ServiceRolloutConfigEntry, now time.Time) error {
+	switch rollout.Status.State {
+	case structs.ServiceRolloutCompleted, structs.ServiceRolloutRolledBack:
+		return nil
+	case structs.ServiceRolloutProgressing:
+	default:
+		return s.setServiceRolloutStep(rollout, 0, now)
+	}
+
+	status := rollout.Status
+	failure, err := s.analyzeServiceRollout(ctx, rollout)
+	if err != nil {
+		return fmt.Errorf("analysis failed: %w", err)
+	}
+
+	if failure != "" {
+		s.logger.Info("rolling back service rollout",
+			"service", rollout.Name,
+			"reason", failure,
+		)
+		if err := s.setServiceRolloutCanaryWeight(rollout, 0); err != nil {
+			return err
+		}
+		status.State = structs.ServiceRolloutRolledBack
+		status.CanaryWeight = 0
+		status.LastTransition = now
+		status.Message = failure
+		return s.updateServiceRolloutStatus(rollout, status)
+	}
+
+	status.Message = ""
+	if now.Sub(status.LastTransition) < rollout.StepInterval {
+		// Restore the weights of the current step in case the splitter was
+		// modified while the rollout is in progress.
+		if err := s.setServiceRolloutCanaryWeight(rollout, status.CanaryWeight); err != nil {
+			return err
+		}
+		return s.updateServiceRolloutStatus(rollout, status)
+	}
+
+	if status.Step+1 >= len(rollout.Steps) {
+		s.logger.Info("service rollout completed", "service", rollout.Name)
+		status.State = structs.ServiceRolloutCompleted
+		status.LastTransition = now
+		return s.updateServiceRolloutStatus(rollout, status)
+	}
+	return s.setServiceRolloutStep(rollout, status.Step+1, now)
+}
+
+func (s *Server) setServiceRolloutStep(rollout *structs.ServiceRolloutConfigEntry, step int, now time.Time) error {
+	if step < 0 || step >= len(rollout.Steps) {
+		return fmt.Errorf("step %d is out of range", step)
+	}
+	weight := rollout.Steps[step]
+	if err := s.setServiceRolloutCanaryWeight(rollout, weight); err != nil {
+		return err
+	}
+
+	s.logger.Info("service rollout moved to the next step",
+		"service", rollout.Name,
+		"step", step,
+		"canary_weight", weight,
+	)
+	return s.updateServiceRolloutStatus(rollout, structs.ServiceRolloutStatus{
+		State:          structs.ServiceRolloutProgressing,
+		Step:           step,
+		CanaryWeight:   weight,
+		LastTransition: now,
+	})
+}
+
+// setServiceRolloutCanaryWeight sets the weight of the canary split of the
+// service-splitter and gives the rest of the traffic that isn't sent to other
+// splits to the baseline split.
+func (s *Server) setServiceRolloutCanaryWeight(rollout *structs.ServiceRolloutConfigEntry, weight float32) error {
+	_, entry, err := s.fsm.State().ConfigEntry(nil, structs.ServiceSplitter, rollout.Name, &rollout.EnterpriseMeta)
+	if err != nil {
+		return err
+	}
+	splitter, ok := entry.(*structs.ServiceSplitterConfigEntry)
+	if !ok || splitter == nil {
+		return fmt.Errorf("service-splitter %q not found", rollout.Name)
+	}
+
+	updated := *splitter
+	updated.Splits = make([]structs.ServiceSplit, len(splitter.Splits))
+	copy(updated.Splits, splitter.Splits)
+
+	canary, baseline := -1, -1
+	var others float32
+	for i, split := range updated.Splits {
+		if split.Service != "" && split.Service != rollout.Name {
+			others += split.Weight
+			continue
+		}
+		switch split.ServiceSubset {
+		case rollout.CanarySubset:
+			canary = i
+		case rollout.BaselineSubset:
+			baseline = i
+		default:
+			others += split.Weight
+		}
+	}
+	if canary < 0 {
+		return fmt.Errorf("service-splitter %q has no split for the canary subset %q", rollout.Name, rollout.CanarySubset)
+	}
+	if baseline < 0 {
+		return fmt.Errorf("service-splitter %q has no split for the baseline subset %q", rollout.Name, rollout.BaselineSubset)
+	}
+
+	baselineWeight := structs.NormalizeServiceSplitWeight(100 - others - weight)
+	if baselineWeight < 0 {
+		return fmt.Errorf("service-splitter %q sends %f%% of the traffic to other splits", rollout.Name, others)
+	}
+	if updated.Splits[canary].Weight == weight && updated.Splits[baseline].Weight == baselineWeight {
+		return nil
+	}
+	updated.Splits[canary].Weight = weight
+	updated.Splits[baseline].Weight = baselineWeight
+
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+	return s.applyServiceRolloutEntry(&updated)
+}
+
+func (s *Server) updateServiceRolloutStatus(rollout *structs.ServiceRolloutConfigEntry, status structs.ServiceRolloutStatus) error {
+	if rollout.Status == status {
+		return nil
+	}
+
+	updated := *rollout
+	updated.Status = status
+	return s.applyServiceRolloutEntry(&updated)
+}
+
+// applyServiceRolloutEntry writes an entry updated by the controller with a
+// check-and-set so that concurrent writes from users are never overwritten.
+func (s *Server) applyServiceRolloutEntry(entry structs.ConfigEntry) error {
+	req := structs.ConfigEntryRequest{
+		Op:         structs.ConfigEntryUpsertCAS,
+		Datacenter: s.config.Datacenter,
+		Entry:      entry,
+	}
+	resp, err := s.leaderRaftApply("ConfigEntry.Apply", structs.ConfigEntryRequestType, &req)
+	if err != nil {
+		return err
+	}
+	if ok, _ := resp.(bool); !ok {
+		return fmt.Errorf("%s %q was modified concurrently", entry.GetKind(), entry.GetName())
+	}
+	return nil
+}
+
+// analyzeServiceRollout returns the reason to roll back, if any. An error is
+// returned when the analysis couldn't run.
+func (s *Server) analyzeServiceRollout(ctx context.Context, rollout *structs.ServiceRolloutConfigEntry) (string, error) {
+	total, critical, err := s.serviceRolloutCanaryHealth(rollout)
+	if err != nil {
+		return "", err
+	}
+	if total == 0 {
+		return fmt.Sprintf("no instances in the canary subset %q", rollout.CanarySubset), nil
+	}
+	if pct := float32(critical) * 100 / float32(total); pct > rollout.MaxCriticalPercentage {
+		return fmt.Sprintf("%d of %d instances of the canary subset %q are critical", critical, total, rollout.CanarySubset), nil
+	}
+
+	if q := rollout.Metrics; q != nil {
+		provider, ok := s.rolloutMetricsProviders[q.Provider]
+		if !ok {
+			return "", fmt.Errorf("unsupported metrics provider %q", q.Provider)
+		}
+		value, err := provider.Query(ctx, q)
+		if err != nil {
+			return "", fmt.Errorf("metrics query failed: %w", err)
+		}
+		if value > q.Threshold {
+			return fmt.Sprintf("metrics query returned %g which is above the threshold of %g", value, q.Threshold), nil
+		}
+	}
+	return "", nil
+}
+
+// serviceRolloutCanaryHealth returns the number of instances of the canary
+// subset along with the number of those that have a critical check.
+func (s *Server) serviceRolloutCanaryHealth(rollout *structs.ServiceRolloutConfigEntry) (int, int, error) {
+	state := s.fsm.State()
+
+	_, entry, err := state.ConfigEntry(nil, structs.ServiceResolver, rollout.Name, &rollout.EnterpriseMeta)
+	if err != nil {
+		return 0, 0, err
+	}
+	resolver, ok := entry.(*structs.ServiceResolverConfigEntry)
+	if !ok || resolver == nil {
+		return 0, 0, fmt.Errorf("service-resolver %q not found", rollout.Name)
+	}
+	subset, ok := resolver.Subsets[rollout.CanarySubset]
+	if !ok {
+		return 0, 0, fmt.Errorf("service-resolver %q has no subset %q", rollout.Name, rollout.CanarySubset)
+	}
+
+	_, nodes, err := state.CheckServiceNodes(nil, rollout.Name, &rollout.EnterpriseMeta, structs.DefaultPeerKeyword)
+	if err != nil {
+		return 0, 0, err
+	}
+	if subset.Filter != "" {
+		filter, err := bexpr.CreateFilter(subset.Filter, nil, nodes)
+		if err != nil {
+			return 0, 0, err
+		}
+		raw, err := filter.Execute(nodes)
+		if err != nil {
+			return 0, 0, err
+		}
+		nodes = raw.(structs.CheckServiceNodes)
+	}
+
+	var critical int
+	for _, node := range nodes {
+		for _, chk := range node.Checks {
+			if chk.Status == api.HealthCritical {
+				critical++
+				break
+			}
+		}
+	}
+	return len(nodes), critical, nil
+}
+
+// prometheusRolloutMetrics runs instant queries against the HTTP API of
+// Prometheus.
+type prometheusRolloutMetrics struct {
+	client *http.Client
+}
+
+func (p *prometheusRolloutMetrics) Query(ctx context.Context, q *structs.RolloutMetricsQuery) (float64, error) {
+	u, err := url.Parse(q.Address)
+	if err != nil {
+		return 0, err
+	}
+	u.Path = path.Join(u.Path, "/api/v1/query")
+	u.RawQuery = url.Values{"query": []string{q.Query}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return 0, err
+	}
+
+	var out struct {
+		Status string
+		Error  string
+		Data   struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		}
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return 0, fmt.Errorf("unexpected response with status %d: %w", resp.StatusCode, err)
+	}
+	if out.Status != "success" {
+		return 0, fmt.Errorf("query failed: %s", out.Error)
+	}
+
+	var sample []interface{}
+	switch out.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(out.Data.Result, &sample); err != nil {
+			return 0, err
+		}
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(out.Data.Result, &vector); err != nil {
+			return 0, err
+		}
+		switch len(vector) {
+		case 0:
+			// No samples usually means no errors were recorded.
+			return 0, nil
+		case 1:
+			sample = vector[0].Value
+		default:
+			return 0, fmt.Errorf("query returned %d series instead of one", len(vector))
+		}
+	default:
+		return 0, fmt.Errorf("unsupported result type %q", out.Data.ResultType)
+	}
+
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("invalid sample")
+	}
+	value, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid sample value")
+	}
+	return strconv.ParseFloat(value, 64)
+}
@@ -0,0 +1,293 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/types"
+)
+
+type fakeRolloutMetrics struct {
+	value float64
+	err   error
+}
+
+func (f *fakeRolloutMetrics) Query(_ context.Context, _ *structs.RolloutMetricsQuery) (float64, error) {
+	return f.value, f.err
+}
+
+func TestLeader_ServiceRollout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir, s := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+	})
+	defer os.RemoveAll(dir)
+	defer s.Shutdown()
+	codec := rpcClient(t, s)
+	defer codec.Close()
+
+	waitForLeaderEstablishment(t, s)
+
+	metrics := &fakeRolloutMetrics{}
+	s.rolloutMetricsProviders = map[string]RolloutMetricsProvider{
+		structs.RolloutMetricsProviderPrometheus: metrics,
+	}
+
+	apply := func(t *testing.T, entry structs.ConfigEntry) {
+		var out bool
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.Apply", &structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Entry:      entry,
+		}, &out))
+		require.True(t, out)
+	}
+	register := func(t *testing.T, id, version, status string) {
+		var out struct{}
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Catalog.Register", &structs.RegisterRequest{
+			Datacenter: "dc1",
+			Node:       "node1",
+			Address:    "10.0.0.1",
+			Service: &structs.NodeService{
+				ID:      id,
+				Service: "web",
+				Port:    8080,
+				Meta:    map[string]string{"version": version},
+			},
+			Check: &structs.HealthCheck{
+				CheckID:   types.CheckID("check-" + id),
+				Name:      "check",
+				ServiceID: id,
+				Status:    status,
+			},
+		}, &out))
+	}
+	splitWeights := func(t *testing.T) (float32, float32) {
+		_, entry, err := s.fsm.State().ConfigEntry(nil, structs.ServiceSplitter, "web", nil)
+		require.NoError(t, err)
+		splitter := entry.(*structs.ServiceSplitterConfigEntry)
+		return splitter.Splits[0].Weight, splitter.Splits[1].Weight
+	}
+	rolloutStatus := func(t *testing.T) structs.ServiceRolloutStatus {
+		_, entry, err := s.fsm.State().ConfigEntry(nil, structs.ServiceRollout, "web", nil)
+		require.NoError(t, err)
+		return entry.(*structs.ServiceRolloutConfigEntry).Status
+	}
+	newRollout := func() *structs.ServiceRolloutConfigEntry {
+		return &structs.ServiceRolloutConfigEntry{
+			Name:           "web",
+			BaselineSubset: "v1",
+			CanarySubset:   "v2",
+			Steps:          []float32{25, 100},
+			StepInterval:   time.Minute,
+			Metrics: &structs.RolloutMetricsQuery{
+				Provider:  structs.RolloutMetricsProviderPrometheus,
+				Address:   "http://prometheus:9090",
+				Query:     "errors",
+				Threshold: 1,
+			},
+		}
+	}
+	resetSplitter := func(t *testing.T) {
+		apply(t, &structs.ServiceSplitterConfigEntry{
+			Kind: structs.ServiceSplitter,
+			Name: "web",
+			Splits: []structs.ServiceSplit{
+				{Weight: 100, ServiceSubset: "v1"},
+				{Weight: 0, ServiceSubset: "v2"},
+			},
+		})
+	}
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// The rollout reports the missing splitter until it is created.
+	apply(t, newRollout())
+	require.NoError(t, s.reconcileServiceRollouts(ctx, now))
+	status := rolloutStatus(t)
+	require.Empty(t, status.State)
+	require.Contains(t, status.Message, `service-splitter "web" not found`)
+
+	apply(t, &structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	})
+	apply(t, &structs.ServiceResolverConfigEntry{
+		Kind: structs.ServiceResolver,
+		Name: "web",
+		Subsets: map[string]structs.ServiceResolverSubset{
+			"v1": {Filter: `Service.Meta.version == "v1"`},
+			"v2": {Filter: `Service.Meta.version == "v2"`},
+		},
+	})
+	resetSplitter(t)
+	register(t, "web-v1", "v1", api.HealthPassing)
+	register(t, "web-v2", "v2", api.HealthPassing)
+
+	t.Run("progress", func(t *testing.T) {
+		apply(t, newRollout())
+
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now))
+		baseline, canary := splitWeights(t)
+		require.Equal(t, float32(75), baseline)
+		require.Equal(t, float32(25), canary)
+		status := rolloutStatus(t)
+		require.Equal(t, structs.ServiceRolloutProgressing, status.State)
+		require.Equal(t, 0, status.Step)
+		require.Empty(t, status.Message)
+
+		// The step is held until the interval passed.
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now.Add(30*time.Second)))
+		require.Equal(t, 0, rolloutStatus(t).Step)
+
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now.Add(61*time.Second)))
+		baseline, canary = splitWeights(t)
+		require.Equal(t, float32(0), baseline)
+		require.Equal(t, float32(100), canary)
+		require.Equal(t, 1, rolloutStatus(t).Step)
+
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now.Add(122*time.Second)))
+		require.Equal(t, structs.ServiceRolloutCompleted, rolloutStatus(t).State)
+		baseline, canary = splitWeights(t)
+		require.Equal(t, float32(0), baseline)
+		require.Equal(t, float32(100), canary)
+	})
+
+	t.Run("rollback on critical instances", func(t *testing.T) {
+		resetSplitter(t)
+		apply(t, newRollout())
+
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now))
+		require.Equal(t, structs.ServiceRolloutProgressing, rolloutStatus(t).State)
+
+		register(t, "web-v2", "v2", api.HealthCritical)
+		defer register(t, "web-v2", "v2", api.HealthPassing)
+
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now.Add(10*time.Second)))
+		status := rolloutStatus(t)
+		require.Equal(t, structs.ServiceRolloutRolledBack, status.State)
+		require.Equal(t, `1 of 1 instances of the canary subset "v2" are critical`, status.Message)
+		baseline, canary := splitWeights(t)
+		require.Equal(t, float32(100), baseline)
+		require.Equal(t, float32(0), canary)
+	})
+
+	t.Run("invalid status step", func(t *testing.T) {
+		resetSplitter(t)
+
+		// Validation rejects such a status, so write it to the state store
+		// directly to check that the controller doesn't rely on it.
+		rollout := newRollout()
+		rollout.Status = structs.ServiceRolloutStatus{
+			State:          structs.ServiceRolloutProgressing,
+			Step:           -2,
+			LastTransition: now,
+		}
+		idx, _, err := s.fsm.State().ConfigEntry(nil, structs.ServiceRollout, "web", nil)
+		require.NoError(t, err)
+		require.NoError(t, s.fsm.State().EnsureConfigEntry(idx+1, rollout))
+
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now.Add(61*time.Second)))
+		status := rolloutStatus(t)
+		require.Equal(t, -2, status.Step)
+		require.Contains(t, status.Message, "step -1 is out of range")
+	})
+
+	t.Run("rollback on metrics", func(t *testing.T) {
+		resetSplitter(t)
+		apply(t, newRollout())
+
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now))
+		require.Equal(t, structs.ServiceRolloutProgressing, rolloutStatus(t).State)
+
+		// The step is held while the metrics can't be queried.
+		metrics.err = fmt.Errorf("connection refused")
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now.Add(61*time.Second)))
+		status := rolloutStatus(t)
+		require.Equal(t, structs.ServiceRolloutProgressing, status.State)
+		require.Equal(t, 0, status.Step)
+		require.Contains(t, status.Message, "connection refused")
+
+		metrics.err = nil
+		metrics.value = 3
+		require.NoError(t, s.reconcileServiceRollouts(ctx, now.Add(62*time.Second)))
+		status = rolloutStatus(t)
+		require.Equal(t, structs.ServiceRolloutRolledBack, status.State)
+		require.Contains(t, status.Message, "above the threshold")
+		baseline, canary := splitWeights(t)
+		require.Equal(t, float32(100), baseline)
+		require.Equal(t, float32(0), canary)
+	})
+}
+
+func TestPrometheusRolloutMetrics(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		response  string
+		expect    float64
+		expectErr string
+	}{
+		"vector": {
+			response: `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1654077600,"0.25"]}]}}`,
+			expect:   0.25,
+		},
+		"empty vector": {
+			response: `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			expect:   0,
+		},
+		"scalar": {
+			response: `{"status":"success","data":{"resultType":"scalar","result":[1654077600,"2"]}}`,
+			expect:   2,
+		},
+		"several series": {
+			response:  `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"1"]},{"value":[1,"2"]}]}}`,
+			expectErr: "query returned 2 series instead of one",
+		},
+		"error": {
+			response:  `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			expectErr: "query failed: parse error",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/prometheus/api/v1/query", r.URL.Path)
+				require.Equal(t, "errors", r.URL.Query().Get("query"))
+				fmt.Fprint(w, tc.response)
+			}))
+			defer srv.Close()
+
+			p := &prometheusRolloutMetrics{client: srv.Client()}
+			value, err := p.Query(context.Background(), &structs.RolloutMetricsQuery{
+				Provider: structs.RolloutMetricsProviderPrometheus,
+				Address:  srv.URL + "/prometheus",
+				Query:    "errors",
+			})
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, value)
+		})
+	}
+}
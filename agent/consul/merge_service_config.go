@@ -115,6 +115,9 @@ func computeResolvedServiceConfig(
 		thisReply.TransparentProxy = proxyConf.TransparentProxy
 		thisReply.MeshGateway = proxyConf.MeshGateway
 		thisReply.Expose = proxyConf.Expose
+		thisReply.AccessLogs = proxyConf.AccessLogs
+		thisReply.Tracing = proxyConf.Tracing
+		thisReply.Metrics = proxyConf.Metrics
 
 		// Extract the global protocol from proxyConf for upstream configs.
 		rawProtocol := proxyConf.Config["protocol"]
@@ -158,6 +161,11 @@ func computeResolvedServiceConfig(
 		if serviceConf.Destination != nil {
 			thisReply.Destination = *serviceConf.Destination
 		}
+		// Access logs set in service-defaults replace the proxy-defaults as a
+		// whole so that a service can turn them off.
+		if serviceConf.AccessLogs != nil {
+			thisReply.AccessLogs = *serviceConf.AccessLogs
+		}
 
 		thisReply.Meta = serviceConf.Meta
 	}
@@ -336,6 +344,15 @@ func MergeServiceConfig(defaults *structs.ServiceConfigResponse, service *struct
 	if !ns.Proxy.TransparentProxy.DialedDirectly {
 		ns.Proxy.TransparentProxy.DialedDirectly = defaults.TransparentProxy.DialedDirectly
 	}
+	if ns.Proxy.AccessLogs.IsZero() {
+		ns.Proxy.AccessLogs = defaults.AccessLogs
+	}
+	if ns.Proxy.Tracing.IsZero() {
+		ns.Proxy.Tracing = defaults.Tracing
+	}
+	if ns.Proxy.Metrics.IsZero() {
+		ns.Proxy.Metrics = defaults.Metrics
+	}
 
 	// remoteUpstreams contains synthetic Upstreams generated from central config (service-defaults.UpstreamConfigs).
 	remoteUpstreams := make(map[structs.ServiceID]structs.Upstream)
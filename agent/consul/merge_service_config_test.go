@@ -109,6 +109,90 @@ func Test_MergeServiceConfig_TransparentProxy(t *testing.T) {
 	}
 }
 
+func Test_MergeServiceConfig_AccessLogs(t *testing.T) {
+	defaults := &structs.ServiceConfigResponse{
+		AccessLogs: structs.AccessLogsConfig{
+			Enabled: true,
+			Type:    structs.StdErrLogSinkType,
+		},
+	}
+
+	t.Run("inherit access logs", func(t *testing.T) {
+		service := &structs.NodeService{
+			ID:      "foo-proxy",
+			Service: "foo-proxy",
+			Proxy: structs.ConnectProxyConfig{
+				DestinationServiceName: "foo",
+			},
+		}
+		got, err := MergeServiceConfig(defaults, service)
+		require.NoError(t, err)
+		require.Equal(t, defaults.AccessLogs, got.Proxy.AccessLogs)
+	})
+
+	t.Run("registration overrides access logs", func(t *testing.T) {
+		local := structs.AccessLogsConfig{
+			Enabled: true,
+			Type:    structs.FileLogSinkType,
+			Path:    "/tmp/foo.log",
+		}
+		service := &structs.NodeService{
+			ID:      "foo-proxy",
+			Service: "foo-proxy",
+			Proxy: structs.ConnectProxyConfig{
+				DestinationServiceName: "foo",
+				AccessLogs:             local,
+			},
+		}
+		got, err := MergeServiceConfig(defaults, service)
+		require.NoError(t, err)
+		require.Equal(t, local, got.Proxy.AccessLogs)
+	})
+}
+
+func Test_MergeServiceConfig_TracingAndMetrics(t *testing.T) {
+	defaults := &structs.ServiceConfigResponse{
+		Tracing: structs.TracingConfig{
+			Provider:           structs.ZipkinTracingProvider,
+			CollectorAddress:   "zipkin:9411",
+			SamplingPercentage: 10,
+		},
+		Metrics: structs.MetricsConfig{
+			PrometheusBindAddr: "0.0.0.0:9102",
+		},
+	}
+
+	t.Run("inherit tracing and metrics", func(t *testing.T) {
+		service := &structs.NodeService{
+			ID:      "foo-proxy",
+			Service: "foo-proxy",
+			Proxy: structs.ConnectProxyConfig{
+				DestinationServiceName: "foo",
+			},
+		}
+		got, err := MergeServiceConfig(defaults, service)
+		require.NoError(t, err)
+		require.Equal(t, defaults.Tracing, got.Proxy.Tracing)
+		require.Equal(t, defaults.Metrics, got.Proxy.Metrics)
+	})
+
+	t.Run("registration overrides tracing", func(t *testing.T) {
+		local := structs.TracingConfig{SamplingPercentage: 100}
+		service := &structs.NodeService{
+			ID:      "foo-proxy",
+			Service: "foo-proxy",
+			Proxy: structs.ConnectProxyConfig{
+				DestinationServiceName: "foo",
+				Tracing:                local,
+			},
+		}
+		got, err := MergeServiceConfig(defaults, service)
+		require.NoError(t, err)
+		require.Equal(t, local, got.Proxy.Tracing)
+		require.Equal(t, defaults.Metrics, got.Proxy.Metrics)
+	})
+}
+
 func Test_MergeServiceConfig_UpstreamOverrides(t *testing.T) {
 	type args struct {
 		defaults *structs.ServiceConfigResponse
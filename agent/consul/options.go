@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/consul-net-rpc/net/rpc"
 
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/consul/stream"
 	"github.com/hashicorp/consul/agent/pool"
 	"github.com/hashicorp/consul/agent/router"
@@ -23,6 +24,9 @@ type Deps struct {
 	ConnPool        *pool.ConnPool
 	GRPCConnPool    GRPCClientConner
 	LeaderForwarder LeaderForwarder
+	// AuditLogger records the RPC requests handled by the server. It is shared
+	// with the agent, which uses it for HTTP requests.
+	AuditLogger *audit.Logger
 	// GetNetRPCInterceptorFunc, if not nil, sets the net/rpc rpc.ServerServiceCallInterceptor on
 	// the server side to record metrics around the RPC requests. If nil, no interceptor is added to
 	// the rpc server.
@@ -1,19 +1,28 @@
 package consul
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/acl/resolver"
+	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/consul/stream"
 	"github.com/hashicorp/consul/agent/grpc-external/services/peerstream"
 	"github.com/hashicorp/consul/agent/rpc/peering"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/ipaddr"
 	"github.com/hashicorp/consul/proto/pbpeering"
+	"github.com/hashicorp/consul/proto/pbpeerstream"
 )
 
 type PeeringBackend struct {
@@ -58,8 +67,24 @@ func (b *PeeringBackend) GetAgentCACertificates() ([]string, error) {
 }
 
 // GetServerAddresses looks up server node addresses from the state store.
+// When peering through mesh gateways is enabled, the WAN addresses of the
+// local mesh gateways are returned instead.
 func (b *PeeringBackend) GetServerAddresses() ([]string, error) {
 	state := b.srv.fsm.State()
+
+	throughGateways, err := peerThroughMeshGateways(state)
+	if err != nil {
+		return nil, err
+	}
+	if throughGateways {
+		// Mesh gateways route the connections of peers based on their SNI,
+		// which requires TLS.
+		if len(b.srv.tlsConfigurator.GRPCManualCAPems()) == 0 {
+			return nil, fmt.Errorf("peering through mesh gateways requires TLS to be enabled on the gRPC port")
+		}
+		return meshGatewayAddresses(state, true)
+	}
+
 	_, nodes, err := state.ServiceNodes(nil, "consul", structs.DefaultEnterpriseMetaInDefaultPartition(), structs.DefaultPeerKeyword)
 	if err != nil {
 		return nil, err
@@ -78,6 +103,39 @@ func (b *PeeringBackend) GetServerAddresses() ([]string, error) {
 	return addrs, nil
 }
 
+// peerThroughMeshGateways returns whether the mesh config entry routes the
+// control plane traffic of peerings through mesh gateways.
+func peerThroughMeshGateways(state *state.Store) (bool, error) {
+	_, entry, err := state.ConfigEntry(nil, structs.MeshConfig, structs.MeshConfigMesh, structs.DefaultEnterpriseMetaInDefaultPartition())
+	if err != nil {
+		return false, fmt.Errorf("failed to read mesh config entry: %w", err)
+	}
+	mesh, ok := entry.(*structs.MeshConfigEntry)
+	if !ok {
+		return false, nil
+	}
+	return mesh.PeerThroughMeshGateways(), nil
+}
+
+// meshGatewayAddresses returns the addresses of the healthy mesh gateways of
+// the default partition, using their WAN address if wan is set.
+func meshGatewayAddresses(state *state.Store, wan bool) ([]string, error) {
+	_, nodes, err := state.ServiceDump(nil, structs.ServiceKindMeshGateway, true, structs.DefaultEnterpriseMetaInDefaultPartition(), structs.DefaultPeerKeyword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mesh gateways: %w", err)
+	}
+
+	var addrs []string
+	for _, node := range nodes.Filter(false) {
+		_, addr, port := node.BestAddress(wan)
+		addrs = append(addrs, ipaddr.FormatAddressPort(addr, port))
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("peering through mesh gateways is enabled but no healthy mesh gateway is registered")
+	}
+	return addrs, nil
+}
+
 // GetServerName returns the SNI to be returned in the peering token data which
 // will be used by peers when establishing peering connections over TLS.
 func (b *PeeringBackend) GetServerName() string {
@@ -153,6 +211,64 @@ func (b *PeeringBackend) PeeringTrustBundleWrite(req *pbpeering.PeeringTrustBund
 	return err
 }
 
+func (b *PeeringBackend) PeeringSecretsWrite(req *pbpeering.PeeringSecretsWriteRequest) error {
+	_, err := b.srv.raftApplyProtobuf(structs.PeeringSecretsWriteType, req)
+	return err
+}
+
+// ExchangeSecret exchanges the establishment secret of a peering token with
+// the servers of the accepting peer for a stream secret. Each server address
+// is tried in turn until one of them, the leader, accepts the request.
+func (b *PeeringBackend) ExchangeSecret(ctx context.Context, peer *pbpeering.Peering, establishmentSecret string) (string, error) {
+	pool, err := peerCertPool(peer)
+	if err != nil {
+		return "", err
+	}
+
+	req := &pbpeerstream.ExchangeSecretRequest{
+		PeerID:              peer.PeerID,
+		EstablishmentSecret: establishmentSecret,
+	}
+
+	var lastErr error
+	for i, addr := range peer.PeerServerAddresses {
+		target, tlsOption, _, err := b.srv.peerDialTarget(peer, pool, addr, i)
+		if err != nil {
+			return "", err
+		}
+
+		secret, err := exchangeSecretWithPeer(ctx, target, tlsOption, req)
+		if err == nil {
+			return secret, nil
+		}
+		lastErr = err
+
+		// Only retry other servers when the one dialed is not the leader or
+		// could not be reached.
+		if code := grpcstatus.Code(err); code != codes.FailedPrecondition && code != codes.Unavailable {
+			break
+		}
+	}
+	return "", fmt.Errorf("failed to exchange peering secret: %w", lastErr)
+}
+
+func exchangeSecretWithPeer(ctx context.Context, addr string, tlsOption grpc.DialOption, req *pbpeerstream.ExchangeSecretRequest) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, addr, tlsOption, grpc.WithBlock())
+	if err != nil {
+		return "", grpcstatus.Errorf(codes.Unavailable, "failed to dial %s: %v", addr, err)
+	}
+	defer conn.Close()
+
+	resp, err := pbpeerstream.NewPeerStreamServiceClient(conn).ExchangeSecret(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.StreamSecret, nil
+}
+
 func (b *PeeringBackend) CatalogRegister(req *structs.RegisterRequest) error {
 	_, err := b.srv.leaderRaftApply("Catalog.Register", structs.RegisterRequestType, req)
 	return err
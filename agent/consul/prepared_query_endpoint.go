@@ -197,6 +197,10 @@ func parseService(svc *structs.ServiceQuery) error {
 		return fmt.Errorf("Targets cannot be populated with NearestN or Datacenters")
 	}
 
+	if failover.SamenessGroup != "" && (failover.NearestN != 0 || len(failover.Datacenters) != 0 || len(failover.Targets) != 0) {
+		return fmt.Errorf("SamenessGroup cannot be populated with NearestN, Datacenters, or Targets")
+	}
+
 	// Make sure the metadata filters are valid
 	if err := structs.ValidateNodeMetadata(svc.NodeMeta, true); err != nil {
 		return err
@@ -662,6 +666,7 @@ type queryServer interface {
 	GetLogger() hclog.Logger
 	GetOtherDatacentersByDistance() ([]string, error)
 	GetLocalDC() string
+	GetSamenessGroupFailoverTargets(name string, entMeta *acl.EnterpriseMeta) ([]structs.QueryFailoverTarget, error)
 	ExecuteRemote(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error
 }
 
@@ -705,6 +710,24 @@ func (q *queryServerWrapper) GetOtherDatacentersByDistance() ([]string, error) {
 	return result, nil
 }
 
+// GetSamenessGroupFailoverTargets returns the peers of the given sameness
+// group as failover targets.
+func (q *queryServerWrapper) GetSamenessGroupFailoverTargets(name string, entMeta *acl.EnterpriseMeta) ([]structs.QueryFailoverTarget, error) {
+	_, entry, err := q.srv.fsm.State().ConfigEntry(nil, structs.SamenessGroup, name, entMeta)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	group, ok := entry.(*structs.SamenessGroupConfigEntry)
+	if !ok {
+		return nil, fmt.Errorf("invalid config entry type %T", entry)
+	}
+	return group.QueryFailoverTargets(), nil
+}
+
 // queryFailover runs an algorithm to determine which DCs to try and then calls
 // them to try to locate alternative services.
 func queryFailover(q queryServer, query *structs.PreparedQuery,
@@ -739,8 +762,19 @@ func queryFailover(q queryServer, query *structs.PreparedQuery,
 		}
 	}
 
+	failoverTargets := query.Service.Failover.AsTargets()
+	if name := query.Service.Failover.SamenessGroup; name != "" {
+		failoverTargets, err = q.GetSamenessGroupFailoverTargets(name, &query.Service.EnterpriseMeta)
+		if err != nil {
+			return err
+		}
+		if len(failoverTargets) == 0 {
+			q.GetLogger().Debug("Sameness group in prepared query has no peers", "samenessGroup", name)
+		}
+	}
+
 	// Then add any DCs explicitly listed that weren't selected above.
-	for _, target := range query.Service.Failover.AsTargets() {
+	for _, target := range failoverTargets {
 		// This will prevent a log of other log spammage if we do not
 		// attempt to talk to datacenters we don't know about.
 		if dc := target.Datacenter; dc != "" {
@@ -104,8 +104,16 @@ func TestPreparedQuery_Apply(t *testing.T) {
 		t.Fatalf("bad: %v", err)
 	}
 
-	// Fix that and make sure it propagates an error from the Raft apply.
+	// Fix that and ensure SamenessGroup and Datacenters cannot be set at the same time.
 	query.Query.Service.Failover.Targets = nil
+	query.Query.Service.Failover.SamenessGroup = "group1"
+	err = msgpackrpc.CallWithCodec(codec, "PreparedQuery.Apply", &query, &reply)
+	if err == nil || !strings.Contains(err.Error(), "SamenessGroup cannot be populated with") {
+		t.Fatalf("bad: %v", err)
+	}
+
+	// Fix that and make sure it propagates an error from the Raft apply.
+	query.Query.Service.Failover.SamenessGroup = ""
 	query.Query.Session = "nope"
 	err = msgpackrpc.CallWithCodec(codec, "PreparedQuery.Apply", &query, &reply)
 	if err == nil || !strings.Contains(err.Error(), "invalid session") {
@@ -2910,6 +2918,7 @@ func TestPreparedQuery_Wrapper(t *testing.T) {
 type mockQueryServer struct {
 	Datacenters      []string
 	DatacentersError error
+	SamenessGroups   map[string][]structs.QueryFailoverTarget
 	QueryLog         []string
 	QueryFn          func(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error
 	Logger           hclog.Logger
@@ -2941,6 +2950,10 @@ func (m *mockQueryServer) GetOtherDatacentersByDistance() ([]string, error) {
 	return m.Datacenters, m.DatacentersError
 }
 
+func (m *mockQueryServer) GetSamenessGroupFailoverTargets(name string, _ *acl.EnterpriseMeta) ([]structs.QueryFailoverTarget, error) {
+	return m.SamenessGroups[name], nil
+}
+
 func (m *mockQueryServer) ExecuteRemote(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error {
 	peerName := args.Query.Service.PeerName
 	dc := args.Datacenter
@@ -3318,4 +3331,34 @@ func TestPreparedQuery_queryFailover(t *testing.T) {
 		require.Equal(t, nodes(), reply.Nodes)
 		require.Equal(t, "peer:cluster-01|dc44:PreparedQuery.ExecuteRemote|peer:cluster-02", mock.JoinQueryLog())
 	}
+
+	// Failover returns data from the first peer of the sameness group with data.
+	query.Service.Failover.Targets = nil
+	query.Service.Failover.SamenessGroup = "group1"
+	{
+		mock := &mockQueryServer{
+			SamenessGroups: map[string][]structs.QueryFailoverTarget{
+				"group1": {
+					{PeerName: "cluster-01"},
+					{PeerName: "cluster-02"},
+					{PeerName: "cluster-03"},
+				},
+			},
+			QueryFn: func(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error {
+				if args.Query.Service.PeerName == "cluster-02" {
+					reply.Nodes = nodes()
+				}
+				return nil
+			},
+		}
+
+		var reply structs.PreparedQueryExecuteResponse
+		if err := queryFailover(mock, query, &structs.PreparedQueryExecuteRequest{}, &reply); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+		require.Equal(t, "cluster-02", reply.PeerName)
+		require.Equal(t, 2, reply.Failovers)
+		require.Equal(t, nodes(), reply.Nodes)
+		require.Equal(t, "peer:cluster-01|peer:cluster-02", mock.JoinQueryLog())
+	}
 }
@@ -9,6 +9,7 @@ import (
 	"io"
 	"net"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -18,14 +19,17 @@ import (
 	"github.com/hashicorp/go-hclog"
 	memdb "github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/go-raftchunking"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/memberlist"
 	"github.com/hashicorp/raft"
 	"github.com/hashicorp/yamux"
 	"google.golang.org/grpc"
 
 	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/hashicorp/consul-net-rpc/net/rpc"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/consul/wanfed"
 	"github.com/hashicorp/consul/agent/metadata"
@@ -104,6 +108,125 @@ type partitionUnsetter interface {
 	UnsetPartition()
 }
 
+// sourceAddrRequest is used to describe request values that carry the address
+// of the client that sent them to the cluster.
+type sourceAddrRequest interface {
+	RequestSourceAddr() string
+	SetRequestSourceAddr(string)
+}
+
+// sourceAddrCodec records the address of the connection's remote end on each
+// request read from it, replacing any address supplied by the client itself.
+// It is only used for connections from outside the cluster; requests relayed
+// by other agents and servers keep the address they were stamped with.
+type sourceAddrCodec struct {
+	rpc.ServerCodec
+	addr string
+}
+
+func (c *sourceAddrCodec) ReadRequestBody(body interface{}) error {
+	if err := c.ServerCodec.ReadRequestBody(body); err != nil {
+		return err
+	}
+	if req, ok := body.(sourceAddrRequest); ok {
+		req.SetRequestSourceAddr(c.addr)
+	}
+	return nil
+}
+
+// auditCodec records an audit event for each request read from a connection
+// once the response to it has been written.
+type auditCodec struct {
+	rpc.ServerCodec
+	srv  *Server
+	addr string
+
+	lock    sync.Mutex
+	pending map[uint64]*auditedRequest
+	current *auditedRequest
+}
+
+type auditedRequest struct {
+	start time.Time
+	event *audit.Event
+}
+
+func (c *auditCodec) ReadRequestHeader(r *rpc.Request) error {
+	if err := c.ServerCodec.ReadRequestHeader(r); err != nil {
+		return err
+	}
+
+	reqID, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	req := &auditedRequest{
+		start: time.Now(),
+		event: &audit.Event{
+			Type:      audit.EventTypeRPC,
+			RequestID: reqID,
+			Endpoint:  r.ServiceMethod,
+			SourceIP:  c.addr,
+		},
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pending[r.Seq] = req
+	c.current = req
+	return nil
+}
+
+func (c *auditCodec) ReadRequestBody(body interface{}) error {
+	if err := c.ServerCodec.ReadRequestBody(body); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	req := c.current
+	c.current = nil
+	c.lock.Unlock()
+	if req == nil {
+		return nil
+	}
+
+	if info, ok := body.(structs.RPCInfo); ok {
+		token := info.TokenSecret()
+		req.event.TokenHash = audit.HashSecret(token)
+		if result, err := c.srv.ACLResolver.ResolveToken(token); err == nil {
+			req.event.AccessorID = result.AccessorID()
+		}
+		req.event.Method = "write"
+		if info.IsRead() {
+			req.event.Method = "read"
+		}
+	}
+	// Prefer the address the request was originally sent to the cluster
+	// from over that of the agent or server relaying it.
+	if sr, ok := body.(sourceAddrRequest); ok && sr.RequestSourceAddr() != "" {
+		req.event.SourceIP = sr.RequestSourceAddr()
+	}
+	return nil
+}
+
+func (c *auditCodec) WriteResponse(r *rpc.Response, body interface{}) error {
+	err := c.ServerCodec.WriteResponse(r, body)
+
+	c.lock.Lock()
+	req, ok := c.pending[r.Seq]
+	delete(c.pending, r.Seq)
+	c.lock.Unlock()
+	if !ok {
+		return err
+	}
+
+	req.event.Timestamp = req.start
+	req.event.Error = r.Error
+	req.event.ElapsedMS = float64(time.Since(req.start)) / float64(time.Millisecond)
+	c.srv.auditLogger.Log(req.event)
+	return err
+}
+
 func (s *Server) rpcLogger() hclog.Logger {
 	return s.loggers.Named(logging.RPC)
 }
@@ -408,7 +531,7 @@ func (s *Server) handleMultiplexV2(conn net.Conn) {
 // handleConsulConn is used to service a single Consul RPC connection
 func (s *Server) handleConsulConn(conn net.Conn) {
 	defer conn.Close()
-	rpcCodec := msgpackrpc.NewCodecFromHandle(true, true, conn, structs.MsgpackHandle)
+	rpcCodec := s.newServerCodec(conn)
 	for {
 		select {
 		case <-s.shutdownCh:
@@ -433,7 +556,7 @@ func (s *Server) handleConsulConn(conn net.Conn) {
 // handleInsecureConsulConn is used to service a single Consul INSECURERPC connection
 func (s *Server) handleInsecureConn(conn net.Conn) {
 	defer conn.Close()
-	rpcCodec := msgpackrpc.NewCodecFromHandle(true, true, conn, structs.MsgpackHandle)
+	rpcCodec := s.newServerCodec(conn)
 	for {
 		select {
 		case <-s.shutdownCh:
@@ -455,6 +578,51 @@ func (s *Server) handleInsecureConn(conn net.Conn) {
 	}
 }
 
+// newServerCodec returns the codec used to read RPC requests from conn. When the
+// remote end is not a member of the cluster its address is recorded on the
+// requests so that token BoundCIDRs can be enforced, and when audit logging is
+// enabled every request is recorded.
+func (s *Server) newServerCodec(conn net.Conn) rpc.ServerCodec {
+	var codec rpc.ServerCodec = msgpackrpc.NewCodecFromHandle(true, true, conn, structs.MsgpackHandle)
+
+	var ip net.IP
+	if host, _, err := net.SplitHostPort(conn.RemoteAddr().String()); err == nil {
+		ip = net.ParseIP(host)
+	}
+	if ip != nil && !s.isMemberAddr(ip) {
+		codec = &sourceAddrCodec{ServerCodec: codec, addr: ip.String()}
+	}
+
+	if s.auditLogger.Enabled() {
+		var addr string
+		if ip != nil {
+			addr = ip.String()
+		}
+		codec = &auditCodec{
+			ServerCodec: codec,
+			srv:         s,
+			addr:        addr,
+			pending:     make(map[uint64]*auditedRequest),
+		}
+	}
+	return codec
+}
+
+// isMemberAddr returns whether ip is the address of a member of one of the
+// server's gossip pools.
+func (s *Server) isMemberAddr(ip net.IP) bool {
+	members := s.LANMembersInAgentPartition()
+	if s.serfWAN != nil {
+		members = append(members, s.serfWAN.Members()...)
+	}
+	for _, m := range members {
+		if m.Addr.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // handleSnapshotConn is used to dispatch snapshot saves and restores, which
 // stream so don't use the normal RPC mechanism.
 func (s *Server) handleSnapshotConn(conn net.Conn) {
@@ -639,6 +807,12 @@ func (s *Server) forwardRPC(
 	forwardToDC func(dc string) error,
 	forwardToLeader func(leader *metadata.Server) error,
 ) (handled bool, err error) {
+	// Reject requests that were sent from outside of the token's BoundCIDRs
+	// before doing anything else with them.
+	if err := s.checkRequestSource(info); err != nil {
+		return true, err
+	}
+
 	// Forward the request to the requested datacenter.
 	if handled, err := s.forwardRequestToOtherDatacenter(info, forwardToDC); handled || err != nil {
 		return handled, err
@@ -653,6 +827,16 @@ func (s *Server) forwardRPC(
 	return s.forwardRequestToLeader(info, forwardToLeader)
 }
 
+// checkRequestSource enforces the BoundCIDRs of the request's token against the
+// address the request was sent to the cluster from, if it is known.
+func (s *Server) checkRequestSource(info structs.RPCInfo) error {
+	req, ok := info.(sourceAddrRequest)
+	if !ok || req.RequestSourceAddr() == "" {
+		return nil
+	}
+	return s.ACLResolver.CheckTokenSource(info.TokenSecret(), net.ParseIP(req.RequestSourceAddr()))
+}
+
 // forwardRequestToOtherDatacenter is an implementation detail of forwardRPC.
 // See the comment for forwardRPC for more details.
 func (s *Server) forwardRequestToOtherDatacenter(info structs.RPCInfo, forwardToDC func(dc string) error) (handled bool, err error) {
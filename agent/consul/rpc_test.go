@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -30,6 +31,7 @@ import (
 	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul/state"
 	agent_grpc "github.com/hashicorp/consul/agent/grpc-internal"
@@ -1800,3 +1802,94 @@ func rpcBlockingQueryTestHarness(
 
 	require.Equal(t, 1, count, "if this fails, then the timer likely needs to be increased above")
 }
+
+func TestRPC_AuditLog(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, conf := testServerConfig(t)
+	testServerACLConfig(conf)
+	conf.ACLResolverSettings.ACLsEnabled = conf.ACLsEnabled
+	conf.ACLResolverSettings.NodeName = conf.NodeName
+	conf.ACLResolverSettings.Datacenter = conf.Datacenter
+	conf.ACLResolverSettings.EnterpriseMeta = *conf.AgentEnterpriseMeta()
+
+	dir := testutil.TempDir(t, "audit")
+	deps := newDefaultDeps(t, conf)
+	auditLogger, err := audit.New(audit.Config{
+		Enabled: true,
+		Sinks: []audit.SinkConfig{{
+			Name:           "test",
+			Type:           audit.SinkTypeFile,
+			Path:           filepath.Join(dir, "audit.json"),
+			RotateDuration: time.Hour,
+		}},
+		Filter: audit.FilterConfig{IncludePaths: []string{"Catalog.*"}},
+	}, hclog.NewNullLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { auditLogger.Close() })
+	deps.AuditLogger = auditLogger
+
+	s, err := newServerWithDeps(t, conf, deps)
+	require.NoError(t, err)
+	testrpc.WaitForLeader(t, s.RPC, "dc1", testrpc.WithToken(TestDefaultInitialManagementToken))
+
+	codec := rpcClient(t, s)
+
+	args := structs.DCSpecificRequest{
+		Datacenter:   "dc1",
+		QueryOptions: structs.QueryOptions{Token: TestDefaultInitialManagementToken},
+	}
+	var out structs.IndexedNodes
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Catalog.ListNodes", &args, &out))
+
+	var pingOut struct{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Status.Ping", struct{}{}, &pingOut))
+
+	err = msgpackrpc.CallWithCodec(codec, "Catalog.Register", &structs.RegisterRequest{
+		Datacenter:   "dc1",
+		Node:         "foo",
+		Address:      "127.0.0.1",
+		WriteRequest: structs.WriteRequest{Token: "not-a-token"},
+	}, &struct{}{})
+	require.Error(t, err)
+
+	_, managementToken, err := s.fsm.State().ACLTokenGetBySecret(nil, TestDefaultInitialManagementToken, nil)
+	require.NoError(t, err)
+
+	retry.Run(t, func(r *retry.R) {
+		files, err := filepath.Glob(filepath.Join(dir, "audit-*.json"))
+		require.NoError(r, err)
+		require.Len(r, files, 1)
+
+		raw, err := ioutil.ReadFile(files[0])
+		require.NoError(r, err)
+
+		var events []audit.Event
+		for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+			var event audit.Event
+			require.NoError(r, json.Unmarshal([]byte(line), &event))
+			events = append(events, event)
+		}
+		require.Len(r, events, 2)
+
+		require.Equal(r, audit.EventTypeRPC, events[0].Type)
+		require.Equal(r, "Catalog.ListNodes", events[0].Endpoint)
+		require.Equal(r, "read", events[0].Method)
+		require.Equal(r, managementToken.AccessorID, events[0].AccessorID)
+		require.Equal(r, audit.HashSecret(TestDefaultInitialManagementToken), events[0].TokenHash)
+		require.Equal(r, "127.0.0.1", events[0].SourceIP)
+		require.NotEmpty(r, events[0].RequestID)
+		require.Empty(r, events[0].Error)
+		require.NotContains(r, string(raw), TestDefaultInitialManagementToken)
+
+		require.Equal(r, "Catalog.Register", events[1].Endpoint)
+		require.Equal(r, "write", events[1].Method)
+		require.Empty(r, events[1].AccessorID)
+		require.Equal(r, audit.HashSecret("not-a-token"), events[1].TokenHash)
+		require.NotEmpty(r, events[1].Error)
+	})
+}
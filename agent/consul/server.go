@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/consul-net-rpc/net/rpc"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/consul/authmethod"
 	"github.com/hashicorp/consul/agent/consul/authmethod/ssoauth"
 	"github.com/hashicorp/consul/agent/consul/fsm"
@@ -123,11 +124,15 @@ const (
 	intentionMigrationRoutineName         = "intention config entry migration"
 	secondaryCARootWatchRoutineName       = "secondary CA roots watch"
 	intermediateCertRenewWatchRoutineName = "intermediate cert renew watch"
+	caProviderReloadWatchRoutineName      = "CA provider reload watch"
 	backgroundCAInitializationRoutineName = "CA initialization"
 	virtualIPCheckRoutineName             = "virtual IP version check"
+	connectJWTKeyRotationRoutineName      = "JWT-SVID signing key rotation"
 	peeringStreamsRoutineName             = "streaming peering resources"
 	peeringDeletionRoutineName            = "peering deferred deletion"
 	peeringStreamsMetricsRoutineName      = "metrics for streaming peering resources"
+	peeringStreamsStatusRoutineName       = "status for streaming peering resources"
+	serviceRolloutRoutineName             = "service rollout controller"
 )
 
 var (
@@ -283,6 +288,13 @@ type Server struct {
 	// rpcRecorder is a middleware component that can emit RPC request metrics.
 	rpcRecorder *middleware.RequestRecorder
 
+	// auditLogger records the RPC requests received over the network.
+	auditLogger *audit.Logger
+
+	// rolloutMetricsProviders evaluate the metrics queries of service
+	// rollouts, keyed by provider name.
+	rolloutMetricsProviders map[string]RolloutMetricsProvider
+
 	// tlsConfigurator holds the agent configuration relevant to TLS and
 	// configures everything related to it.
 	tlsConfigurator *tlsutil.Configurator
@@ -442,6 +454,8 @@ func NewServer(config *Config, flat Deps, externalGRPCServer *grpc.Server) (*Ser
 		aclAuthMethodValidators: authmethod.NewCache(),
 		fsm:                     fsm.NewFromDeps(fsmDeps),
 		publisher:               flat.EventPublisher,
+		auditLogger:             flat.AuditLogger,
+		rolloutMetricsProviders: newRolloutMetricsProviders(),
 	}
 
 	var recorder *middleware.RequestRecorder
@@ -3596,6 +3596,7 @@ func terminatingConfigGatewayServices(
 			CertFile:    svc.CertFile,
 			CAFile:      svc.CAFile,
 			SNI:         svc.SNI,
+			DNS:         svc.DNS,
 			ServiceKind: kind,
 		}
 
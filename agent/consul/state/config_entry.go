@@ -493,6 +493,12 @@ func validateProposedConfigEntryInGraph(
 	case structs.ServiceIntentions:
 	case structs.MeshConfig:
 	case structs.ExportedServices:
+	case structs.ServiceRollout:
+	case structs.ImportedServices:
+	case structs.SamenessGroup:
+		if err := checkDefaultSamenessGroupClash(tx, kindName, newEntry); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unhandled kind %q during validation of %q", kindName.Kind, kindName.Name)
 	}
@@ -512,6 +518,32 @@ func checkGatewayClash(tx ReadTxn, kindName configentry.KindName, otherKind stri
 	return nil
 }
 
+// checkDefaultSamenessGroupClash ensures there is at most one sameness group
+// per partition that is the default for failover.
+func checkDefaultSamenessGroupClash(tx ReadTxn, kindName configentry.KindName, newEntry structs.ConfigEntry) error {
+	group, ok := newEntry.(*structs.SamenessGroupConfigEntry)
+	if !ok || !group.DefaultForFailover {
+		return nil
+	}
+
+	_, entries, err := configEntriesByKindTxn(tx, nil, structs.SamenessGroup, &kindName.EnterpriseMeta)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		other, ok := entry.(*structs.SamenessGroupConfigEntry)
+		if !ok || other.Name == kindName.Name || !other.DefaultForFailover {
+			continue
+		}
+		if !acl.EqualPartitions(other.PartitionOrDefault(), kindName.PartitionOrDefault()) {
+			continue
+		}
+		return fmt.Errorf("cannot make sameness group %q the default for failover, "+
+			"sameness group %q is already the default", kindName.Name, other.Name)
+	}
+	return nil
+}
+
 var serviceGraphKinds = []string{
 	structs.ServiceRouter,
 	structs.ServiceSplitter,
@@ -664,6 +696,11 @@ func validateProposedConfigEntryInServiceGraph(
 		// Exported services and mesh config do not influence discovery chains.
 		return nil
 
+	case structs.SamenessGroup:
+		// Sameness groups only add failover targets, which never change the
+		// protocol of a discovery chain.
+		return nil
+
 	case structs.ProxyDefaults:
 		// Check anything that has a discovery chain entry. In the future we could
 		// somehow omit the ones that have a default protocol configured.
@@ -1377,6 +1414,17 @@ func readDiscoveryChainConfigEntriesTxn(
 		res.Services[svcID] = entry
 	}
 
+	// Sameness groups are fetched as a whole because any of them can be the
+	// default failover policy for the chain.
+	idx, groups, err := getSamenessGroupConfigEntriesTxn(tx, ws, overrides, entMeta)
+	if err != nil {
+		return 0, nil, err
+	}
+	if idx > maxIdx {
+		maxIdx = idx
+	}
+	res.AddSamenessGroups(groups...)
+
 	// Strip nils now that they are no longer necessary.
 	for sid, entry := range res.Routers {
 		if entry == nil {
@@ -1549,6 +1597,54 @@ func getResolverConfigEntryTxn(
 	return idx, resolver, nil
 }
 
+// getSamenessGroupConfigEntriesTxn is a convenience method for fetching all
+// sameness-group config entries in a partition.
+//
+// Overrides for sameness groups in the partition replace the stored entry, or
+// remove it if the override VALUE is nil.
+func getSamenessGroupConfigEntriesTxn(
+	tx ReadTxn,
+	ws memdb.WatchSet,
+	overrides map[configentry.KindName]structs.ConfigEntry,
+	entMeta *acl.EnterpriseMeta,
+) (uint64, []*structs.SamenessGroupConfigEntry, error) {
+	partitionMeta := acl.NewEnterpriseMetaWithPartition(entMeta.PartitionOrDefault(), "")
+	// Watch only the sameness groups themselves. Watching the max index of the
+	// table would fire on unrelated writes while it has no entries.
+	idx := maxIndexTxn(tx, tableConfigEntries)
+	iter, err := getConfigEntryKindsWithTxn(tx, structs.SamenessGroup, &partitionMeta)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed config entry lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	byName := make(map[string]structs.ConfigEntry)
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		entry := v.(structs.ConfigEntry)
+		byName[entry.GetName()] = entry
+	}
+	for kn, entry := range overrides {
+		if kn.Kind != structs.SamenessGroup || !acl.EqualPartitions(kn.PartitionOrDefault(), entMeta.PartitionOrDefault()) {
+			continue
+		}
+		if entry == nil {
+			delete(byName, kn.Name)
+		} else {
+			byName[kn.Name] = entry
+		}
+	}
+
+	var groups []*structs.SamenessGroupConfigEntry
+	for _, entry := range byName {
+		group, ok := entry.(*structs.SamenessGroupConfigEntry)
+		if !ok {
+			return 0, nil, fmt.Errorf("invalid sameness group config type %T", entry)
+		}
+		groups = append(groups, group)
+	}
+	return idx, groups, nil
+}
+
 // getServiceIntentionsConfigEntryTxn is a convenience method for fetching a
 // service-intentions kind of config entry.
 //
@@ -1594,6 +1594,39 @@ func TestStore_ConfigEntry_GraphValidation(t *testing.T) {
 			},
 			expectErr: `cannot introduce new discovery chain targets like`,
 		},
+		"only one default sameness group per partition": {
+			entries: []structs.ConfigEntry{
+				&structs.SamenessGroupConfigEntry{
+					Name:               "group",
+					DefaultForFailover: true,
+					Members:            []structs.SamenessGroupMember{{Peer: "cluster-01"}},
+				},
+			},
+			opAdd: &structs.SamenessGroupConfigEntry{
+				Name:               "other",
+				DefaultForFailover: true,
+				Members:            []structs.SamenessGroupMember{{Peer: "cluster-02"}},
+			},
+			expectErr: `sameness group "group" is already the default`,
+		},
+		"update the default sameness group": {
+			entries: []structs.ConfigEntry{
+				&structs.SamenessGroupConfigEntry{
+					Name:               "group",
+					DefaultForFailover: true,
+					Members:            []structs.SamenessGroupMember{{Peer: "cluster-01"}},
+				},
+				&structs.SamenessGroupConfigEntry{
+					Name:    "other",
+					Members: []structs.SamenessGroupMember{{Peer: "cluster-02"}},
+				},
+			},
+			opAdd: &structs.SamenessGroupConfigEntry{
+				Name:               "group",
+				DefaultForFailover: true,
+				Members:            []structs.SamenessGroupMember{{Peer: "cluster-02"}},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -1885,6 +1918,52 @@ func TestStore_ReadDiscoveryChainConfigEntries_Overrides(t *testing.T) {
 				require.Equal(t, 33*time.Second, resolver.ConnectTimeout)
 			},
 		},
+		{
+			name: "mask sameness-group",
+			entries: []structs.ConfigEntry{
+				&structs.SamenessGroupConfigEntry{
+					Name:    "group",
+					Members: []structs.SamenessGroupMember{{Peer: "cluster-01"}},
+				},
+			},
+			expectBefore: []configentry.KindName{
+				configentry.NewKindName(structs.SamenessGroup, "group", nil),
+			},
+			overrides: map[configentry.KindName]structs.ConfigEntry{
+				configentry.NewKindName(structs.SamenessGroup, "group", nil): nil,
+			},
+			expectAfter: []configentry.KindName{
+				// nothing
+			},
+		},
+		{
+			name: "add sameness-group",
+			entries: []structs.ConfigEntry{
+				&structs.SamenessGroupConfigEntry{
+					Name:    "group",
+					Members: []structs.SamenessGroupMember{{Peer: "cluster-01"}},
+				},
+			},
+			expectBefore: []configentry.KindName{
+				configentry.NewKindName(structs.SamenessGroup, "group", nil),
+			},
+			overrides: map[configentry.KindName]structs.ConfigEntry{
+				configentry.NewKindName(structs.SamenessGroup, "other", nil): &structs.SamenessGroupConfigEntry{
+					Name:               "other",
+					DefaultForFailover: true,
+					Members:            []structs.SamenessGroupMember{{Peer: "cluster-02"}},
+				},
+			},
+			expectAfter: []configentry.KindName{
+				configentry.NewKindName(structs.SamenessGroup, "group", nil),
+				configentry.NewKindName(structs.SamenessGroup, "other", nil),
+			},
+			checkAfter: func(t *testing.T, entrySet *configentry.DiscoveryChainSet) {
+				group := entrySet.GetDefaultSamenessGroup()
+				require.NotNil(t, group)
+				require.Equal(t, "other", group.Name)
+			},
+		},
 	} {
 		tc := tc
 
@@ -1958,6 +2037,13 @@ func entrySetToKindNames(entrySet *configentry.DiscoveryChainSet) []configentry.
 			&entry.EnterpriseMeta,
 		))
 	}
+	for _, entry := range entrySet.SamenessGroups {
+		out = append(out, configentry.NewKindName(
+			entry.GetKind(),
+			entry.Name,
+			&entry.EnterpriseMeta,
+		))
+	}
 	return out
 }
 
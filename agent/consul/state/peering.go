@@ -19,8 +19,15 @@ import (
 const (
 	tablePeering             = "peering"
 	tablePeeringTrustBundles = "peering-trust-bundles"
+	tablePeeringSecrets      = "peering-secrets"
+	tablePeeringStreamStatus = "peering-stream-status"
 )
 
+// ErrPeeringSecretMismatch is returned when a secrets operation references a
+// secret that is not the one currently stored, e.g. because it was already
+// consumed or revoked.
+var ErrPeeringSecretMismatch = errors.New("peering secret does not match")
+
 func peeringTableSchema() *memdb.TableSchema {
 	return &memdb.TableSchema{
 		Name: tablePeering,
@@ -75,6 +82,68 @@ func peeringTrustBundlesTableSchema() *memdb.TableSchema {
 	}
 }
 
+func peeringSecretsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tablePeeringSecrets,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: indexerSingle[string, *pbpeering.PeeringSecrets]{
+					readIndex:  indexFromUUIDString,
+					writeIndex: indexIDFromPeeringSecret,
+				},
+			},
+		},
+	}
+}
+
+func indexIDFromPeeringSecret(p *pbpeering.PeeringSecrets) ([]byte, error) {
+	if p.PeeringID == "" {
+		return nil, errMissingValueForIndex
+	}
+
+	uuid, err := uuidStringToBytes(p.PeeringID)
+	if err != nil {
+		return nil, err
+	}
+	var b indexBuilder
+	b.Raw(uuid)
+	return b.Bytes(), nil
+}
+
+func peeringStreamStatusTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tablePeeringStreamStatus,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: indexerSingle[string, *pbpeering.PeeringStreamStatus]{
+					readIndex:  indexFromUUIDString,
+					writeIndex: indexIDFromPeeringStreamStatus,
+				},
+			},
+		},
+	}
+}
+
+func indexIDFromPeeringStreamStatus(p *pbpeering.PeeringStreamStatus) ([]byte, error) {
+	if p.PeeringID == "" {
+		return nil, errMissingValueForIndex
+	}
+
+	uuid, err := uuidStringToBytes(p.PeeringID)
+	if err != nil {
+		return nil, err
+	}
+	var b indexBuilder
+	b.Raw(uuid)
+	return b.Bytes(), nil
+}
+
 func indexIDFromPeering(p *pbpeering.Peering) ([]byte, error) {
 	if p.ID == "" {
 		return nil, errMissingValueForIndex
@@ -187,6 +256,35 @@ func (s *Store) PeeringWrite(idx uint64, p *pbpeering.Peering) error {
 	tx := s.db.WriteTxn(idx)
 	defer tx.Abort()
 
+	if err := peeringWriteTxn(tx, idx, p); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// PeeringWriteWithSecrets writes the peering and applies the secrets
+// operation in a single transaction. It is used when generating a token so
+// that a peering is never persisted without its establishment secret.
+func (s *Store) PeeringWriteWithSecrets(idx uint64, p *pbpeering.Peering, req *pbpeering.PeeringSecretsWriteRequest) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	if err := peeringWriteTxn(tx, idx, p); err != nil {
+		return err
+	}
+	if req.PeeringID == "" {
+		req.PeeringID = p.ID
+	}
+	if req.PeeringID != p.ID {
+		return fmt.Errorf("secrets request is for peering %q but the written peering is %q", req.PeeringID, p.ID)
+	}
+	if err := peeringSecretsWriteTxn(tx, idx, req); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func peeringWriteTxn(tx WriteTxn, idx uint64, p *pbpeering.Peering) error {
 	// Check that the ID and Name are set.
 	if p.ID == "" {
 		return errors.New("Missing Peering ID")
@@ -241,14 +339,14 @@ func (s *Store) PeeringWrite(idx uint64, p *pbpeering.Peering) error {
 		p.ModifyIndex = idx
 	}
 
+	// The stream status is kept in its own table and only attached on reads.
+	p.StreamStatus = nil
+
 	if err := tx.Insert(tablePeering, p); err != nil {
 		return fmt.Errorf("failed inserting peering: %w", err)
 	}
 
-	if err := updatePeeringTableIndexes(tx, idx, p.PartitionOrDefault()); err != nil {
-		return err
-	}
-	return tx.Commit()
+	return updatePeeringTableIndexes(tx, idx, p.PartitionOrDefault())
 }
 
 func (s *Store) PeeringDelete(idx uint64, q Query) error {
@@ -272,6 +370,14 @@ func (s *Store) PeeringDelete(idx uint64, q Query) error {
 		return fmt.Errorf("failed deleting peering: %v", err)
 	}
 
+	if err := peeringSecretsDeleteTxn(tx, idx, existing.(*pbpeering.Peering).ID); err != nil {
+		return err
+	}
+
+	if err := peeringStreamStatusDeleteTxn(tx, idx, existing.(*pbpeering.Peering).ID); err != nil {
+		return err
+	}
+
 	if err := updatePeeringTableIndexes(tx, idx, q.PartitionOrDefault()); err != nil {
 		return err
 	}
@@ -389,6 +495,7 @@ func exportedServicesForPeerTxn(
 	var (
 		normalSet = make(map[structs.ServiceName]struct{})
 		discoSet  = make(map[structs.ServiceName]struct{})
+		filters   = make(map[structs.ServiceName]string)
 	)
 
 	// At least one of the following should be true for a name for it to
@@ -398,10 +505,13 @@ func exportedServicesForPeerTxn(
 	// - have an explicit sidecar kind=connect-proxy
 	// - use connect native mode
 
-	for _, svc := range conf.Services {
+	// Entries naming a service are processed before the wildcard entries so
+	// that their filter wins when both match the service.
+	for _, svc := range explicitServicesFirst(conf.Services) {
 		svcMeta := acl.NewEnterpriseMetaWithPartition(entMeta.PartitionOrDefault(), svc.Namespace)
 
 		sawPeer := false
+		var filter string
 		for _, consumer := range svc.Consumers {
 			name := structs.NewServiceName(svc.Name, &svcMeta)
 
@@ -413,9 +523,13 @@ func exportedServicesForPeerTxn(
 				continue
 			}
 			sawPeer = true
+			filter = consumer.Filter
 
 			if svc.Name != structs.WildcardSpecifier {
 				normalSet[name] = struct{}{}
+				if filter != "" {
+					filters[name] = filter
+				}
 			}
 		}
 
@@ -429,7 +543,13 @@ func exportedServicesForPeerTxn(
 				maxIdx = idx
 			}
 			for _, s := range typicalServices {
+				if _, ok := normalSet[s.Service]; ok {
+					continue
+				}
 				normalSet[s.Service] = struct{}{}
+				if filter != "" {
+					filters[s.Service] = filter
+				}
 			}
 
 			// list all config entries of kind service-resolver, service-router, service-splitter?
@@ -507,6 +627,9 @@ func exportedServicesForPeerTxn(
 		Services:    normal,
 		DiscoChains: chainInfo,
 	}
+	if len(filters) > 0 {
+		list.Filters = filters
+	}
 
 	return maxIdx, list, nil
 }
@@ -538,6 +661,23 @@ func listAllExportedServices(
 	return idx, found, nil
 }
 
+// explicitServicesFirst returns the exported services with the entries naming
+// a service ahead of the wildcard entries.
+func explicitServicesFirst(services []structs.ExportedService) []structs.ExportedService {
+	out := make([]structs.ExportedService, 0, len(services))
+	for _, svc := range services {
+		if svc.Name != structs.WildcardSpecifier {
+			out = append(out, svc)
+		}
+	}
+	for _, svc := range services {
+		if svc.Name == structs.WildcardSpecifier {
+			out = append(out, svc)
+		}
+	}
+	return out
+}
+
 func listServicesExportedToAnyPeerByConfigEntry(
 	ws memdb.WatchSet,
 	tx ReadTxn,
@@ -797,6 +937,237 @@ func (s *Store) PeeringTrustBundleDelete(idx uint64, q Query) error {
 	return tx.Commit()
 }
 
+// PeeringSecretsRead returns the secrets stored for the peering with the given
+// ID, or nil if none have been recorded.
+func (s *Store) PeeringSecretsRead(ws memdb.WatchSet, peeringID string) (*pbpeering.PeeringSecrets, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	return peeringSecretsReadTxn(tx, ws, peeringID)
+}
+
+func peeringSecretsReadTxn(tx ReadTxn, ws memdb.WatchSet, peeringID string) (*pbpeering.PeeringSecrets, error) {
+	watchCh, secretRaw, err := tx.FirstWatch(tablePeeringSecrets, indexID, peeringID)
+	if err != nil {
+		return nil, fmt.Errorf("failed peering secret lookup: %w", err)
+	}
+	ws.Add(watchCh)
+
+	secret, ok := secretRaw.(*pbpeering.PeeringSecrets)
+	if secretRaw != nil && !ok {
+		return nil, fmt.Errorf("invalid type %T", secretRaw)
+	}
+	return secret, nil
+}
+
+// PeeringSecretsWrite applies a single operation to the secrets of an
+// existing peering. Operations which consume or promote a secret are checked
+// against the stored values so that concurrent requests cannot both succeed.
+func (s *Store) PeeringSecretsWrite(idx uint64, req *pbpeering.PeeringSecretsWriteRequest) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	peering, err := peeringReadByIDTxn(tx, nil, req.PeeringID)
+	if err != nil {
+		return err
+	}
+	if peering == nil {
+		return fmt.Errorf("unknown peering %q for secret", req.PeeringID)
+	}
+
+	if err := peeringSecretsWriteTxn(tx, idx, req); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func peeringSecretsWriteTxn(tx WriteTxn, idx uint64, req *pbpeering.PeeringSecretsWriteRequest) error {
+	if req.PeeringID == "" {
+		return errors.New("missing peering ID for secret")
+	}
+
+	existing, err := peeringSecretsReadTxn(tx, nil, req.PeeringID)
+	if err != nil {
+		return err
+	}
+
+	secrets := &pbpeering.PeeringSecrets{}
+	if existing != nil {
+		secrets = proto.Clone(existing).(*pbpeering.PeeringSecrets)
+	}
+	secrets.PeeringID = req.PeeringID
+
+	switch r := req.Request.(type) {
+	case *pbpeering.PeeringSecretsWriteRequest_GenerateToken:
+		op := r.GenerateToken
+		if op.GetEstablishmentSecret() == "" {
+			return errors.New("missing establishment secret")
+		}
+		// Generating a new token invalidates any previously issued one.
+		secrets.Establishment = &pbpeering.PeeringSecrets_Establishment{
+			SecretID:  op.EstablishmentSecret,
+			CreatedAt: op.CreatedAt,
+			ExpiresAt: op.ExpiresAt,
+		}
+
+	case *pbpeering.PeeringSecretsWriteRequest_ExchangeSecret:
+		op := r.ExchangeSecret
+		if op.GetEstablishmentSecret() == "" || op.GetPendingStreamSecret() == "" {
+			return errors.New("missing secret to exchange")
+		}
+		if secrets.Establishment == nil || secrets.Establishment.SecretID != op.EstablishmentSecret {
+			return ErrPeeringSecretMismatch
+		}
+		secrets.Establishment = nil
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID:  secrets.GetStream().GetActiveSecretID(),
+			PendingSecretID: op.PendingStreamSecret,
+		}
+
+	case *pbpeering.PeeringSecretsWriteRequest_PromotePending:
+		op := r.PromotePending
+		if op.GetActiveStreamSecret() == "" {
+			return errors.New("missing stream secret to promote")
+		}
+		if secrets.GetStream().GetPendingSecretID() != op.ActiveStreamSecret {
+			return ErrPeeringSecretMismatch
+		}
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID: op.ActiveStreamSecret,
+		}
+
+	case *pbpeering.PeeringSecretsWriteRequest_RotateStreamSecret:
+		op := r.RotateStreamSecret
+		if op.GetPendingStreamSecret() == "" {
+			return errors.New("missing pending stream secret")
+		}
+		if secrets.GetStream().GetActiveSecretID() == "" {
+			return errors.New("cannot rotate a stream secret that was never established")
+		}
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID:  secrets.Stream.ActiveSecretID,
+			PendingSecretID: op.PendingStreamSecret,
+		}
+
+	case *pbpeering.PeeringSecretsWriteRequest_StoreStreamSecret:
+		op := r.StoreStreamSecret
+		if op.GetActiveStreamSecret() == "" {
+			return errors.New("missing stream secret to store")
+		}
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID: op.ActiveStreamSecret,
+		}
+
+	case *pbpeering.PeeringSecretsWriteRequest_RevokeToken:
+		if secrets.Establishment == nil {
+			return ErrPeeringSecretMismatch
+		}
+		secrets.Establishment = nil
+
+	default:
+		return fmt.Errorf("unexpected request type %T", req.Request)
+	}
+
+	if err := tx.Insert(tablePeeringSecrets, secrets); err != nil {
+		return fmt.Errorf("failed inserting peering secret: %w", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{Key: tablePeeringSecrets, Value: idx}); err != nil {
+		return fmt.Errorf("failed updating table index: %w", err)
+	}
+	return nil
+}
+
+func peeringSecretsDeleteTxn(tx WriteTxn, idx uint64, peeringID string) error {
+	existing, err := tx.First(tablePeeringSecrets, indexID, peeringID)
+	if err != nil {
+		return fmt.Errorf("failed peering secret lookup: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if err := tx.Delete(tablePeeringSecrets, existing); err != nil {
+		return fmt.Errorf("failed deleting peering secret: %w", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{Key: tablePeeringSecrets, Value: idx}); err != nil {
+		return fmt.Errorf("failed updating table index: %w", err)
+	}
+	return nil
+}
+
+// PeeringStreamStatusRead returns the last replication stream status recorded
+// by a leader for the peering with the given ID.
+func (s *Store) PeeringStreamStatusRead(ws memdb.WatchSet, peeringID string) (uint64, *pbpeering.StreamStatus, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	idx := maxIndexWatchTxn(tx, ws, tablePeeringStreamStatus)
+
+	watchCh, statusRaw, err := tx.FirstWatch(tablePeeringStreamStatus, indexID, peeringID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed peering stream status lookup: %w", err)
+	}
+	ws.Add(watchCh)
+
+	if statusRaw == nil {
+		return idx, nil, nil
+	}
+	return idx, statusRaw.(*pbpeering.PeeringStreamStatus).Status, nil
+}
+
+// PeeringStreamStatusWrite stores the replication stream statuses reported by
+// the leader. Statuses for peerings that no longer exist are ignored.
+func (s *Store) PeeringStreamStatusWrite(idx uint64, req *pbpeering.PeeringStreamStatusWriteRequest) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	var changed bool
+	for _, status := range req.Statuses {
+		if status.PeeringID == "" {
+			return errors.New("missing peering ID")
+		}
+
+		existing, err := peeringReadByIDTxn(tx, nil, status.PeeringID)
+		if err != nil {
+			return fmt.Errorf("failed peering lookup: %w", err)
+		}
+		if existing == nil {
+			continue
+		}
+
+		if err := tx.Insert(tablePeeringStreamStatus, status); err != nil {
+			return fmt.Errorf("failed inserting peering stream status: %w", err)
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{Key: tablePeeringStreamStatus, Value: idx}); err != nil {
+		return fmt.Errorf("failed updating table index: %w", err)
+	}
+	return tx.Commit()
+}
+
+func peeringStreamStatusDeleteTxn(tx WriteTxn, idx uint64, peeringID string) error {
+	existing, err := tx.First(tablePeeringStreamStatus, indexID, peeringID)
+	if err != nil {
+		return fmt.Errorf("failed peering stream status lookup: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if err := tx.Delete(tablePeeringStreamStatus, existing); err != nil {
+		return fmt.Errorf("failed deleting peering stream status: %w", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{Key: tablePeeringStreamStatus, Value: idx}); err != nil {
+		return fmt.Errorf("failed updating table index: %w", err)
+	}
+	return nil
+}
+
 func (s *Snapshot) Peerings() (memdb.ResultIterator, error) {
 	return s.tx.Get(tablePeering, indexName)
 }
@@ -805,6 +1176,14 @@ func (s *Snapshot) PeeringTrustBundles() (memdb.ResultIterator, error) {
 	return s.tx.Get(tablePeeringTrustBundles, indexID)
 }
 
+func (s *Snapshot) PeeringSecrets() (memdb.ResultIterator, error) {
+	return s.tx.Get(tablePeeringSecrets, indexID)
+}
+
+func (s *Snapshot) PeeringStreamStatuses() (memdb.ResultIterator, error) {
+	return s.tx.Get(tablePeeringStreamStatus, indexID)
+}
+
 func (r *Restore) Peering(p *pbpeering.Peering) error {
 	if err := r.tx.Insert(tablePeering, p); err != nil {
 		return fmt.Errorf("failed restoring peering: %w", err)
@@ -827,6 +1206,20 @@ func (r *Restore) PeeringTrustBundle(ptb *pbpeering.PeeringTrustBundle) error {
 	return nil
 }
 
+func (r *Restore) PeeringSecrets(p *pbpeering.PeeringSecrets) error {
+	if err := r.tx.Insert(tablePeeringSecrets, p); err != nil {
+		return fmt.Errorf("failed restoring peering secrets: %w", err)
+	}
+	return nil
+}
+
+func (r *Restore) PeeringStreamStatus(p *pbpeering.PeeringStreamStatus) error {
+	if err := r.tx.Insert(tablePeeringStreamStatus, p); err != nil {
+		return fmt.Errorf("failed restoring peering stream status: %w", err)
+	}
+	return nil
+}
+
 // peersForServiceTxn returns the names of all peers that a service is exported to.
 func peersForServiceTxn(
 	tx ReadTxn,
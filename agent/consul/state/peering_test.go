@@ -525,6 +525,242 @@ func TestStore_PeeringTerminateByID(t *testing.T) {
 	require.Equal(t, pbpeering.PeeringState_TERMINATED, p.State)
 }
 
+func TestStore_PeeringSecretsWrite(t *testing.T) {
+	s := NewStateStore(nil)
+	insertTestPeerings(t, s)
+
+	const (
+		establishment = "0c1dbf8c-7a4f-4a5d-9c3e-d5c1d1a4ce6e"
+		pending       = "b1a1d7e4-2ad9-4c2a-9a43-49ee2c3bb4c2"
+		rotated       = "8f7a3b8d-34c1-4ae1-9b87-c7f4de60ff3a"
+	)
+
+	write := func(idx uint64, req *pbpeering.PeeringSecretsWriteRequest) error {
+		req.PeeringID = testFooPeerID
+		return s.PeeringSecretsWrite(idx, req)
+	}
+	exchange := func(idx uint64) error {
+		return write(idx, &pbpeering.PeeringSecretsWriteRequest{
+			Request: &pbpeering.PeeringSecretsWriteRequest_ExchangeSecret{
+				ExchangeSecret: &pbpeering.PeeringSecretsWriteRequest_ExchangeSecretRequest{
+					EstablishmentSecret: establishment,
+					PendingStreamSecret: pending,
+				},
+			},
+		})
+	}
+	read := func(t *testing.T) *pbpeering.PeeringSecrets {
+		secrets, err := s.PeeringSecretsRead(nil, testFooPeerID)
+		require.NoError(t, err)
+		require.NotNil(t, secrets)
+		return secrets
+	}
+
+	testutil.RunStep(t, "unknown peering", func(t *testing.T) {
+		err := s.PeeringSecretsWrite(10, &pbpeering.PeeringSecretsWriteRequest{
+			PeeringID: testBazPeerID,
+			Request: &pbpeering.PeeringSecretsWriteRequest_RevokeToken{
+				RevokeToken: &pbpeering.PeeringSecretsWriteRequest_RevokeTokenRequest{},
+			},
+		})
+		testutil.RequireErrorContains(t, err, "unknown peering")
+	})
+
+	testutil.RunStep(t, "exchange without a token", func(t *testing.T) {
+		require.ErrorIs(t, exchange(11), ErrPeeringSecretMismatch)
+	})
+
+	testutil.RunStep(t, "generate token", func(t *testing.T) {
+		require.NoError(t, write(12, &pbpeering.PeeringSecretsWriteRequest{
+			Request: &pbpeering.PeeringSecretsWriteRequest_GenerateToken{
+				GenerateToken: &pbpeering.PeeringSecretsWriteRequest_GenerateTokenRequest{
+					EstablishmentSecret: establishment,
+				},
+			},
+		}))
+		require.Equal(t, establishment, read(t).GetEstablishment().GetSecretID())
+	})
+
+	testutil.RunStep(t, "exchange consumes the establishment secret", func(t *testing.T) {
+		require.NoError(t, exchange(13))
+
+		secrets := read(t)
+		require.Nil(t, secrets.Establishment)
+		require.Equal(t, pending, secrets.GetStream().GetPendingSecretID())
+		require.Empty(t, secrets.GetStream().GetActiveSecretID())
+
+		// The same token can only be used once.
+		require.ErrorIs(t, exchange(14), ErrPeeringSecretMismatch)
+	})
+
+	testutil.RunStep(t, "promote pending", func(t *testing.T) {
+		err := write(15, &pbpeering.PeeringSecretsWriteRequest{
+			Request: &pbpeering.PeeringSecretsWriteRequest_PromotePending{
+				PromotePending: &pbpeering.PeeringSecretsWriteRequest_PromotePendingRequest{
+					ActiveStreamSecret: rotated,
+				},
+			},
+		})
+		require.ErrorIs(t, err, ErrPeeringSecretMismatch)
+
+		require.NoError(t, write(16, &pbpeering.PeeringSecretsWriteRequest{
+			Request: &pbpeering.PeeringSecretsWriteRequest_PromotePending{
+				PromotePending: &pbpeering.PeeringSecretsWriteRequest_PromotePendingRequest{
+					ActiveStreamSecret: pending,
+				},
+			},
+		}))
+		secrets := read(t)
+		require.Equal(t, pending, secrets.GetStream().GetActiveSecretID())
+		require.Empty(t, secrets.GetStream().GetPendingSecretID())
+	})
+
+	testutil.RunStep(t, "rotate stream secret", func(t *testing.T) {
+		require.NoError(t, write(17, &pbpeering.PeeringSecretsWriteRequest{
+			Request: &pbpeering.PeeringSecretsWriteRequest_RotateStreamSecret{
+				RotateStreamSecret: &pbpeering.PeeringSecretsWriteRequest_RotateStreamSecretRequest{
+					PendingStreamSecret: rotated,
+				},
+			},
+		}))
+		secrets := read(t)
+		require.Equal(t, pending, secrets.GetStream().GetActiveSecretID())
+		require.Equal(t, rotated, secrets.GetStream().GetPendingSecretID())
+	})
+
+	testutil.RunStep(t, "revoke token", func(t *testing.T) {
+		revoke := &pbpeering.PeeringSecretsWriteRequest{
+			Request: &pbpeering.PeeringSecretsWriteRequest_RevokeToken{
+				RevokeToken: &pbpeering.PeeringSecretsWriteRequest_RevokeTokenRequest{},
+			},
+		}
+		require.ErrorIs(t, write(18, revoke), ErrPeeringSecretMismatch)
+
+		require.NoError(t, write(19, &pbpeering.PeeringSecretsWriteRequest{
+			Request: &pbpeering.PeeringSecretsWriteRequest_GenerateToken{
+				GenerateToken: &pbpeering.PeeringSecretsWriteRequest_GenerateTokenRequest{
+					EstablishmentSecret: establishment,
+				},
+			},
+		}))
+		require.NoError(t, write(20, revoke))
+
+		secrets := read(t)
+		require.Nil(t, secrets.Establishment)
+		// Revoking a token does not affect an established stream.
+		require.Equal(t, pending, secrets.GetStream().GetActiveSecretID())
+	})
+
+	testutil.RunStep(t, "secrets are deleted with the peering", func(t *testing.T) {
+		require.NoError(t, s.PeeringWrite(21, &pbpeering.Peering{
+			ID:        testFooPeerID,
+			Name:      "foo",
+			DeletedAt: structs.TimeToProto(time.Now()),
+		}))
+		require.NoError(t, s.PeeringDelete(22, Query{Value: "foo"}))
+
+		secrets, err := s.PeeringSecretsRead(nil, testFooPeerID)
+		require.NoError(t, err)
+		require.Nil(t, secrets)
+	})
+}
+
+func TestStore_PeeringStreamStatusWrite(t *testing.T) {
+	s := NewStateStore(nil)
+	insertTestPeerings(t, s)
+
+	testutil.RunStep(t, "unknown peerings are ignored", func(t *testing.T) {
+		require.NoError(t, s.PeeringStreamStatusWrite(10, &pbpeering.PeeringStreamStatusWriteRequest{
+			Statuses: []*pbpeering.PeeringStreamStatus{
+				{
+					PeeringID: "1c7e1b0e-55e4-4e8c-bb7d-5d0e2cb4c2b5",
+					Status:    &pbpeering.StreamStatus{LastErrorMessage: "boom"},
+				},
+			},
+		}))
+
+		idx, status, err := s.PeeringStreamStatusRead(nil, "1c7e1b0e-55e4-4e8c-bb7d-5d0e2cb4c2b5")
+		require.NoError(t, err)
+		require.Nil(t, status)
+		require.Zero(t, idx)
+	})
+
+	testutil.RunStep(t, "write and read", func(t *testing.T) {
+		require.NoError(t, s.PeeringStreamStatusWrite(11, &pbpeering.PeeringStreamStatusWriteRequest{
+			Statuses: []*pbpeering.PeeringStreamStatus{
+				{
+					PeeringID: testFooPeerID,
+					Status: &pbpeering.StreamStatus{
+						ImportedServices:     []string{"api"},
+						SentResourceVersions: map[string]uint64{"roots": 2},
+					},
+				},
+			},
+		}))
+
+		idx, status, err := s.PeeringStreamStatusRead(nil, testFooPeerID)
+		require.NoError(t, err)
+		require.Equal(t, uint64(11), idx)
+		require.Equal(t, []string{"api"}, status.ImportedServices)
+		require.Equal(t, map[string]uint64{"roots": 2}, status.SentResourceVersions)
+	})
+
+	testutil.RunStep(t, "deleted with the peering", func(t *testing.T) {
+		require.NoError(t, s.PeeringWrite(12, &pbpeering.Peering{
+			ID:        testFooPeerID,
+			Name:      "foo",
+			DeletedAt: structs.TimeToProto(time.Now()),
+		}))
+		require.NoError(t, s.PeeringDelete(13, Query{Value: "foo"}))
+
+		idx, status, err := s.PeeringStreamStatusRead(nil, testFooPeerID)
+		require.NoError(t, err)
+		require.Nil(t, status)
+		require.Equal(t, uint64(13), idx)
+	})
+}
+
+func TestStore_PeeringWriteWithSecrets(t *testing.T) {
+	s := NewStateStore(nil)
+
+	p := &pbpeering.Peering{
+		ID:   testFooPeerID,
+		Name: "foo",
+	}
+	req := &pbpeering.PeeringSecretsWriteRequest{
+		Request: &pbpeering.PeeringSecretsWriteRequest_GenerateToken{
+			GenerateToken: &pbpeering.PeeringSecretsWriteRequest_GenerateTokenRequest{
+				EstablishmentSecret: "0c1dbf8c-7a4f-4a5d-9c3e-d5c1d1a4ce6e",
+			},
+		},
+	}
+	require.NoError(t, s.PeeringWriteWithSecrets(10, p, req))
+
+	_, got, err := s.PeeringReadByID(nil, testFooPeerID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	secrets, err := s.PeeringSecretsRead(nil, testFooPeerID)
+	require.NoError(t, err)
+	require.Equal(t, testFooPeerID, secrets.PeeringID)
+	require.Equal(t, "0c1dbf8c-7a4f-4a5d-9c3e-d5c1d1a4ce6e", secrets.GetEstablishment().GetSecretID())
+
+	// An invalid secrets request must not persist the peering either.
+	err = s.PeeringWriteWithSecrets(11, &pbpeering.Peering{
+		ID:   testBarPeerID,
+		Name: "bar",
+	}, &pbpeering.PeeringSecretsWriteRequest{
+		Request: &pbpeering.PeeringSecretsWriteRequest_GenerateToken{
+			GenerateToken: &pbpeering.PeeringSecretsWriteRequest_GenerateTokenRequest{},
+		},
+	})
+	testutil.RequireErrorContains(t, err, "missing establishment secret")
+
+	_, got, err = s.PeeringReadByID(nil, testBarPeerID)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
 func TestStateStore_PeeringTrustBundleList(t *testing.T) {
 	s := NewStateStore(nil)
 	insertTestPeeringTrustBundles(t, s)
@@ -957,6 +1193,86 @@ func TestStateStore_ExportedServicesForPeer(t *testing.T) {
 		require.Equal(t, expect, got)
 	})
 
+	testutil.RunStep(t, "config entry with consumer filter", func(t *testing.T) {
+		entry := &structs.ExportedServicesConfigEntry{
+			Name: "default",
+			Services: []structs.ExportedService{
+				{
+					Name: "payments",
+					Consumers: []structs.ServiceConsumer{
+						{PeerName: "my-peering", Filter: `Service.Meta.env == "prod"`},
+					},
+				},
+			},
+		}
+		ensureConfigEntry(t, entry)
+
+		require.True(t, watchFired(ws))
+		ws = memdb.NewWatchSet()
+
+		expect := &structs.ExportedServiceList{
+			Services: []structs.ServiceName{
+				{
+					Name:           "payments",
+					EnterpriseMeta: *defaultEntMeta,
+				},
+			},
+			DiscoChains: map[structs.ServiceName]structs.ExportedDiscoveryChainInfo{
+				newSN("payments"): {
+					Protocol: "http",
+				},
+			},
+			Filters: map[structs.ServiceName]string{
+				newSN("payments"): `Service.Meta.env == "prod"`,
+			},
+		}
+		idx, got, err := s.ExportedServicesForPeer(ws, id, "dc1")
+		require.NoError(t, err)
+		require.Equal(t, lastIdx, idx)
+		require.Equal(t, expect, got)
+	})
+
+	testutil.RunStep(t, "explicit consumer filter wins over wildcard", func(t *testing.T) {
+		lastIdx++
+		require.NoError(t, s.EnsureService(lastIdx, "foo", &structs.NodeService{
+			ID: "billing", Service: "billing", Port: 5000,
+		}))
+
+		wildcard := structs.ExportedService{
+			Name: structs.WildcardSpecifier,
+			Consumers: []structs.ServiceConsumer{
+				{PeerName: "my-peering", Filter: `Service.Meta.env == "dev"`},
+			},
+		}
+		payments := structs.ExportedService{
+			Name: "payments",
+			Consumers: []structs.ServiceConsumer{
+				{PeerName: "my-peering", Filter: `Service.Meta.env == "prod"`},
+			},
+		}
+
+		for _, services := range [][]structs.ExportedService{
+			{wildcard, payments},
+			{payments, wildcard},
+		} {
+			ensureConfigEntry(t, &structs.ExportedServicesConfigEntry{
+				Name:     "default",
+				Services: services,
+			})
+
+			_, got, err := s.ExportedServicesForPeer(nil, id, "dc1")
+			require.NoError(t, err)
+			require.Len(t, got.Services, 2)
+			for _, sn := range got.Services {
+				if sn == newSN("payments") {
+					require.Equal(t, `Service.Meta.env == "prod"`, got.Filter(sn))
+				} else {
+					require.Equal(t, `Service.Meta.env == "dev"`, got.Filter(sn), sn.String())
+				}
+			}
+		}
+	})
+
 	testutil.RunStep(t, "deleting the config entry clears exported services", func(t *testing.T) {
 		expect := &structs.ExportedServiceList{}
 
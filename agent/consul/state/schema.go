@@ -31,6 +31,8 @@ func newDBSchema() *memdb.DBSchema {
 		meshTopologyTableSchema,
 		nodesTableSchema,
 		peeringTableSchema,
+		peeringSecretsTableSchema,
+		peeringStreamStatusTableSchema,
 		peeringTrustBundlesTableSchema,
 		policiesTableSchema,
 		preparedQueriesTableSchema,
@@ -194,6 +194,30 @@ func TestUsageReporter_emitNodeUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 			getMembersFunc: func() []serf.Member { return []serf.Member{} },
 		},
@@ -385,6 +409,30 @@ func TestUsageReporter_emitNodeUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 		},
 	}
@@ -596,6 +644,30 @@ func TestUsageReporter_emitPeeringUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 			getMembersFunc: func() []serf.Member { return []serf.Member{} },
 		},
@@ -788,6 +860,30 @@ func TestUsageReporter_emitPeeringUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 		},
 	}
@@ -1007,6 +1103,30 @@ func TestUsageReporter_emitServiceUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 			getMembersFunc: func() []serf.Member { return []serf.Member{} },
 		},
@@ -1240,6 +1360,30 @@ func TestUsageReporter_emitServiceUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 		},
 	}
@@ -1450,6 +1594,30 @@ func TestUsageReporter_emitKVUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 			getMembersFunc: func() []serf.Member { return []serf.Member{} },
 		},
@@ -1650,6 +1818,30 @@ func TestUsageReporter_emitKVUsage_OSS(t *testing.T) {
 						{Name: "kind", Value: "exported-services"},
 					},
 				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=service-rollout": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "service-rollout"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=imported-services": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "imported-services"},
+					},
+				},
+				"consul.usage.test.consul.state.config_entries;datacenter=dc1;kind=sameness-group": {
+					Name:  "consul.usage.test.consul.state.config_entries",
+					Value: 0,
+					Labels: []metrics.Label{
+						{Name: "datacenter", Value: "dc1"},
+						{Name: "kind", Value: "sameness-group"},
+					},
+				},
 			},
 		},
 	}
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"io"
+	"net"
 
 	"github.com/hashicorp/serf/serf"
 	"github.com/stretchr/testify/mock"
@@ -53,6 +54,10 @@ func (m *delegateMock) ResolveTokenAndDefaultMeta(token string, entMeta *acl.Ent
 	return ret.Get(0).(resolver.Result), ret.Error(1)
 }
 
+func (m *delegateMock) CheckTokenSource(token string, source net.IP) error {
+	return m.Called(token, source).Error(0)
+}
+
 func (m *delegateMock) RPC(method string, args interface{}, reply interface{}) error {
 	return m.Called(method, args, reply).Error(0)
 }
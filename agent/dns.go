@@ -394,6 +394,15 @@ func (d *DNSServer) handlePtr(resp dns.ResponseWriter, req *dns.Msg) {
 	m.Authoritative = true
 	m.RecursionAvailable = (len(cfg.Recursors) > 0)
 
+	if err := d.checkTokenSource(resp.RemoteAddr()); err != nil {
+		d.logger.Warn("refusing query", "client", resp.RemoteAddr().String(), "error", err)
+		m.SetRcode(req, rCodeFromError(err))
+		if err := resp.WriteMsg(m); err != nil {
+			d.logger.Warn("failed to respond", "error", err)
+		}
+		return
+	}
+
 	// Only add the SOA if requested
 	if req.Question[0].Qtype == dns.TypeSOA {
 		d.addSOA(cfg, m, q.Name)
@@ -704,6 +713,10 @@ func (l queryLocality) effectiveDatacenter(defaultDC string) string {
 // dispatch is used to parse a request and invoke the correct handler.
 // parameter maxRecursionLevel will handle whether recursive call can be performed
 func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, maxRecursionLevel int) error {
+	if err := d.checkTokenSource(remoteAddr); err != nil {
+		return err
+	}
+
 	// Choose correct response domain
 	respDomain := d.getResponseDomain(req.Question[0].Name)
 
@@ -1004,11 +1017,26 @@ func rCodeFromError(err error) int {
 		return dns.RcodeNameError
 	case structs.IsErrNoDCPath(err) || structs.IsErrQueryNotFound(err):
 		return dns.RcodeNameError
+	case acl.IsErrPermissionDenied(err):
+		return dns.RcodeRefused
 	default:
 		return dns.RcodeServerFailure
 	}
 }
 
+// checkTokenSource returns an error if the agent's token is bound to CIDR
+// blocks that do not contain the address of the client making the query.
+func (d *DNSServer) checkTokenSource(remoteAddr net.Addr) error {
+	if remoteAddr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(remoteAddr.String())
+	if err != nil {
+		return nil
+	}
+	return d.agent.delegate.CheckTokenSource(d.agent.tokens.UserToken(), net.ParseIP(host))
+}
+
 // nodeLookup is used to handle a node query
 func (d *DNSServer) nodeLookup(cfg *dnsConfig, lookup nodeLookup, req, resp *dns.Msg) error {
 	// Only handle ANY, A, AAAA, and TXT type requests
@@ -0,0 +1,95 @@
+package peerstream
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/consul/state"
+	external "github.com/hashicorp/consul/agent/grpc-external"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbpeering"
+	"github.com/hashicorp/consul/proto/pbpeerstream"
+)
+
+// ExchangeSecret consumes the establishment secret of a peering token and
+// returns a pending stream secret that the dialing peer must present when
+// opening a replication stream.
+func (s *Server) ExchangeSecret(ctx context.Context, req *pbpeerstream.ExchangeSecretRequest) (*pbpeerstream.ExchangeSecretResponse, error) {
+	logger := s.Logger.Named("exchange-secret").With("request_id", external.TraceID())
+
+	if !s.Backend.IsLeader() {
+		// Secrets are only exchanged with the leader so that the dialing peer
+		// can retry against the right server.
+		st, err := grpcstatus.New(codes.FailedPrecondition,
+			"cannot exchange peering secrets on a follower node").WithDetails(
+			&pbpeerstream.LeaderAddress{Address: s.Backend.GetLeaderAddress()})
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to marshal the leader address in response; err: %v", err))
+			return nil, grpcstatus.Error(codes.FailedPrecondition, "cannot exchange peering secrets on a follower node")
+		}
+		return nil, st.Err()
+	}
+
+	if req.PeerID == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "missing PeerID value")
+	}
+	if req.EstablishmentSecret == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "missing establishment secret value")
+	}
+
+	_, p, err := s.GetStore().PeeringReadByID(nil, req.PeerID)
+	if err != nil {
+		logger.Error("failed to look up peer", "peer_id", req.PeerID, "error", err)
+		return nil, grpcstatus.Error(codes.Internal, "failed to find PeerID: "+req.PeerID)
+	}
+	if p == nil || !p.IsActive() {
+		return nil, grpcstatus.Error(codes.NotFound, "peering not found")
+	}
+	if p.PeerID != "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "expected PeerID to be empty; the wrong end of peering is being dialed")
+	}
+
+	secrets, err := s.GetStore().PeeringSecretsRead(nil, p.ID)
+	if err != nil {
+		logger.Error("failed to read peering secrets", "peer_id", p.ID, "error", err)
+		return nil, grpcstatus.Error(codes.Internal, "failed to read peering secrets")
+	}
+	establishment := secrets.GetEstablishment()
+	if establishment == nil || subtle.ConstantTimeCompare([]byte(establishment.SecretID), []byte(req.EstablishmentSecret)) != 1 {
+		return nil, grpcstatus.Error(codes.PermissionDenied, "invalid peering establishment secret")
+	}
+	if establishment.ExpiresAt != nil && time.Now().After(structs.TimeFromProto(establishment.ExpiresAt)) {
+		return nil, grpcstatus.Error(codes.PermissionDenied, "peering token has expired")
+	}
+
+	streamSecret, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Internal, "failed to generate stream secret")
+	}
+	err = s.Backend.PeeringSecretsWrite(&pbpeering.PeeringSecretsWriteRequest{
+		PeeringID: p.ID,
+		Request: &pbpeering.PeeringSecretsWriteRequest_ExchangeSecret{
+			ExchangeSecret: &pbpeering.PeeringSecretsWriteRequest_ExchangeSecretRequest{
+				EstablishmentSecret: req.EstablishmentSecret,
+				PendingStreamSecret: streamSecret,
+			},
+		},
+	})
+	switch {
+	case errors.Is(err, state.ErrPeeringSecretMismatch):
+		// The secret was consumed concurrently.
+		return nil, grpcstatus.Error(codes.PermissionDenied, "invalid peering establishment secret")
+	case err != nil:
+		logger.Error("failed to exchange peering secret", "peer_id", p.ID, "error", err)
+		return nil, grpcstatus.Error(codes.Internal, "failed to exchange peering secret")
+	}
+
+	return &pbpeerstream.ExchangeSecretResponse{StreamSecret: streamSecret}, nil
+}
@@ -1,6 +1,9 @@
 package peerstream
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/types"
 )
@@ -25,19 +28,65 @@ type serviceSnapshot struct {
 	Checks  map[types.CheckID]*structs.HealthCheck
 }
 
-func newHealthSnapshot(all []structs.CheckServiceNode, partition, peerName string) *healthSnapshot {
+// importedServiceNames maps the names of services imported from a peer to the
+// local names they are registered under, as configured in the
+// imported-services config entry. Services without an entry keep their name.
+type importedServiceNames map[string]string
+
+// localName returns the name that the imported service name is registered
+// under. The synthetic proxies of an aliased service are renamed along with
+// it.
+func (n importedServiceNames) localName(name string) (string, error) {
+	if len(n) == 0 {
+		return name, nil
+	}
+
+	base, suffix := name, ""
+	if trimmed := strings.TrimSuffix(name, syntheticProxyNameSuffix); trimmed != name {
+		base, suffix = trimmed, syntheticProxyNameSuffix
+	}
+
+	if local, ok := n[base]; ok {
+		return local + suffix, nil
+	}
+	for remote, local := range n {
+		if local == base {
+			return "", fmt.Errorf("imported service %q conflicts with the local name of imported service %q", base, remote)
+		}
+	}
+	return name, nil
+}
+
+func newHealthSnapshot(all []structs.CheckServiceNode, partition, peerName string, names importedServiceNames) (*healthSnapshot, error) {
 	// For all nodes, services, and checks we override the peer name and
 	// partition to be the local partition and local name for the peer.
+	// Services are also renamed to their local names.
 	for _, instance := range all {
 		instance.Node.PeerName = peerName
 		instance.Node.OverridePartition(partition)
 
+		localName, err := names.localName(instance.Service.Service)
+		if err != nil {
+			return nil, err
+		}
+		instance.Service.Service = localName
 		instance.Service.PeerName = peerName
 		instance.Service.OverridePartition(partition)
 
+		if instance.Service.Kind == structs.ServiceKindConnectProxy && instance.Service.Proxy.DestinationServiceName != "" {
+			dest, err := names.localName(instance.Service.Proxy.DestinationServiceName)
+			if err != nil {
+				return nil, err
+			}
+			instance.Service.Proxy.DestinationServiceName = dest
+		}
+
 		for _, chk := range instance.Checks {
 			chk.PeerName = peerName
 			chk.OverridePartition(partition)
+			if chk.ServiceName != "" {
+				chk.ServiceName = localName
+			}
 		}
 	}
 
@@ -80,5 +129,5 @@ func newHealthSnapshot(all []structs.CheckServiceNode, partition, peerName strin
 		}
 	}
 
-	return snap
+	return snap, nil
 }
@@ -20,7 +20,8 @@ func TestHealthSnapshot(t *testing.T) {
 	entMeta := acl.DefaultEnterpriseMeta()
 
 	run := func(t *testing.T, tc testcase) {
-		snap := newHealthSnapshot(tc.in, entMeta.PartitionOrEmpty(), "my-peer")
+		snap, err := newHealthSnapshot(tc.in, entMeta.PartitionOrEmpty(), "my-peer", nil)
+		require.NoError(t, err)
 		require.Equal(t, tc.expect, snap)
 	}
 
@@ -150,3 +151,50 @@ func TestHealthSnapshot(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthSnapshot_LocalNames(t *testing.T) {
+	names := importedServiceNames{"xyz": "local-xyz"}
+
+	in := []structs.CheckServiceNode{
+		{
+			Node: &structs.Node{Node: "abc"},
+			Service: &structs.NodeService{
+				ID:      "xyz-123",
+				Service: "xyz",
+			},
+			Checks: structs.HealthChecks{
+				{Node: "abc", CheckID: "xyz-123:check", ServiceID: "xyz-123", ServiceName: "xyz"},
+			},
+		},
+		{
+			Node: &structs.Node{Node: "gw"},
+			Service: &structs.NodeService{
+				Kind:    structs.ServiceKindConnectProxy,
+				ID:      "xyz-sidecar-proxy",
+				Service: "xyz-sidecar-proxy",
+				Proxy: structs.ConnectProxyConfig{
+					DestinationServiceName: "xyz",
+					DestinationServiceID:   "xyz",
+				},
+			},
+		},
+	}
+
+	snap, err := newHealthSnapshot(in, "", "my-peer", names)
+	require.NoError(t, err)
+
+	svc := snap.Nodes["abc"].Services[structs.NewServiceID("xyz-123", nil)]
+	require.Equal(t, "local-xyz", svc.Service.Service)
+	require.Equal(t, "local-xyz", svc.Checks["xyz-123:check"].ServiceName)
+
+	proxy := snap.Nodes["gw"].Services[structs.NewServiceID("xyz-sidecar-proxy", nil)]
+	require.Equal(t, "local-xyz-sidecar-proxy", proxy.Service.Service)
+	require.Equal(t, "local-xyz", proxy.Service.Proxy.DestinationServiceName)
+
+	// A service that isn't aliased can't take the local name of another.
+	_, err = newHealthSnapshot([]structs.CheckServiceNode{{
+		Node:    &structs.Node{Node: "abc"},
+		Service: &structs.NodeService{ID: "local-xyz", Service: "local-xyz"},
+	}}, "", "my-peer", names)
+	require.EqualError(t, err, `imported service "local-xyz" conflicts with the local name of imported service "xyz"`)
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/hashicorp/consul/agent/cache"
+	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/proto/pbpeering"
 	"github.com/hashicorp/consul/proto/pbpeerstream"
@@ -105,6 +106,22 @@ func makeCARootsResponse(
 	}, nil
 }
 
+func makeStreamSecretResponse(secretID string) (*pbpeerstream.ReplicationMessage_Response, error) {
+	any, err := anypb.New(&pbpeerstream.PeeringStreamSecret{SecretID: secretID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	return &pbpeerstream.ReplicationMessage_Response{
+		ResourceURL: pbpeerstream.TypeURLPeeringStreamSecret,
+		// TODO(peering): Nonce management
+		Nonce:      "",
+		ResourceID: "stream-secret",
+		Operation:  pbpeerstream.Operation_OPERATION_UPSERT,
+		Resource:   any,
+	}, nil
+}
+
 // marshalToProtoAny takes any input and returns:
 // the protobuf.Any type, the asserted T type, and any errors
 // during marshalling or type assertion.
@@ -212,12 +229,31 @@ func (s *Server) handleUpsert(
 			return fmt.Errorf("failed to unmarshal resource: %w", err)
 		}
 
-		err := s.handleUpdateService(peerName, partition, sn, export)
+		names, err := s.importedServiceNames(peerName, partition, sn)
+		if err != nil {
+			return err
+		}
+		localName, err := names.localName(sn.Name)
+		if err != nil {
+			return err
+		}
+		localSN := structs.NewServiceName(localName, &sn.EnterpriseMeta)
+
+		// Clean up the instances registered under the previous local name of
+		// the service if its alias changed.
+		if prev, changed := mutableStatus.SetImportedServiceLocalName(sn, localSN); changed {
+			if err := s.handleUpdateService(peerName, partition, prev, nil, nil); err != nil {
+				return fmt.Errorf("failed to remove service=%q after its local name changed: %w", prev.String(), err)
+			}
+			mutableStatus.RemoveImportedService(prev)
+		}
+
+		err = s.handleUpdateService(peerName, partition, localSN, export, names)
 		if err != nil {
-			return fmt.Errorf("did not increment imported services count for service=%q: %w", sn.String(), err)
+			return fmt.Errorf("did not increment imported services count for service=%q: %w", localSN.String(), err)
 		}
 
-		mutableStatus.TrackImportedService(sn)
+		mutableStatus.TrackImportedService(localSN)
 
 		return nil
 
@@ -229,11 +265,35 @@ func (s *Server) handleUpsert(
 
 		return s.handleUpsertRoots(peerName, partition, roots)
 
+	case pbpeerstream.TypeURLPeeringStreamSecret:
+		secret := &pbpeerstream.PeeringStreamSecret{}
+		if err := resource.UnmarshalTo(secret); err != nil {
+			return fmt.Errorf("failed to unmarshal resource: %w", err)
+		}
+
+		return s.handleUpsertStreamSecret(peerName, partition, secret)
+
 	default:
 		return fmt.Errorf("unexpected resourceURL: %s", resourceURL)
 	}
 }
 
+// importedServiceNames returns the local names of the services imported from
+// the peer in the namespace of sn, as configured in the imported-services
+// config entry of the partition.
+func (s *Server) importedServiceNames(peerName, partition string, sn structs.ServiceName) (importedServiceNames, error) {
+	entMeta := structs.NodeEnterpriseMetaInPartition(partition)
+	_, entry, err := s.GetStore().ConfigEntry(nil, structs.ImportedServices, entMeta.PartitionOrDefault(), entMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read imported-services config entry: %w", err)
+	}
+	imports, ok := entry.(*structs.ImportedServicesConfigEntry)
+	if !ok {
+		return nil, nil
+	}
+	return imports.LocalNames(peerName, &sn.EnterpriseMeta), nil
+}
+
 // handleUpdateService handles both deletion and upsert events for a service.
 // 	On an UPSERT event:
 // 		- All nodes, services, checks in the input pbNodes are re-applied through Raft.
@@ -242,11 +302,15 @@ func (s *Server) handleUpsert(
 //	On a DELETE event:
 //		- A reconciliation against nil or empty input pbNodes leads to deleting all stored catalog resources
 //		  associated with the service name.
+//
+// The service name sn is the local name of the service, names maps the names
+// of the exported instances to their local names.
 func (s *Server) handleUpdateService(
 	peerName string,
 	partition string,
 	sn structs.ServiceName,
 	export *pbpeerstream.ExportedService,
+	names importedServiceNames,
 ) error {
 	// Capture instances in the state store for reconciliation later.
 	_, storedInstances, err := s.GetStore().CheckServiceNodes(nil, sn.Name, &sn.EnterpriseMeta, peerName)
@@ -260,7 +324,10 @@ func (s *Server) handleUpdateService(
 	}
 
 	// Normalize the data into a convenient form for operation.
-	snap := newHealthSnapshot(structsNodes, partition, peerName)
+	snap, err := newHealthSnapshot(structsNodes, partition, peerName, names)
+	if err != nil {
+		return err
+	}
 
 	for _, nodeSnap := range snap.Nodes {
 		// First register the node
@@ -449,6 +516,41 @@ func (s *Server) handleUpsertRoots(
 	return s.Backend.PeeringTrustBundleWrite(req)
 }
 
+// handleUpsertStreamSecret stores the stream secret sent by the accepting peer
+// so that it is presented the next time the stream is opened.
+func (s *Server) handleUpsertStreamSecret(
+	peerName string,
+	partition string,
+	secret *pbpeerstream.PeeringStreamSecret,
+) error {
+	if secret.SecretID == "" {
+		return fmt.Errorf("missing stream secret")
+	}
+
+	_, p, err := s.GetStore().PeeringRead(nil, state.Query{
+		Value:          peerName,
+		EnterpriseMeta: *structs.NodeEnterpriseMetaInPartition(partition),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read peering: %w", err)
+	}
+	if p == nil {
+		return fmt.Errorf("unknown peering %q", peerName)
+	}
+	if p.PeerID == "" {
+		return fmt.Errorf("stream secrets can only be sent to the dialing peer")
+	}
+
+	return s.Backend.PeeringSecretsWrite(&pbpeering.PeeringSecretsWriteRequest{
+		PeeringID: p.ID,
+		Request: &pbpeering.PeeringSecretsWriteRequest_StoreStreamSecret{
+			StoreStreamSecret: &pbpeering.PeeringSecretsWriteRequest_StoreStreamSecretRequest{
+				ActiveStreamSecret: secret.SecretID,
+			},
+		},
+	})
+}
+
 func (s *Server) handleDelete(
 	peerName string,
 	partition string,
@@ -462,12 +564,26 @@ func (s *Server) handleDelete(
 		sn := structs.ServiceNameFromString(resourceID)
 		sn.OverridePartition(partition)
 
-		err := s.handleUpdateService(peerName, partition, sn, nil)
+		localSN, ok := mutableStatus.ImportedServiceLocalName(sn)
+		if !ok {
+			names, err := s.importedServiceNames(peerName, partition, sn)
+			if err != nil {
+				return err
+			}
+			localName, err := names.localName(sn.Name)
+			if err != nil {
+				return err
+			}
+			localSN = structs.NewServiceName(localName, &sn.EnterpriseMeta)
+		}
+
+		err := s.handleUpdateService(peerName, partition, localSN, nil, nil)
 		if err != nil {
 			return err
 		}
 
-		mutableStatus.RemoveImportedService(sn)
+		mutableStatus.RemoveImportedService(localSN)
+		mutableStatus.RemoveImportedServiceLocalName(sn)
 
 		return nil
 
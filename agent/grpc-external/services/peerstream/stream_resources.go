@@ -2,6 +2,7 @@ package peerstream
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"io"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-uuid"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -99,13 +101,19 @@ func (s *Server) StreamResources(stream pbpeerstream.PeerStreamService_StreamRes
 		return grpcstatus.Error(codes.InvalidArgument, "expected PeerID to be empty; the wrong end of peering is being dialed")
 	}
 
+	pendingSecretID, err := s.validateStreamSecret(p.ID, req.StreamSecretID, logger)
+	if err != nil {
+		return err
+	}
+
 	streamReq := HandleStreamRequest{
-		LocalID:            p.ID,
-		RemoteID:           "",
-		PeerName:           p.Name,
-		Partition:          p.Partition,
-		InitialResourceURL: req.ResourceURL,
-		Stream:             stream,
+		LocalID:               p.ID,
+		RemoteID:              "",
+		PeerName:              p.Name,
+		Partition:             p.Partition,
+		InitialResourceURL:    req.ResourceURL,
+		PendingStreamSecretID: pendingSecretID,
+		Stream:                stream,
 	}
 	err = s.HandleStream(streamReq)
 	// A nil error indicates that the peering was deleted and the stream needs to be gracefully shutdown.
@@ -118,6 +126,70 @@ func (s *Server) StreamResources(stream pbpeerstream.PeerStreamService_StreamRes
 	return err
 }
 
+// validateStreamSecret checks the secret presented by the dialing peer against
+// the ones stored for the peering. Peerings without any stored secrets predate
+// them and are accepted without one.
+//
+// A pending secret is promoted to active the first time it is presented. When
+// the active secret is presented, a new pending secret is stored and returned
+// so that it can be sent to the dialing peer over the stream.
+func (s *Server) validateStreamSecret(peeringID, secretID string, logger hclog.Logger) (string, error) {
+	secrets, err := s.GetStore().PeeringSecretsRead(nil, peeringID)
+	if err != nil {
+		logger.Error("failed to read peering secrets", "peer_id", peeringID, "error", err)
+		return "", grpcstatus.Error(codes.Internal, "failed to read peering secrets")
+	}
+	if secrets == nil {
+		return "", nil
+	}
+
+	var (
+		active  = secrets.GetStream().GetActiveSecretID()
+		pending = secrets.GetStream().GetPendingSecretID()
+	)
+	switch {
+	case secretID == "":
+		return "", grpcstatus.Error(codes.PermissionDenied, "initial subscription request must specify a stream secret")
+
+	case pending != "" && subtle.ConstantTimeCompare([]byte(secretID), []byte(pending)) == 1:
+		err := s.Backend.PeeringSecretsWrite(&pbpeering.PeeringSecretsWriteRequest{
+			PeeringID: peeringID,
+			Request: &pbpeering.PeeringSecretsWriteRequest_PromotePending{
+				PromotePending: &pbpeering.PeeringSecretsWriteRequest_PromotePendingRequest{
+					ActiveStreamSecret: secretID,
+				},
+			},
+		})
+		if err != nil {
+			logger.Error("failed to promote pending stream secret", "peer_id", peeringID, "error", err)
+			return "", grpcstatus.Error(codes.Internal, "failed to promote pending stream secret")
+		}
+		return "", nil
+
+	case active != "" && subtle.ConstantTimeCompare([]byte(secretID), []byte(active)) == 1:
+		newSecret, err := uuid.GenerateUUID()
+		if err != nil {
+			return "", grpcstatus.Error(codes.Internal, "failed to generate stream secret")
+		}
+		err = s.Backend.PeeringSecretsWrite(&pbpeering.PeeringSecretsWriteRequest{
+			PeeringID: peeringID,
+			Request: &pbpeering.PeeringSecretsWriteRequest_RotateStreamSecret{
+				RotateStreamSecret: &pbpeering.PeeringSecretsWriteRequest_RotateStreamSecretRequest{
+					PendingStreamSecret: newSecret,
+				},
+			},
+		})
+		if err != nil {
+			logger.Error("failed to rotate stream secret", "peer_id", peeringID, "error", err)
+			return "", grpcstatus.Error(codes.Internal, "failed to rotate stream secret")
+		}
+		return newSecret, nil
+
+	default:
+		return "", grpcstatus.Error(codes.PermissionDenied, "invalid peering stream secret")
+	}
+}
+
 type HandleStreamRequest struct {
 	// LocalID is the UUID for the peering in the local Consul datacenter.
 	LocalID string
@@ -134,6 +206,14 @@ type HandleStreamRequest struct {
 	// InitialResourceURL is the ResourceURL from the initial Request.
 	InitialResourceURL string
 
+	// StreamSecretID is the secret presented to the peer when dialing it.
+	// It is empty for peerings established before stream secrets existed.
+	StreamSecretID string
+
+	// PendingStreamSecretID is a new stream secret to send to the dialing
+	// peer, which it must present the next time it opens a stream.
+	PendingStreamSecretID string
+
 	// Stream is the open stream to the peer cluster.
 	Stream BidirectionalStream
 }
@@ -185,6 +265,14 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 		return fmt.Errorf("failed to register stream: %v", err)
 	}
 
+	// The resource counters are only kept in memory, so continue from the
+	// ones last persisted, which may have been counted by a previous leader.
+	if _, persisted, err := s.GetStore().PeeringStreamStatusRead(nil, streamReq.LocalID); err != nil {
+		logger.Warn("failed to read persisted stream status", "error", err)
+	} else if persisted != nil {
+		status.SeedResourceVersions(persisted.SentResourceVersions, persisted.ReceivedResourceVersions)
+	}
+
 	var trustDomain string
 	if s.ConnectEnabled {
 		// Read the TrustDomain up front - we do not allow users to change the ClusterID
@@ -218,7 +306,7 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 
 	// streamSend is a helper function that sends msg over the stream
 	// respecting the send mutex. It also logs the send and calls status.TrackSendError
-	// on error.
+	// on error or status.TrackSendSuccess otherwise.
 	streamSend := func(msg *pbpeerstream.ReplicationMessage) error {
 		logTraceSend(logger, msg)
 
@@ -228,19 +316,30 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 
 		if err != nil {
 			status.TrackSendError(err.Error())
+		} else {
+			status.TrackSendSuccess(msg.GetResponse().GetResourceURL())
 		}
 		return err
 	}
 
 	// Subscribe to all relevant resource types.
-	for _, resourceURL := range []string{
+	resourceURLs := []string{
 		pbpeerstream.TypeURLExportedService,
 		pbpeerstream.TypeURLPeeringTrustBundle,
-	} {
+	}
+	if !streamReq.WasDialed() {
+		// Only the dialing peer receives stream secrets.
+		resourceURLs = append(resourceURLs, pbpeerstream.TypeURLPeeringStreamSecret)
+	}
+	for i, resourceURL := range resourceURLs {
 		sub := makeReplicationRequest(&pbpeerstream.ReplicationMessage_Request{
 			ResourceURL: resourceURL,
 			PeerID:      streamReq.RemoteID,
 		})
+		if i == 0 && !streamReq.WasDialed() {
+			// The first request is used by the peer to authorize the stream.
+			sub.GetRequest().StreamSecretID = streamReq.StreamSecretID
+		}
 		if err := streamSend(sub); err != nil {
 			if err == io.EOF {
 				logger.Info("stream ended by peer")
@@ -251,6 +350,20 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 		}
 	}
 
+	if streamReq.PendingStreamSecretID != "" {
+		resp, err := makeStreamSecretResponse(streamReq.PendingStreamSecretID)
+		if err != nil {
+			return err
+		}
+		if err := streamSend(makeReplicationResponse(resp)); err != nil {
+			if err == io.EOF {
+				logger.Info("stream ended by peer")
+				return nil
+			}
+			return fmt.Errorf("failed to send stream secret to stream: %w", err)
+		}
+	}
+
 	// TODO(peering): Should this be buffered?
 	recvChan := make(chan *pbpeerstream.ReplicationMessage)
 	go func() {
@@ -461,7 +574,7 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 					logger.Error("failed to persist resource", "resourceURL", resp.ResourceURL, "resourceID", resp.ResourceID)
 					status.TrackRecvError(err.Error())
 				} else {
-					status.TrackRecvResourceSuccess()
+					status.TrackRecvResourceSuccess(resp.ResourceURL)
 				}
 
 				if err := streamSend(reply); err != nil {
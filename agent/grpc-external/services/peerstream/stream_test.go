@@ -274,6 +274,124 @@ func TestStreamResources_Server_FirstRequest(t *testing.T) {
 	}
 }
 
+func TestStreamResources_Server_StreamSecret(t *testing.T) {
+	srv, store := newTestServer(t, nil)
+
+	// Set the initial roots and CA configuration.
+	_, _ = writeInitialRootsAndCA(t, store)
+
+	p := writePeeringToBeDialed(t, store, 1, "my-peer")
+	require.NoError(t, store.PeeringSecretsWrite(2, &pbpeering.PeeringSecretsWriteRequest{
+		PeeringID: p.ID,
+		Request: &pbpeering.PeeringSecretsWriteRequest_GenerateToken{
+			GenerateToken: &pbpeering.PeeringSecretsWriteRequest_GenerateTokenRequest{
+				EstablishmentSecret: "establishment",
+			},
+		},
+	}))
+	require.NoError(t, store.PeeringSecretsWrite(3, &pbpeering.PeeringSecretsWriteRequest{
+		PeeringID: p.ID,
+		Request: &pbpeering.PeeringSecretsWriteRequest_ExchangeSecret{
+			ExchangeSecret: &pbpeering.PeeringSecretsWriteRequest_ExchangeSecretRequest{
+				EstablishmentSecret: "establishment",
+				PendingStreamSecret: "stream-1",
+			},
+		},
+	}))
+
+	openStream := func(t *testing.T, secretID string) *MockClient {
+		client := NewMockClient(context.Background())
+
+		errCh := make(chan error, 1)
+		client.ErrCh = errCh
+
+		go func() {
+			if err := srv.StreamResources(client.ReplicationStream); err != nil {
+				errCh <- err
+			}
+		}()
+
+		require.NoError(t, client.Send(&pbpeerstream.ReplicationMessage{
+			Payload: &pbpeerstream.ReplicationMessage_Request_{
+				Request: &pbpeerstream.ReplicationMessage_Request{
+					PeerID:         p.ID,
+					ResourceURL:    pbpeerstream.TypeURLExportedService,
+					StreamSecretID: secretID,
+				},
+			},
+		}))
+		return client
+	}
+
+	readStreamSecrets := func(t *testing.T) *pbpeering.PeeringSecrets_Stream {
+		secrets, err := store.PeeringSecretsRead(nil, p.ID)
+		require.NoError(t, err)
+		require.NotNil(t, secrets)
+		return secrets.GetStream()
+	}
+
+	testutil.RunStep(t, "missing secret is rejected", func(t *testing.T) {
+		client := openStream(t, "")
+		_, err := client.RecvWithTimeout(time.Second)
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	testutil.RunStep(t, "unknown secret is rejected", func(t *testing.T) {
+		client := openStream(t, "stream-0")
+		_, err := client.RecvWithTimeout(time.Second)
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	testutil.RunStep(t, "pending secret gets promoted", func(t *testing.T) {
+		client := openStream(t, "stream-1")
+		t.Cleanup(client.Close)
+
+		// Drain the subscription requests sent by the server.
+		for i := 0; i < 2; i++ {
+			msg, err := client.RecvWithTimeout(time.Second)
+			require.NoError(t, err)
+			require.NotNil(t, msg.GetRequest())
+		}
+
+		retry.Run(t, func(r *retry.R) {
+			status, ok := srv.StreamStatus(p.ID)
+			require.True(r, ok)
+			require.True(r, status.Connected)
+		})
+		stream := readStreamSecrets(t)
+		require.Equal(t, "stream-1", stream.ActiveSecretID)
+		require.Empty(t, stream.PendingSecretID)
+
+		close(srv.ConnectedStreams()[p.ID])
+		retry.Run(t, func(r *retry.R) {
+			_, ok := srv.StreamStatus(p.ID)
+			require.False(r, ok)
+		})
+	})
+
+	testutil.RunStep(t, "active secret gets rotated", func(t *testing.T) {
+		client := openStream(t, "stream-1")
+		t.Cleanup(client.Close)
+
+		var secret *pbpeerstream.PeeringStreamSecret
+		retry.Run(t, func(r *retry.R) {
+			msg, err := client.Recv()
+			require.NoError(r, err)
+			resp := msg.GetResponse()
+			require.NotNil(r, resp)
+			require.Equal(r, pbpeerstream.TypeURLPeeringStreamSecret, resp.ResourceURL)
+
+			secret = &pbpeerstream.PeeringStreamSecret{}
+			require.NoError(r, resp.Resource.UnmarshalTo(secret))
+		})
+		require.NotEmpty(t, secret.SecretID)
+
+		stream := readStreamSecrets(t)
+		require.Equal(t, "stream-1", stream.ActiveSecretID)
+		require.Equal(t, secret.SecretID, stream.PendingSecretID)
+	})
+}
+
 func TestStreamResources_Server_Terminate(t *testing.T) {
 	it := incrementalTime{
 		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
@@ -344,18 +462,31 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 	require.Empty(t, p.PeerID, "should be empty if being dialed")
 	peerID := p.ID
 
+	// Setting up the stream takes four ticks: sending our two subscription
+	// requests, acking the client's second one (the first opens the stream)
+	// and sending it the trust bundle. The last two happen in either order.
+	setupDone := it.FutureNow(4)
+	sentResourceVersions := map[string]uint64{
+		pbpeerstream.TypeURLPeeringTrustBundle: 1,
+	}
+
 	client := makeClient(t, srv, peerID)
 
+	var lastAck, lastSendSuccess time.Time
+
 	testutil.RunStep(t, "new stream gets tracked", func(t *testing.T) {
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(peerID)
 			require.True(r, ok)
 			require.True(r, status.Connected)
+			require.Equal(r, setupDone, latest(status.LastAck, status.LastSendSuccess))
+			require.Equal(r, sentResourceVersions, status.SentResourceVersions)
+
+			lastAck = status.LastAck
+			lastSendSuccess = status.LastSendSuccess
 		})
 	})
 
-	var lastSendSuccess time.Time
-
 	testutil.RunStep(t, "ack tracked as success", func(t *testing.T) {
 		ack := &pbpeerstream.ReplicationMessage{
 			Payload: &pbpeerstream.ReplicationMessage_Request_{
@@ -369,13 +500,15 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 			},
 		}
 
-		lastSendSuccess = it.FutureNow(1)
+		lastAck = it.FutureNow(1)
 		err := client.Send(ack)
 		require.NoError(t, err)
 
 		expect := Status{
-			Connected: true,
-			LastAck:   lastSendSuccess,
+			Connected:            true,
+			LastAck:              lastAck,
+			LastSendSuccess:      lastSendSuccess,
+			SentResourceVersions: sentResourceVersions,
 		}
 
 		retry.Run(t, func(r *retry.R) {
@@ -410,20 +543,27 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		lastNackMsg = "client peer was unable to apply resource: bad bad not good"
 
 		expect := Status{
-			Connected:       true,
-			LastAck:         lastSendSuccess,
-			LastNack:        lastNack,
-			LastNackMessage: lastNackMsg,
+			Connected:            true,
+			LastAck:              lastAck,
+			LastNack:             lastNack,
+			LastNackMessage:      lastNackMsg,
+			LastSendSuccess:      lastSendSuccess,
+			SentResourceVersions: sentResourceVersions,
 		}
 
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(peerID)
 			require.True(r, ok)
 			require.Equal(r, expect, status)
+
+			pbStatus := status.ToProto()
+			require.Equal(r, lastNackMsg, pbStatus.LastErrorMessage)
+			require.Equal(r, lastNack, pbStatus.LastError.AsTime())
 		})
 	})
 
 	var lastRecvResourceSuccess time.Time
+	var recvResourceVersions map[string]uint64
 
 	testutil.RunStep(t, "response applied locally", func(t *testing.T) {
 		resp := &pbpeerstream.ReplicationMessage{
@@ -438,6 +578,8 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 			},
 		}
 		lastRecvResourceSuccess = it.FutureNow(1)
+		// Sending the ACK back is the next tick.
+		lastSendSuccess = it.FutureNow(2)
 		err := client.Send(resp)
 		require.NoError(t, err)
 
@@ -473,22 +615,33 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		prototest.AssertDeepEqual(t, expectAck, ack)
 
 		api := structs.NewServiceName("api", nil)
+		recvResourceVersions = map[string]uint64{
+			pbpeerstream.TypeURLExportedService: 1,
+		}
 
 		expect := Status{
 			Connected:               true,
-			LastAck:                 lastSendSuccess,
+			LastAck:                 lastAck,
 			LastNack:                lastNack,
 			LastNackMessage:         lastNackMsg,
+			LastSendSuccess:         lastSendSuccess,
 			LastRecvResourceSuccess: lastRecvResourceSuccess,
 			ImportedServices: map[string]struct{}{
 				api.String(): {},
 			},
+			SentResourceVersions: sentResourceVersions,
+			RecvResourceVersions: recvResourceVersions,
 		}
 
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(peerID)
 			require.True(r, ok)
 			require.Equal(r, expect, status)
+
+			pbStatus := status.ToProto()
+			require.Equal(r, []string{api.String()}, pbStatus.ImportedServices)
+			require.Equal(r, recvResourceVersions, pbStatus.ReceivedResourceVersions)
+			require.Equal(r, lastSendSuccess, pbStatus.LastSend.AsTime())
 		})
 	})
 
@@ -509,6 +662,8 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 			},
 		}
 		lastRecvError = it.FutureNow(1)
+		// Sending the NACK back is the next tick.
+		lastSendSuccess = it.FutureNow(2)
 		err := client.Send(resp)
 		require.NoError(t, err)
 
@@ -535,21 +690,27 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 
 		expect := Status{
 			Connected:               true,
-			LastAck:                 lastSendSuccess,
+			LastAck:                 lastAck,
 			LastNack:                lastNack,
 			LastNackMessage:         lastNackMsg,
+			LastSendSuccess:         lastSendSuccess,
 			LastRecvResourceSuccess: lastRecvResourceSuccess,
 			LastRecvError:           lastRecvError,
 			LastRecvErrorMessage:    lastRecvErrorMsg,
 			ImportedServices: map[string]struct{}{
 				api.String(): {},
 			},
+			SentResourceVersions: sentResourceVersions,
+			RecvResourceVersions: recvResourceVersions,
 		}
 
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(peerID)
 			require.True(r, ok)
 			require.Equal(r, expect, status)
+
+			pbStatus := status.ToProto()
+			require.Equal(r, lastRecvErrorMsg, pbStatus.LastErrorMessage)
 		})
 	})
 
@@ -567,9 +728,10 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 
 		expect := Status{
 			Connected:               true,
-			LastAck:                 lastSendSuccess,
+			LastAck:                 lastAck,
 			LastNack:                lastNack,
 			LastNackMessage:         lastNackMsg,
+			LastSendSuccess:         lastSendSuccess,
 			LastRecvResourceSuccess: lastRecvResourceSuccess,
 			LastRecvError:           lastRecvError,
 			LastRecvErrorMessage:    lastRecvErrorMsg,
@@ -577,12 +739,18 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 			ImportedServices: map[string]struct{}{
 				api.String(): {},
 			},
+			SentResourceVersions: sentResourceVersions,
+			RecvResourceVersions: recvResourceVersions,
 		}
 
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(peerID)
 			require.True(r, ok)
 			require.Equal(r, expect, status)
+
+			pbStatus := status.ToProto()
+			require.Equal(r, lastRecvHeartbeat, pbStatus.LastHeartbeat.AsTime())
+			require.Equal(r, lastRecvHeartbeat, pbStatus.LastReceive.AsTime())
 		})
 	})
 
@@ -598,10 +766,11 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		expect := Status{
 			Connected:               false,
 			DisconnectErrorMessage:  "stream ended unexpectedly",
-			LastAck:                 lastSendSuccess,
+			LastAck:                 lastAck,
 			LastNack:                lastNack,
 			LastNackMessage:         lastNackMsg,
 			DisconnectTime:          disconnectTime,
+			LastSendSuccess:         lastSendSuccess,
 			LastRecvResourceSuccess: lastRecvResourceSuccess,
 			LastRecvError:           lastRecvError,
 			LastRecvErrorMessage:    lastRecvErrorMsg,
@@ -609,12 +778,18 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 			ImportedServices: map[string]struct{}{
 				api.String(): {},
 			},
+			SentResourceVersions: sentResourceVersions,
+			RecvResourceVersions: recvResourceVersions,
 		}
 
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(peerID)
 			require.True(r, ok)
 			require.Equal(r, expect, status)
+
+			pbStatus := status.ToProto()
+			require.Equal(r, disconnectTime, pbStatus.DisconnectTime.AsTime())
+			require.Equal(r, "stream ended unexpectedly", pbStatus.DisconnectErrorMessage)
 		})
 	})
 }
@@ -1208,6 +1383,10 @@ func (b *testStreamBackend) PeeringTerminateByID(req *pbpeering.PeeringTerminate
 	panic("not implemented")
 }
 
+func (b *testStreamBackend) PeeringSecretsWrite(req *pbpeering.PeeringSecretsWriteRequest) error {
+	return b.store.PeeringSecretsWrite(1, req)
+}
+
 func (b *testStreamBackend) PeeringTrustBundleWrite(req *pbpeering.PeeringTrustBundleWriteRequest) error {
 	panic("not implemented")
 }
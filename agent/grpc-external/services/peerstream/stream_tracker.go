@@ -2,10 +2,14 @@ package peerstream
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
+
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbpeering"
 )
 
 // Tracker contains a map of (PeerID -> MutableStatus).
@@ -136,6 +140,10 @@ type MutableStatus struct {
 	// to the peer before the stream's context is cancelled.
 	doneCh chan struct{}
 
+	// importedLocalNames maps the names of services imported from the peer
+	// to the local names they were last registered under.
+	importedLocalNames map[structs.ServiceName]structs.ServiceName
+
 	Status
 }
 
@@ -167,6 +175,9 @@ type Status struct {
 	// LastSendErrorMessage tracks the last error message when sending into the stream.
 	LastSendErrorMessage string
 
+	// LastSendSuccess tracks the time of the last successful send into the stream.
+	LastSendSuccess time.Time
+
 	// LastRecvHeartbeat tracks when we last received a heartbeat from our peer.
 	LastRecvHeartbeat time.Time
 
@@ -186,6 +197,14 @@ type Status struct {
 	ImportedServices map[string]struct{}
 	// ExportedServices keeps track of which service names a peer asks to export
 	ExportedServices map[string]struct{}
+
+	// SentResourceVersions counts the resources sent TO the peer, keyed by resource URL.
+	// It is seeded from the persisted status when a stream starts, so it
+	// keeps counting across leadership changes.
+	SentResourceVersions map[string]uint64
+	// RecvResourceVersions counts the resources successfully stored FROM the peer, keyed by resource URL.
+	// It is seeded like SentResourceVersions.
+	RecvResourceVersions map[string]uint64
 }
 
 func (s *Status) GetImportedServicesCount() uint64 {
@@ -196,6 +215,71 @@ func (s *Status) GetExportedServicesCount() uint64 {
 	return uint64(len(s.ExportedServices))
 }
 
+// ToProto converts the status into its replicated form. The UpdatedAt
+// timestamp is left for the caller to set.
+func (s *Status) ToProto() *pbpeering.StreamStatus {
+	out := &pbpeering.StreamStatus{
+		LastHeartbeat:            timeToProto(s.LastRecvHeartbeat),
+		LastReceive:              timeToProto(latest(s.LastAck, s.LastNack, s.LastRecvHeartbeat, s.LastRecvResourceSuccess, s.LastRecvError)),
+		LastSend:                 timeToProto(latest(s.LastSendSuccess, s.LastSendError)),
+		DisconnectErrorMessage:   s.DisconnectErrorMessage,
+		ImportedServices:         sortedKeys(s.ImportedServices),
+		ExportedServices:         sortedKeys(s.ExportedServices),
+		SentResourceVersions:     cloneVersions(s.SentResourceVersions),
+		ReceivedResourceVersions: cloneVersions(s.RecvResourceVersions),
+	}
+	if !s.Connected {
+		out.DisconnectTime = timeToProto(s.DisconnectTime)
+	}
+
+	var lastErr time.Time
+	for _, e := range []struct {
+		at  time.Time
+		msg string
+	}{
+		{s.LastSendError, s.LastSendErrorMessage},
+		{s.LastRecvError, s.LastRecvErrorMessage},
+		{s.LastNack, s.LastNackMessage},
+	} {
+		if e.at.After(lastErr) {
+			lastErr = e.at
+			out.LastErrorMessage = e.msg
+		}
+	}
+	out.LastError = timeToProto(lastErr)
+
+	return out
+}
+
+func timeToProto(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return structs.TimeToProto(t)
+}
+
+func latest(times ...time.Time) time.Time {
+	var out time.Time
+	for _, t := range times {
+		if t.After(out) {
+			out = t
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
 func newMutableStatus(now func() time.Time, connected bool) *MutableStatus {
 	return &MutableStatus{
 		Status: Status{
@@ -223,11 +307,55 @@ func (s *MutableStatus) TrackSendError(error string) {
 	s.mu.Unlock()
 }
 
+// TrackSendSuccess tracks a successful send into the stream. If the message
+// carried a resource, its resource URL is passed so the sent version can be bumped.
+func (s *MutableStatus) TrackSendSuccess(resourceURL string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.LastSendSuccess = s.timeNow().UTC()
+	if resourceURL == "" {
+		return
+	}
+	if s.SentResourceVersions == nil {
+		s.SentResourceVersions = make(map[string]uint64)
+	}
+	s.SentResourceVersions[resourceURL]++
+}
+
+// SeedResourceVersions continues the resource counters from the given ones,
+// which were persisted by this or a previous leader. Counters that are already
+// higher in memory are kept.
+func (s *MutableStatus) SeedResourceVersions(sent, recv map[string]uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.SentResourceVersions = seedVersions(s.SentResourceVersions, sent)
+	s.RecvResourceVersions = seedVersions(s.RecvResourceVersions, recv)
+}
+
+func seedVersions(current, persisted map[string]uint64) map[string]uint64 {
+	for url, v := range persisted {
+		if current == nil {
+			current = make(map[string]uint64)
+		}
+		if v > current[url] {
+			current[url] = v
+		}
+	}
+	return current
+}
+
 // TrackRecvResourceSuccess tracks receiving a replicated resource.
-func (s *MutableStatus) TrackRecvResourceSuccess() {
+func (s *MutableStatus) TrackRecvResourceSuccess(resourceURL string) {
 	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.LastRecvResourceSuccess = s.timeNow().UTC()
-	s.mu.Unlock()
+	if s.RecvResourceVersions == nil {
+		s.RecvResourceVersions = make(map[string]uint64)
+	}
+	s.RecvResourceVersions[resourceURL]++
 }
 
 // TrackRecvHeartbeat tracks receiving a heartbeat from our peer.
@@ -292,11 +420,37 @@ func (s *MutableStatus) IsConnected() bool {
 func (s *MutableStatus) GetStatus() Status {
 	s.mu.RLock()
 	copy := s.Status
+	copy.ImportedServices = cloneSet(s.ImportedServices)
+	copy.ExportedServices = cloneSet(s.ExportedServices)
+	copy.SentResourceVersions = cloneVersions(s.SentResourceVersions)
+	copy.RecvResourceVersions = cloneVersions(s.RecvResourceVersions)
 	s.mu.RUnlock()
 
 	return copy
 }
 
+func cloneSet(m map[string]struct{}) map[string]struct{} {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]struct{}, len(m))
+	for k := range m {
+		out[k] = struct{}{}
+	}
+	return out
+}
+
+func cloneVersions(m map[string]uint64) map[string]uint64 {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]uint64, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
 func (s *MutableStatus) RemoveImportedService(sn structs.ServiceName) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -315,6 +469,39 @@ func (s *MutableStatus) TrackImportedService(sn structs.ServiceName) {
 	s.ImportedServices[sn.String()] = struct{}{}
 }
 
+// SetImportedServiceLocalName records the local name that the service imported
+// as remote is registered under. It returns the local name it was previously
+// registered under if that differs.
+func (s *MutableStatus) SetImportedServiceLocalName(remote, local structs.ServiceName) (structs.ServiceName, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.importedLocalNames == nil {
+		s.importedLocalNames = make(map[structs.ServiceName]structs.ServiceName)
+	}
+
+	prev, ok := s.importedLocalNames[remote]
+	s.importedLocalNames[remote] = local
+	return prev, ok && prev != local
+}
+
+// ImportedServiceLocalName returns the local name that the service imported as
+// remote was last registered under.
+func (s *MutableStatus) ImportedServiceLocalName(remote structs.ServiceName) (structs.ServiceName, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	local, ok := s.importedLocalNames[remote]
+	return local, ok
+}
+
+func (s *MutableStatus) RemoveImportedServiceLocalName(remote structs.ServiceName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.importedLocalNames, remote)
+}
+
 func (s *MutableStatus) GetImportedServicesCount() int {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
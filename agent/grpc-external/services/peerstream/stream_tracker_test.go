@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/consul/proto/pbpeerstream"
 	"github.com/hashicorp/consul/sdk/testutil"
 )
 
@@ -220,3 +221,32 @@ func TestMutableStatus_TrackDisconnectedDueToError(t *testing.T) {
 	require.Equal(t, disconnectTime, s.DisconnectTime)
 	require.Equal(t, "disconnect err", s.DisconnectErrorMessage)
 }
+
+func TestMutableStatus_SeedResourceVersions(t *testing.T) {
+	s := MutableStatus{
+		Status: Status{
+			SentResourceVersions: map[string]uint64{
+				pbpeerstream.TypeURLExportedService: 5,
+			},
+		},
+	}
+
+	s.SeedResourceVersions(
+		map[string]uint64{
+			pbpeerstream.TypeURLExportedService:    3,
+			pbpeerstream.TypeURLPeeringTrustBundle: 2,
+		},
+		map[string]uint64{
+			pbpeerstream.TypeURLExportedService: 4,
+		},
+	)
+
+	// Counters that are already higher in memory are kept.
+	require.Equal(t, map[string]uint64{
+		pbpeerstream.TypeURLExportedService:    5,
+		pbpeerstream.TypeURLPeeringTrustBundle: 2,
+	}, s.SentResourceVersions)
+	require.Equal(t, map[string]uint64{
+		pbpeerstream.TypeURLExportedService: 4,
+	}, s.RecvResourceVersions)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/hashicorp/consul/acl"
@@ -130,6 +131,15 @@ func (m *subscriptionManager) handleEvent(ctx context.Context, state *subscripti
 			return fmt.Errorf("invalid type for response: %T", u.Result)
 		}
 
+		// The instances of services whose filter changed have to be sent
+		// again, so restart their subscriptions to get a fresh snapshot.
+		for svc, cancel := range state.watchedServices {
+			if state.exportList.Filter(svc) != evt.Filter(svc) {
+				cancel()
+				delete(state.watchedServices, svc)
+			}
+		}
+
 		state.exportList = evt
 
 		pending := &pendingPayload{}
@@ -157,6 +167,11 @@ func (m *subscriptionManager) handleEvent(ctx context.Context, state *subscripti
 		// with the synthetic entries that point to mesh gateways.
 		filterConnectReferences(csn)
 
+		sn := structs.ServiceNameFromString(strings.TrimPrefix(u.CorrelationID, subExportedService))
+		if err := filterExportedInstances(csn, state.exportList.Filter(sn)); err != nil {
+			return err
+		}
+
 		// Flatten health checks
 		for _, instance := range csn.Nodes {
 			instance.Checks = flattenChecks(
@@ -298,6 +313,37 @@ func filterConnectReferences(orig *pbservice.IndexedCheckServiceNodes) {
 	orig.Nodes = newNodes
 }
 
+// filterExportedInstances removes the instances that don't match the filter
+// expression of an exported service.
+func filterExportedInstances(orig *pbservice.IndexedCheckServiceNodes, filter string) error {
+	if filter == "" {
+		return nil
+	}
+
+	eval, err := bexpr.CreateEvaluator(filter, nil)
+	if err != nil {
+		return fmt.Errorf("invalid filter for exported service: %w", err)
+	}
+
+	newNodes := make([]*pbservice.CheckServiceNode, 0, len(orig.Nodes))
+	for _, csn := range orig.Nodes {
+		instance, err := pbservice.CheckServiceNodeToStructs(csn)
+		if err != nil {
+			return fmt.Errorf("failed to convert instance to structs: %w", err)
+		}
+
+		match, err := eval.Evaluate(instance)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate filter for exported service: %w", err)
+		}
+		if match {
+			newNodes = append(newNodes, csn)
+		}
+	}
+	orig.Nodes = newNodes
+	return nil
+}
+
 func (m *subscriptionManager) notifyRootCAUpdatesForPartition(
 	ctx context.Context,
 	updateCh chan<- cache.UpdateEvent,
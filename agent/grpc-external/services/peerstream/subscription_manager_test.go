@@ -627,6 +627,46 @@ func TestSubscriptionManager_CARoots(t *testing.T) {
 	})
 }
 
+func Test_filterExportedInstances(t *testing.T) {
+	newNodes := func() *pbservice.IndexedCheckServiceNodes {
+		nodes := &pbservice.IndexedCheckServiceNodes{}
+		for _, name := range []string{"foo", "bar"} {
+			svc := pbService("", "mysql-"+name, "mysql", 5000, nil)
+			svc.Meta = map[string]string{"env": name}
+			nodes.Nodes = append(nodes.Nodes, &pbservice.CheckServiceNode{
+				Node:    pbNode(name, "10.0.0.1", "default"),
+				Service: svc,
+			})
+		}
+		return nodes
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		nodes := newNodes()
+		require.NoError(t, filterExportedInstances(nodes, ""))
+		require.Len(t, nodes.Nodes, 2)
+	})
+
+	t.Run("filter by service meta", func(t *testing.T) {
+		nodes := newNodes()
+		require.NoError(t, filterExportedInstances(nodes, `Service.Meta.env == "bar"`))
+		require.Len(t, nodes.Nodes, 1)
+		require.Equal(t, "mysql-bar", nodes.Nodes[0].Service.ID)
+	})
+
+	t.Run("filter by node", func(t *testing.T) {
+		nodes := newNodes()
+		require.NoError(t, filterExportedInstances(nodes, `Node.Node == "baz"`))
+		require.Empty(t, nodes.Nodes)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		nodes := newNodes()
+		require.Error(t, filterExportedInstances(nodes, `Service.Meta.env ==`))
+		require.Len(t, nodes.Nodes, 2)
+	})
+}
+
 type testSubscriptionBackend struct {
 	state.EventPublisher
 	store *state.Store
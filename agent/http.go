@@ -19,10 +19,12 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
 	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-uuid"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/config"
 	"github.com/hashicorp/consul/agent/consul"
@@ -327,6 +329,19 @@ var (
 func (s *HTTPHandlers) wrap(handler endpoint, methods []string) http.HandlerFunc {
 	httpLogger := s.agent.logger.Named(logging.HTTP)
 	return func(resp http.ResponseWriter, req *http.Request) {
+		if s.agent.baseDeps.AuditLogger.Enabled() {
+			auditResp, event := s.startAudit(resp, req)
+			defer func() {
+				event.Status = auditResp.status
+				if event.Status == 0 {
+					event.Status = http.StatusOK
+				}
+				event.ElapsedMS = float64(time.Since(event.Timestamp)) / float64(time.Millisecond)
+				s.agent.baseDeps.AuditLogger.Log(event)
+			}()
+			resp = auditResp
+		}
+
 		setHeaders(resp, s.agent.config.HTTPResponseHeaders)
 		setTranslateAddr(resp, s.agent.config.TranslateWANAddrs)
 		setACLDefaultPolicy(resp, s.agent.config.ACLResolverSettings.ACLDefaultPolicy)
@@ -473,6 +488,9 @@ func (s *HTTPHandlers) wrap(handler endpoint, methods []string) http.HandlerFunc
 			err = MethodNotAllowedError{req.Method, append([]string{"OPTIONS"}, methods...)}
 		} else {
 			err = s.checkWriteAccess(req)
+			if err == nil {
+				err = s.checkTokenSource(req)
+			}
 
 			// Give the user a hint that they might be doing something wrong if they issue a GET request
 			// with a non-empty body (e.g., parameters placed in body rather than query string).
@@ -1112,6 +1130,91 @@ func (s *HTTPHandlers) checkWriteAccess(req *http.Request) error {
 	return HTTPError{StatusCode: http.StatusForbidden, Reason: "Access is restricted"}
 }
 
+// checkTokenSource rejects requests whose token is bound to CIDR blocks that
+// do not contain the address the request was made from.
+func (s *HTTPHandlers) checkTokenSource(req *http.Request) error {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		// Requests made over a unix socket have no address to check.
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil
+	}
+
+	var token string
+	s.parseToken(req, &token)
+	return s.agent.delegate.CheckTokenSource(token, ip)
+}
+
+// auditResponseWriter records the status code of a response for the audit log.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush is needed by the endpoints which stream their responses.
+func (w *auditResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// startAudit begins the audit event for req, returning it along with the
+// writer the response must be written to so its status can be recorded. The
+// token is resolved up front as the request may be the one that deletes it.
+// Tokens in the URL are replaced by their hash.
+func (s *HTTPHandlers) startAudit(resp http.ResponseWriter, req *http.Request) (*auditResponseWriter, *audit.Event) {
+	event := &audit.Event{
+		Type:      audit.EventTypeHTTP,
+		Timestamp: time.Now(),
+		Endpoint:  req.URL.Path,
+		Method:    req.Method,
+	}
+
+	if reqID, err := uuid.GenerateUUID(); err == nil {
+		event.RequestID = reqID
+		resp.Header().Set("X-Consul-Request-Id", reqID)
+	}
+	if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		event.SourceIP = host
+	}
+
+	var token string
+	s.parseToken(req, &token)
+	event.TokenHash = audit.HashSecret(token)
+	if result, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil); err == nil {
+		event.AccessorID = result.AccessorID()
+	}
+
+	if m := aclEndpointRE.FindStringSubmatch(event.Endpoint); m != nil {
+		event.Endpoint = m[1] + audit.HashSecret(m[3])
+	}
+	if query, err := url.ParseQuery(req.URL.RawQuery); err == nil && len(query) > 0 {
+		for i, token := range query["token"] {
+			query["token"][i] = audit.HashSecret(token)
+		}
+		event.Query = query.Encode()
+	}
+
+	return &auditResponseWriter{ResponseWriter: resp}, event
+}
+
 func (s *HTTPHandlers) parseFilter(req *http.Request, filter *string) {
 	if other := req.URL.Query().Get("filter"); other != "" {
 		*filter = other
@@ -11,6 +11,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/http2"
 
+	"github.com/hashicorp/consul/agent/audit"
 	"github.com/hashicorp/consul/agent/config"
 	"github.com/hashicorp/consul/agent/structs"
 	tokenStore "github.com/hashicorp/consul/agent/token"
@@ -703,6 +705,79 @@ func TestHTTP_wrap_obfuscateLog(t *testing.T) {
 	}
 }
 
+func TestHTTP_wrap_audit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir := testutil.TempDir(t, "audit")
+	a := NewTestAgent(t, TestACLConfig()+fmt.Sprintf(`
+		audit {
+			enabled = true
+			sink "test" {
+				path = %q
+				rotate_duration = "1h"
+			}
+			filter {
+				include_paths = ["/v1/kv/*", "/v1/acl/info/*"]
+				exclude_methods = ["DELETE"]
+			}
+		}
+	`, filepath.Join(dir, "audit.json")))
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1", testrpc.WithToken("root"))
+
+	do := func(method, url string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(method, url, strings.NewReader("bar"))
+		req.RemoteAddr = "192.0.2.1:1234"
+		resp := httptest.NewRecorder()
+		a.srv.handler(true).ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := do("PUT", "/v1/kv/foo?token=root")
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.NotEmpty(t, resp.Header().Get("X-Consul-Request-Id"))
+
+	require.Equal(t, http.StatusForbidden, do("GET", "/v1/kv/foo?token=secret").Code)
+	require.Equal(t, http.StatusOK, do("DELETE", "/v1/kv/foo?token=root").Code)
+	require.Equal(t, http.StatusOK, do("GET", "/v1/catalog/nodes?token=root").Code)
+	do("GET", "/v1/acl/info/secret")
+
+	files, err := filepath.Glob(filepath.Join(dir, "audit-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	raw, err := ioutil.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "root")
+	require.NotContains(t, string(raw), "secret")
+
+	var events []audit.Event
+	for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		var event audit.Event
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+	require.Len(t, events, 3)
+
+	require.Equal(t, audit.EventTypeHTTP, events[0].Type)
+	require.Equal(t, "/v1/kv/foo", events[0].Endpoint)
+	require.Equal(t, "PUT", events[0].Method)
+	require.Equal(t, "token="+url.QueryEscape(audit.HashSecret("root")), events[0].Query)
+	require.Equal(t, http.StatusOK, events[0].Status)
+	require.Equal(t, "192.0.2.1", events[0].SourceIP)
+	require.Equal(t, resp.Header().Get("X-Consul-Request-Id"), events[0].RequestID)
+	require.Equal(t, audit.HashSecret("root"), events[0].TokenHash)
+	require.NotEmpty(t, events[0].AccessorID)
+
+	require.Equal(t, "GET", events[1].Method)
+	require.Equal(t, http.StatusForbidden, events[1].Status)
+	require.Empty(t, events[1].AccessorID)
+
+	require.Equal(t, "/v1/acl/info/"+audit.HashSecret("secret"), events[2].Endpoint)
+}
+
 func TestPrettyPrint(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
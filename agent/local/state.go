@@ -194,6 +194,11 @@ type State struct {
 	checks       map[structs.CheckID]*CheckState
 	checkAliases map[structs.ServiceID]map[structs.CheckID]chan<- struct{}
 
+	// outlierChecks tracks when the outlier ejection checks written on this
+	// node by the agents of other proxies were last refreshed, so that they
+	// can be removed once their TTL has expired.
+	outlierChecks map[structs.CheckID]outlierCheckRefresh
+
 	// metadata tracks the node metadata fields
 	metadata map[string]string
 
@@ -220,6 +225,7 @@ func NewState(c Config, logger hclog.Logger, tokens *token.Store) *State {
 		services:            make(map[structs.ServiceID]*ServiceState),
 		checks:              make(map[structs.CheckID]*CheckState),
 		checkAliases:        make(map[structs.ServiceID]map[structs.CheckID]chan<- struct{}),
+		outlierChecks:       make(map[structs.CheckID]outlierCheckRefresh),
 		metadata:            make(map[string]string),
 		tokens:              tokens,
 		notifyHandlers:      make(map[chan<- struct{}]struct{}),
@@ -992,6 +998,26 @@ func (l *State) Stats() map[string]string {
 	}
 }
 
+// outlierCheckRefresh records when an outlier ejection check was first seen
+// with its current ModifyIndex.
+type outlierCheckRefresh struct {
+	modifyIndex uint64
+	seen        time.Time
+}
+
+// outlierCheckExpired returns true if the outlier ejection check hasn't been
+// refreshed by the agent that reported it within its TTL. Every refresh
+// updates the output of the check, and so its ModifyIndex.
+func (l *State) outlierCheckExpired(id structs.CheckID, rc *structs.HealthCheck) bool {
+	now := time.Now()
+	last, ok := l.outlierChecks[id]
+	if !ok || last.modifyIndex != rc.ModifyIndex {
+		l.outlierChecks[id] = outlierCheckRefresh{modifyIndex: rc.ModifyIndex, seen: now}
+		return false
+	}
+	return rc.Definition.TTL > 0 && now.Sub(last.seen) > rc.Definition.TTL
+}
+
 // updateSyncState queries the server for all the services and checks in the catalog
 // registered to this node, and updates the local entries as InSync or Deleted.
 func (l *State) updateSyncState() error {
@@ -1137,6 +1163,13 @@ func (l *State) updateSyncState() error {
 		}
 	}
 
+	// Forget about outlier ejection checks which have been removed.
+	for id := range l.outlierChecks {
+		if remoteChecks[id] == nil {
+			delete(l.outlierChecks, id)
+		}
+	}
+
 	// Traverse the list of checks from the server.
 	// Remote checks which do not exist locally have been deregistered.
 	// Otherwise, check whether the two definitions are still in sync.
@@ -1151,6 +1184,21 @@ func (l *State) updateSyncState() error {
 				continue
 			}
 
+			// Outlier ejections are reported by the agent of the proxy
+			// that ejected the instance, which refreshes the check until
+			// the instance is returned to the pool. It is only removed here
+			// if that agent stopped refreshing it.
+			if structs.IsOutlierEjectionCheckID(id) {
+				if !l.outlierCheckExpired(id, rc) {
+					l.logger.Debug("Skipping remote check since it is managed by another agent", "check", id.String())
+					continue
+				}
+				l.logger.Info("Removing outlier ejection check which is no longer refreshed", "check", id.String())
+				delete(l.outlierChecks, id)
+				l.checks[id] = &CheckState{Deleted: true}
+				continue
+			}
+
 			// Mark a remote check that does not exist locally as deleted so
 			// that it will be removed on the server later.
 			l.checks[id] = &CheckState{Deleted: true}
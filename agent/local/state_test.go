@@ -1292,6 +1292,81 @@ func TestAgentAntiEntropy_RemovingServiceAndCheck(t *testing.T) {
 	}
 }
 
+func TestAgentAntiEntropy_OutlierEjectionCheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	srv := &structs.NodeService{
+		ID:             "web",
+		Service:        "web",
+		Port:           8080,
+		EnterpriseMeta: *structs.DefaultEnterpriseMetaInDefaultPartition(),
+	}
+	require.NoError(t, a.State.AddServiceWithChecks(srv, nil, ""))
+	require.NoError(t, a.State.SyncFull())
+
+	// The check is written by the agent of a proxy which ejected the
+	// instance and must survive the anti-entropy of this agent until it is
+	// no longer refreshed.
+	ttl := 200 * time.Millisecond
+	args := &structs.RegisterRequest{
+		Datacenter:     "dc1",
+		Node:           a.Config.NodeName,
+		Address:        "127.0.0.1",
+		SkipNodeUpdate: true,
+		Check: &structs.HealthCheck{
+			Node:           a.Config.NodeName,
+			CheckID:        types.CheckID(structs.OutlierEjectionCheckPrefix + "other-node/web-proxy/web"),
+			Name:           "Outlier Ejection",
+			ServiceID:      "web",
+			ServiceName:    "web",
+			Status:         api.HealthWarning,
+			Output:         "ejected",
+			Definition:     structs.HealthCheckDefinition{TTL: ttl},
+			EnterpriseMeta: *structs.DefaultEnterpriseMetaInDefaultPartition(),
+		},
+	}
+	var out struct{}
+	require.NoError(t, a.RPC("Catalog.Register", args, &out))
+
+	hasCheck := func(t *testing.T) bool {
+		req := structs.NodeSpecificRequest{
+			Datacenter: "dc1",
+			Node:       a.Config.NodeName,
+		}
+		var checks structs.IndexedHealthChecks
+		require.NoError(t, a.RPC("Health.NodeChecks", &req, &checks))
+		for _, chk := range checks.HealthChecks {
+			if chk.CheckID == args.Check.CheckID {
+				return true
+			}
+		}
+		return false
+	}
+
+	require.NoError(t, a.State.SyncFull())
+	require.True(t, hasCheck(t), "outlier ejection check was removed")
+
+	// A refresh keeps the check.
+	time.Sleep(ttl)
+	args.Check.Output = "ejected again"
+	require.NoError(t, a.RPC("Catalog.Register", args, &out))
+	require.NoError(t, a.State.SyncFull())
+	require.True(t, hasCheck(t), "refreshed outlier ejection check was removed")
+
+	// Once it is no longer refreshed, the check expires.
+	time.Sleep(ttl + 50*time.Millisecond)
+	require.NoError(t, a.State.SyncFull())
+	require.False(t, hasCheck(t), "expired outlier ejection check was kept")
+}
+
 func TestAgentAntiEntropy_Checks_ACLDeny(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
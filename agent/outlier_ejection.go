@@ -0,0 +1,297 @@
+package agent
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/types"
+)
+
+const (
+	outlierEjectAction   = "EJECT"
+	outlierUnejectAction = "UNEJECT"
+
+	outlierEjectionCheckName = "Outlier Ejection"
+
+	// outlierEjectionCheckTTL is how long an outlier ejection check is kept
+	// without being refreshed by the agent that reported it. Checks are
+	// refreshed every half TTL until the instance is returned to the pool,
+	// so a check outlives its reporter by at most the TTL plus the
+	// anti-entropy interval of the instance's agent.
+	outlierEjectionCheckTTL = 2 * time.Minute
+
+	// outlierSnapshotTimeout bounds how long an event waits for the
+	// configuration of a proxy that was just registered.
+	outlierSnapshotTimeout = 5 * time.Second
+)
+
+// OutlierEvent is an outlier detection event as written by Envoy to the file
+// configured with envoy_outlier_event_log_path. Only the fields needed to
+// find the ejected instance are decoded.
+type OutlierEvent struct {
+	// Type is the kind of detection that triggered the event, for example
+	// "CONSECUTIVE_5XX".
+	Type string `json:"type"`
+
+	// ClusterName is the Envoy cluster of the ejected host.
+	ClusterName string `json:"cluster_name"`
+
+	// UpstreamURL is the <ip>:<port> of the ejected host.
+	UpstreamURL string `json:"upstream_url"`
+
+	// Action is either "EJECT" or "UNEJECT".
+	Action string `json:"action"`
+}
+
+// outlierEjectionCheckID returns the ID of the check reflecting the ejection
+// of the given service instance by a proxy on the given node. Every proxy
+// reports its own ejections, so that one proxy returning an instance to the
+// pool doesn't clear the ejection by another.
+func outlierEjectionCheckID(node string, proxyID structs.ServiceID, serviceID string) types.CheckID {
+	return types.CheckID(fmt.Sprintf("%s%s/%s/%s", structs.OutlierEjectionCheckPrefix, node, proxyID.ID, serviceID))
+}
+
+// outlierEjectionKey identifies an outlier ejection check in the catalog.
+type outlierEjectionKey struct {
+	datacenter string
+	node       string
+	checkID    types.CheckID
+}
+
+// outlierEjections holds the stop channels of the goroutines refreshing the
+// outlier ejection checks reported by local proxies.
+type outlierEjections struct {
+	sync.Mutex
+	refreshing map[outlierEjectionKey]chan struct{}
+}
+
+// start replaces the goroutine refreshing the given check, if any, and
+// returns the channel closed to stop the new one.
+func (o *outlierEjections) start(key outlierEjectionKey) chan struct{} {
+	o.Lock()
+	defer o.Unlock()
+	if o.refreshing == nil {
+		o.refreshing = make(map[outlierEjectionKey]chan struct{})
+	}
+	if stopCh, ok := o.refreshing[key]; ok {
+		close(stopCh)
+	}
+	stopCh := make(chan struct{})
+	o.refreshing[key] = stopCh
+	return stopCh
+}
+
+// stop stops the goroutine refreshing the given check. If stopCh is not nil
+// it is only stopped if it hasn't been replaced since.
+func (o *outlierEjections) stop(key outlierEjectionKey, stopCh chan struct{}) {
+	o.Lock()
+	defer o.Unlock()
+	current, ok := o.refreshing[key]
+	if !ok || (stopCh != nil && current != stopCh) {
+		return
+	}
+	close(current)
+	delete(o.refreshing, key)
+}
+
+// ReportOutlierEvent reflects an outlier detection event of a local proxy in
+// the catalog: the ejected instance gets a warning check with a TTL, which is
+// refreshed until the proxy returns the instance to the load balancing pool
+// and is then removed. Events for upstreams that don't have ReportEjections
+// set in their passive health check, or for hosts that aren't service
+// instances of the upstream, are ignored.
+func (a *Agent) ReportOutlierEvent(proxyID structs.ServiceID, event OutlierEvent, token string) error {
+	switch event.Action {
+	case outlierEjectAction, outlierUnejectAction:
+	default:
+		return fmt.Errorf("unsupported outlier event action %q", event.Action)
+	}
+
+	svc := a.State.Service(proxyID)
+	if svc == nil {
+		return fmt.Errorf("unknown proxy service ID: %s", proxyID)
+	}
+	if svc.Kind != structs.ServiceKindConnectProxy {
+		return fmt.Errorf("service %s is not a connect proxy", proxyID)
+	}
+
+	snap, err := a.proxySnapshot(proxyID)
+	if err != nil {
+		return err
+	}
+
+	target, instance := findOutlierInstance(snap, event)
+	if instance == nil {
+		a.logger.Debug("ignoring outlier event for unknown host",
+			"proxy", proxyID.String(),
+			"cluster", event.ClusterName,
+			"host", event.UpstreamURL,
+		)
+		return nil
+	}
+
+	checkID := outlierEjectionCheckID(a.config.NodeName, proxyID, instance.Service.ID)
+	key := outlierEjectionKey{
+		datacenter: target.Datacenter,
+		node:       instance.Node.Node,
+		checkID:    checkID,
+	}
+	if event.Action == outlierUnejectAction {
+		a.outlierEjections.stop(key, nil)
+
+		req := structs.DeregisterRequest{
+			Datacenter:     target.Datacenter,
+			Node:           instance.Node.Node,
+			CheckID:        checkID,
+			EnterpriseMeta: instance.Service.EnterpriseMeta,
+			WriteRequest:   structs.WriteRequest{Token: token},
+		}
+		var out struct{}
+		return a.RPC("Catalog.Deregister", &req, &out)
+	}
+
+	req := structs.RegisterRequest{
+		Datacenter:     target.Datacenter,
+		ID:             instance.Node.ID,
+		Node:           instance.Node.Node,
+		Address:        instance.Node.Address,
+		SkipNodeUpdate: true,
+		Check: &structs.HealthCheck{
+			Node:        instance.Node.Node,
+			CheckID:     checkID,
+			Name:        outlierEjectionCheckName,
+			Status:      api.HealthWarning,
+			ServiceID:   instance.Service.ID,
+			ServiceName: instance.Service.Service,
+			Type:        "outlier-ejection",
+			Definition: structs.HealthCheckDefinition{
+				TTL: outlierEjectionCheckTTL,
+			},
+		},
+		EnterpriseMeta: instance.Service.EnterpriseMeta,
+		WriteRequest:   structs.WriteRequest{Token: token},
+	}
+	output := fmt.Sprintf("Ejected by the outlier detection of %s on node %q: %s", proxyID.ID, a.config.NodeName, event.Type)
+	if err := a.registerOutlierEjectionCheck(&req, output); err != nil {
+		return err
+	}
+
+	stopCh := a.outlierEjections.start(key)
+	go a.refreshOutlierEjectionCheck(proxyID, key, stopCh, req, output)
+	return nil
+}
+
+// registerOutlierEjectionCheck registers an outlier ejection check. The time
+// is added to the output so that every refresh updates the check.
+func (a *Agent) registerOutlierEjectionCheck(req *structs.RegisterRequest, output string) error {
+	req.Check.Output = fmt.Sprintf("%s (reported at %s)", output, time.Now().UTC().Format(time.RFC3339))
+	var out struct{}
+	return a.RPC("Catalog.Register", req, &out)
+}
+
+// refreshOutlierEjectionCheck refreshes an outlier ejection check until it is
+// stopped or the proxy that reported it is deregistered, after which the check
+// expires.
+func (a *Agent) refreshOutlierEjectionCheck(proxyID structs.ServiceID, key outlierEjectionKey, stopCh chan struct{}, req structs.RegisterRequest, output string) {
+	ticker := time.NewTicker(outlierEjectionCheckTTL / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-a.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+
+		if a.State.Service(proxyID) == nil {
+			a.outlierEjections.stop(key, stopCh)
+			return
+		}
+
+		check := *req.Check
+		req.Check = &check
+		if err := a.registerOutlierEjectionCheck(&req, output); err != nil {
+			a.logger.Warn("failed to refresh outlier ejection check",
+				"check", string(key.checkID),
+				"node", key.node,
+				"error", err,
+			)
+		}
+	}
+}
+
+// proxySnapshot returns the current configuration of a local proxy.
+func (a *Agent) proxySnapshot(proxyID structs.ServiceID) (*proxycfg.ConfigSnapshot, error) {
+	ch, cancel := a.proxyConfig.Watch(proxycfg.ProxyID{
+		ServiceID: proxyID,
+		NodeName:  a.config.NodeName,
+	})
+	defer cancel()
+
+	select {
+	case snap, ok := <-ch:
+		if !ok || snap == nil {
+			return nil, fmt.Errorf("no configuration for proxy %s", proxyID)
+		}
+		return snap, nil
+	case <-time.After(outlierSnapshotTimeout):
+		return nil, fmt.Errorf("timed out waiting for the configuration of proxy %s", proxyID)
+	}
+}
+
+// findOutlierInstance returns the service instance behind the host of an
+// outlier event along with the discovery chain target it belongs to. It
+// returns a nil instance when the host isn't known or when the upstream
+// doesn't report its ejections.
+func findOutlierInstance(snap *proxycfg.ConfigSnapshot, event OutlierEvent) (*structs.DiscoveryTarget, *structs.CheckServiceNode) {
+	host, portStr, err := net.SplitHostPort(strings.TrimPrefix(event.UpstreamURL, "tcp://"))
+	if err != nil {
+		return nil, nil
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, nil
+	}
+
+	// Cluster names may be prefixed by the hash of the customizations of
+	// the discovery chain.
+	clusterName := event.ClusterName
+	if idx := strings.LastIndex(clusterName, "~"); idx >= 0 {
+		clusterName = clusterName[idx+1:]
+	}
+
+	for uid, chain := range snap.ConnectProxy.DiscoveryChain {
+		u := snap.ConnectProxy.UpstreamConfig[uid]
+		if u == nil {
+			continue
+		}
+		cfg, err := structs.ParseUpstreamConfigNoDefaults(u.Config)
+		if err != nil || cfg.PassiveHealthCheck == nil || !cfg.PassiveHealthCheck.ReportEjections {
+			continue
+		}
+
+		for targetID, target := range chain.Targets {
+			if target.Name != clusterName {
+				continue
+			}
+			for _, csn := range snap.ConnectProxy.WatchedUpstreamEndpoints[uid][targetID] {
+				for _, wan := range []bool{false, true} {
+					if _, addr, p := csn.BestAddress(wan); addr == host && p == port {
+						csn := csn
+						return target, &csn
+					}
+				}
+			}
+		}
+	}
+	return nil, nil
+}
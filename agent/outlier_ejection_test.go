@@ -0,0 +1,85 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestFindOutlierInstance(t *testing.T) {
+	reportEjections := func(ns *structs.NodeService) {
+		ns.Proxy.Upstreams[0].Config = map[string]interface{}{
+			"passive_health_check": map[string]interface{}{
+				"max_failures":     5,
+				"report_ejections": true,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		nsFn     func(ns *structs.NodeService)
+		event    OutlierEvent
+		wantNode string
+	}{
+		"ejected instance": {
+			nsFn: reportEjections,
+			event: OutlierEvent{
+				ClusterName: "db.default.dc1.internal.11111111-2222-3333-4444-555555555555.consul",
+				UpstreamURL: "10.10.1.2:8080",
+				Action:      outlierEjectAction,
+			},
+			wantNode: "test2",
+		},
+		"ejected instance with tcp scheme": {
+			nsFn: reportEjections,
+			event: OutlierEvent{
+				ClusterName: "db.default.dc1.internal.11111111-2222-3333-4444-555555555555.consul",
+				UpstreamURL: "tcp://10.10.1.1:8080",
+				Action:      outlierEjectAction,
+			},
+			wantNode: "test1",
+		},
+		"unknown host": {
+			nsFn: reportEjections,
+			event: OutlierEvent{
+				ClusterName: "db.default.dc1.internal.11111111-2222-3333-4444-555555555555.consul",
+				UpstreamURL: "10.10.1.3:8080",
+				Action:      outlierEjectAction,
+			},
+		},
+		"reporting disabled": {
+			event: OutlierEvent{
+				ClusterName: "db.default.dc1.internal.11111111-2222-3333-4444-555555555555.consul",
+				UpstreamURL: "10.10.1.2:8080",
+				Action:      outlierEjectAction,
+			},
+		},
+		"invalid host": {
+			nsFn: reportEjections,
+			event: OutlierEvent{
+				ClusterName: "db.default.dc1.internal.11111111-2222-3333-4444-555555555555.consul",
+				UpstreamURL: "10.10.1.2",
+				Action:      outlierEjectAction,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			snap := proxycfg.TestConfigSnapshot(t, tc.nsFn, nil)
+
+			target, instance := findOutlierInstance(snap, tc.event)
+			if tc.wantNode == "" {
+				require.Nil(t, instance)
+				return
+			}
+			require.NotNil(t, instance)
+			require.Equal(t, tc.wantNode, instance.Node.Node)
+			require.Equal(t, "db", target.Service)
+			require.Equal(t, "dc1", target.Datacenter)
+		})
+	}
+}
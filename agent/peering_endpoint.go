@@ -5,6 +5,9 @@ import (
 	"net/http"
 	"strings"
 
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
 	"github.com/hashicorp/consul/acl"
 	external "github.com/hashicorp/consul/agent/grpc-external"
 	"github.com/hashicorp/consul/api"
@@ -79,6 +82,72 @@ func (s *HTTPHandlers) PeeringList(resp http.ResponseWriter, req *http.Request)
 	return pbresp.ToAPI(), nil
 }
 
+// PeeringToken handles GET, POST on /v1/peering/token
+func (s *HTTPHandlers) PeeringToken(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	switch req.Method {
+	case "GET":
+		return s.peeringTokenList(resp, req)
+	case "POST":
+		return s.PeeringGenerateToken(resp, req)
+	default:
+		return nil, MethodNotAllowedError{req.Method, []string{"GET", "POST"}}
+	}
+}
+
+// peeringTokenList lists the peering tokens that can still be used to
+// establish a peering.
+func (s *HTTPHandlers) peeringTokenList(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var entMeta acl.EnterpriseMeta
+	if err := s.parseEntMetaPartition(req, &entMeta); err != nil {
+		return nil, err
+	}
+	args := pbpeering.TokenListRequest{
+		Partition: entMeta.PartitionOrEmpty(),
+	}
+
+	var token string
+	s.parseToken(req, &token)
+	ctx := external.ContextWithToken(req.Context(), token)
+
+	out, err := s.agent.rpcClientPeering.TokenList(ctx, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.ToAPI(), nil
+}
+
+// PeeringTokenRevoke handles DELETE on /v1/peering/token/name
+func (s *HTTPHandlers) PeeringTokenRevoke(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	name := strings.TrimPrefix(req.URL.Path, "/v1/peering/token/")
+	if name == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Must specify a peer name to revoke the token of."}
+	}
+
+	var entMeta acl.EnterpriseMeta
+	if err := s.parseEntMetaPartition(req, &entMeta); err != nil {
+		return nil, err
+	}
+	args := pbpeering.TokenRevokeRequest{
+		PeerName:  name,
+		Partition: entMeta.PartitionOrEmpty(),
+	}
+
+	var token string
+	s.parseToken(req, &token)
+	ctx := external.ContextWithToken(req.Context(), token)
+
+	_, err := s.agent.rpcClientPeering.TokenRevoke(ctx, &args)
+	if st, ok := grpcstatus.FromError(err); ok && st.Code() == codes.NotFound {
+		return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: st.Message()}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 // PeeringGenerateToken handles POSTs to the /v1/peering/token endpoint. The request
 // will always be forwarded via RPC to the local leader.
 func (s *HTTPHandlers) PeeringGenerateToken(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
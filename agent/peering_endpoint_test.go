@@ -214,6 +214,66 @@ func TestHTTP_Peering_GenerateToken_EdgeCases(t *testing.T) {
 
 }
 
+func TestHTTP_Peering_Tokens(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	listTokens := func(t *testing.T) []*api.PeeringTokenInfo {
+		t.Helper()
+		req, err := http.NewRequest("GET", "/v1/peering/token", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, "expected 200, got %d: %v", resp.Code, resp.Body.String())
+
+		var tokens []*api.PeeringTokenInfo
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&tokens))
+		return tokens
+	}
+
+	require.Empty(t, listTokens(t))
+
+	req, err := http.NewRequest("POST", "/v1/peering/token",
+		bytes.NewReader([]byte(`{"PeerName": "peering-a", "ExpiresAfter": "1h"}`)))
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	a.srv.h.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code, "expected 200, got %d: %v", resp.Code, resp.Body.String())
+
+	t.Run("list", func(t *testing.T) {
+		tokens := listTokens(t)
+		require.Len(t, tokens, 1)
+		require.Equal(t, "peering-a", tokens[0].PeerName)
+		require.NotEmpty(t, tokens[0].PeeringID)
+		require.Equal(t, time.Hour, tokens[0].ExpiresAt.Sub(tokens[0].CreatedAt))
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		req, err := http.NewRequest("DELETE", "/v1/peering/token/peering-a", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, "expected 200, got %d: %v", resp.Code, resp.Body.String())
+
+		require.Empty(t, listTokens(t))
+	})
+
+	t.Run("revoke without outstanding token", func(t *testing.T) {
+		req, err := http.NewRequest("DELETE", "/v1/peering/token/peering-a", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		require.Contains(t, resp.Body.String(), "no outstanding peering token")
+	})
+}
+
 func TestHTTP_Peering_Establish(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
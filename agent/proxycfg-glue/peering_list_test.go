@@ -0,0 +1,76 @@
+package proxycfgglue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+	cachetype "github.com/hashicorp/consul/agent/cache-types"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/proto/pbpeering"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestServerPeeringList(t *testing.T) {
+	const index uint64 = 123
+
+	store := state.NewStateStore(nil)
+
+	require.NoError(t, store.PeeringWrite(index, &pbpeering.Peering{
+		ID:    testUUID(t),
+		Name:  "peer1",
+		State: pbpeering.PeeringState_ACTIVE,
+	}))
+
+	dataSource := ServerPeeringList(ServerDataSourceDeps{
+		GetStore:    func() Store { return store },
+		ACLResolver: newStaticResolver(acl.ManageAll()),
+	})
+
+	eventCh := make(chan proxycfg.UpdateEvent)
+	err := dataSource.Notify(context.Background(), &cachetype.PeeringListRequest{
+		Request: &pbpeering.PeeringListRequest{},
+	}, "", eventCh)
+	require.NoError(t, err)
+
+	testutil.RunStep(t, "initial state", func(t *testing.T) {
+		result := getEventResult[*pbpeering.PeeringListResponse](t, eventCh)
+		require.Equal(t, index, result.Index)
+		require.Len(t, result.Peerings, 1)
+		require.Equal(t, "peer1", result.Peerings[0].Name)
+	})
+
+	testutil.RunStep(t, "add a peering", func(t *testing.T) {
+		require.NoError(t, store.PeeringWrite(index+1, &pbpeering.Peering{
+			ID:                  testUUID(t),
+			Name:                "peer2",
+			PeerID:              testUUID(t),
+			PeerServerAddresses: []string{"10.0.0.1:8443"},
+		}))
+
+		result := getEventResult[*pbpeering.PeeringListResponse](t, eventCh)
+		require.Equal(t, index+1, result.Index)
+		require.Len(t, result.Peerings, 2)
+	})
+}
+
+func TestServerPeeringList_ACLEnforcement(t *testing.T) {
+	store := state.NewStateStore(nil)
+
+	dataSource := ServerPeeringList(ServerDataSourceDeps{
+		GetStore:    func() Store { return store },
+		ACLResolver: newStaticResolver(acl.DenyAll()),
+	})
+
+	eventCh := make(chan proxycfg.UpdateEvent)
+	err := dataSource.Notify(context.Background(), &cachetype.PeeringListRequest{
+		Request: &pbpeering.PeeringListRequest{},
+	}, "", eventCh)
+	require.NoError(t, err)
+
+	err = getEventError(t, eventCh)
+	require.True(t, acl.IsErrPermissionDenied(err))
+}
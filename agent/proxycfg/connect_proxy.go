@@ -225,21 +225,9 @@ func (s *handlerConnectProxy) initialize(ctx context.Context) (ConfigSnapshot, e
 					return snap, err
 				}
 
-				// Check whether a watch for this peer exists to avoid duplicates.
-				if ok := snap.ConnectProxy.UpstreamPeerTrustBundles.IsWatched(uid.Peer); !ok {
-					peerCtx, cancel := context.WithCancel(ctx)
-					if err := s.dataSources.TrustBundle.Notify(peerCtx, &cachetype.TrustBundleReadRequest{
-						Request: &pbpeering.TrustBundleReadRequest{
-							Name:      uid.Peer,
-							Partition: uid.PartitionOrDefault(),
-						},
-						QueryOptions: structs.QueryOptions{Token: s.token},
-					}, peerTrustBundleIDPrefix+uid.Peer, s.ch); err != nil {
-						cancel()
-						return snap, fmt.Errorf("error while watching trust bundle for peer %q: %w", uid.Peer, err)
-					}
-
-					snap.ConnectProxy.UpstreamPeerTrustBundles.InitWatch(uid.Peer, cancel)
+				err = (*handlerUpstreams)(s).watchPeerTrustBundle(ctx, &snap.ConnectProxy.ConfigSnapshotUpstreams, uid.Peer, uid.PartitionOrDefault())
+				if err != nil {
+					return snap, err
 				}
 				continue
 			}
@@ -280,16 +268,6 @@ func (s *handlerConnectProxy) handleUpdate(ctx context.Context, u UpdateEvent, s
 		}
 		snap.Roots = roots
 
-	case strings.HasPrefix(u.CorrelationID, peerTrustBundleIDPrefix):
-		resp, ok := u.Result.(*pbpeering.TrustBundleReadResponse)
-		if !ok {
-			return fmt.Errorf("invalid type for response: %T", u.Result)
-		}
-		peer := strings.TrimPrefix(u.CorrelationID, peerTrustBundleIDPrefix)
-		if resp.Bundle != nil {
-			snap.ConnectProxy.UpstreamPeerTrustBundles.Set(peer, resp.Bundle)
-		}
-
 	case u.CorrelationID == peeringTrustBundlesWatchID:
 		resp, ok := u.Result.(*pbpeering.TrustBundleListByServiceResponse)
 		if !ok {
@@ -346,21 +324,9 @@ func (s *handlerConnectProxy) handleUpdate(ctx context.Context, u UpdateEvent, s
 			}
 			snap.ConnectProxy.PeerUpstreamEndpoints.InitWatch(uid, hcancel)
 
-			// Check whether a watch for this peer exists to avoid duplicates.
-			if ok := snap.ConnectProxy.UpstreamPeerTrustBundles.IsWatched(uid.Peer); !ok {
-				peerCtx, cancel := context.WithCancel(ctx)
-				if err := s.dataSources.TrustBundle.Notify(peerCtx, &cachetype.TrustBundleReadRequest{
-					Request: &pbpeering.TrustBundleReadRequest{
-						Name:      uid.Peer,
-						Partition: uid.PartitionOrDefault(),
-					},
-					QueryOptions: structs.QueryOptions{Token: s.token},
-				}, peerTrustBundleIDPrefix+uid.Peer, s.ch); err != nil {
-					cancel()
-					return fmt.Errorf("error while watching trust bundle for peer %q: %w", uid.Peer, err)
-				}
-
-				snap.ConnectProxy.UpstreamPeerTrustBundles.InitWatch(uid.Peer, cancel)
+			err = (*handlerUpstreams)(s).watchPeerTrustBundle(ctx, &snap.ConnectProxy.ConfigSnapshotUpstreams, uid.Peer, uid.PartitionOrDefault())
+			if err != nil {
+				return err
 			}
 		}
 		snap.ConnectProxy.PeeredUpstreams = seenUpstreams
@@ -387,6 +353,15 @@ func (s *handlerConnectProxy) handleUpdate(ctx context.Context, u UpdateEvent, s
 			return true
 		})
 
+		// Peers can also be failover targets of discovery chains.
+		for _, chain := range snap.ConnectProxy.DiscoveryChain {
+			for _, target := range chain.Targets {
+				if target.Peer != "" {
+					validPeerNames[target.Peer] = struct{}{}
+				}
+			}
+		}
+
 		// Iterate through all known trust bundles and remove references to any unseen peer names
 		snap.ConnectProxy.UpstreamPeerTrustBundles.ForEachKey(func(peerName PeerName) bool {
 			if _, ok := validPeerNames[peerName]; !ok {
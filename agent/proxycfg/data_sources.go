@@ -80,6 +80,10 @@ type DataSources struct {
 	// notification channel.
 	PeeredUpstreams PeeredUpstreams
 
+	// PeeringList provides updates about the list of peerings of a partition
+	// on a notification channel.
+	PeeringList PeeringList
+
 	// PreparedQuery provides updates about the results of a prepared query.
 	PreparedQuery PreparedQuery
 
@@ -222,6 +226,12 @@ type TrustBundle interface {
 	Notify(ctx context.Context, req *cachetype.TrustBundleReadRequest, correlationID string, ch chan<- UpdateEvent) error
 }
 
+// PeeringList is the interface used to consume updates about the list of
+// peerings of a partition.
+type PeeringList interface {
+	Notify(ctx context.Context, req *cachetype.PeeringListRequest, correlationID string, ch chan<- UpdateEvent) error
+}
+
 // TrustBundleList is the interface used to consume updates about trust bundles
 // for peered clusters that the given proxy is exported to.
 type TrustBundleList interface {
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	cachetype "github.com/hashicorp/consul/agent/cache-types"
+	"github.com/hashicorp/consul/agent/proxycfg/internal/watch"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbpeering"
 )
 
 type handlerIngressGateway struct {
@@ -63,6 +65,7 @@ func (s *handlerIngressGateway) initialize(ctx context.Context) (ConfigSnapshot,
 	snap.IngressGateway.DiscoveryChain = make(map[UpstreamID]*structs.CompiledDiscoveryChain)
 	snap.IngressGateway.WatchedUpstreams = make(map[UpstreamID]map[string]context.CancelFunc)
 	snap.IngressGateway.WatchedUpstreamEndpoints = make(map[UpstreamID]map[string]structs.CheckServiceNodes)
+	snap.IngressGateway.UpstreamPeerTrustBundles = watch.NewMap[string, *pbpeering.PeeringTrustBundle]()
 	snap.IngressGateway.WatchedGateways = make(map[UpstreamID]map[string]context.CancelFunc)
 	snap.IngressGateway.WatchedGatewayEndpoints = make(map[UpstreamID]map[string]structs.CheckServiceNodes)
 	snap.IngressGateway.Listeners = make(map[IngressListenerKey]structs.IngressListener)
@@ -101,6 +104,10 @@ func (s *handlerIngressGateway) handleUpdate(ctx context.Context, u UpdateEvent,
 			snap.IngressGateway.Listeners[key] = l
 		}
 
+		if err := s.watchExtAuthzServices(ctx, snap, gatewayConf); err != nil {
+			return err
+		}
+
 		if err := s.watchIngressLeafCert(ctx, snap); err != nil {
 			return err
 		}
@@ -135,7 +142,12 @@ func (s *handlerIngressGateway) handleUpdate(ctx context.Context, u UpdateEvent,
 			}
 			watchedSvcs[uid] = struct{}{}
 
-			hosts = append(hosts, service.Hosts...)
+			// Hosts on TCP listeners are matched against the SNI of connections
+			// that are passed through to the service, which presents its own
+			// certificate, so the gateway's certificate must not include them.
+			if service.Protocol != "tcp" {
+				hosts = append(hosts, service.Hosts...)
+			}
 
 			id := IngressListenerKeyFromGWService(*service)
 			upstreamsMap[id] = append(upstreamsMap[id], u)
@@ -146,18 +158,7 @@ func (s *handlerIngressGateway) handleUpdate(ctx context.Context, u UpdateEvent,
 		snap.IngressGateway.Hosts = hosts
 		snap.IngressGateway.HostsSet = true
 
-		for uid, cancelFn := range snap.IngressGateway.WatchedDiscoveryChains {
-			if _, ok := watchedSvcs[uid]; !ok {
-				for targetID, cancelUpstreamFn := range snap.IngressGateway.WatchedUpstreams[uid] {
-					delete(snap.IngressGateway.WatchedUpstreams[uid], targetID)
-					delete(snap.IngressGateway.WatchedUpstreamEndpoints[uid], targetID)
-					cancelUpstreamFn()
-				}
-
-				cancelFn()
-				delete(snap.IngressGateway.WatchedDiscoveryChains, uid)
-			}
-		}
+		s.cancelUnusedDiscoveryChains(snap)
 
 		if err := s.watchIngressLeafCert(ctx, snap); err != nil {
 			return err
@@ -170,6 +171,70 @@ func (s *handlerIngressGateway) handleUpdate(ctx context.Context, u UpdateEvent,
 	return nil
 }
 
+// watchExtAuthzServices watches the discovery chain of each external
+// authorization service referenced by the gateway's listeners so that xDS can
+// generate clusters for them like any other upstream.
+func (s *handlerIngressGateway) watchExtAuthzServices(ctx context.Context, snap *ConfigSnapshot, conf *structs.IngressGatewayConfigEntry) error {
+	extAuthz := make(map[IngressListenerKey]structs.Upstream)
+	for _, l := range conf.Listeners {
+		if l.ExtAuthz == nil {
+			continue
+		}
+		u := makeExtAuthzUpstream(l.ExtAuthz)
+		uid := NewUpstreamID(&u)
+
+		watchOpts := discoveryChainWatchOpts{
+			id:         uid,
+			name:       u.DestinationName,
+			namespace:  u.DestinationNamespace,
+			partition:  u.DestinationPartition,
+			datacenter: s.source.Datacenter,
+			cfg:        reducedUpstreamConfig{Protocol: l.ExtAuthz.Protocol},
+		}
+		up := &handlerUpstreams{handlerState: s.handlerState}
+		if err := up.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
+			return fmt.Errorf("failed to watch discovery chain for %s: %v", uid, err)
+		}
+		extAuthz[IngressListenerKeyFromListener(l)] = u
+	}
+	snap.IngressGateway.ExtAuthzUpstreams = extAuthz
+
+	s.cancelUnusedDiscoveryChains(snap)
+	return nil
+}
+
+// cancelUnusedDiscoveryChains stops watching the discovery chains and
+// endpoints of upstreams the gateway no longer routes to or authorizes with.
+func (s *handlerIngressGateway) cancelUnusedDiscoveryChains(snap *ConfigSnapshot) {
+	for uid, cancelFn := range snap.IngressGateway.WatchedDiscoveryChains {
+		if snap.IngressGateway.isWatchedUpstream(uid) {
+			continue
+		}
+		for targetID, cancelUpstreamFn := range snap.IngressGateway.WatchedUpstreams[uid] {
+			delete(snap.IngressGateway.WatchedUpstreams[uid], targetID)
+			delete(snap.IngressGateway.WatchedUpstreamEndpoints[uid], targetID)
+			cancelUpstreamFn()
+		}
+
+		cancelFn()
+		delete(snap.IngressGateway.WatchedDiscoveryChains, uid)
+	}
+}
+
+// makeExtAuthzUpstream returns the upstream used to reach an external
+// authorization service. It has no local bind port as it is only referenced
+// from the listeners' HTTP filters.
+func makeExtAuthzUpstream(cfg *structs.IngressExtAuthzConfig) structs.Upstream {
+	return structs.Upstream{
+		DestinationName:      cfg.Service,
+		DestinationNamespace: cfg.NamespaceOrDefault(),
+		DestinationPartition: cfg.PartitionOrDefault(),
+		Config: map[string]interface{}{
+			"protocol": cfg.Protocol,
+		},
+	}
+}
+
 // Note: Ingress gateways are always bound to ports and never unix sockets.
 // This means LocalBindPort is the only possibility
 func makeUpstream(g *structs.GatewayService) structs.Upstream {
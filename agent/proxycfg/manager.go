@@ -75,6 +75,10 @@ type ManagerConfig struct {
 	// information to proxies that need to make intention decisions on their
 	// own.
 	IntentionDefaultAllow bool
+
+	// NodeMeta is the agent's node metadata. It is used to determine the
+	// locality of proxies that don't declare one in their own service meta.
+	NodeMeta map[string]string
 }
 
 // NewManager constructs a Manager.
@@ -137,6 +141,7 @@ func (m *Manager) Register(id ProxyID, ns *structs.NodeService, source ProxySour
 		source:                m.Source,
 		dnsConfig:             m.DNSConfig,
 		intentionDefaultAllow: m.IntentionDefaultAllow,
+		nodeMeta:              m.NodeMeta,
 	}
 	if m.TLSConfigurator != nil {
 		stateConfig.serverSNIFn = m.TLSConfigurator.ServerSNI
@@ -325,6 +325,10 @@ func (s *handlerMeshGateway) handleUpdate(ctx context.Context, u UpdateEvent, sn
 			return fmt.Errorf("invalid type for response: %T", u.Result)
 		}
 
+		if s.meta[structs.MetaWANFederationKey] != "1" && snap.MeshGateway.ConsulServersWatchCancel == nil {
+			return nil // ignore this update, it's stale
+		}
+
 		// Do some initial sanity checks to avoid doing something dumb.
 		for _, csn := range resp.Nodes {
 			if csn.Service.Service != structs.ConsulServiceName {
@@ -474,6 +478,21 @@ func (s *handlerMeshGateway) handleUpdate(ctx context.Context, u UpdateEvent, sn
 		}
 		snap.MeshGateway.MeshConfigSet = true
 
+		if err := s.updatePeeringWatches(ctx, snap); err != nil {
+			return err
+		}
+
+	case peeringListWatchID:
+		resp, ok := u.Result.(*pbpeering.PeeringListResponse)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+
+		if snap.MeshGateway.PeeringsWatchCancel == nil {
+			return nil // ignore this update, it's stale
+		}
+		snap.MeshGateway.Peerings = resp.Peerings
+
 	default:
 		switch {
 		case strings.HasPrefix(u.CorrelationID, "connect-service:"):
@@ -534,3 +553,55 @@ func (s *handlerMeshGateway) handleUpdate(ctx context.Context, u UpdateEvent, sn
 
 	return nil
 }
+
+// updatePeeringWatches starts or stops the watches needed to route the control
+// plane traffic of peerings depending on whether peering through mesh gateways
+// is enabled in the mesh config entry. Only the mesh gateways of the default
+// partition route this traffic since that is where the servers are.
+func (s *handlerMeshGateway) updatePeeringWatches(ctx context.Context, snap *ConfigSnapshot) error {
+	enabled := s.proxyID.InDefaultPartition() && snap.MeshGateway.MeshConfig.PeerThroughMeshGateways()
+
+	if !enabled {
+		if snap.MeshGateway.PeeringsWatchCancel != nil {
+			snap.MeshGateway.PeeringsWatchCancel()
+			snap.MeshGateway.PeeringsWatchCancel = nil
+			snap.MeshGateway.Peerings = nil
+		}
+		if snap.MeshGateway.ConsulServersWatchCancel != nil {
+			snap.MeshGateway.ConsulServersWatchCancel()
+			snap.MeshGateway.ConsulServersWatchCancel = nil
+			snap.MeshGateway.ConsulServers = nil
+		}
+		return nil
+	}
+
+	if snap.MeshGateway.PeeringsWatchCancel == nil {
+		ctx, cancel := context.WithCancel(ctx)
+		err := s.dataSources.PeeringList.Notify(ctx, &cachetype.PeeringListRequest{
+			Request:      &pbpeering.PeeringListRequest{Partition: s.proxyID.PartitionOrEmpty()},
+			QueryOptions: structs.QueryOptions{Token: s.token},
+		}, peeringListWatchID, s.ch)
+		if err != nil {
+			cancel()
+			return err
+		}
+		snap.MeshGateway.PeeringsWatchCancel = cancel
+	}
+
+	// The consul servers are already watched when WAN federation through mesh
+	// gateways is enabled.
+	if s.meta[structs.MetaWANFederationKey] != "1" && snap.MeshGateway.ConsulServersWatchCancel == nil {
+		ctx, cancel := context.WithCancel(ctx)
+		err := s.dataSources.Health.Notify(ctx, &structs.ServiceSpecificRequest{
+			Datacenter:   s.source.Datacenter,
+			QueryOptions: structs.QueryOptions{Token: s.token},
+			ServiceName:  structs.ConsulServiceName,
+		}, consulServerListWatchID, s.ch)
+		if err != nil {
+			cancel()
+			return err
+		}
+		snap.MeshGateway.ConsulServersWatchCancel = cancel
+	}
+	return nil
+}
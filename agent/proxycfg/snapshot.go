@@ -425,6 +425,20 @@ type configSnapshotMeshGateway struct {
 	// PeeringTrustBundlesSet indicates that the watch on the peer trust
 	// bundles has completed at least once.
 	PeeringTrustBundlesSet bool
+
+	// Peerings is the list of peerings of the partition whose control plane
+	// traffic is routed through this mesh gateway. It is only watched when
+	// peering through mesh gateways is enabled in the mesh config entry.
+	Peerings []*pbpeering.Peering
+
+	// PeeringsWatchCancel is a CancelFunc to use when peering through mesh
+	// gateways gets disabled.
+	PeeringsWatchCancel context.CancelFunc
+
+	// ConsulServersWatchCancel is a CancelFunc to use when peering through
+	// mesh gateways gets disabled. It is only set when the consul servers are
+	// not already watched for WAN federation.
+	ConsulServersWatchCancel context.CancelFunc
 }
 
 // MeshGatewayValidExportedServices ensures that the following data is present
@@ -609,6 +623,25 @@ type configSnapshotIngressGateway struct {
 	// Listeners is the original listener config from the ingress-gateway config
 	// entry to save us trying to pass fields through Upstreams
 	Listeners map[IngressListenerKey]structs.IngressListener
+
+	// ExtAuthzUpstreams maps listeners that delegate request authorization to
+	// an external service to the upstream used to reach that service. Their
+	// discovery chains and endpoints are watched alongside the routed upstreams.
+	ExtAuthzUpstreams map[IngressListenerKey]structs.Upstream
+}
+
+// isWatchedUpstream returns true if the gateway either routes traffic to the
+// upstream or uses it as an external authorization service.
+func (c *configSnapshotIngressGateway) isWatchedUpstream(uid UpstreamID) bool {
+	if _, ok := c.UpstreamsSet[uid]; ok {
+		return true
+	}
+	for _, u := range c.ExtAuthzUpstreams {
+		if NewUpstreamID(&u) == uid {
+			return true
+		}
+	}
+	return false
 }
 
 // isEmpty is a test helper
@@ -651,6 +684,7 @@ type ConfigSnapshot struct {
 	Address               string
 	Port                  int
 	ServiceMeta           map[string]string
+	NodeMeta              map[string]string
 	TaggedAddresses       map[string]structs.ServiceAddress
 	Proxy                 structs.ConnectProxyConfig
 	Datacenter            string
@@ -738,6 +772,8 @@ func (s *ConfigSnapshot) Clone() (*ConfigSnapshot, error) {
 	case structs.ServiceKindMeshGateway:
 		snap.MeshGateway.WatchedGateways = nil
 		snap.MeshGateway.WatchedServices = nil
+		snap.MeshGateway.PeeringsWatchCancel = nil
+		snap.MeshGateway.ConsulServersWatchCancel = nil
 	case structs.ServiceKindIngressGateway:
 		// common with connect-proxy and ingress-gateway
 		snap.IngressGateway.WatchedUpstreams = nil
@@ -783,6 +819,19 @@ func (s *ConfigSnapshot) RootPEMs() string {
 	return rootPEMs
 }
 
+// UpstreamPeerTrustBundle returns the trust bundle of a peer that the
+// upstreams of a connect proxy or ingress gateway are imported from.
+func (s *ConfigSnapshot) UpstreamPeerTrustBundle(peer string) (*pbpeering.PeeringTrustBundle, bool) {
+	switch s.Kind {
+	case structs.ServiceKindConnectProxy:
+		return s.ConnectProxy.UpstreamPeerTrustBundles.Get(peer)
+	case structs.ServiceKindIngressGateway:
+		return s.IngressGateway.UpstreamPeerTrustBundles.Get(peer)
+	default:
+		return nil, false
+	}
+}
+
 func (s *ConfigSnapshot) MeshConfig() *structs.MeshConfigEntry {
 	switch s.Kind {
 	case structs.ServiceKindConnectProxy:
@@ -806,6 +855,12 @@ func (s *ConfigSnapshot) MeshConfigTLSIncoming() *structs.MeshDirectionalTLSConf
 	return mesh.TLS.Incoming
 }
 
+// ProxyLocality returns the region and zone of the proxy as determined by the
+// mesh locality config. It is empty if no locality config is set.
+func (s *ConfigSnapshot) ProxyLocality() structs.Locality {
+	return s.MeshConfig().LocalityConfig().Locality(s.ServiceMeta, s.NodeMeta)
+}
+
 func (s *ConfigSnapshot) MeshConfigTLSOutgoing() *structs.MeshDirectionalTLSConfig {
 	mesh := s.MeshConfig()
 	if mesh == nil || mesh.TLS == nil {
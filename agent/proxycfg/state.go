@@ -26,6 +26,7 @@ const (
 	serviceListWatchID                 = "service-list"
 	federationStateListGatewaysWatchID = "federation-state-list-mesh-gateways"
 	consulServerListWatchID            = "consul-server-list"
+	peeringListWatchID                 = "peering-list"
 	datacentersWatchID                 = "datacenters"
 	serviceResolversWatchID            = "service-resolvers"
 	gatewayServicesWatchID             = "gateway-services"
@@ -55,6 +56,7 @@ type stateConfig struct {
 	dnsConfig             DNSConfig
 	serverSNIFn           ServerSNIFunc
 	intentionDefaultAllow bool
+	nodeMeta              map[string]string
 }
 
 // state holds all the state needed to maintain the config for a registered
@@ -272,6 +274,7 @@ func newConfigSnapshotFromServiceInstance(s serviceInstance, config stateConfig)
 		Address:               s.address,
 		Port:                  s.port,
 		ServiceMeta:           s.meta,
+		NodeMeta:              config.nodeMeta,
 		TaggedAddresses:       s.taggedAddresses,
 		Proxy:                 s.proxyCfg,
 		Datacenter:            config.source.Datacenter,
@@ -411,8 +411,10 @@ func TestState_WatchesAndUpdates(t *testing.T) {
 		billing = structs.NewServiceName("billing", nil)
 		api     = structs.NewServiceName("api", nil)
 		apiA    = structs.NewServiceName("api-a", nil)
+		authz   = structs.NewServiceName("authz", nil)
 
 		apiUID    = NewUpstreamIDFromServiceName(api)
+		authzUID  = NewUpstreamIDFromServiceName(authz)
 		dbUID     = NewUpstreamIDFromServiceName(db)
 		pqUID     = UpstreamIDFromString("prepared_query:query")
 		extApiUID = NewUpstreamIDFromServiceName(apiA)
@@ -1222,6 +1224,113 @@ func TestState_WatchesAndUpdates(t *testing.T) {
 				},
 			},
 		},
+		"ingress-gateway-with-ext-authz": {
+			ns: structs.NodeService{
+				Kind:    structs.ServiceKindIngressGateway,
+				ID:      "ingress-gateway",
+				Service: "ingress-gateway",
+				Address: "10.0.1.1",
+			},
+			sourceDC: "dc1",
+			stages: []verificationStage{
+				{
+					requiredWatches: map[string]verifyWatchRequest{
+						meshConfigEntryID:      genVerifyMeshConfigWatch("dc1"),
+						gatewayConfigWatchID:   genVerifyConfigEntryWatch(structs.IngressGateway, "ingress-gateway", "dc1"),
+						rootsWatchID:           genVerifyDCSpecificWatch("dc1"),
+						gatewayServicesWatchID: genVerifyGatewayServiceWatch("ingress-gateway", "dc1"),
+					},
+					events: []UpdateEvent{
+						rootWatchEvent(),
+						{
+							CorrelationID: meshConfigEntryID,
+							Result:        &structs.ConfigEntryResponse{},
+						},
+						{
+							CorrelationID: gatewayConfigWatchID,
+							Result: &structs.ConfigEntryResponse{
+								Entry: &structs.IngressGatewayConfigEntry{
+									Listeners: []structs.IngressListener{
+										{
+											Port:     9999,
+											Protocol: "http",
+											ExtAuthz: &structs.IngressExtAuthzConfig{
+												Service:  "authz",
+												Protocol: "grpc",
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							CorrelationID: gatewayServicesWatchID,
+							Result: &structs.IndexedGatewayServices{
+								Services: structs.GatewayServices{
+									{
+										Gateway:  structs.NewServiceName("ingress-gateway", nil),
+										Service:  api,
+										Port:     9999,
+										Protocol: "http",
+									},
+								},
+							},
+						},
+					},
+					verifySnapshot: func(t testing.TB, snap *ConfigSnapshot) {
+						require.Len(t, snap.IngressGateway.ExtAuthzUpstreams, 1)
+						require.Len(t, snap.IngressGateway.WatchedDiscoveryChains, 2)
+						require.Contains(t, snap.IngressGateway.WatchedDiscoveryChains, apiUID)
+						require.Contains(t, snap.IngressGateway.WatchedDiscoveryChains, authzUID)
+					},
+				},
+				{
+					requiredWatches: map[string]verifyWatchRequest{
+						"discovery-chain:" + authzUID.String(): genVerifyDiscoveryChainWatch(&structs.DiscoveryChainRequest{
+							Name:                 "authz",
+							EvaluateInDatacenter: "dc1",
+							EvaluateInNamespace:  "default",
+							EvaluateInPartition:  "default",
+							Datacenter:           "dc1",
+							OverrideProtocol:     "grpc",
+						}),
+					},
+					events: []UpdateEvent{
+						{
+							CorrelationID: "discovery-chain:" + authzUID.String(),
+							Result: &structs.DiscoveryChainResponse{
+								Chain: discoverychain.TestCompileConfigEntries(t, "authz", "default", "default", "dc1", "trustdomain.consul",
+									func(req *discoverychain.CompileRequest) {
+										req.OverrideProtocol = "grpc"
+									}),
+							},
+						},
+						{
+							// The gateway no longer routes to any services but
+							// still needs its authorization service.
+							CorrelationID: gatewayServicesWatchID,
+							Result:        &structs.IndexedGatewayServices{},
+						},
+					},
+					verifySnapshot: func(t testing.TB, snap *ConfigSnapshot) {
+						require.Contains(t, snap.IngressGateway.DiscoveryChain, authzUID)
+						require.Len(t, snap.IngressGateway.WatchedUpstreams[authzUID], 1)
+						require.Len(t, snap.IngressGateway.WatchedDiscoveryChains, 1)
+						require.Contains(t, snap.IngressGateway.WatchedDiscoveryChains, authzUID)
+					},
+				},
+				{
+					events: []UpdateEvent{
+						ingressConfigWatchEvent(false, false),
+					},
+					verifySnapshot: func(t testing.TB, snap *ConfigSnapshot) {
+						require.Empty(t, snap.IngressGateway.ExtAuthzUpstreams)
+						require.Empty(t, snap.IngressGateway.WatchedDiscoveryChains)
+						require.Empty(t, snap.IngressGateway.WatchedUpstreams[authzUID])
+					},
+				},
+			},
+		},
 		"ingress-gateway-with-mixed-tls": {
 			ns: structs.NodeService{
 				Kind:    structs.ServiceKindIngressGateway,
@@ -342,6 +342,52 @@ func TestUpstreamNodesAlternate(t testing.T) structs.CheckServiceNodes {
 	}
 }
 
+// TestUpstreamNodesPeerCluster01 returns instances of db imported from the
+// cluster-01 peer. Their addresses are those of the peer's mesh gateways.
+func TestUpstreamNodesPeerCluster01(t testing.T) structs.CheckServiceNodes {
+	peerTrustDomain := "1c053652-8512-4373-90cf-5a7f6263a994.consul"
+	service := func(address string) *structs.NodeService {
+		return &structs.NodeService{
+			ID:       "db",
+			Service:  "db",
+			PeerName: "cluster-01",
+			Address:  address,
+			Port:     8443,
+			Connect: structs.ServiceConnect{
+				PeerMeta: &structs.PeeringServiceMeta{
+					SNI: []string{
+						"db.default.default.cluster-01.external." + peerTrustDomain,
+					},
+					SpiffeID: []string{
+						"spiffe://" + peerTrustDomain + "/ns/default/dc/cluster-01-dc/svc/db",
+					},
+					Protocol: "tcp",
+				},
+			},
+		}
+	}
+	return structs.CheckServiceNodes{
+		structs.CheckServiceNode{
+			Node: &structs.Node{
+				ID:       "test1",
+				Node:     "test1",
+				Address:  "10.40.1.1",
+				PeerName: "cluster-01",
+			},
+			Service: service("10.40.1.1"),
+		},
+		structs.CheckServiceNode{
+			Node: &structs.Node{
+				ID:       "test2",
+				Node:     "test2",
+				Address:  "10.40.1.2",
+				PeerName: "cluster-01",
+			},
+			Service: service("10.40.1.2"),
+		},
+	}
+}
+
 func TestGatewayNodesDC1(t testing.T) structs.CheckServiceNodes {
 	return structs.CheckServiceNodes{
 		structs.CheckServiceNode{
@@ -748,6 +794,7 @@ func testConfigSnapshotFixture(
 			InternalServiceDump:             &noopDataSource[*structs.ServiceDumpRequest]{},
 			LeafCertificate:                 &noopDataSource[*cachetype.ConnectCALeafRequest]{},
 			PeeredUpstreams:                 &noopDataSource[*structs.PartitionSpecificRequest]{},
+			PeeringList:                     &noopDataSource[*cachetype.PeeringListRequest]{},
 			PreparedQuery:                   &noopDataSource[*structs.PreparedQueryExecuteRequest]{},
 			ResolvedServiceConfig:           &noopDataSource[*structs.ServiceConfigRequest]{},
 			ServiceList:                     &noopDataSource[*structs.DCSpecificRequest]{},
@@ -951,6 +998,7 @@ func NewTestDataSources() *TestDataSources {
 		IntentionUpstreamsDestination:   NewTestDataSource[*structs.ServiceSpecificRequest, *structs.IndexedServiceList](),
 		InternalServiceDump:             NewTestDataSource[*structs.ServiceDumpRequest, *structs.IndexedNodesWithGateways](),
 		LeafCertificate:                 NewTestDataSource[*cachetype.ConnectCALeafRequest, *structs.IssuedCert](),
+		PeeringList:                     NewTestDataSource[*cachetype.PeeringListRequest, *pbpeering.PeeringListResponse](),
 		PreparedQuery:                   NewTestDataSource[*structs.PreparedQueryExecuteRequest, *structs.PreparedQueryExecuteResponse](),
 		ResolvedServiceConfig:           NewTestDataSource[*structs.ServiceConfigRequest, *structs.ServiceConfigResponse](),
 		ServiceList:                     NewTestDataSource[*structs.DCSpecificRequest, *structs.IndexedServiceList](),
@@ -978,6 +1026,7 @@ type TestDataSources struct {
 	InternalServiceDump             *TestDataSource[*structs.ServiceDumpRequest, *structs.IndexedNodesWithGateways]
 	LeafCertificate                 *TestDataSource[*cachetype.ConnectCALeafRequest, *structs.IssuedCert]
 	PeeredUpstreams                 *TestDataSource[*structs.PartitionSpecificRequest, *structs.IndexedPeeredServiceList]
+	PeeringList                     *TestDataSource[*cachetype.PeeringListRequest, *pbpeering.PeeringListResponse]
 	PreparedQuery                   *TestDataSource[*structs.PreparedQueryExecuteRequest, *structs.PreparedQueryExecuteResponse]
 	ResolvedServiceConfig           *TestDataSource[*structs.ServiceConfigRequest, *structs.ServiceConfigResponse]
 	ServiceList                     *TestDataSource[*structs.DCSpecificRequest, *structs.IndexedServiceList]
@@ -1004,6 +1053,7 @@ func (t *TestDataSources) ToDataSources() DataSources {
 		InternalServiceDump:           t.InternalServiceDump,
 		LeafCertificate:               t.LeafCertificate,
 		PeeredUpstreams:               t.PeeredUpstreams,
+		PeeringList:                   t.PeeringList,
 		PreparedQuery:                 t.PreparedQuery,
 		ResolvedServiceConfig:         t.ResolvedServiceConfig,
 		ServiceList:                   t.ServiceList,
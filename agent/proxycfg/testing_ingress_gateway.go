@@ -645,6 +645,81 @@ func TestConfigSnapshotIngressGateway_MixedListeners(t testing.T) *ConfigSnapsho
 	})
 }
 
+func TestConfigSnapshotIngressGateway_TCPSNIPassthrough(t testing.T) *ConfigSnapshot {
+	var (
+		db1      = structs.NewServiceName("db1", nil)
+		db1UID   = NewUpstreamIDFromServiceName(db1)
+		db1Chain = discoverychain.TestCompileConfigEntries(t, "db1", "default", "default", "dc1", connect.TestClusterID+".consul", nil)
+
+		db2      = structs.NewServiceName("db2", nil)
+		db2UID   = NewUpstreamIDFromServiceName(db2)
+		db2Chain = discoverychain.TestCompileConfigEntries(t, "db2", "default", "default", "dc1", connect.TestClusterID+".consul", nil)
+	)
+
+	return TestConfigSnapshotIngressGateway(t, false, "tcp", "default", nil, func(entry *structs.IngressGatewayConfigEntry) {
+		entry.Listeners = []structs.IngressListener{
+			{
+				Port:     8080,
+				Protocol: "tcp",
+				Services: []structs.IngressService{
+					{
+						Name:  "db1",
+						Hosts: []string{"db1.example.com", "*.db1.example.com"},
+					},
+					{
+						Name:  "db2",
+						Hosts: []string{"db2.example.com"},
+					},
+				},
+			},
+		}
+	}, []UpdateEvent{
+		{
+			CorrelationID: gatewayServicesWatchID,
+			Result: &structs.IndexedGatewayServices{
+				Services: []*structs.GatewayService{
+					{
+						Service:  db1,
+						Port:     8080,
+						Protocol: "tcp",
+						Hosts:    []string{"db1.example.com", "*.db1.example.com"},
+					},
+					{
+						Service:  db2,
+						Port:     8080,
+						Protocol: "tcp",
+						Hosts:    []string{"db2.example.com"},
+					},
+				},
+			},
+		},
+		{
+			CorrelationID: "discovery-chain:" + db1UID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: db1Chain,
+			},
+		},
+		{
+			CorrelationID: "upstream-target:" + db1Chain.ID() + ":" + db1UID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodes(t, "db1"),
+			},
+		},
+		{
+			CorrelationID: "discovery-chain:" + db2UID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: db2Chain,
+			},
+		},
+		{
+			CorrelationID: "upstream-target:" + db2Chain.ID() + ":" + db2UID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodes(t, "db2"),
+			},
+		},
+	})
+}
+
 func TestConfigSnapshotIngress_HTTPMultipleServices(t testing.T) *ConfigSnapshot {
 	// We do not add baz/qux here so that we test the chain.IsDefault() case
 	entries := []structs.ConfigEntry{
@@ -800,6 +875,166 @@ func TestConfigSnapshotIngress_HTTPMultipleServices(t testing.T) *ConfigSnapshot
 	})
 }
 
+func TestConfigSnapshotIngress_JWTAndExtAuthz(t testing.T) *ConfigSnapshot {
+	entries := []structs.ConfigEntry{
+		&structs.ProxyConfigEntry{
+			Kind: structs.ProxyDefaults,
+			Name: structs.ProxyConfigGlobal,
+			Config: map[string]interface{}{
+				"protocol": "http",
+			},
+		},
+	}
+
+	var (
+		foo      = structs.NewServiceName("foo", nil)
+		fooUID   = NewUpstreamIDFromServiceName(foo)
+		fooChain = discoverychain.TestCompileConfigEntries(t, "foo", "default", "default", "dc1", connect.TestClusterID+".consul", nil, entries...)
+
+		bar      = structs.NewServiceName("bar", nil)
+		barUID   = NewUpstreamIDFromServiceName(bar)
+		barChain = discoverychain.TestCompileConfigEntries(t, "bar", "default", "default", "dc1", connect.TestClusterID+".consul", nil, entries...)
+
+		baz      = structs.NewServiceName("baz", nil)
+		bazUID   = NewUpstreamIDFromServiceName(baz)
+		bazChain = discoverychain.TestCompileConfigEntries(t, "baz", "default", "default", "dc1", connect.TestClusterID+".consul", nil, entries...)
+
+		authz      = structs.NewServiceName("authz", nil)
+		authzUID   = NewUpstreamIDFromServiceName(authz)
+		authzChain = discoverychain.TestCompileConfigEntries(t, "authz", "default", "default", "dc1", connect.TestClusterID+".consul",
+			func(req *discoverychain.CompileRequest) {
+				req.OverrideProtocol = "grpc"
+			}, entries...)
+	)
+
+	oidc := structs.IngressJWTProvider{
+		Name:      "oidc",
+		Issuer:    "https://login.example.com/",
+		Audiences: []string{"ingress"},
+		JSONWebKeySet: &structs.IngressJWKS{
+			Remote: &structs.IngressRemoteJWKS{
+				URI:           "https://login.example.com/.well-known/jwks.json",
+				ClusterName:   "oidc_jwks",
+				CacheDuration: 10 * time.Minute,
+			},
+		},
+		ClaimsToHeaders: []structs.IngressJWTClaimToHeader{
+			{Claim: "sub", Header: "X-User"},
+		},
+	}
+	partner := structs.IngressJWTProvider{
+		Name: "partner",
+		JSONWebKeySet: &structs.IngressJWKS{
+			Local: &structs.IngressLocalJWKS{Filename: "/etc/envoy/partner-jwks.json"},
+		},
+		ForwardToken: true,
+	}
+
+	return TestConfigSnapshotIngressGateway(t, false, "http", "default", nil, func(entry *structs.IngressGatewayConfigEntry) {
+		entry.Listeners = []structs.IngressListener{
+			{
+				Port:     8080,
+				Protocol: "http",
+				JWT: &structs.IngressJWTConfig{
+					Providers: []structs.IngressJWTProvider{oidc},
+				},
+				ExtAuthz: &structs.IngressExtAuthzConfig{
+					Service:  "authz",
+					Protocol: "grpc",
+					Timeout:  time.Second,
+				},
+				Services: []structs.IngressService{
+					{Name: "foo"},
+					{
+						Name: "bar",
+						JWT: &structs.IngressJWTConfig{
+							Providers: []structs.IngressJWTProvider{oidc, partner},
+						},
+					},
+				},
+			},
+			{
+				Port:     443,
+				Protocol: "http",
+				Services: []structs.IngressService{
+					{Name: "baz"},
+				},
+			},
+		}
+	}, []UpdateEvent{
+		{
+			CorrelationID: gatewayServicesWatchID,
+			Result: &structs.IndexedGatewayServices{
+				Services: []*structs.GatewayService{
+					{
+						Service:  foo,
+						Port:     8080,
+						Protocol: "http",
+					},
+					{
+						Service:  bar,
+						Port:     8080,
+						Protocol: "http",
+					},
+					{
+						Service:  baz,
+						Port:     443,
+						Protocol: "http",
+					},
+				},
+			},
+		},
+		{
+			CorrelationID: "discovery-chain:" + fooUID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: fooChain,
+			},
+		},
+		{
+			CorrelationID: "upstream-target:" + fooChain.ID() + ":" + fooUID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodes(t, "foo"),
+			},
+		},
+		{
+			CorrelationID: "discovery-chain:" + barUID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: barChain,
+			},
+		},
+		{
+			CorrelationID: "upstream-target:" + barChain.ID() + ":" + barUID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodes(t, "bar"),
+			},
+		},
+		{
+			CorrelationID: "discovery-chain:" + bazUID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: bazChain,
+			},
+		},
+		{
+			CorrelationID: "upstream-target:" + bazChain.ID() + ":" + bazUID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodes(t, "baz"),
+			},
+		},
+		{
+			CorrelationID: "discovery-chain:" + authzUID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: authzChain,
+			},
+		},
+		{
+			CorrelationID: "upstream-target:" + authzChain.ID() + ":" + authzUID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodes(t, "authz"),
+			},
+		},
+	})
+}
+
 func TestConfigSnapshotIngress_GRPCMultipleServices(t testing.T) *ConfigSnapshot {
 	// We do not add baz/qux here so that we test the chain.IsDefault() case
 	entries := []structs.ConfigEntry{
@@ -631,6 +631,88 @@ func TestConfigSnapshotPeeredMeshGateway(t testing.T, variant string, nsFn func(
 				},
 			},
 		)
+	case "peer-through-mesh-gateways":
+		extraUpdates = append(extraUpdates,
+			UpdateEvent{
+				CorrelationID: meshConfigEntryID,
+				Result: &structs.ConfigEntryResponse{
+					Entry: &structs.MeshConfigEntry{
+						Peering: &structs.PeeringMeshConfig{
+							PeerThroughMeshGateways: true,
+						},
+					},
+				},
+			},
+			UpdateEvent{
+				CorrelationID: peeringListWatchID,
+				Result: &pbpeering.PeeringListResponse{
+					Peerings: []*pbpeering.Peering{
+						{
+							Name:   "peer-a",
+							ID:     "6d942ff2-6a78-46f4-a52f-915e26c48797",
+							PeerID: "f8e3a4d0-28a2-4b49-9b57-2a4b6a3c1d8e",
+							State:  pbpeering.PeeringState_ACTIVE,
+						},
+						{
+							Name:   "peer-b",
+							ID:     "a2cf2ff0-4a2b-4a8a-9b17-0d2b6a1f6c07",
+							PeerID: "c61bc9cb-45cc-4b4f-a45b-1d4c6b9b6e3f",
+							State:  pbpeering.PeeringState_ACTIVE,
+							PeerServerAddresses: []string{
+								"10.40.1.1:8443",
+								"10.40.1.2:8443",
+							},
+						},
+						{
+							Name:                "peer-c",
+							ID:                  "ba3fd1e4-0b3a-4c5e-95b2-06c3b6fd3d07",
+							PeerID:              "0b1c3ab1-7c53-4a4e-8a46-3e3b43d2f6e1",
+							State:               pbpeering.PeeringState_ACTIVE,
+							PeerServerAddresses: []string{"gateway.peer-c.example.com:443"},
+						},
+						{
+							Name:   "peer-terminated",
+							ID:     "3d5f6c2e-6e4f-4b5b-8d8f-6b3a5e4c2d1f",
+							PeerID: "7e2b1c4d-9a8b-4c7d-8e6f-5a4b3c2d1e0f",
+							State:  pbpeering.PeeringState_TERMINATED,
+						},
+					},
+				},
+			},
+			UpdateEvent{
+				CorrelationID: consulServerListWatchID,
+				Result: &structs.IndexedCheckServiceNodes{
+					Nodes: structs.CheckServiceNodes{
+						{
+							Node: &structs.Node{Node: "server-1", Datacenter: "dc1", Address: "10.0.1.1"},
+							Service: &structs.NodeService{
+								ID:      structs.ConsulServiceID,
+								Service: structs.ConsulServiceName,
+								Port:    8300,
+								Meta:    map[string]string{"grpc_port": "8502"},
+							},
+						},
+						{
+							Node: &structs.Node{Node: "server-2", Datacenter: "dc1", Address: "10.0.1.2"},
+							Service: &structs.NodeService{
+								ID:      structs.ConsulServiceID,
+								Service: structs.ConsulServiceName,
+								Port:    8300,
+								Meta:    map[string]string{"grpc_port": "8502"},
+							},
+						},
+						{
+							Node: &structs.Node{Node: "server-3", Datacenter: "dc1", Address: "10.0.1.3"},
+							Service: &structs.NodeService{
+								ID:      structs.ConsulServiceID,
+								Service: structs.ConsulServiceName,
+								Port:    8300,
+							},
+						},
+					},
+				},
+			},
+		)
 
 	default:
 		t.Fatalf("unknown variant: %s", variant)
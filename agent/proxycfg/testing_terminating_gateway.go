@@ -1,6 +1,8 @@
 package proxycfg
 
 import (
+	"time"
+
 	"github.com/mitchellh/go-testing-interface"
 
 	"github.com/hashicorp/consul/agent/structs"
@@ -723,6 +725,30 @@ func TestConfigSnapshotTerminatingGatewaySNI(t testing.T) *ConfigSnapshot {
 	})
 }
 
+func TestConfigSnapshotTerminatingGatewayHostnameDNSConfig(t testing.T) *ConfigSnapshot {
+	return TestConfigSnapshotTerminatingGateway(t, true, nil, []UpdateEvent{
+		{
+			CorrelationID: "gateway-services",
+			Result: &structs.IndexedGatewayServices{
+				Services: []*structs.GatewayService{
+					{
+						Service: structs.NewServiceName("web", nil),
+					},
+					{
+						Service: structs.NewServiceName("api", nil),
+						DNS: &structs.LinkedServiceDNSConfig{
+							DiscoveryType: "strict_dns",
+							RefreshRate:   time.Minute,
+							RespectTTL:    true,
+							LookupFamily:  "v4_preferred",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestConfigSnapshotTerminatingGatewayHTTP2(t testing.T) *ConfigSnapshot {
 	web := structs.NewServiceName("web", nil)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul/discoverychain"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbpeering"
 )
 
 func setupTestVariationConfigEntriesAndSnapshot(
@@ -153,6 +154,27 @@ func setupTestVariationConfigEntriesAndSnapshot(
 				Nodes: TestGatewayNodesDC1(t),
 			},
 		})
+	case "failover-to-cluster-peer-triggered":
+		events = append(events, UpdateEvent{
+			CorrelationID: "upstream-target:db.default.default.dc1:" + dbUID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodesInStatus(t, "critical"),
+			},
+		})
+		fallthrough
+	case "failover-to-cluster-peer":
+		events = append(events, UpdateEvent{
+			CorrelationID: "upstream-target:db.default.default.external.cluster-01:" + dbUID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: TestUpstreamNodesPeerCluster01(t),
+			},
+		})
+		events = append(events, UpdateEvent{
+			CorrelationID: peerTrustBundleIDPrefix + "cluster-01",
+			Result: &pbpeering.TrustBundleReadResponse{
+				Bundle: TestPeerTrustBundles(t).Bundles[0],
+			},
+		})
 	case "splitter-with-resolver-redirect-multidc":
 		events = append(events, UpdateEvent{
 			CorrelationID: "upstream-target:v1.db.default.default.dc1:" + dbUID.String(),
@@ -321,6 +343,27 @@ func setupTestVariationDiscoveryChain(
 				},
 			},
 		)
+	case "failover-to-cluster-peer-triggered":
+		fallthrough
+	case "failover-to-cluster-peer":
+		entries = append(entries,
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				ConnectTimeout: 33 * time.Second,
+				Failover: map[string]structs.ServiceResolverFailover{
+					"*": {
+						SamenessGroup: "group",
+					},
+				},
+			},
+			&structs.SamenessGroupConfigEntry{
+				Name: "group",
+				Members: []structs.SamenessGroupMember{
+					{Peer: "cluster-01"},
+				},
+			},
+		)
 	case "splitter-with-resolver-redirect-multidc":
 		entries = append(entries,
 			&structs.ProxyConfigEntry{
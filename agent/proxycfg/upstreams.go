@@ -9,7 +9,9 @@ import (
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/hashicorp/consul/acl"
+	cachetype "github.com/hashicorp/consul/agent/cache-types"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/pbpeering"
 )
 
 type handlerUpstreams struct {
@@ -61,7 +63,7 @@ func (s *handlerUpstreams) handleUpdateUpstreams(ctx context.Context, u UpdateEv
 
 		switch snap.Kind {
 		case structs.ServiceKindIngressGateway:
-			if _, ok := snap.IngressGateway.UpstreamsSet[uid]; !ok {
+			if !snap.IngressGateway.isWatchedUpstream(uid) {
 				// Discovery chain is not associated with a known explicit or implicit upstream so it is purged/skipped.
 				// The associated watch was likely cancelled.
 				delete(upstreamsSnapshot.DiscoveryChain, uid)
@@ -89,6 +91,16 @@ func (s *handlerUpstreams) handleUpdateUpstreams(ctx context.Context, u UpdateEv
 			return err
 		}
 
+	case strings.HasPrefix(u.CorrelationID, peerTrustBundleIDPrefix):
+		resp, ok := u.Result.(*pbpeering.TrustBundleReadResponse)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+		peer := strings.TrimPrefix(u.CorrelationID, peerTrustBundleIDPrefix)
+		if resp.Bundle != nil {
+			upstreamsSnapshot.UpstreamPeerTrustBundles.Set(peer, resp.Bundle)
+		}
+
 	case strings.HasPrefix(u.CorrelationID, upstreamPeerWatchIDPrefix):
 		resp, ok := u.Result.(*structs.IndexedCheckServiceNodes)
 		if !ok {
@@ -268,6 +280,27 @@ func (s *handlerUpstreams) resetWatchesFromChain(
 			watchedChainEndpoints = true
 		}
 
+		if target.Peer != "" {
+			// Services imported from peers are queried in the local
+			// datacenter and are always dialed through the mesh gateways of
+			// the peer, which are the endpoints of the imported service.
+			opts := targetWatchOpts{
+				upstreamID: uid,
+				chainID:    target.ID,
+				service:    target.Service,
+				datacenter: s.source.Datacenter,
+				peer:       target.Peer,
+				entMeta:    target.GetEnterpriseMetadata(),
+			}
+			if err := s.watchUpstreamTarget(ctx, snap, opts); err != nil {
+				return fmt.Errorf("failed to watch target %q for upstream %q", target.ID, uid)
+			}
+			if err := s.watchPeerTrustBundle(ctx, snap, target.Peer, uid.PartitionOrDefault()); err != nil {
+				return err
+			}
+			continue
+		}
+
 		opts := targetWatchOpts{
 			upstreamID: uid,
 			chainID:    target.ID,
@@ -384,6 +417,7 @@ type targetWatchOpts struct {
 	service    string
 	filter     string
 	datacenter string
+	peer       string
 	entMeta    *acl.EnterpriseMeta
 }
 
@@ -401,7 +435,7 @@ func (s *handlerUpstreams) watchUpstreamTarget(ctx context.Context, snap *Config
 
 	ctx, cancel := context.WithCancel(ctx)
 	err := s.dataSources.Health.Notify(ctx, &structs.ServiceSpecificRequest{
-		PeerName:   opts.upstreamID.Peer,
+		PeerName:   opts.peer,
 		Datacenter: opts.datacenter,
 		QueryOptions: structs.QueryOptions{
 			Token:  s.token,
@@ -425,6 +459,29 @@ func (s *handlerUpstreams) watchUpstreamTarget(ctx context.Context, snap *Config
 	return nil
 }
 
+// watchPeerTrustBundle watches the trust bundle of the peer unless it is
+// already watched. Trust bundles are shared by all upstreams of the peer.
+func (s *handlerUpstreams) watchPeerTrustBundle(ctx context.Context, snap *ConfigSnapshotUpstreams, peer, partition string) error {
+	if ok := snap.UpstreamPeerTrustBundles.IsWatched(peer); ok {
+		return nil
+	}
+
+	peerCtx, cancel := context.WithCancel(ctx)
+	if err := s.dataSources.TrustBundle.Notify(peerCtx, &cachetype.TrustBundleReadRequest{
+		Request: &pbpeering.TrustBundleReadRequest{
+			Name:      peer,
+			Partition: partition,
+		},
+		QueryOptions: structs.QueryOptions{Token: s.token},
+	}, peerTrustBundleIDPrefix+peer, s.ch); err != nil {
+		cancel()
+		return fmt.Errorf("error while watching trust bundle for peer %q: %w", peer, err)
+	}
+
+	snap.UpstreamPeerTrustBundles.InitWatch(peer, cancel)
+	return nil
+}
+
 type discoveryChainWatchOpts struct {
 	id          UpstreamID
 	name        string
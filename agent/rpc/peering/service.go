@@ -148,6 +148,11 @@ type Backend interface {
 
 	CheckPeeringUUID(id string) (bool, error)
 	PeeringWrite(req *pbpeering.PeeringWriteRequest) error
+	PeeringSecretsWrite(req *pbpeering.PeeringSecretsWriteRequest) error
+
+	// ExchangeSecret exchanges the establishment secret from a peering token
+	// with the accepting peer for the secret used to open replication streams.
+	ExchangeSecret(ctx context.Context, peering *pbpeering.Peering, establishmentSecret string) (string, error)
 
 	Store() Store
 }
@@ -157,6 +162,8 @@ type Store interface {
 	PeeringRead(ws memdb.WatchSet, q state.Query) (uint64, *pbpeering.Peering, error)
 	PeeringReadByID(ws memdb.WatchSet, id string) (uint64, *pbpeering.Peering, error)
 	PeeringList(ws memdb.WatchSet, entMeta acl.EnterpriseMeta) (uint64, []*pbpeering.Peering, error)
+	PeeringSecretsRead(ws memdb.WatchSet, peeringID string) (*pbpeering.PeeringSecrets, error)
+	PeeringStreamStatusRead(ws memdb.WatchSet, peeringID string) (uint64, *pbpeering.StreamStatus, error)
 	PeeringTrustBundleRead(ws memdb.WatchSet, q state.Query) (uint64, *pbpeering.PeeringTrustBundle, error)
 	PeeringTrustBundleList(ws memdb.WatchSet, entMeta acl.EnterpriseMeta) (uint64, []*pbpeering.PeeringTrustBundle, error)
 	TrustBundleListByService(ws memdb.WatchSet, service, dc string, entMeta acl.EnterpriseMeta) (uint64, []*pbpeering.PeeringTrustBundle, error)
@@ -164,6 +171,10 @@ type Store interface {
 
 var peeringNotEnabledErr = grpcstatus.Error(codes.FailedPrecondition, "peering must be enabled to use this endpoint")
 
+// defaultPeeringTokenTTL is how long a peering token can be used to establish
+// a peering when the request does not specify an expiry.
+const defaultPeeringTokenTTL = 24 * time.Hour
+
 // GenerateToken implements the PeeringService RPC method to generate a
 // peering token which is the initial step in establishing a peering relationship
 // with other Consul clusters.
@@ -187,6 +198,14 @@ func (s *Server) GenerateToken(
 		return nil, fmt.Errorf("meta tags failed validation: %w", err)
 	}
 
+	ttl := defaultPeeringTokenTTL
+	switch expiresAfter := structs.DurationFromProto(req.ExpiresAfter); {
+	case expiresAfter < 0:
+		return nil, grpcstatus.Error(codes.InvalidArgument, "ExpiresAfter must not be negative")
+	case expiresAfter > 0:
+		ttl = expiresAfter
+	}
+
 	defer metrics.MeasureSince([]string{"peering", "generate_token"}, time.Now())
 
 	resp := &pbpeering.GenerateTokenResponse{}
@@ -211,6 +230,23 @@ func (s *Server) GenerateToken(
 		return nil, err
 	}
 
+	// The establishment secret is consumed by the dialing peer when it uses
+	// the token, which makes each token single-use.
+	establishmentSecret, err := lib.GenerateUUID(nil)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	secretsReq := &pbpeering.PeeringSecretsWriteRequest{
+		Request: &pbpeering.PeeringSecretsWriteRequest_GenerateToken{
+			GenerateToken: &pbpeering.PeeringSecretsWriteRequest_GenerateTokenRequest{
+				EstablishmentSecret: establishmentSecret,
+				CreatedAt:           structs.TimeToProto(now),
+				ExpiresAt:           structs.TimeToProto(now.Add(ttl)),
+			},
+		},
+	}
+
 	var peering *pbpeering.Peering
 
 	// This loop ensures at most one retry in the case of a race condition.
@@ -239,8 +275,10 @@ func (s *Server) GenerateToken(
 				return nil, err
 			}
 		}
+		secretsReq.PeeringID = peering.ID
 		writeReq := pbpeering.PeeringWriteRequest{
-			Peering: peering,
+			Peering:        peering,
+			SecretsRequest: secretsReq,
 		}
 		if err := s.Backend.PeeringWrite(&writeReq); err != nil {
 			// There's a possible race where two servers call Generate Token at the
@@ -276,10 +314,11 @@ func (s *Server) GenerateToken(
 
 	tok := structs.PeeringToken{
 		// Store the UUID so that we can do a global search when handling inbound streams.
-		PeerID:          peering.ID,
-		CA:              ca,
-		ServerAddresses: serverAddrs,
-		ServerName:      s.Backend.GetServerName(),
+		PeerID:              peering.ID,
+		CA:                  ca,
+		ServerAddresses:     serverAddrs,
+		ServerName:          s.Backend.GetServerName(),
+		EstablishmentSecret: establishmentSecret,
 	}
 
 	encoded, err := s.Backend.EncodeToken(&tok)
@@ -392,6 +431,24 @@ func (s *Server) Establish(
 			Partition: entMeta.PartitionOrEmpty(),
 		},
 	}
+
+	// Tokens generated before establishment secrets were introduced do not
+	// carry one, in which case the stream is opened without a secret.
+	if tok.EstablishmentSecret != "" {
+		streamSecret, err := s.Backend.ExchangeSecret(ctx, writeReq.Peering, tok.EstablishmentSecret)
+		if err != nil {
+			return nil, err
+		}
+		writeReq.SecretsRequest = &pbpeering.PeeringSecretsWriteRequest{
+			PeeringID: id,
+			Request: &pbpeering.PeeringSecretsWriteRequest_StoreStreamSecret{
+				StoreStreamSecret: &pbpeering.PeeringSecretsWriteRequest_StoreStreamSecretRequest{
+					ActiveStreamSecret: streamSecret,
+				},
+			},
+		}
+	}
+
 	if err := s.Backend.PeeringWrite(writeReq); err != nil {
 		return nil, fmt.Errorf("failed to write peering: %w", err)
 	}
@@ -499,7 +556,7 @@ func (s *Server) PeeringList(ctx context.Context, req *pbpeering.PeeringListRequ
 
 	defer metrics.MeasureSince([]string{"peering", "list"}, time.Now())
 
-	_, peerings, err := s.Backend.Store().PeeringList(nil, *entMeta)
+	idx, peerings, err := s.Backend.Store().PeeringList(nil, *entMeta)
 	if err != nil {
 		return nil, err
 	}
@@ -511,20 +568,35 @@ func (s *Server) PeeringList(ctx context.Context, req *pbpeering.PeeringListRequ
 		cPeerings = append(cPeerings, cp)
 	}
 
-	return &pbpeering.PeeringListResponse{Peerings: cPeerings}, nil
+	return &pbpeering.PeeringListResponse{Peerings: cPeerings, Index: idx}, nil
 }
 
 // TODO(peering): Get rid of this func when we stop using the stream tracker for imported/ exported services and the peering state
 // reconcilePeering enriches the peering with the following information:
 // -- PeeringState.Active if the peering is active
 // -- ImportedServicesCount and ExportedServicesCount
+// -- StreamStatus with the replication details of the stream
+// When the stream is not tracked locally, the status last persisted by a
+// leader is used instead and the peering state is left as stored.
 // NOTE: we return a new peering with this additional data
 func (s *Server) reconcilePeering(peering *pbpeering.Peering) *pbpeering.Peering {
 	streamState, found := s.Tracker.StreamStatus(peering.ID)
 	if !found {
-		s.Logger.Warn("did not find peer in stream tracker; cannot populate imported and"+
-			" exported services count or reconcile peering state", "peerID", peering.ID)
-		return peering
+		_, stored, err := s.Backend.Store().PeeringStreamStatusRead(nil, peering.ID)
+		if err != nil {
+			s.Logger.Warn("failed to read persisted peering stream status", "peerID", peering.ID, "error", err)
+		}
+		if stored == nil {
+			s.Logger.Warn("did not find peer in stream tracker; cannot populate imported and"+
+				" exported services count or reconcile peering state", "peerID", peering.ID)
+			return peering
+		}
+
+		cp := copyPeering(peering)
+		cp.StreamStatus = stored
+		cp.ImportedServiceCount = uint64(len(stored.ImportedServices))
+		cp.ExportedServiceCount = uint64(len(stored.ExportedServices))
+		return cp
 	} else {
 		cp := copyPeering(peering)
 
@@ -539,6 +611,9 @@ func (s *Server) reconcilePeering(peering *pbpeering.Peering) *pbpeering.Peering
 		cp.ImportedServiceCount = streamState.GetImportedServicesCount()
 		cp.ExportedServiceCount = streamState.GetExportedServicesCount()
 
+		cp.StreamStatus = streamState.ToProto()
+		cp.StreamStatus.UpdatedAt = structs.TimeToProto(time.Now().UTC())
+
 		return cp
 	}
 }
@@ -786,6 +861,145 @@ func (s *Server) TrustBundleListByService(ctx context.Context, req *pbpeering.Tr
 	return &pbpeering.TrustBundleListByServiceResponse{Index: idx, Bundles: bundles}, nil
 }
 
+// TokenList returns the peering tokens that were generated in a partition and
+// can still be used to establish a peering.
+func (s *Server) TokenList(ctx context.Context, req *pbpeering.TokenListRequest) (*pbpeering.TokenListResponse, error) {
+	if !s.Config.PeeringEnabled {
+		return nil, peeringNotEnabledErr
+	}
+
+	if err := s.Backend.EnterpriseCheckPartitions(req.Partition); err != nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var resp *pbpeering.TokenListResponse
+	handled, err := s.ForwardRPC(&readRequest, func(conn *grpc.ClientConn) error {
+		ctx := external.ForwardMetadataContext(ctx)
+		var err error
+		resp, err = pbpeering.NewPeeringServiceClient(conn).TokenList(ctx, req)
+		return err
+	})
+	if handled || err != nil {
+		return resp, err
+	}
+
+	defer metrics.MeasureSince([]string{"peering", "token_list"}, time.Now())
+
+	var authzCtx acl.AuthorizerContext
+	entMeta := structs.DefaultEnterpriseMetaInPartition(req.Partition)
+	authz, err := s.Backend.ResolveTokenAndDefaultMeta(external.TokenFromContext(ctx), entMeta, &authzCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.ToAllowAuthorizer().PeeringReadAllowed(&authzCtx); err != nil {
+		return nil, err
+	}
+
+	_, peerings, err := s.Backend.Store().PeeringList(nil, *entMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tokens := make([]*pbpeering.PeeringTokenInfo, 0)
+	for _, p := range peerings {
+		if !p.IsActive() || p.ShouldDial() {
+			continue
+		}
+		secrets, err := s.Backend.Store().PeeringSecretsRead(nil, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		establishment := secrets.GetEstablishment()
+		if establishment == nil {
+			continue
+		}
+		if establishment.ExpiresAt != nil && now.After(structs.TimeFromProto(establishment.ExpiresAt)) {
+			continue
+		}
+		tokens = append(tokens, &pbpeering.PeeringTokenInfo{
+			PeerName:  p.Name,
+			PeeringID: p.ID,
+			Partition: p.Partition,
+			CreatedAt: establishment.CreatedAt,
+			ExpiresAt: establishment.ExpiresAt,
+		})
+	}
+	return &pbpeering.TokenListResponse{Tokens: tokens}, nil
+}
+
+// TokenRevoke invalidates the outstanding peering token of a peer so that it
+// can no longer be used to establish the peering. Already established
+// peerings are not affected.
+func (s *Server) TokenRevoke(ctx context.Context, req *pbpeering.TokenRevokeRequest) (*pbpeering.TokenRevokeResponse, error) {
+	if !s.Config.PeeringEnabled {
+		return nil, peeringNotEnabledErr
+	}
+
+	if err := s.Backend.EnterpriseCheckPartitions(req.Partition); err != nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.PeerName == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "missing peer name")
+	}
+
+	var resp *pbpeering.TokenRevokeResponse
+	handled, err := s.ForwardRPC(&writeRequest, func(conn *grpc.ClientConn) error {
+		ctx := external.ForwardMetadataContext(ctx)
+		var err error
+		resp, err = pbpeering.NewPeeringServiceClient(conn).TokenRevoke(ctx, req)
+		return err
+	})
+	if handled || err != nil {
+		return resp, err
+	}
+
+	defer metrics.MeasureSince([]string{"peering", "token_revoke"}, time.Now())
+
+	var authzCtx acl.AuthorizerContext
+	entMeta := structs.DefaultEnterpriseMetaInPartition(req.Partition)
+	authz, err := s.Backend.ResolveTokenAndDefaultMeta(external.TokenFromContext(ctx), entMeta, &authzCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.ToAllowAuthorizer().PeeringWriteAllowed(&authzCtx); err != nil {
+		return nil, err
+	}
+
+	peering, err := s.getExistingPeering(req.PeerName, entMeta.PartitionOrDefault())
+	if err != nil {
+		return nil, err
+	}
+	if peering == nil || !peering.IsActive() {
+		return nil, grpcstatus.Errorf(codes.NotFound, "no peering found with name %q", req.PeerName)
+	}
+
+	secrets, err := s.Backend.Store().PeeringSecretsRead(nil, peering.ID)
+	if err != nil {
+		return nil, err
+	}
+	if secrets.GetEstablishment() == nil {
+		return nil, grpcstatus.Errorf(codes.NotFound, "no outstanding peering token for peer %q", req.PeerName)
+	}
+
+	err = s.Backend.PeeringSecretsWrite(&pbpeering.PeeringSecretsWriteRequest{
+		PeeringID: peering.ID,
+		Request: &pbpeering.PeeringSecretsWriteRequest_RevokeToken{
+			RevokeToken: &pbpeering.PeeringSecretsWriteRequest_RevokeTokenRequest{},
+		},
+	})
+	if errors.Is(err, state.ErrPeeringSecretMismatch) {
+		// The token was used or revoked concurrently.
+		return nil, grpcstatus.Errorf(codes.NotFound, "no outstanding peering token for peer %q", req.PeerName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbpeering.TokenRevokeResponse{}, nil
+}
+
 func (s *Server) getExistingPeering(peerName, partition string) (*pbpeering.Peering, error) {
 	q := state.Query{
 		Value:          strings.ToLower(peerName),
@@ -17,6 +17,8 @@ import (
 	gogrpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/consul"
@@ -49,6 +51,10 @@ const (
 	testTokenServiceWriteSecret = "4a3dc05d-d86c-4f20-be43-8f4f8f045fea"
 )
 
+// ignoreStreamStatus ignores the live stream status, which depends on the
+// leader's attempts to dial the test peerings.
+var ignoreStreamStatus = protocmp.IgnoreFields(&pbpeering.Peering{}, "StreamStatus")
+
 func generateTooManyMetaKeys() map[string]string {
 	// todo -- modularize in structs.go or testing.go
 	tooMuchMeta := make(map[string]string)
@@ -152,6 +158,62 @@ func TestPeeringService_GenerateTokenExternalAddress(t *testing.T) {
 	require.Equal(t, []string{ca}, token.CA)
 }
 
+func TestPeeringService_GenerateTokenThroughMeshGateways(t *testing.T) {
+	dir := testutil.TempDir(t, "consul")
+	signer, _, _ := tlsutil.GeneratePrivateKey()
+	ca, _, _ := tlsutil.GenerateCA(tlsutil.CAOpts{Signer: signer})
+	cafile := path.Join(dir, "cacert.pem")
+	require.NoError(t, ioutil.WriteFile(cafile, []byte(ca), 0600))
+
+	// TODO(peering): see note on newTestServer, refactor to not use this
+	s := newTestServer(t, func(c *consul.Config) {
+		c.SerfLANConfig.MemberlistConfig.AdvertiseAddr = "127.0.0.1"
+		c.TLSConfig.GRPC.CAFile = cafile
+		c.DataDir = dir
+	})
+	client := pbpeering.NewPeeringServiceClient(s.ClientConn(t))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	store := s.Server.FSM().State()
+	require.NoError(t, store.EnsureConfigEntry(10, &structs.MeshConfigEntry{
+		Peering: &structs.PeeringMeshConfig{
+			PeerThroughMeshGateways: true,
+		},
+	}))
+
+	req := pbpeering.GenerateTokenRequest{PeerName: "peerB"}
+	_, err := client.GenerateToken(ctx, &req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no healthy mesh gateway is registered")
+
+	require.NoError(t, store.EnsureRegistration(11, &structs.RegisterRequest{
+		Node:    "gateway-node",
+		Address: "10.0.0.1",
+		Service: &structs.NodeService{
+			Kind:    structs.ServiceKindMeshGateway,
+			ID:      "mesh-gateway",
+			Service: "mesh-gateway",
+			Port:    8443,
+			TaggedAddresses: map[string]structs.ServiceAddress{
+				structs.TaggedAddressWAN: {Address: "32.1.2.3", Port: 443},
+			},
+		},
+	}))
+
+	resp, err := client.GenerateToken(ctx, &req)
+	require.NoError(t, err)
+
+	tokenJSON, err := base64.StdEncoding.DecodeString(resp.PeeringToken)
+	require.NoError(t, err)
+
+	token := &structs.PeeringToken{}
+	require.NoError(t, json.Unmarshal(tokenJSON, token))
+	require.Equal(t, "server.dc1.consul", token.ServerName)
+	require.Equal(t, []string{"32.1.2.3:443"}, token.ServerAddresses)
+	require.Equal(t, []string{ca}, token.CA)
+}
+
 func TestPeeringService_GenerateToken_ACLEnforcement(t *testing.T) {
 	// TODO(peering): see note on newTestServer, refactor to not use this
 	s := newTestServer(t, func(conf *consul.Config) {
@@ -314,6 +376,69 @@ func TestPeeringService_Establish(t *testing.T) {
 	}
 }
 
+func TestPeeringService_Establish_TokenSecret(t *testing.T) {
+	// TODO(peering): see note on newTestServer, refactor to not use this
+	acceptor := newTestServer(t, nil)
+	acceptorClient := pbpeering.NewPeeringServiceClient(acceptor.ClientConn(t))
+
+	newDialer := func(t *testing.T, name string) pbpeering.PeeringServiceClient {
+		s := newTestServer(t, func(c *consul.Config) {
+			c.NodeName = name
+			c.Datacenter = "dc2"
+			c.PrimaryDatacenter = "dc2"
+		})
+		return pbpeering.NewPeeringServiceClient(s.ClientConn(t))
+	}
+	dialerClient := newDialer(t, "dialer")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	testutil.RunStep(t, "expired token is rejected", func(t *testing.T) {
+		resp, err := acceptorClient.GenerateToken(ctx, &pbpeering.GenerateTokenRequest{
+			PeerName:     "expired",
+			ExpiresAfter: durationpb.New(time.Nanosecond),
+		})
+		require.NoError(t, err)
+
+		_, err = dialerClient.Establish(ctx, &pbpeering.EstablishRequest{
+			PeerName:     "expired",
+			PeeringToken: resp.PeeringToken,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "peering token has expired")
+	})
+
+	var token string
+	testutil.RunStep(t, "token can be used once", func(t *testing.T) {
+		resp, err := acceptorClient.GenerateToken(ctx, &pbpeering.GenerateTokenRequest{PeerName: "my-peer-dialer"})
+		require.NoError(t, err)
+		token = resp.PeeringToken
+
+		_, err = dialerClient.Establish(ctx, &pbpeering.EstablishRequest{
+			PeerName:     "my-peer-acceptor",
+			PeeringToken: token,
+		})
+		require.NoError(t, err)
+
+		// The token is no longer outstanding once it was used.
+		list, err := acceptorClient.TokenList(ctx, &pbpeering.TokenListRequest{})
+		require.NoError(t, err)
+		for _, info := range list.Tokens {
+			require.NotEqual(t, "my-peer-dialer", info.PeerName)
+		}
+	})
+
+	testutil.RunStep(t, "reused token is rejected", func(t *testing.T) {
+		_, err := newDialer(t, "other-dialer").Establish(ctx, &pbpeering.EstablishRequest{
+			PeerName:     "my-peer-acceptor",
+			PeeringToken: token,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid peering establishment secret")
+	})
+}
+
 // We define a valid peering by a peering that does not occur over the same server addresses
 func TestPeeringService_Establish_validPeeringInPartition(t *testing.T) {
 	// TODO(peering): see note on newTestServer, refactor to not use this
@@ -403,6 +528,73 @@ func TestPeeringService_Establish_ACLEnforcement(t *testing.T) {
 	}
 }
 
+func TestPeeringService_TokenListRevoke(t *testing.T) {
+	// TODO(peering): see note on newTestServer, refactor to not use this
+	s := newTestServer(t, nil)
+	client := pbpeering.NewPeeringServiceClient(s.ClientConn(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	_, err := client.GenerateToken(ctx, &pbpeering.GenerateTokenRequest{
+		PeerName:     "peerA",
+		ExpiresAfter: durationpb.New(-time.Hour),
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), grpcstatus.Code(err).String())
+
+	before := time.Now()
+	resp, err := client.GenerateToken(ctx, &pbpeering.GenerateTokenRequest{
+		PeerName:     "peerA",
+		ExpiresAfter: durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+
+	tokenJSON, err := base64.StdEncoding.DecodeString(resp.PeeringToken)
+	require.NoError(t, err)
+	var token structs.PeeringToken
+	require.NoError(t, json.Unmarshal(tokenJSON, &token))
+	require.NotEmpty(t, token.EstablishmentSecret)
+
+	// Tokens are valid for a day unless specified otherwise.
+	_, err = client.GenerateToken(ctx, &pbpeering.GenerateTokenRequest{PeerName: "peerB"})
+	require.NoError(t, err)
+
+	list, err := client.TokenList(ctx, &pbpeering.TokenListRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Tokens, 2)
+
+	byName := make(map[string]*pbpeering.PeeringTokenInfo)
+	for _, info := range list.Tokens {
+		byName[info.PeerName] = info
+	}
+	require.Contains(t, byName, "peerA")
+	require.Contains(t, byName, "peerB")
+	require.WithinDuration(t, before.Add(time.Hour), structs.TimeFromProto(byName["peerA"].ExpiresAt), 5*time.Second)
+	require.WithinDuration(t, before.Add(24*time.Hour), structs.TimeFromProto(byName["peerB"].ExpiresAt), 5*time.Second)
+
+	_, err = client.TokenRevoke(ctx, &pbpeering.TokenRevokeRequest{PeerName: "peerA"})
+	require.NoError(t, err)
+
+	list, err = client.TokenList(ctx, &pbpeering.TokenListRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Tokens, 1)
+	require.Equal(t, "peerB", list.Tokens[0].PeerName)
+
+	// The peering itself is kept around.
+	read, err := client.PeeringRead(ctx, &pbpeering.PeeringReadRequest{Name: "peerA"})
+	require.NoError(t, err)
+	require.NotNil(t, read.Peering)
+
+	_, err = client.TokenRevoke(ctx, &pbpeering.TokenRevokeRequest{PeerName: "peerA"})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound.String(), grpcstatus.Code(err).String())
+
+	_, err = client.TokenRevoke(ctx, &pbpeering.TokenRevokeRequest{PeerName: "unknown"})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound.String(), grpcstatus.Code(err).String())
+}
+
 func TestPeeringService_Read(t *testing.T) {
 	// TODO(peering): see note on newTestServer, refactor to not use this
 	s := newTestServer(t, nil)
@@ -439,7 +631,7 @@ func TestPeeringService_Read(t *testing.T) {
 			return
 		}
 		require.NoError(t, err)
-		prototest.AssertDeepEqual(t, tc.expect, resp)
+		prototest.AssertDeepEqual(t, tc.expect, resp, ignoreStreamStatus)
 	}
 	tcs := []testcase{
 		{
@@ -462,6 +654,45 @@ func TestPeeringService_Read(t *testing.T) {
 	}
 }
 
+func TestPeeringService_Read_PersistedStreamStatus(t *testing.T) {
+	// TODO(peering): see note on newTestServer, refactor to not use this
+	s := newTestServer(t, nil)
+
+	// insert peering directly to state store
+	p := &pbpeering.Peering{
+		ID:     testUUID(t),
+		Name:   "foo",
+		State:  pbpeering.PeeringState_ACTIVE,
+		PeerID: testUUID(t),
+	}
+	require.NoError(t, s.Server.FSM().State().PeeringWrite(10, p))
+
+	// The stream is not tracked on this server, as happens after a leadership
+	// change, so the status last persisted by a leader is returned.
+	status := &pbpeering.StreamStatus{
+		LastHeartbeat:            structs.TimeToProto(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		LastErrorMessage:         "stream ended unexpectedly",
+		ImportedServices:         []string{"api", "web"},
+		ExportedServices:         []string{"db"},
+		ReceivedResourceVersions: map[string]uint64{"exported-service": 4},
+	}
+	require.NoError(t, s.Server.FSM().State().PeeringStreamStatusWrite(11, &pbpeering.PeeringStreamStatusWriteRequest{
+		Statuses: []*pbpeering.PeeringStreamStatus{{PeeringID: p.ID, Status: status}},
+	}))
+
+	client := pbpeering.NewPeeringServiceClient(s.ClientConn(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	resp, err := client.PeeringRead(ctx, &pbpeering.PeeringReadRequest{Name: "foo"})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), resp.Peering.ImportedServiceCount)
+	require.Equal(t, uint64(1), resp.Peering.ExportedServiceCount)
+	prototest.AssertDeepEqual(t, status, resp.Peering.StreamStatus)
+}
+
 func TestPeeringService_Read_ACLEnforcement(t *testing.T) {
 	// TODO(peering): see note on newTestServer, refactor to not use this
 	s := newTestServer(t, func(conf *consul.Config) {
@@ -503,7 +734,7 @@ func TestPeeringService_Read_ACLEnforcement(t *testing.T) {
 			return
 		}
 		require.NoError(t, err)
-		prototest.AssertDeepEqual(t, tc.expect, resp)
+		prototest.AssertDeepEqual(t, tc.expect, resp, ignoreStreamStatus)
 	}
 	tcs := []testcase{
 		{
@@ -673,8 +904,9 @@ func TestPeeringService_List(t *testing.T) {
 
 	expect := &pbpeering.PeeringListResponse{
 		Peerings: []*pbpeering.Peering{bar, foo},
+		Index:    15,
 	}
-	prototest.AssertDeepEqual(t, expect, resp)
+	prototest.AssertDeepEqual(t, expect, resp, ignoreStreamStatus)
 }
 
 func TestPeeringService_List_ACLEnforcement(t *testing.T) {
@@ -727,7 +959,7 @@ func TestPeeringService_List_ACLEnforcement(t *testing.T) {
 			return
 		}
 		require.NoError(t, err)
-		prototest.AssertDeepEqual(t, tc.expect, resp)
+		prototest.AssertDeepEqual(t, tc.expect, resp, ignoreStreamStatus)
 	}
 	tcs := []testcase{
 		{
@@ -739,6 +971,7 @@ func TestPeeringService_List_ACLEnforcement(t *testing.T) {
 			token: testTokenPeeringReadSecret,
 			expect: &pbpeering.PeeringListResponse{
 				Peerings: []*pbpeering.Peering{bar, foo},
+				Index:    15,
 			},
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/grpclog"
 
+	"github.com/hashicorp/consul/agent/audit"
 	autoconf "github.com/hashicorp/consul/agent/auto-config"
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/config"
@@ -96,6 +97,11 @@ func NewBaseDeps(configLoader ConfigLoader, logOut io.Writer) (BaseDeps, error)
 	d.RuntimeConfig = cfg
 	d.Tokens = new(token.Store)
 
+	d.AuditLogger, err = audit.New(cfg.Audit, d.Logger.Named(logging.Audit))
+	if err != nil {
+		return d, fmt.Errorf("failed to setup audit log: %w", err)
+	}
+
 	cfg.Cache.Logger = d.Logger.Named("cache")
 	// cache-types are not registered yet, but they won't be used until the components are started.
 	d.Cache = cache.New(cfg.Cache)
@@ -103,6 +103,9 @@ type ACLIdentity interface {
 	IsExpired(asOf time.Time) bool
 	IsLocal() bool
 	EnterpriseMetadata() *acl.EnterpriseMeta
+	// BoundCIDRList returns the CIDR blocks the identity may be used from. An
+	// empty list means it may be used from anywhere.
+	BoundCIDRList() []string
 }
 type ACLTokenPolicyLink struct {
 	ID   string
@@ -327,6 +330,11 @@ type ACLToken struct {
 	// This is a string version of a time.Duration like "2m".
 	ExpirationTTL time.Duration `json:",omitempty"`
 
+	// BoundCIDRs restricts the source addresses that this token may be used
+	// from to the given CIDR blocks. The zero value allows the token to be used
+	// from any address.
+	BoundCIDRs []string `json:",omitempty"`
+
 	// The time when this token was created
 	CreateTime time.Time `json:",omitempty"`
 
@@ -380,6 +388,7 @@ func (t *ACLToken) Clone() *ACLToken {
 	t2.Roles = nil
 	t2.ServiceIdentities = nil
 	t2.NodeIdentities = nil
+	t2.BoundCIDRs = nil
 
 	if len(t.Policies) > 0 {
 		t2.Policies = make([]ACLTokenPolicyLink, len(t.Policies))
@@ -401,6 +410,10 @@ func (t *ACLToken) Clone() *ACLToken {
 			t2.NodeIdentities[i] = n.Clone()
 		}
 	}
+	if len(t.BoundCIDRs) > 0 {
+		t2.BoundCIDRs = make([]string, len(t.BoundCIDRs))
+		copy(t2.BoundCIDRs, t.BoundCIDRs)
+	}
 
 	return &t2
 }
@@ -460,6 +473,10 @@ func (t *ACLToken) IsLocal() bool {
 	return t.Local
 }
 
+func (t *ACLToken) BoundCIDRList() []string {
+	return t.BoundCIDRs
+}
+
 func (t *ACLToken) HasExpirationTime() bool {
 	return t.ExpirationTime != nil && !t.ExpirationTime.IsZero()
 }
@@ -511,6 +528,10 @@ func (t *ACLToken) SetHash(force bool) []byte {
 			nodeID.AddToHash(hash)
 		}
 
+		for _, cidr := range t.BoundCIDRs {
+			hash.Write([]byte(cidr))
+		}
+
 		t.EnterpriseMeta.AddToHash(hash, false)
 
 		// Finalize the hash
@@ -537,6 +558,9 @@ func (t *ACLToken) EstimateSize() int {
 	for _, nodeID := range t.NodeIdentities {
 		size += nodeID.EstimateSize()
 	}
+	for _, cidr := range t.BoundCIDRs {
+		size += len(cidr)
+	}
 	return size + t.EnterpriseMeta.EstimateSize()
 }
 
@@ -554,6 +578,7 @@ type ACLTokenListStub struct {
 	Local             bool
 	AuthMethod        string     `json:",omitempty"`
 	ExpirationTime    *time.Time `json:",omitempty"`
+	BoundCIDRs        []string   `json:",omitempty"`
 	CreateTime        time.Time  `json:",omitempty"`
 	Hash              []byte
 	CreateIndex       uint64
@@ -577,6 +602,7 @@ func (token *ACLToken) Stub() *ACLTokenListStub {
 		Local:                       token.Local,
 		AuthMethod:                  token.AuthMethod,
 		ExpirationTime:              token.ExpirationTime,
+		BoundCIDRs:                  token.BoundCIDRs,
 		CreateTime:                  token.CreateTime,
 		Hash:                        token.Hash,
 		CreateIndex:                 token.CreateIndex,
@@ -1053,6 +1079,10 @@ type ACLBindingRule struct {
 	// upon the BindType.
 	BindName string
 
+	// BoundCIDRs is copied onto tokens created by logging in when this rule
+	// matches, restricting the source addresses they may be used from.
+	BoundCIDRs []string `json:",omitempty"`
+
 	// Embedded Enterprise ACL metadata
 	acl.EnterpriseMeta `mapstructure:",squash"`
 
@@ -1062,6 +1092,10 @@ type ACLBindingRule struct {
 
 func (r *ACLBindingRule) Clone() *ACLBindingRule {
 	r2 := *r
+	if len(r.BoundCIDRs) > 0 {
+		r2.BoundCIDRs = make([]string, len(r.BoundCIDRs))
+		copy(r2.BoundCIDRs, r.BoundCIDRs)
+	}
 	return &r2
 }
 
@@ -1265,6 +1299,44 @@ func (r *ACLTokenSetRequest) RequestDatacenter() string {
 	return r.Datacenter
 }
 
+// ACLBootstrapRotateRequest is used to issue a new global management token
+// and schedule the expiration of the one it replaces at the RPC layer
+type ACLBootstrapRotateRequest struct {
+	AccessorID    string        // Accessor of the token to rotate out, defaults to the requesting token
+	ExpirationTTL time.Duration // How long the rotated out token remains valid
+	Datacenter    string        // The datacenter to perform the request within
+	WriteRequest
+}
+
+func (r *ACLBootstrapRotateRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+func (r *ACLBootstrapRotateRequest) UnmarshalJSON(data []byte) (err error) {
+	type Alias ACLBootstrapRotateRequest
+	aux := &struct {
+		ExpirationTTL interface{}
+		*Alias
+	}{
+		Alias: (*Alias)(r),
+	}
+
+	if err = lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	if aux.ExpirationTTL != nil {
+		switch v := aux.ExpirationTTL.(type) {
+		case string:
+			if r.ExpirationTTL, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			r.ExpirationTTL = time.Duration(v)
+		}
+	}
+	return nil
+}
+
 // ACLTokenGetRequest is used for token read operations at the RPC layer
 type ACLTokenGetRequest struct {
 	TokenID     string         // id used for the token lookup
@@ -1742,6 +1814,27 @@ func (r *ACLLoginRequest) RequestDatacenter() string {
 	return r.Datacenter
 }
 
+// ACLLoginValidation describes the outcome of checking a bearer token against
+// an auth method without creating an ACL token, so that operators can debug
+// why a login is rejected.
+type ACLLoginValidation struct {
+	// Valid is true if logging in with the bearer token would succeed.
+	Valid bool
+
+	// Error explains why the bearer token was rejected.
+	Error string `json:",omitempty"`
+
+	// ProjectedVars are the variables extracted from the verified identity
+	// which are available for interpolation into binding rule bind names.
+	ProjectedVars map[string]string `json:",omitempty"`
+
+	// Roles, ServiceIdentities and NodeIdentities are what a token created by
+	// logging in with the bearer token would be linked to.
+	Roles             []ACLTokenRoleLink   `json:",omitempty"`
+	ServiceIdentities ACLServiceIdentities `json:",omitempty"`
+	NodeIdentities    ACLNodeIdentities    `json:",omitempty"`
+}
+
 type ACLLogoutRequest struct {
 	Datacenter string // The datacenter to perform the request within
 	WriteRequest
@@ -1838,3 +1931,7 @@ func (id *AgentRecoveryTokenIdentity) IsLocal() bool {
 func (id *AgentRecoveryTokenIdentity) EnterpriseMetadata() *acl.EnterpriseMeta {
 	return nil
 }
+
+func (id *AgentRecoveryTokenIdentity) BoundCIDRList() []string {
+	return nil
+}
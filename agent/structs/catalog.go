@@ -1,6 +1,8 @@
 package structs
 
 import (
+	"strings"
+
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/types"
@@ -15,6 +17,18 @@ const (
 	SerfCheckFailedOutput               = "Agent not live or unreachable"
 )
 
+// OutlierEjectionCheckPrefix prefixes the ID of the checks reflecting the
+// ejection of a service instance by the outlier detection of a proxy. These
+// checks are written by the agent of the proxy rather than the agent of the
+// instance.
+const OutlierEjectionCheckPrefix = "_outlier_ejection:"
+
+// IsOutlierEjectionCheckID returns true if the check reflects an outlier
+// ejection.
+func IsOutlierEjectionCheckID(id CheckID) bool {
+	return strings.HasPrefix(string(id.ID), OutlierEjectionCheckPrefix)
+}
+
 const (
 	// These are used to manage the "consul" service that's attached to every
 	// Consul server node in the catalog.
@@ -32,6 +32,9 @@ const (
 	ServiceIntentions  string = "service-intentions"
 	MeshConfig         string = "mesh"
 	ExportedServices   string = "exported-services"
+	ServiceRollout     string = "service-rollout"
+	ImportedServices   string = "imported-services"
+	SamenessGroup      string = "sameness-group"
 
 	ProxyConfigGlobal string = "global"
 	MeshConfigMesh    string = "mesh"
@@ -50,6 +53,9 @@ var AllConfigEntryKinds = []string{
 	ServiceIntentions,
 	MeshConfig,
 	ExportedServices,
+	ServiceRollout,
+	ImportedServices,
+	SamenessGroup,
 }
 
 // ConfigEntry is the interface for centralized configuration stored in Raft.
@@ -109,6 +115,11 @@ type ServiceConfigEntry struct {
 	Destination           *DestinationConfig     `json:",omitempty"`
 	MaxInboundConnections int                    `json:",omitempty" alias:"max_inbound_connections"`
 
+	// AccessLogs replaces the access logs configured in proxy-defaults as a
+	// whole when set, even to a disabled config, so that a service can turn
+	// them off.
+	AccessLogs *AccessLogsConfig `json:",omitempty" alias:"access_logs"`
+
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 	RaftIndex
@@ -118,6 +129,10 @@ func (e *ServiceConfigEntry) Clone() *ServiceConfigEntry {
 	e2 := *e
 	e2.Expose = e.Expose.Clone()
 	e2.UpstreamConfig = e.UpstreamConfig.Clone()
+	if e.AccessLogs != nil {
+		accessLogs := *e.AccessLogs
+		e2.AccessLogs = &accessLogs
+	}
 	return &e2
 }
 
@@ -180,6 +195,12 @@ func (e *ServiceConfigEntry) Validate() error {
 
 	validationErr := validateConfigEntryMeta(e.Meta)
 
+	if e.AccessLogs != nil {
+		if err := e.AccessLogs.Validate(); err != nil {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("invalid access logs configuration: %v", err))
+		}
+	}
+
 	// External endpoints are invalid with an existing service's upstream configuration
 	if e.UpstreamConfig != nil && e.Destination != nil {
 		validationErr = multierror.Append(validationErr, errors.New("UpstreamConfig and Destination are mutually exclusive for service defaults"))
@@ -331,6 +352,9 @@ type ProxyConfigEntry struct {
 	TransparentProxy TransparentProxyConfig `json:",omitempty" alias:"transparent_proxy"`
 	MeshGateway      MeshGatewayConfig      `json:",omitempty" alias:"mesh_gateway"`
 	Expose           ExposeConfig           `json:",omitempty"`
+	AccessLogs       AccessLogsConfig       `json:",omitempty" alias:"access_logs"`
+	Tracing          TracingConfig          `json:",omitempty"`
+	Metrics          MetricsConfig          `json:",omitempty"`
 
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
@@ -382,6 +406,18 @@ func (e *ProxyConfigEntry) Validate() error {
 		return err
 	}
 
+	if err := e.AccessLogs.Validate(); err != nil {
+		return fmt.Errorf("invalid access logs configuration: %v", err)
+	}
+
+	if err := e.Tracing.Validate(); err != nil {
+		return fmt.Errorf("invalid tracing configuration: %v", err)
+	}
+
+	if err := e.Metrics.Validate(); err != nil {
+		return fmt.Errorf("invalid metrics configuration: %v", err)
+	}
+
 	return e.validateEnterpriseMeta()
 }
 
@@ -614,6 +650,12 @@ func MakeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &MeshConfigEntry{}, nil
 	case ExportedServices:
 		return &ExportedServicesConfigEntry{Name: name}, nil
+	case ServiceRollout:
+		return &ServiceRolloutConfigEntry{Name: name}, nil
+	case ImportedServices:
+		return &ImportedServicesConfigEntry{Name: name}, nil
+	case SamenessGroup:
+		return &SamenessGroupConfigEntry{Name: name}, nil
 	default:
 		return nil, fmt.Errorf("invalid config entry kind: %s", kind)
 	}
@@ -961,6 +1003,11 @@ type PassiveHealthCheck struct {
 	// MaxFailures is the count of consecutive failures that results in a host
 	// being removed from the pool.
 	MaxFailures uint32 `json:",omitempty" alias:"max_failures"`
+
+	// ReportEjections reflects the hosts ejected by the proxy as a warning
+	// health check on the matching service instance in the catalog. Ejection
+	// events are reported by the proxy to its local agent.
+	ReportEjections bool `json:",omitempty" codec:",omitempty" alias:"report_ejections"`
 }
 
 func (chk *PassiveHealthCheck) Clone() *PassiveHealthCheck {
@@ -1063,6 +1110,9 @@ type ServiceConfigResponse struct {
 	MeshGateway       MeshGatewayConfig      `json:",omitempty"`
 	Expose            ExposeConfig           `json:",omitempty"`
 	TransparentProxy  TransparentProxyConfig `json:",omitempty"`
+	AccessLogs        AccessLogsConfig       `json:",omitempty"`
+	Tracing           TracingConfig          `json:",omitempty"`
+	Metrics           MetricsConfig          `json:",omitempty"`
 	Mode              ProxyMode              `json:",omitempty"`
 	Destination       DestinationConfig      `json:",omitempty"`
 	Meta              map[string]string      `json:",omitempty"`
@@ -815,6 +815,11 @@ type ServiceResolverConfigEntry struct {
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
 
+	// PrioritizeByLocality controls whether instances of this service closest
+	// to the calling proxy are preferred. Localities are read from the metadata
+	// keys configured in the mesh config entry.
+	PrioritizeByLocality *ServiceResolverPrioritizeByLocality `json:",omitempty" alias:"prioritize_by_locality"`
+
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 	RaftIndex
@@ -873,7 +878,8 @@ func (e *ServiceResolverConfigEntry) IsDefault() bool {
 		e.Redirect == nil &&
 		len(e.Failover) == 0 &&
 		e.ConnectTimeout == 0 &&
-		e.LoadBalancer == nil
+		e.LoadBalancer == nil &&
+		e.PrioritizeByLocality == nil
 }
 
 func (e *ServiceResolverConfigEntry) GetKind() string {
@@ -992,8 +998,15 @@ func (e *ServiceResolverConfigEntry) Validate() error {
 				return fmt.Errorf("Bad Failover[%q]: not a valid subset", subset)
 			}
 
+			if f.SamenessGroup != "" {
+				if f.Service != "" || f.ServiceSubset != "" || f.Namespace != "" || len(f.Datacenters) != 0 {
+					return fmt.Errorf("Bad Failover[%q]: SamenessGroup cannot be set with Service, ServiceSubset, Namespace, or Datacenters", subset)
+				}
+				continue
+			}
+
 			if f.Service == "" && f.ServiceSubset == "" && f.Namespace == "" && len(f.Datacenters) == 0 {
-				return fmt.Errorf("Bad Failover[%q] one of Service, ServiceSubset, Namespace, or Datacenters is required", subset)
+				return fmt.Errorf("Bad Failover[%q] one of Service, ServiceSubset, Namespace, Datacenters, or SamenessGroup is required", subset)
 			}
 
 			if f.ServiceSubset != "" {
@@ -1016,6 +1029,10 @@ func (e *ServiceResolverConfigEntry) Validate() error {
 		return fmt.Errorf("Bad ConnectTimeout '%s', must be >= 0", e.ConnectTimeout)
 	}
 
+	if err := e.PrioritizeByLocality.validate(); err != nil {
+		return err
+	}
+
 	if e.LoadBalancer != nil {
 		lb := e.LoadBalancer
 
@@ -1175,8 +1192,10 @@ type ServiceResolverRedirect struct {
 
 // There are some restrictions on what is allowed in here:
 //
-// - Service, ServiceSubset, Namespace, and Datacenters cannot all be
-//   empty at once.
+// - Service, ServiceSubset, Namespace, Datacenters, and SamenessGroup cannot
+//   all be empty at once.
+//
+// - SamenessGroup cannot be combined with any of the other fields.
 //
 type ServiceResolverFailover struct {
 	// Service is the service to resolve instead of the default as the failover
@@ -1205,6 +1224,38 @@ type ServiceResolverFailover struct {
 	//
 	// This is a DESTINATION during failover.
 	Datacenters []string `json:",omitempty"`
+
+	// SamenessGroup is the name of a sameness group whose members are tried
+	// in order. The requested service is resolved in each member under the
+	// same name (optional).
+	//
+	// This is a DESTINATION during failover.
+	SamenessGroup string `json:",omitempty" alias:"sameness_group"`
+}
+
+// ServiceResolverPrioritizeByLocality controls whether a service's instances
+// are prioritized by their proximity to the calling proxy.
+type ServiceResolverPrioritizeByLocality struct {
+	// Mode is either "none" or "failover". In "failover" mode, traffic is sent
+	// to healthy instances in the caller's zone, failing over to other zones
+	// of its region and then to other regions. Defaults to "none".
+	Mode string `json:",omitempty"`
+}
+
+// Failover returns true if instances are prioritized by locality.
+func (p *ServiceResolverPrioritizeByLocality) Failover() bool {
+	return p != nil && p.Mode == "failover"
+}
+
+func (p *ServiceResolverPrioritizeByLocality) validate() error {
+	if p == nil {
+		return nil
+	}
+	switch p.Mode {
+	case "", "none", "failover":
+		return nil
+	}
+	return fmt.Errorf("Bad PrioritizeByLocality mode: %q is not supported, must be 'none' or 'failover'", p.Mode)
 }
 
 // LoadBalancer determines the load balancing policy and configuration for services
@@ -541,6 +541,23 @@ func TestServiceResolverConfigEntry(t *testing.T) {
 				Name: "test",
 			},
 		},
+		{
+			name: "prioritize by locality failover",
+			entry: &ServiceResolverConfigEntry{
+				Kind:                 ServiceResolver,
+				Name:                 "test",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{Mode: "failover"},
+			},
+		},
+		{
+			name: "prioritize by locality bad mode",
+			entry: &ServiceResolverConfigEntry{
+				Kind:                 ServiceResolver,
+				Name:                 "test",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{Mode: "zone"},
+			},
+			validateErr: `Bad PrioritizeByLocality mode: "zone" is not supported`,
+		},
 		{
 			name: "empty subset name",
 			entry: &ServiceResolverConfigEntry{
@@ -695,7 +712,33 @@ func TestServiceResolverConfigEntry(t *testing.T) {
 					"v1": {},
 				},
 			},
-			validateErr: `Bad Failover["v1"] one of Service, ServiceSubset, Namespace, or Datacenters is required`,
+			validateErr: `Bad Failover["v1"] one of Service, ServiceSubset, Namespace, Datacenters, or SamenessGroup is required`,
+		},
+		{
+			name: "failover to sameness group",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				Failover: map[string]ServiceResolverFailover{
+					"*": {
+						SamenessGroup: "group1",
+					},
+				},
+			},
+		},
+		{
+			name: "failover to sameness group with datacenters",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				Failover: map[string]ServiceResolverFailover{
+					"*": {
+						SamenessGroup: "group1",
+						Datacenters:   []string{"dc2"},
+					},
+				},
+			},
+			validateErr: `Bad Failover["*"]: SamenessGroup cannot be set with Service, ServiceSubset, Namespace, or Datacenters`,
 		},
 		{
 			name: "failover to self using invalid subset",
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/go-bexpr"
+
 	"github.com/hashicorp/consul/acl"
 )
 
@@ -43,6 +45,12 @@ type ServiceConsumer struct {
 
 	// PeerName is the name of the peer to export the service to.
 	PeerName string `json:",omitempty" alias:"peer_name"`
+
+	// Filter is a boolean expression over service instances which limits the
+	// instances replicated to the catalog of the peer. It does not limit the
+	// instances the mesh gateways route the peer's traffic to. It can only be
+	// set for peers.
+	Filter string `json:",omitempty"`
 }
 
 func (e *ExportedServicesConfigEntry) ToMap() map[string]map[string][]string {
@@ -139,6 +147,14 @@ func (e *ExportedServicesConfigEntry) Validate() error {
 			if consumer.PeerName == WildcardSpecifier {
 				return fmt.Errorf("Services[%d].Consumers[%d]: exporting to all peers (wildcard) is not supported", i, j)
 			}
+			if consumer.Filter != "" {
+				if consumer.PeerName == "" {
+					return fmt.Errorf("Services[%d].Consumers[%d]: Filter can only be set for peers", i, j)
+				}
+				if _, err := bexpr.CreateEvaluator(consumer.Filter, nil); err != nil {
+					return fmt.Errorf("Services[%d].Consumers[%d]: Filter is not a valid expression: %v", i, j, err)
+				}
+			}
 		}
 	}
 	return nil
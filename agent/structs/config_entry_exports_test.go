@@ -88,6 +88,56 @@ func TestExportedServicesConfigEntry(t *testing.T) {
 			},
 			validateErr: `Services[0].Consumers[0]: must define at most one of PeerName or Partition`,
 		},
+		"validate: filter": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				Services: []ExportedService{
+					{
+						Name: "web",
+						Consumers: []ServiceConsumer{
+							{
+								PeerName: "foo",
+								Filter:   `Service.Meta.env == "prod"`,
+							},
+						},
+					},
+				},
+			},
+		},
+		"validate: invalid filter": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				Services: []ExportedService{
+					{
+						Name: "web",
+						Consumers: []ServiceConsumer{
+							{
+								PeerName: "foo",
+								Filter:   `Service.Meta.env ==`,
+							},
+						},
+					},
+				},
+			},
+			validateErr: `Services[0].Consumers[0]: Filter is not a valid expression`,
+		},
+		"validate: filter for partition": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				Services: []ExportedService{
+					{
+						Name: "web",
+						Consumers: []ServiceConsumer{
+							{
+								Partition: "foo",
+								Filter:    `Service.Meta.env == "prod"`,
+							},
+						},
+					},
+				},
+			},
+			validateErr: `Services[0].Consumers[0]: Filter can only be set for peers`,
+		},
 	}
 
 	testConfigEntryNormalizeAndValidate(t, cases)
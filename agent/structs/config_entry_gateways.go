@@ -1,13 +1,18 @@
 package structs
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/lib/stringslice"
 	"github.com/hashicorp/consul/types"
 )
@@ -49,10 +54,21 @@ type IngressListener struct {
 	// TLS config for this listener.
 	TLS *GatewayTLSConfig `json:",omitempty"`
 
+	// JWT configures the JSON Web Token providers used to authenticate requests
+	// to every service on this listener. Services may override it with their
+	// own JWT config. Only allowed on layer 7 protocols.
+	JWT *IngressJWTConfig `json:",omitempty"`
+
+	// ExtAuthz configures an external authorization service which must allow
+	// each request before it is forwarded. Only allowed on layer 7 protocols.
+	ExtAuthz *IngressExtAuthzConfig `json:",omitempty" alias:"ext_authz"`
+
 	// Services declares the set of services to which the listener forwards
 	// traffic.
 	//
-	// For "tcp" protocol listeners, only a single service is allowed.
+	// For "tcp" protocol listeners, only a single service is allowed unless
+	// every service declares Hosts, in which case connections are passed
+	// through to the service matching their TLS SNI.
 	// For "http" listeners, multiple services can be declared.
 	Services []IngressService
 }
@@ -69,16 +85,19 @@ type IngressService struct {
 	Name string
 
 	// Hosts is a list of hostnames which should be associated to this service on
-	// the defined listener. Only allowed on layer 7 protocols, this will be used
-	// to route traffic to the service by matching the Host header of the HTTP
-	// request.
+	// the defined listener. On layer 7 protocols, this will be used to route
+	// traffic to the service by matching the Host header of the HTTP request.
+	//
+	// On "tcp" listeners, this will be used to route TLS connections to the
+	// service by matching the SNI of the client's handshake. The gateway does
+	// not terminate these connections, so TLS must not be enabled for the
+	// listener.
 	//
 	// If a host is provided for a service that also has a wildcard specifier
 	// defined, the host will override the wildcard-specifier-provided
 	// "<service-name>.*" domain for that listener.
 	//
-	// This cannot be specified when using the wildcard specifier, "*", or when
-	// using a "tcp" listener.
+	// This cannot be specified when using the wildcard specifier, "*".
 	Hosts []string
 
 	// TLS configuration overrides for this service. At least one entry must exist
@@ -90,6 +109,10 @@ type IngressService struct {
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
 
+	// JWT overrides the listener's JWT configuration for this service. Only
+	// allowed on layer 7 protocols.
+	JWT *IngressJWTConfig `json:",omitempty"`
+
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 }
@@ -122,6 +145,170 @@ type GatewayTLSSDSConfig struct {
 	CertResource string `json:",omitempty" alias:"cert_resource"`
 }
 
+// IngressJWTConfig requires requests to carry a JSON Web Token that is valid
+// according to at least one of the listed providers.
+type IngressJWTConfig struct {
+	Providers []IngressJWTProvider
+}
+
+type IngressJWTProvider struct {
+	// Name identifies the provider. Providers with the same name on a listener
+	// and its services must be identical.
+	Name string
+
+	// Issuer is the expected value of the token's "iss" claim. If empty the
+	// issuer is not checked.
+	Issuer string `json:",omitempty"`
+
+	// Audiences restricts the accepted values of the token's "aud" claim. If
+	// empty the audience is not checked.
+	Audiences []string `json:",omitempty"`
+
+	// JSONWebKeySet is where to find the keys used to verify token signatures.
+	JSONWebKeySet *IngressJWKS `json:",omitempty" alias:"json_web_key_set"`
+
+	// ForwardToken keeps the token on the request forwarded to the service. By
+	// default it is removed once verified.
+	ForwardToken bool `json:",omitempty" alias:"forward_token"`
+
+	// ClaimsToHeaders copies claims from a verified token into request headers.
+	ClaimsToHeaders []IngressJWTClaimToHeader `json:",omitempty" alias:"claims_to_headers"`
+}
+
+// IngressJWKS holds exactly one of a local or remote JSON Web Key Set.
+type IngressJWKS struct {
+	Local  *IngressLocalJWKS  `json:",omitempty"`
+	Remote *IngressRemoteJWKS `json:",omitempty"`
+}
+
+type IngressLocalJWKS struct {
+	// JWKS is an inline JSON Web Key Set.
+	JWKS string `json:",omitempty"`
+
+	// Filename is the path to a file containing a JSON Web Key Set, read by the
+	// gateway's Envoy process.
+	Filename string `json:",omitempty"`
+}
+
+type IngressRemoteJWKS struct {
+	// URI is the HTTP(S) endpoint serving the JSON Web Key Set.
+	URI string
+
+	// ClusterName is the name of an Envoy cluster, defined in the gateway's
+	// bootstrap config, used to reach URI.
+	ClusterName string `alias:"cluster_name"`
+
+	// CacheDuration is how long fetched keys are cached. Envoy defaults to
+	// five minutes.
+	CacheDuration time.Duration `json:",omitempty" alias:"cache_duration"`
+}
+
+func (r *IngressRemoteJWKS) MarshalJSON() ([]byte, error) {
+	type Alias IngressRemoteJWKS
+	exported := &struct {
+		CacheDuration string `json:",omitempty"`
+		*Alias
+	}{
+		CacheDuration: r.CacheDuration.String(),
+		Alias:         (*Alias)(r),
+	}
+	if r.CacheDuration == 0 {
+		exported.CacheDuration = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (r *IngressRemoteJWKS) UnmarshalJSON(data []byte) error {
+	type Alias IngressRemoteJWKS
+	aux := &struct {
+		CacheDuration string
+		*Alias
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.CacheDuration != "" {
+		if r.CacheDuration, err = time.ParseDuration(aux.CacheDuration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type IngressJWTClaimToHeader struct {
+	// Claim is the name of a top-level claim in the token.
+	Claim string
+
+	// Header is the request header the claim value is written to.
+	Header string
+}
+
+// IngressExtAuthzConfig delegates authorization of each request to a Consul
+// service implementing Envoy's external authorization API.
+type IngressExtAuthzConfig struct {
+	// Service is the name of the authorization service. The gateway connects to
+	// it over mTLS like any other upstream.
+	Service string
+
+	// Protocol is the API the authorization service implements, either "grpc"
+	// (the default) or "http".
+	Protocol string `json:",omitempty"`
+
+	// Timeout bounds each authorization check. Envoy defaults to 200ms.
+	Timeout time.Duration `json:",omitempty"`
+
+	// FailureModeAllow lets requests through when the authorization service
+	// cannot be reached or returns an error.
+	FailureModeAllow bool `json:",omitempty" alias:"failure_mode_allow"`
+
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+}
+
+func (c *IngressExtAuthzConfig) MarshalJSON() ([]byte, error) {
+	type Alias IngressExtAuthzConfig
+	exported := &struct {
+		Timeout string `json:",omitempty"`
+		*Alias
+	}{
+		Timeout: c.Timeout.String(),
+		Alias:   (*Alias)(c),
+	}
+	if c.Timeout == 0 {
+		exported.Timeout = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (c *IngressExtAuthzConfig) UnmarshalJSON(data []byte) error {
+	type Alias IngressExtAuthzConfig
+	aux := &struct {
+		Timeout string
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.Timeout != "" {
+		if c.Timeout, err = time.ParseDuration(aux.Timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToServiceName returns the name of the authorization service.
+func (c *IngressExtAuthzConfig) ToServiceName() ServiceName {
+	return NewServiceName(c.Service, &c.EnterpriseMeta)
+}
+
 func (e *IngressGatewayConfigEntry) GetKind() string {
 	return IngressGateway
 }
@@ -155,6 +342,14 @@ func (e *IngressGatewayConfigEntry) Normalize() error {
 		}
 
 		listener.Protocol = strings.ToLower(listener.Protocol)
+		if listener.ExtAuthz != nil {
+			if listener.ExtAuthz.Protocol == "" {
+				listener.ExtAuthz.Protocol = "grpc"
+			}
+			listener.ExtAuthz.Protocol = strings.ToLower(listener.ExtAuthz.Protocol)
+			listener.ExtAuthz.EnterpriseMeta.Merge(&e.EnterpriseMeta)
+			listener.ExtAuthz.EnterpriseMeta.Normalize()
+		}
 		for i := range listener.Services {
 			listener.Services[i].EnterpriseMeta.Merge(&e.EnterpriseMeta)
 			listener.Services[i].EnterpriseMeta.Normalize()
@@ -274,9 +469,17 @@ func (e *IngressGatewayConfigEntry) Validate() error {
 			return fmt.Errorf("No service declared for listener with port %d", listener.Port)
 		}
 
+		// TCP listeners route by SNI when any of their services declares hosts.
+		sniRouting := listener.Protocol == "tcp" && ingressListenerHasHosts(listener)
+
 		// Validate that http features aren't being used with tcp or another non-supported protocol.
-		if !IsProtocolHTTPLike(listener.Protocol) && len(listener.Services) > 1 {
-			return fmt.Errorf("Multiple services per listener are only supported for L7 protocols, 'http', 'grpc' and 'http2' (listener on port %d)",
+		if !IsProtocolHTTPLike(listener.Protocol) && len(listener.Services) > 1 && !sniRouting {
+			return fmt.Errorf("Multiple services per listener are only supported for L7 protocols, 'http', 'grpc' and 'http2', or for 'tcp' services with Hosts (listener on port %d)",
+				listener.Port)
+		}
+
+		if sniRouting && e.listenerTLSEnabled(listener) {
+			return fmt.Errorf("TLS cannot be enabled for a tcp listener with Hosts, connections are passed through to the service (listener on port %d)",
 				listener.Port)
 		}
 
@@ -286,6 +489,15 @@ func (e *IngressGatewayConfigEntry) Validate() error {
 			}
 		}
 
+		if err := listener.ExtAuthz.Validate(listener.Protocol); err != nil {
+			return fmt.Errorf("ext authz %s (listener on port %d)", err, listener.Port)
+		}
+
+		jwtProviders := make(map[string]IngressJWTProvider)
+		if err := listener.JWT.Validate(listener.Protocol, jwtProviders); err != nil {
+			return fmt.Errorf("JWT %s (listener on port %d)", err, listener.Port)
+		}
+
 		declaredHosts := make(map[string]bool)
 		serviceNames := make(map[ServiceID]struct{})
 		for _, s := range listener.Services {
@@ -296,13 +508,19 @@ func (e *IngressGatewayConfigEntry) Validate() error {
 			if err := s.ResponseHeaders.Validate(listener.Protocol); err != nil {
 				return fmt.Errorf("response headers %s (service %q on listener on port %d)", err, sn.String(), listener.Port)
 			}
+			if err := s.JWT.Validate(listener.Protocol, jwtProviders); err != nil {
+				return fmt.Errorf("JWT %s (service %q on listener on port %d)", err, sn.String(), listener.Port)
+			}
 
 			if listener.Protocol == "tcp" {
 				if s.Name == WildcardSpecifier {
 					return fmt.Errorf("Wildcard service name is only valid for protocol = 'http' (listener on port %d)", listener.Port)
 				}
-				if len(s.Hosts) != 0 {
-					return fmt.Errorf("Associating hosts to a service is not supported for the %s protocol (listener on port %d)", listener.Protocol, listener.Port)
+				if sniRouting && len(s.Hosts) == 0 {
+					return fmt.Errorf("Hosts must be set on every service of a tcp listener with Hosts (service %q on listener on port %d)", sn.String(), listener.Port)
+				}
+				if sniRouting && s.TLS != nil {
+					return fmt.Errorf("TLS overrides are not supported for a tcp listener with Hosts (service %q on listener on port %d)", sn.String(), listener.Port)
 				}
 			}
 			if s.Name == "" {
@@ -330,6 +548,9 @@ func (e *IngressGatewayConfigEntry) Validate() error {
 					return fmt.Errorf("Hosts must be unique within a specific listener (listener on port %d)", listener.Port)
 				}
 				declaredHosts[h] = true
+				if sniRouting && h == WildcardSpecifier {
+					return fmt.Errorf("Host '*' is not allowed on a tcp listener, hosts are matched against the SNI of each connection (listener on port %d)", listener.Port)
+				}
 				if err := validateHost(e.TLS.Enabled, h); err != nil {
 					return err
 				}
@@ -340,6 +561,118 @@ func (e *IngressGatewayConfigEntry) Validate() error {
 	return nil
 }
 
+// ingressListenerHasHosts returns true if any service on the listener declares
+// hosts.
+func ingressListenerHasHosts(listener IngressListener) bool {
+	for _, s := range listener.Services {
+		if len(s.Hosts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// listenerTLSEnabled returns true if the gateway terminates TLS on the given
+// listener, either with a Connect certificate or one loaded from SDS.
+func (e *IngressGatewayConfigEntry) listenerTLSEnabled(listener IngressListener) bool {
+	if e.TLS.Enabled || (e.TLS.SDS != nil && e.TLS.SDS.CertResource != "") {
+		return true
+	}
+	if listener.TLS == nil {
+		return false
+	}
+	return listener.TLS.Enabled || (listener.TLS.SDS != nil && listener.TLS.SDS.CertResource != "")
+}
+
+// Validate checks the JWT config for a listener or service using the given
+// protocol. Providers are recorded in declared so that a provider name used on
+// several services of a listener always refers to the same settings.
+func (c *IngressJWTConfig) Validate(protocol string, declared map[string]IngressJWTProvider) error {
+	if c == nil {
+		return nil
+	}
+	if !IsProtocolHTTPLike(protocol) {
+		return fmt.Errorf("only valid for http, http2 and grpc protocols")
+	}
+	if len(c.Providers) == 0 {
+		return fmt.Errorf("must declare at least one provider")
+	}
+
+	for _, p := range c.Providers {
+		if p.Name == "" {
+			return fmt.Errorf("provider name cannot be blank")
+		}
+		if err := p.validate(); err != nil {
+			return fmt.Errorf("provider %q %s", p.Name, err)
+		}
+		if prev, ok := declared[p.Name]; ok && !reflect.DeepEqual(prev, p) {
+			return fmt.Errorf("provider %q is declared more than once with different settings", p.Name)
+		}
+		declared[p.Name] = p
+	}
+	return nil
+}
+
+func (p *IngressJWTProvider) validate() error {
+	jwks := p.JSONWebKeySet
+	if jwks == nil || (jwks.Local == nil && jwks.Remote == nil) {
+		return fmt.Errorf("must set a JSONWebKeySet")
+	}
+	if jwks.Local != nil && jwks.Remote != nil {
+		return fmt.Errorf("must set only one of JSONWebKeySet.Local or JSONWebKeySet.Remote")
+	}
+
+	if local := jwks.Local; local != nil {
+		if (local.JWKS == "") == (local.Filename == "") {
+			return fmt.Errorf("must set exactly one of JSONWebKeySet.Local.JWKS or JSONWebKeySet.Local.Filename")
+		}
+	}
+
+	if remote := jwks.Remote; remote != nil {
+		u, err := url.Parse(remote.URI)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("JSONWebKeySet.Remote.URI must be an absolute http or https URL")
+		}
+		if remote.ClusterName == "" {
+			return fmt.Errorf("JSONWebKeySet.Remote.ClusterName is required")
+		}
+		if remote.CacheDuration < 0 {
+			return fmt.Errorf("JSONWebKeySet.Remote.CacheDuration cannot be negative")
+		}
+	}
+
+	for _, c := range p.ClaimsToHeaders {
+		if c.Claim == "" || c.Header == "" {
+			return fmt.Errorf("ClaimsToHeaders entries must set both Claim and Header")
+		}
+	}
+	return nil
+}
+
+// Validate checks the external authorization config for a listener using the
+// given protocol.
+func (c *IngressExtAuthzConfig) Validate(protocol string) error {
+	if c == nil {
+		return nil
+	}
+	if !IsProtocolHTTPLike(protocol) {
+		return fmt.Errorf("only valid for http, http2 and grpc protocols")
+	}
+	if c.Service == "" {
+		return fmt.Errorf("service name cannot be blank")
+	}
+	if c.Service == WildcardSpecifier {
+		return fmt.Errorf("service name cannot be the wildcard specifier")
+	}
+	if c.Protocol != "grpc" && c.Protocol != "http" {
+		return fmt.Errorf("protocol must be 'grpc' or 'http'. '%s' is an unsupported protocol", c.Protocol)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("timeout cannot be negative")
+	}
+	return nil
+}
+
 func validateHost(tlsEnabled bool, host string) error {
 	// Special case '*' so that non-TLS ingress gateways can use it. This allows
 	// an easy demo/testing experience.
@@ -464,9 +797,92 @@ type LinkedService struct {
 	// SNI is the optional name to specify during the TLS handshake with a linked service
 	SNI string `json:",omitempty"`
 
+	// DNS configures how the gateway resolves instances of the linked service
+	// that are registered with a hostname rather than an IP address.
+	DNS *LinkedServiceDNSConfig `json:",omitempty"`
+
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 }
 
+// LinkedServiceDNSConfig configures the DNS resolution of hostname addressed
+// instances of a service linked to a terminating gateway.
+type LinkedServiceDNSConfig struct {
+	// DiscoveryType is the Envoy service discovery type used to resolve the
+	// hostname: "logical_dns" connects to a single resolved address at a time
+	// while "strict_dns" load balances across every resolved address. Defaults
+	// to the gateway's envoy_dns_discovery_type proxy config.
+	DiscoveryType string `json:",omitempty" alias:"discovery_type"`
+
+	// RefreshRate is the interval at which the hostname is resolved again.
+	// Defaults to 10s.
+	RefreshRate time.Duration `json:",omitempty" alias:"refresh_rate"`
+
+	// RespectTTL resolves the hostname again when the TTL of its DNS records
+	// expires, rather than at RefreshRate.
+	RespectTTL bool `json:",omitempty" alias:"respect_ttl"`
+
+	// LookupFamily is the IP address family to resolve the hostname to. One of
+	// "v4_only", "v6_only", "v4_preferred", "auto" or "all". Defaults to
+	// "v4_only".
+	LookupFamily string `json:",omitempty" alias:"lookup_family"`
+}
+
+func (c *LinkedServiceDNSConfig) MarshalJSON() ([]byte, error) {
+	type Alias LinkedServiceDNSConfig
+	exported := &struct {
+		RefreshRate string `json:",omitempty"`
+		*Alias
+	}{
+		RefreshRate: c.RefreshRate.String(),
+		Alias:       (*Alias)(c),
+	}
+	if c.RefreshRate == 0 {
+		exported.RefreshRate = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (c *LinkedServiceDNSConfig) UnmarshalJSON(data []byte) error {
+	type Alias LinkedServiceDNSConfig
+	aux := &struct {
+		RefreshRate string
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RefreshRate != "" {
+		if c.RefreshRate, err = time.ParseDuration(aux.RefreshRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *LinkedServiceDNSConfig) validate() error {
+	switch c.DiscoveryType {
+	case "", "logical_dns", "strict_dns":
+	default:
+		return fmt.Errorf("DiscoveryType must be 'logical_dns' or 'strict_dns'. '%s' is an unsupported type", c.DiscoveryType)
+	}
+	if c.RefreshRate < 0 {
+		return fmt.Errorf("RefreshRate cannot be negative")
+	}
+	if c.RefreshRate > 0 && c.RefreshRate < time.Millisecond {
+		return fmt.Errorf("RefreshRate must be at least 1ms")
+	}
+	switch c.LookupFamily {
+	case "", "v4_only", "v6_only", "v4_preferred", "auto", "all":
+	default:
+		return fmt.Errorf("LookupFamily must be one of 'v4_only', 'v6_only', 'v4_preferred', 'auto' or 'all'. '%s' is an unsupported family", c.LookupFamily)
+	}
+	return nil
+}
+
 func (e *TerminatingGatewayConfigEntry) GetKind() string {
 	return TerminatingGateway
 }
@@ -497,6 +913,11 @@ func (e *TerminatingGatewayConfigEntry) Normalize() error {
 	for i := range e.Services {
 		e.Services[i].EnterpriseMeta.Merge(&e.EnterpriseMeta)
 		e.Services[i].EnterpriseMeta.Normalize()
+
+		if dns := e.Services[i].DNS; dns != nil {
+			dns.DiscoveryType = strings.ToLower(dns.DiscoveryType)
+			dns.LookupFamily = strings.ToLower(dns.LookupFamily)
+		}
 	}
 
 	return nil
@@ -538,6 +959,12 @@ func (e *TerminatingGatewayConfigEntry) Validate() error {
 
 			return fmt.Errorf("Service %q must have a CertFile, CAFile, and KeyFile specified for TLS origination", svc.Name)
 		}
+
+		if svc.DNS != nil {
+			if err := svc.DNS.validate(); err != nil {
+				return fmt.Errorf("Service %q has invalid DNS config: %v", svc.Name, err)
+			}
+		}
 	}
 	return nil
 }
@@ -608,6 +1035,9 @@ type GatewayService struct {
 	SNI          string             `json:",omitempty"`
 	FromWildcard bool               `json:",omitempty"`
 	ServiceKind  GatewayServiceKind `json:",omitempty"`
+
+	// DNS is the DNS config of a service linked to a terminating gateway.
+	DNS *LinkedServiceDNSConfig `json:",omitempty"`
 	RaftIndex
 }
 
@@ -645,11 +1075,12 @@ func (g *GatewayService) IsSame(o *GatewayService) bool {
 		g.KeyFile == o.KeyFile &&
 		g.SNI == o.SNI &&
 		g.ServiceKind == o.ServiceKind &&
-		g.FromWildcard == o.FromWildcard
+		g.FromWildcard == o.FromWildcard &&
+		reflect.DeepEqual(g.DNS, o.DNS)
 }
 
 func (g *GatewayService) Clone() *GatewayService {
-	return &GatewayService{
+	clone := &GatewayService{
 		Gateway:     g.Gateway,
 		Service:     g.Service,
 		GatewayKind: g.GatewayKind,
@@ -665,4 +1096,9 @@ func (g *GatewayService) Clone() *GatewayService {
 		RaftIndex:    g.RaftIndex,
 		ServiceKind:  g.ServiceKind,
 	}
+	if g.DNS != nil {
+		dns := *g.DNS
+		clone.DNS = &dns
+	}
+	return clone
 }
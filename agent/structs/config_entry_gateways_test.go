@@ -2,6 +2,7 @@ package structs
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -238,10 +239,76 @@ func TestIngressGatewayConfigEntry(t *testing.T) {
 			},
 			validateErr: "protocol must be 'tcp', 'http', 'http2', or 'grpc'. 'asdf' is an unsupported protocol",
 		},
-		"hosts cannot be set on a tcp listener": {
+		"tcp listener routes by hosts": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						Services: []IngressService{
+							{
+								Name:  "db1",
+								Hosts: []string{"db1.example.com", "*.db1.example.com"},
+							},
+							{
+								Name:  "db2",
+								Hosts: []string{"db2.example.com"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"tcp listener with hosts requires hosts on every service": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						Services: []IngressService{
+							{
+								Name:  "db1",
+								Hosts: []string{"db1.example.com"},
+							},
+							{
+								Name: "db2",
+							},
+						},
+					},
+				},
+			},
+			validateErr: `Hosts must be set on every service of a tcp listener with Hosts (service "db2" on listener on port 1111)`,
+		},
+		"tcp listener with hosts cannot use the wildcard host": {
 			entry: &IngressGatewayConfigEntry{
 				Kind: "ingress-gateway",
 				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						Services: []IngressService{
+							{
+								Name:  "db",
+								Hosts: []string{"*"},
+							},
+						},
+					},
+				},
+			},
+			validateErr: "Host '*' is not allowed on a tcp listener",
+		},
+		"tcp listener with hosts cannot enable gateway TLS": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				TLS: GatewayTLSConfig{
+					Enabled: true,
+				},
 				Listeners: []IngressListener{
 					{
 						Port:     1111,
@@ -255,7 +322,79 @@ func TestIngressGatewayConfigEntry(t *testing.T) {
 					},
 				},
 			},
-			validateErr: "Associating hosts to a service is not supported for the tcp protocol",
+			validateErr: "TLS cannot be enabled for a tcp listener with Hosts",
+		},
+		"tcp listener with hosts cannot enable listener SDS": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						TLS: &GatewayTLSConfig{
+							SDS: &GatewayTLSSDSConfig{
+								ClusterName:  "sds-cluster",
+								CertResource: "cert-resource",
+							},
+						},
+						Services: []IngressService{
+							{
+								Name:  "db",
+								Hosts: []string{"db.example.com"},
+							},
+						},
+					},
+				},
+			},
+			validateErr: "TLS cannot be enabled for a tcp listener with Hosts",
+		},
+		"tcp listener with hosts service cannot override TLS": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						Services: []IngressService{
+							{
+								Name:  "db",
+								Hosts: []string{"db.example.com"},
+								TLS: &GatewayServiceTLSConfig{
+									SDS: &GatewayTLSSDSConfig{
+										ClusterName:  "sds-cluster",
+										CertResource: "cert-resource",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			validateErr: "TLS overrides are not supported for a tcp listener with Hosts",
+		},
+		"tcp listener without hosts service can override TLS": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						Services: []IngressService{
+							{
+								Name: "db",
+								TLS: &GatewayServiceTLSConfig{
+									SDS: &GatewayTLSSDSConfig{
+										ClusterName: "sds-cluster",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		"hosts cannot be set on a wildcard specifier": {
 			entry: &IngressGatewayConfigEntry{
@@ -895,11 +1034,264 @@ func TestIngressGatewayConfigEntry(t *testing.T) {
 			// since the service name is normalized differently on OSS and Ent
 			validateErr: "TLS.SDS.ClusterName is required if CertResource is set",
 		},
+		"normalize: ext authz protocol defaults to grpc": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						ExtAuthz: &IngressExtAuthzConfig{Service: "authz"},
+						Services: []IngressService{},
+					},
+				},
+			},
+			expected: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						ExtAuthz: &IngressExtAuthzConfig{
+							Service:        "authz",
+							Protocol:       "grpc",
+							EnterpriseMeta: *defaultMeta,
+						},
+						Services: []IngressService{},
+					},
+				},
+				EnterpriseMeta: *defaultMeta,
+			},
+			normalizeOnly: true,
+		},
+		"JWT and ext authz on http listener and service": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						JWT: &IngressJWTConfig{
+							Providers: []IngressJWTProvider{testIngressJWTProvider("okta")},
+						},
+						ExtAuthz: &IngressExtAuthzConfig{
+							Service:  "authz",
+							Protocol: "http",
+							Timeout:  time.Second,
+						},
+						Services: []IngressService{
+							{Name: "web"},
+							{
+								Name: "api",
+								JWT: &IngressJWTConfig{
+									Providers: []IngressJWTProvider{
+										testIngressJWTProvider("okta"),
+										{
+											Name: "partner",
+											JSONWebKeySet: &IngressJWKS{
+												Remote: &IngressRemoteJWKS{
+													URI:         "https://partner.example.com/jwks.json",
+													ClusterName: "partner_jwks",
+												},
+											},
+											ClaimsToHeaders: []IngressJWTClaimToHeader{
+												{Claim: "sub", Header: "x-partner-user"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"JWT not supported on tcp listener": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						JWT: &IngressJWTConfig{
+							Providers: []IngressJWTProvider{testIngressJWTProvider("okta")},
+						},
+						Services: []IngressService{{Name: "db"}},
+					},
+				},
+			},
+			validateErr: "JWT only valid for http, http2 and grpc protocols (listener on port 1111)",
+		},
+		"JWT requires a provider": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						JWT:      &IngressJWTConfig{},
+						Services: []IngressService{{Name: "web"}},
+					},
+				},
+			},
+			validateErr: "JWT must declare at least one provider (listener on port 1111)",
+		},
+		"JWT provider requires a key set": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						Services: []IngressService{
+							{
+								Name: "web",
+								JWT: &IngressJWTConfig{
+									Providers: []IngressJWTProvider{{Name: "okta"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			validateErr: `JWT provider "okta" must set a JSONWebKeySet`,
+		},
+		"JWT provider cannot set local and remote key sets": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						JWT: &IngressJWTConfig{
+							Providers: []IngressJWTProvider{
+								{
+									Name: "okta",
+									JSONWebKeySet: &IngressJWKS{
+										Local:  &IngressLocalJWKS{Filename: "/jwks.json"},
+										Remote: &IngressRemoteJWKS{URI: "https://example.com/jwks.json", ClusterName: "jwks"},
+									},
+								},
+							},
+						},
+						Services: []IngressService{{Name: "web"}},
+					},
+				},
+			},
+			validateErr: `JWT provider "okta" must set only one of JSONWebKeySet.Local or JSONWebKeySet.Remote`,
+		},
+		"JWT provider remote key set requires http URI": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						JWT: &IngressJWTConfig{
+							Providers: []IngressJWTProvider{
+								{
+									Name: "okta",
+									JSONWebKeySet: &IngressJWKS{
+										Remote: &IngressRemoteJWKS{URI: "/jwks.json", ClusterName: "jwks"},
+									},
+								},
+							},
+						},
+						Services: []IngressService{{Name: "web"}},
+					},
+				},
+			},
+			validateErr: `JWT provider "okta" JSONWebKeySet.Remote.URI must be an absolute http or https URL`,
+		},
+		"JWT provider redeclared with different settings": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						JWT: &IngressJWTConfig{
+							Providers: []IngressJWTProvider{testIngressJWTProvider("okta")},
+						},
+						Services: []IngressService{
+							{
+								Name: "web",
+								JWT: &IngressJWTConfig{
+									Providers: []IngressJWTProvider{
+										{
+											Name:   "okta",
+											Issuer: "https://other.example.com/",
+											JSONWebKeySet: &IngressJWKS{
+												Local: &IngressLocalJWKS{Filename: "/jwks.json"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			validateErr: `JWT provider "okta" is declared more than once with different settings`,
+		},
+		"ext authz requires a service": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						ExtAuthz: &IngressExtAuthzConfig{},
+						Services: []IngressService{{Name: "web"}},
+					},
+				},
+			},
+			validateErr: "ext authz service name cannot be blank (listener on port 1111)",
+		},
+		"ext authz unsupported protocol": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						ExtAuthz: &IngressExtAuthzConfig{Service: "authz", Protocol: "tcp"},
+						Services: []IngressService{{Name: "web"}},
+					},
+				},
+			},
+			validateErr: "ext authz protocol must be 'grpc' or 'http'. 'tcp' is an unsupported protocol (listener on port 1111)",
+		},
 	}
 
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
 
+func testIngressJWTProvider(name string) IngressJWTProvider {
+	return IngressJWTProvider{
+		Name:      name,
+		Issuer:    "https://" + name + ".example.com/",
+		Audiences: []string{"ingress"},
+		JSONWebKeySet: &IngressJWKS{
+			Local: &IngressLocalJWKS{JWKS: `{"keys":[]}`},
+		},
+		ClaimsToHeaders: []IngressJWTClaimToHeader{
+			{Claim: "sub", Header: "x-user"},
+		},
+	}
+}
+
 func TestIngressConfigEntry_ListRelatedServices(t *testing.T) {
 	type testcase struct {
 		entry          IngressGatewayConfigEntry
@@ -1063,6 +1455,85 @@ func TestTerminatingGatewayConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		"dns config is normalized": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						DNS: &LinkedServiceDNSConfig{
+							DiscoveryType: "STRICT_DNS",
+							RefreshRate:   time.Minute,
+							RespectTTL:    true,
+							LookupFamily:  "V6_ONLY",
+						},
+					},
+				},
+			},
+			expected: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						DNS: &LinkedServiceDNSConfig{
+							DiscoveryType: "strict_dns",
+							RefreshRate:   time.Minute,
+							RespectTTL:    true,
+							LookupFamily:  "v6_only",
+						},
+						EnterpriseMeta: *DefaultEnterpriseMetaInDefaultPartition(),
+					},
+				},
+				EnterpriseMeta: *DefaultEnterpriseMetaInDefaultPartition(),
+			},
+		},
+		"invalid dns discovery type": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						DNS: &LinkedServiceDNSConfig{
+							DiscoveryType: "eds",
+						},
+					},
+				},
+			},
+			validateErr: "DiscoveryType must be 'logical_dns' or 'strict_dns'",
+		},
+		"invalid dns refresh rate": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						DNS: &LinkedServiceDNSConfig{
+							RefreshRate: time.Microsecond,
+						},
+					},
+				},
+			},
+			validateErr: "RefreshRate must be at least 1ms",
+		},
+		"invalid dns lookup family": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						DNS: &LinkedServiceDNSConfig{
+							LookupFamily: "v5_only",
+						},
+					},
+				},
+			},
+			validateErr: "LookupFamily must be one of",
+		},
 	}
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
@@ -0,0 +1,186 @@
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+// ImportedServicesConfigEntry configures how services imported from cluster
+// peers are registered in the local partition.
+type ImportedServicesConfigEntry struct {
+	Name string
+
+	// Services is a list of imported services to register under a local name.
+	Services []ImportedService `json:",omitempty"`
+
+	Meta               map[string]string `json:",omitempty"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex
+}
+
+// ImportedService registers a service imported from a peer under a local
+// alias instead of the name it was exported with.
+type ImportedService struct {
+	// Peer is the name of the peer the service is imported from.
+	Peer string
+
+	// Name is the name of the service in the exporting peer.
+	Name string
+
+	// Namespace is the namespace of the imported service.
+	Namespace string `json:",omitempty"`
+
+	// LocalName is the name the service is registered under locally.
+	LocalName string `alias:"local_name"`
+}
+
+// LocalNames returns a map of the names of the services imported from the
+// given peer and namespace to their local names.
+func (e *ImportedServicesConfigEntry) LocalNames(peerName string, entMeta *acl.EnterpriseMeta) map[string]string {
+	if e == nil {
+		return nil
+	}
+
+	names := make(map[string]string)
+	for _, svc := range e.Services {
+		if svc.Peer != peerName || !acl.EqualNamespaces(svc.Namespace, entMeta.NamespaceOrDefault()) {
+			continue
+		}
+		names[svc.Name] = svc.LocalName
+	}
+	return names
+}
+
+func (e *ImportedServicesConfigEntry) GetKind() string {
+	return ImportedServices
+}
+
+func (e *ImportedServicesConfigEntry) GetName() string {
+	if e == nil {
+		return ""
+	}
+
+	return e.Name
+}
+
+func (e *ImportedServicesConfigEntry) GetMeta() map[string]string {
+	if e == nil {
+		return nil
+	}
+	return e.Meta
+}
+
+func (e *ImportedServicesConfigEntry) Normalize() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+	e.EnterpriseMeta = *DefaultEnterpriseMetaInPartition(e.Name)
+	e.EnterpriseMeta.Normalize()
+
+	for i := range e.Services {
+		e.Services[i].Namespace = acl.NormalizeNamespace(e.Services[i].Namespace)
+	}
+
+	return nil
+}
+
+func (e *ImportedServicesConfigEntry) Validate() error {
+	if err := validateImportedServicesName(e.Name); err != nil {
+		return err
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	type importKey struct {
+		peer, namespace, name string
+	}
+	var (
+		seenNames      = make(map[importKey]struct{})
+		seenLocalNames = make(map[importKey]struct{})
+	)
+	for i, svc := range e.Services {
+		if svc.Peer == "" {
+			return fmt.Errorf("Services[%d]: Peer cannot be empty", i)
+		}
+		if svc.Name == "" {
+			return fmt.Errorf("Services[%d]: Name cannot be empty", i)
+		}
+		if svc.LocalName == "" {
+			return fmt.Errorf("Services[%d]: LocalName cannot be empty", i)
+		}
+		if svc.Peer == WildcardSpecifier || svc.Name == WildcardSpecifier ||
+			svc.Namespace == WildcardSpecifier || svc.LocalName == WildcardSpecifier {
+			return fmt.Errorf("Services[%d]: wildcards are not supported", i)
+		}
+		if strings.HasSuffix(svc.Name, importedProxyNameSuffix) || strings.HasSuffix(svc.LocalName, importedProxyNameSuffix) {
+			return fmt.Errorf("Services[%d]: names ending in %q are reserved for proxies", i, importedProxyNameSuffix)
+		}
+
+		key := importKey{peer: svc.Peer, namespace: svc.Namespace, name: svc.Name}
+		if _, ok := seenNames[key]; ok {
+			return fmt.Errorf("Services[%d]: service %q from peer %q is already imported", i, svc.Name, svc.Peer)
+		}
+		seenNames[key] = struct{}{}
+
+		key.name = svc.LocalName
+		if _, ok := seenLocalNames[key]; ok {
+			return fmt.Errorf("Services[%d]: LocalName %q is already used for another service from peer %q", i, svc.LocalName, svc.Peer)
+		}
+		seenLocalNames[key] = struct{}{}
+	}
+	return nil
+}
+
+// importedProxyNameSuffix is the suffix of the synthetic proxies replicated
+// for imported services. The proxies of an aliased service are renamed along
+// with it.
+const importedProxyNameSuffix = "-sidecar-proxy"
+
+func (e *ImportedServicesConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *ImportedServicesConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *ImportedServicesConfigEntry) GetRaftIndex() *RaftIndex {
+	if e == nil {
+		return &RaftIndex{}
+	}
+
+	return &e.RaftIndex
+}
+
+func (e *ImportedServicesConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
+	if e == nil {
+		return nil
+	}
+
+	return &e.EnterpriseMeta
+}
+
+// MarshalJSON adds the Kind field so that the JSON can be decoded back into the
+// correct type.
+// This method is implemented on the structs type (as apposed to the api type)
+// because that is what the API currently uses to return a response.
+func (e *ImportedServicesConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ImportedServicesConfigEntry
+	source := &struct {
+		Kind string
+		*Alias
+	}{
+		Kind:  ImportedServices,
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(source)
+}
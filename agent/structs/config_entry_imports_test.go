@@ -0,0 +1,106 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportedServicesConfigEntry(t *testing.T) {
+	cases := map[string]configEntryTestcase{
+		"validate: other name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "foo",
+			},
+			validateErr: `imported-services Name must be "default"`,
+		},
+		"validate: empty peer": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "default",
+				Services: []ImportedService{
+					{Name: "web", LocalName: "peer-web"},
+				},
+			},
+			validateErr: `Services[0]: Peer cannot be empty`,
+		},
+		"validate: empty local name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "default",
+				Services: []ImportedService{
+					{Peer: "east", Name: "web"},
+				},
+			},
+			validateErr: `Services[0]: LocalName cannot be empty`,
+		},
+		"validate: wildcard": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "default",
+				Services: []ImportedService{
+					{Peer: "east", Name: "*", LocalName: "peer-web"},
+				},
+			},
+			validateErr: `Services[0]: wildcards are not supported`,
+		},
+		"validate: proxy name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "default",
+				Services: []ImportedService{
+					{Peer: "east", Name: "web-sidecar-proxy", LocalName: "peer-web"},
+				},
+			},
+			validateErr: `Services[0]: names ending in "-sidecar-proxy" are reserved for proxies`,
+		},
+		"validate: duplicate service": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "default",
+				Services: []ImportedService{
+					{Peer: "east", Name: "web", LocalName: "east-web"},
+					{Peer: "east", Name: "web", LocalName: "east-web-2"},
+				},
+			},
+			validateErr: `Services[1]: service "web" from peer "east" is already imported`,
+		},
+		"validate: duplicate local name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "default",
+				Services: []ImportedService{
+					{Peer: "east", Name: "web", LocalName: "frontend"},
+					{Peer: "east", Name: "ui", LocalName: "frontend"},
+				},
+			},
+			validateErr: `Services[1]: LocalName "frontend" is already used for another service from peer "east"`,
+		},
+		"validate: same local name from different peers": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "default",
+				Services: []ImportedService{
+					{Peer: "east", Name: "web", LocalName: "frontend"},
+					{Peer: "west", Name: "web", LocalName: "frontend"},
+				},
+			},
+		},
+	}
+
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestImportedServicesConfigEntry_LocalNames(t *testing.T) {
+	entry := &ImportedServicesConfigEntry{
+		Name: "default",
+		Services: []ImportedService{
+			{Peer: "east", Name: "web", LocalName: "east-web"},
+			{Peer: "east", Name: "api", LocalName: "east-api"},
+			{Peer: "west", Name: "web", LocalName: "west-web"},
+		},
+	}
+	require.NoError(t, entry.Normalize())
+
+	expect := map[string]string{
+		"web": "east-web",
+		"api": "east-api",
+	}
+	require.Equal(t, expect, entry.LocalNames("east", DefaultEnterpriseMetaInDefaultPartition()))
+
+	var nilEntry *ImportedServicesConfigEntry
+	require.Nil(t, nilEntry.LocalNames("east", DefaultEnterpriseMetaInDefaultPartition()))
+}
@@ -17,6 +17,10 @@ type MeshConfigEntry struct {
 
 	HTTP *MeshHTTPConfig `json:",omitempty"`
 
+	Peering *PeeringMeshConfig `json:",omitempty"`
+
+	Locality *MeshLocalityConfig `json:",omitempty"`
+
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 	RaftIndex
@@ -48,6 +52,61 @@ type MeshHTTPConfig struct {
 	SanitizeXForwardedClientCert bool `alias:"sanitize_x_forwarded_client_cert"`
 }
 
+// PeeringMeshConfig contains cluster-wide options pertaining to peering.
+type PeeringMeshConfig struct {
+	// PeerThroughMeshGateways determines whether the control plane traffic of
+	// peerings is routed through the mesh gateways of the default partition:
+	// the peering tokens generated by this cluster advertise the addresses of
+	// its mesh gateways instead of its servers, and the servers of this
+	// cluster dial their peers through their local mesh gateways.
+	PeerThroughMeshGateways bool `alias:"peer_through_mesh_gateways"`
+}
+
+// MeshLocalityConfig determines how the locality of service instances is read
+// from their metadata. Service metadata takes precedence over node metadata.
+//
+// When set, proxies group the endpoints of their upstreams by locality, and
+// may prioritize those closest to them when the service-resolver of an
+// upstream enables PrioritizeByLocality.
+type MeshLocalityConfig struct {
+	// RegionMetaKey is the metadata key holding the region of an instance.
+	RegionMetaKey string `json:",omitempty" alias:"region_meta_key"`
+
+	// ZoneMetaKey is the metadata key holding the zone of an instance.
+	ZoneMetaKey string `json:",omitempty" alias:"zone_meta_key"`
+}
+
+// Locality returns the locality described by the given service and node
+// metadata.
+func (c *MeshLocalityConfig) Locality(serviceMeta, nodeMeta map[string]string) Locality {
+	if c == nil {
+		return Locality{}
+	}
+	lookup := func(key string) string {
+		if key == "" {
+			return ""
+		}
+		if v := serviceMeta[key]; v != "" {
+			return v
+		}
+		return nodeMeta[key]
+	}
+	return Locality{
+		Region: lookup(c.RegionMetaKey),
+		Zone:   lookup(c.ZoneMetaKey),
+	}
+}
+
+// Locality identifies where a service instance or proxy runs.
+type Locality struct {
+	Region string `json:",omitempty"`
+	Zone   string `json:",omitempty"`
+}
+
+func (l Locality) IsEmpty() bool {
+	return l.Region == "" && l.Zone == ""
+}
+
 func (e *MeshConfigEntry) GetKind() string {
 	return MeshConfig
 }
@@ -85,6 +144,14 @@ func (e *MeshConfigEntry) Validate() error {
 		return err
 	}
 
+	if e.Peering != nil && e.Peering.PeerThroughMeshGateways && !e.InDefaultPartition() {
+		return fmt.Errorf("Peering.PeerThroughMeshGateways is only supported in the default partition")
+	}
+
+	if e.Locality != nil && e.Locality.RegionMetaKey == "" && e.Locality.ZoneMetaKey == "" {
+		return fmt.Errorf("Locality must set at least one of RegionMetaKey or ZoneMetaKey")
+	}
+
 	if e.TLS != nil {
 		if e.TLS.Incoming != nil {
 			if err := validateMeshDirectionalTLSConfig(e.TLS.Incoming); err != nil {
@@ -127,6 +194,24 @@ func (e *MeshConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
 	return &e.EnterpriseMeta
 }
 
+// PeerThroughMeshGateways returns whether the control plane traffic of
+// peerings is routed through mesh gateways.
+func (e *MeshConfigEntry) PeerThroughMeshGateways() bool {
+	if e == nil || e.Peering == nil {
+		return false
+	}
+	return e.Peering.PeerThroughMeshGateways
+}
+
+// LocalityConfig returns how the locality of service instances is determined,
+// or nil if localities aren't used.
+func (e *MeshConfigEntry) LocalityConfig() *MeshLocalityConfig {
+	if e == nil {
+		return nil
+	}
+	return e.Locality
+}
+
 // MarshalJSON adds the Kind field so that the JSON can be decoded back into the
 // correct type.
 // This method is implemented on the structs type (as apposed to the api type)
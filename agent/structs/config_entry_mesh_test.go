@@ -0,0 +1,29 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeshConfigEntry_ValidateLocality(t *testing.T) {
+	entry := &MeshConfigEntry{Locality: &MeshLocalityConfig{}}
+	require.EqualError(t, entry.Validate(), "Locality must set at least one of RegionMetaKey or ZoneMetaKey")
+
+	entry.Locality.ZoneMetaKey = "zone"
+	require.NoError(t, entry.Validate())
+}
+
+func TestMeshLocalityConfig_Locality(t *testing.T) {
+	serviceMeta := map[string]string{"zone": "us-east-1a"}
+	nodeMeta := map[string]string{"region": "us-east-1", "zone": "us-east-1b"}
+
+	var nilCfg *MeshLocalityConfig
+	require.Equal(t, Locality{}, nilCfg.Locality(serviceMeta, nodeMeta))
+
+	cfg := &MeshLocalityConfig{RegionMetaKey: "region", ZoneMetaKey: "zone"}
+	require.Equal(t, Locality{Region: "us-east-1", Zone: "us-east-1a"}, cfg.Locality(serviceMeta, nodeMeta))
+
+	cfg = &MeshLocalityConfig{ZoneMetaKey: "zone"}
+	require.Equal(t, Locality{Zone: "us-east-1b"}, cfg.Locality(nil, nodeMeta))
+}
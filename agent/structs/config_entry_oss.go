@@ -44,3 +44,10 @@ func validateExportedServicesName(name string) error {
 	}
 	return nil
 }
+
+func validateImportedServicesName(name string) error {
+	if name != "default" {
+		return fmt.Errorf(`imported-services Name must be "default"`)
+	}
+	return nil
+}
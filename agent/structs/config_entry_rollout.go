@@ -0,0 +1,317 @@
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/lib"
+)
+
+// ServiceRolloutState is the state of the rollout driven by a service-rollout
+// config entry.
+type ServiceRolloutState string
+
+const (
+	// ServiceRolloutProgressing means the canary weight is being increased
+	// step by step.
+	ServiceRolloutProgressing ServiceRolloutState = "progressing"
+
+	// ServiceRolloutCompleted means every step passed the analysis and the
+	// canary weight of the last step was kept.
+	ServiceRolloutCompleted ServiceRolloutState = "completed"
+
+	// ServiceRolloutRolledBack means the analysis failed and all the traffic
+	// was sent back to the baseline subset.
+	ServiceRolloutRolledBack ServiceRolloutState = "rolled-back"
+)
+
+const (
+	// RolloutMetricsProviderPrometheus queries the HTTP API of Prometheus.
+	RolloutMetricsProviderPrometheus = "prometheus"
+)
+
+// ServiceRolloutConfigEntry progressively moves the traffic of a service from
+// a baseline subset to a canary subset by updating the weights of its
+// service-splitter. The rollout is driven by the leader of the primary
+// datacenter which rolls back to the baseline subset as soon as the analysis
+// fails.
+type ServiceRolloutConfigEntry struct {
+	Kind string
+	Name string
+
+	// BaselineSubset is the subset serving the current version of the
+	// service. An empty string refers to the default subset.
+	BaselineSubset string `json:",omitempty" alias:"baseline_subset"`
+
+	// CanarySubset is the subset serving the new version of the service.
+	CanarySubset string `alias:"canary_subset"`
+
+	// Steps are the successive weights of the canary split, between 0 and
+	// 100 and in increasing order.
+	Steps []float32
+
+	// StepInterval is how long each step must pass the analysis before
+	// moving to the next one.
+	StepInterval time.Duration `json:",omitempty" alias:"step_interval"`
+
+	// MaxCriticalPercentage is the percentage of instances of the canary
+	// subset that may have a critical health check before rolling back. With
+	// the default of 0, a single critical instance triggers a rollback.
+	MaxCriticalPercentage float32 `json:",omitempty" alias:"max_critical_percentage"`
+
+	// Metrics is an optional query returning the error rate of the canary
+	// subset.
+	Metrics *RolloutMetricsQuery `json:",omitempty"`
+
+	// Status is maintained by the rollout controller. Writing the entry
+	// without a status starts the rollout over.
+	Status ServiceRolloutStatus
+
+	Meta               map[string]string `json:",omitempty"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex
+}
+
+// RolloutMetricsQuery is a query to a metrics provider whose result is
+// compared to a threshold during the analysis of a rollout step.
+type RolloutMetricsQuery struct {
+	// Provider is the type of metrics provider. Only "prometheus" is
+	// supported.
+	Provider string
+
+	// Address is the base URL of the metrics provider.
+	Address string
+
+	// Query must evaluate to a single value, for example the rate of 5xx
+	// responses of the canary subset.
+	Query string
+
+	// Threshold is the highest value of the query result for which the
+	// analysis passes.
+	Threshold float64
+}
+
+// ServiceRolloutStatus is the progress of a rollout.
+type ServiceRolloutStatus struct {
+	State ServiceRolloutState `json:",omitempty"`
+
+	// Step is the index of the current step in Steps.
+	Step int
+
+	// CanaryWeight is the weight of the canary split set by the controller.
+	CanaryWeight float32
+
+	// LastTransition is the time the current step was applied.
+	LastTransition time.Time
+
+	// Message explains the last rollback or why the analysis couldn't run.
+	Message string `json:",omitempty"`
+}
+
+func (e *ServiceRolloutConfigEntry) GetKind() string {
+	return ServiceRollout
+}
+
+func (e *ServiceRolloutConfigEntry) GetName() string {
+	if e == nil {
+		return ""
+	}
+
+	return e.Name
+}
+
+func (e *ServiceRolloutConfigEntry) GetMeta() map[string]string {
+	if e == nil {
+		return nil
+	}
+	return e.Meta
+}
+
+func (e *ServiceRolloutConfigEntry) Normalize() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+
+	e.Kind = ServiceRollout
+
+	e.EnterpriseMeta.Normalize()
+
+	for i, step := range e.Steps {
+		e.Steps[i] = NormalizeServiceSplitWeight(step)
+	}
+
+	return nil
+}
+
+func (e *ServiceRolloutConfigEntry) Validate() error {
+	if e.Name == "" {
+		return fmt.Errorf("Name is required")
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	if e.CanarySubset == "" {
+		return fmt.Errorf("CanarySubset is required")
+	}
+	if e.CanarySubset == e.BaselineSubset {
+		return fmt.Errorf("CanarySubset and BaselineSubset must be different")
+	}
+
+	if len(e.Steps) == 0 {
+		return fmt.Errorf("no steps configured")
+	}
+	var prev float32
+	for i, step := range e.Steps {
+		if step <= 0 || step > 100 {
+			return fmt.Errorf("Steps[%d]: weight must be greater than 0 and at most 100, not %f", i, step)
+		}
+		if step <= prev {
+			return fmt.Errorf("Steps[%d]: weights must be increasing", i)
+		}
+		prev = step
+	}
+
+	if e.StepInterval <= 0 {
+		return fmt.Errorf("StepInterval must be greater than 0")
+	}
+
+	if e.MaxCriticalPercentage < 0 || e.MaxCriticalPercentage > 100 {
+		return fmt.Errorf("MaxCriticalPercentage must be between 0 and 100")
+	}
+
+	if e.Metrics != nil {
+		if err := e.Metrics.Validate(); err != nil {
+			return fmt.Errorf("Metrics: %w", err)
+		}
+	}
+
+	if err := e.Status.validate(len(e.Steps)); err != nil {
+		return fmt.Errorf("Status: %w", err)
+	}
+
+	return nil
+}
+
+// validate checks a status written back with the entry, which the rollout
+// controller relies on.
+func (s *ServiceRolloutStatus) validate(steps int) error {
+	switch s.State {
+	case "", ServiceRolloutProgressing, ServiceRolloutCompleted, ServiceRolloutRolledBack:
+	default:
+		return fmt.Errorf("invalid state %q", s.State)
+	}
+	if s.Step < 0 || s.Step >= steps {
+		return fmt.Errorf("step %d is out of range, remove the status to start the rollout over", s.Step)
+	}
+	if s.CanaryWeight < 0 || s.CanaryWeight > 100 {
+		return fmt.Errorf("canary weight must be between 0 and 100")
+	}
+	return nil
+}
+
+func (q *RolloutMetricsQuery) Validate() error {
+	switch q.Provider {
+	case RolloutMetricsProviderPrometheus:
+	default:
+		return fmt.Errorf("unsupported provider %q", q.Provider)
+	}
+
+	u, err := url.Parse(q.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("address must be an http or https URL")
+	}
+
+	if q.Query == "" {
+		return fmt.Errorf("query is required")
+	}
+	return nil
+}
+
+func (e *ServiceRolloutConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().ServiceReadAllowed(e.Name, &authzContext)
+}
+
+// CanWrite requires the same permissions as writing the service-splitter the
+// rollout updates. Metrics queries additionally require operator:write since
+// the servers send them to an arbitrary address.
+func (e *ServiceRolloutConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(e.Name, &authzContext); err != nil {
+		return err
+	}
+	if e.Metrics != nil {
+		return authz.ToAllowAuthorizer().OperatorWriteAllowed(&authzContext)
+	}
+	return nil
+}
+
+func (e *ServiceRolloutConfigEntry) GetRaftIndex() *RaftIndex {
+	if e == nil {
+		return &RaftIndex{}
+	}
+
+	return &e.RaftIndex
+}
+
+func (e *ServiceRolloutConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
+	if e == nil {
+		return nil
+	}
+
+	return &e.EnterpriseMeta
+}
+
+// CurrentStepWeight returns the canary weight of the current step.
+func (e *ServiceRolloutConfigEntry) CurrentStepWeight() float32 {
+	if e.Status.Step < 0 || e.Status.Step >= len(e.Steps) {
+		return 0
+	}
+	return e.Steps[e.Status.Step]
+}
+
+func (e *ServiceRolloutConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ServiceRolloutConfigEntry
+	exported := &struct {
+		StepInterval string `json:",omitempty"`
+		*Alias
+	}{
+		StepInterval: e.StepInterval.String(),
+		Alias:        (*Alias)(e),
+	}
+	if e.StepInterval == 0 {
+		exported.StepInterval = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *ServiceRolloutConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias ServiceRolloutConfigEntry
+	aux := &struct {
+		StepInterval string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.StepInterval != "" {
+		if e.StepInterval, err = time.ParseDuration(aux.StepInterval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
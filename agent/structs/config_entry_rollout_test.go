@@ -0,0 +1,226 @@
+package structs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+func TestServiceRolloutConfigEntry(t *testing.T) {
+	newRollout := func(fn func(e *ServiceRolloutConfigEntry)) *ServiceRolloutConfigEntry {
+		e := &ServiceRolloutConfigEntry{
+			Name:         "web",
+			CanarySubset: "v2",
+			Steps:        []float32{10, 50, 100},
+			StepInterval: time.Minute,
+		}
+		if fn != nil {
+			fn(e)
+		}
+		return e
+	}
+
+	cases := map[string]configEntryTestcase{
+		"normalize: steps": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Steps = []float32{33.33333, 100}
+			}),
+			check: func(t *testing.T, entry ConfigEntry) {
+				rollout := entry.(*ServiceRolloutConfigEntry)
+				require.Equal(t, ServiceRollout, rollout.Kind)
+				require.Equal(t, []float32{33.33, 100}, rollout.Steps)
+			},
+		},
+		"validate: no canary subset": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.CanarySubset = ""
+			}),
+			validateErr: "CanarySubset is required",
+		},
+		"validate: same subsets": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.BaselineSubset = "v2"
+			}),
+			validateErr: "CanarySubset and BaselineSubset must be different",
+		},
+		"validate: no steps": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Steps = nil
+			}),
+			validateErr: "no steps configured",
+		},
+		"validate: step above 100": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Steps = []float32{50, 101}
+			}),
+			validateErr: "Steps[1]: weight must be greater than 0 and at most 100",
+		},
+		"validate: decreasing steps": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Steps = []float32{50, 25}
+			}),
+			validateErr: "Steps[1]: weights must be increasing",
+		},
+		"validate: no step interval": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.StepInterval = 0
+			}),
+			validateErr: "StepInterval must be greater than 0",
+		},
+		"validate: max critical percentage": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.MaxCriticalPercentage = 150
+			}),
+			validateErr: "MaxCriticalPercentage must be between 0 and 100",
+		},
+		"validate: metrics provider": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Metrics = &RolloutMetricsQuery{
+					Provider: "graphite",
+					Address:  "http://graphite:8080",
+					Query:    "errors",
+				}
+			}),
+			validateErr: `Metrics: unsupported provider "graphite"`,
+		},
+		"validate: metrics address": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Metrics = &RolloutMetricsQuery{
+					Provider: RolloutMetricsProviderPrometheus,
+					Address:  "prometheus:9090",
+					Query:    "errors",
+				}
+			}),
+			validateErr: "Metrics: address must be an http or https URL",
+		},
+		"validate: metrics query": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Metrics = &RolloutMetricsQuery{
+					Provider: RolloutMetricsProviderPrometheus,
+					Address:  "http://prometheus:9090",
+				}
+			}),
+			validateErr: "Metrics: query is required",
+		},
+		"validate: status state": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Status = ServiceRolloutStatus{State: "paused"}
+			}),
+			validateErr: `Status: invalid state "paused"`,
+		},
+		"validate: negative status step": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Status = ServiceRolloutStatus{State: ServiceRolloutProgressing, Step: -2}
+			}),
+			validateErr: "Status: step -2 is out of range",
+		},
+		"validate: status step past the last step": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Status = ServiceRolloutStatus{State: ServiceRolloutProgressing, Step: 3}
+			}),
+			validateErr: "Status: step 3 is out of range",
+		},
+		"validate: status": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.Status = ServiceRolloutStatus{
+					State:        ServiceRolloutProgressing,
+					Step:         2,
+					CanaryWeight: 100,
+				}
+			}),
+		},
+		"validate: valid": {
+			entry: newRollout(func(e *ServiceRolloutConfigEntry) {
+				e.BaselineSubset = "v1"
+				e.MaxCriticalPercentage = 20
+				e.Metrics = &RolloutMetricsQuery{
+					Provider:  RolloutMetricsProviderPrometheus,
+					Address:   "http://prometheus:9090",
+					Query:     `sum(rate(envoy_cluster_upstream_rq_xx{envoy_response_code_class="5"}[1m]))`,
+					Threshold: 0.5,
+				}
+			}),
+		},
+	}
+
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestServiceRolloutConfigEntry_ACLs(t *testing.T) {
+	type testACL = configEntryTestACL
+	type testcase = configEntryACLTestCase
+
+	newAuthz := func(t *testing.T, src string) acl.Authorizer {
+		policy, err := acl.NewPolicyFromSource(src, acl.SyntaxCurrent, nil, nil)
+		require.NoError(t, err)
+
+		authorizer, err := acl.NewPolicyAuthorizerWithDefaults(acl.DenyAll(), []*acl.Policy{policy}, nil)
+		require.NoError(t, err)
+		return authorizer
+	}
+
+	cases := []testcase{
+		{
+			name: "health analysis",
+			entry: &ServiceRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        []float32{50, 100},
+				StepInterval: time.Minute,
+			},
+			expectACLs: []testACL{
+				{
+					name:       "no-authz",
+					authorizer: newAuthz(t, ``),
+					canRead:    false,
+					canWrite:   false,
+				},
+				{
+					name:       "service read",
+					authorizer: newAuthz(t, `service "web" { policy = "read" }`),
+					canRead:    true,
+					canWrite:   false,
+				},
+				{
+					name:       "service write",
+					authorizer: newAuthz(t, `service "web" { policy = "write" }`),
+					canRead:    true,
+					canWrite:   true,
+				},
+			},
+		},
+		{
+			name: "metrics analysis",
+			entry: &ServiceRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        []float32{50, 100},
+				StepInterval: time.Minute,
+				Metrics: &RolloutMetricsQuery{
+					Provider: RolloutMetricsProviderPrometheus,
+					Address:  "http://prometheus:9090",
+					Query:    "errors",
+				},
+			},
+			expectACLs: []testACL{
+				{
+					name:       "service write",
+					authorizer: newAuthz(t, `service "web" { policy = "write" }`),
+					canRead:    true,
+					canWrite:   false,
+				},
+				{
+					name:       "service and operator write",
+					authorizer: newAuthz(t, `service "web" { policy = "write" } operator = "write"`),
+					canRead:    true,
+					canWrite:   true,
+				},
+			},
+		},
+	}
+
+	testConfigEntries_ListRelatedServices_AndACLs(t, cases)
+}
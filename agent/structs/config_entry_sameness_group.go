@@ -0,0 +1,166 @@
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+// SamenessGroupConfigEntry lists the partitions and cluster peers that host
+// equivalent services. A service of a given name is assumed to be the same
+// service in every member of the group, which allows discovery chains and
+// prepared queries to fail over across the group without listing failover
+// targets for each service.
+type SamenessGroupConfigEntry struct {
+	Name string
+
+	// DefaultForFailover makes the group the failover policy of every service
+	// in the partition whose service-resolver does not define one. At most one
+	// group per partition can be the default.
+	DefaultForFailover bool `json:",omitempty" alias:"default_for_failover"`
+
+	// Members are the partitions and peers of the group, in the order they
+	// are failed over to.
+	Members []SamenessGroupMember
+
+	Meta               map[string]string `json:",omitempty"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex
+}
+
+// SamenessGroupMember is either a partition of the local datacenter or a
+// cluster peer.
+type SamenessGroupMember struct {
+	Partition string `json:",omitempty"`
+	Peer      string `json:",omitempty"`
+}
+
+func (e *SamenessGroupConfigEntry) GetKind() string {
+	return SamenessGroup
+}
+
+func (e *SamenessGroupConfigEntry) GetName() string {
+	if e == nil {
+		return ""
+	}
+
+	return e.Name
+}
+
+func (e *SamenessGroupConfigEntry) GetMeta() map[string]string {
+	if e == nil {
+		return nil
+	}
+	return e.Meta
+}
+
+func (e *SamenessGroupConfigEntry) Normalize() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+
+	e.EnterpriseMeta.Normalize()
+	return nil
+}
+
+func (e *SamenessGroupConfigEntry) Validate() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+
+	if e.Name == "" {
+		return fmt.Errorf("Name is required")
+	}
+	if e.Name == WildcardSpecifier {
+		return fmt.Errorf("sameness-group name cannot be a wildcard")
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	seen := make(map[SamenessGroupMember]struct{})
+	for i, m := range e.Members {
+		if (m.Partition == "") == (m.Peer == "") {
+			return fmt.Errorf("Members[%d]: must define exactly one of Partition or Peer", i)
+		}
+		if m.Partition == WildcardSpecifier || m.Peer == WildcardSpecifier {
+			return fmt.Errorf("Members[%d]: wildcards are not supported", i)
+		}
+		if _, ok := seen[m]; ok {
+			return fmt.Errorf("Members[%d]: duplicate member %s", i, m.String())
+		}
+		seen[m] = struct{}{}
+	}
+	return nil
+}
+
+func (m SamenessGroupMember) String() string {
+	if m.Peer != "" {
+		return fmt.Sprintf("peer %q", m.Peer)
+	}
+	return fmt.Sprintf("partition %q", m.Partition)
+}
+
+// QueryFailoverTargets returns the peers of the group as prepared query
+// failover targets. Prepared queries cannot fail over to other partitions, so
+// partition members are skipped.
+func (e *SamenessGroupConfigEntry) QueryFailoverTargets() []QueryFailoverTarget {
+	if e == nil {
+		return nil
+	}
+
+	var targets []QueryFailoverTarget
+	for _, m := range e.Members {
+		if m.Peer == "" {
+			continue
+		}
+		targets = append(targets, QueryFailoverTarget{PeerName: m.Peer})
+	}
+	return targets
+}
+
+func (e *SamenessGroupConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *SamenessGroupConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *SamenessGroupConfigEntry) GetRaftIndex() *RaftIndex {
+	if e == nil {
+		return &RaftIndex{}
+	}
+
+	return &e.RaftIndex
+}
+
+func (e *SamenessGroupConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
+	if e == nil {
+		return nil
+	}
+
+	return &e.EnterpriseMeta
+}
+
+// MarshalJSON adds the Kind field so that the JSON can be decoded back into the
+// correct type.
+// This method is implemented on the structs type (as apposed to the api type)
+// because that is what the API currently uses to return a response.
+func (e *SamenessGroupConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias SamenessGroupConfigEntry
+	source := &struct {
+		Kind string
+		*Alias
+	}{
+		Kind:  SamenessGroup,
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(source)
+}
@@ -0,0 +1,91 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSamenessGroupConfigEntry(t *testing.T) {
+	cases := map[string]configEntryTestcase{
+		"validate: empty name": {
+			entry:       &SamenessGroupConfigEntry{},
+			validateErr: `Name is required`,
+		},
+		"validate: wildcard name": {
+			entry: &SamenessGroupConfigEntry{
+				Name: "*",
+			},
+			validateErr: `sameness-group name cannot be a wildcard`,
+		},
+		"validate: empty member": {
+			entry: &SamenessGroupConfigEntry{
+				Name:    "group",
+				Members: []SamenessGroupMember{{}},
+			},
+			validateErr: `Members[0]: must define exactly one of Partition or Peer`,
+		},
+		"validate: partition and peer": {
+			entry: &SamenessGroupConfigEntry{
+				Name: "group",
+				Members: []SamenessGroupMember{
+					{Partition: "default", Peer: "cluster-01"},
+				},
+			},
+			validateErr: `Members[0]: must define exactly one of Partition or Peer`,
+		},
+		"validate: wildcard peer": {
+			entry: &SamenessGroupConfigEntry{
+				Name: "group",
+				Members: []SamenessGroupMember{
+					{Peer: "*"},
+				},
+			},
+			validateErr: `Members[0]: wildcards are not supported`,
+		},
+		"validate: duplicate peer": {
+			entry: &SamenessGroupConfigEntry{
+				Name: "group",
+				Members: []SamenessGroupMember{
+					{Peer: "cluster-01"},
+					{Peer: "cluster-02"},
+					{Peer: "cluster-01"},
+				},
+			},
+			validateErr: `Members[2]: duplicate member peer "cluster-01"`,
+		},
+		"validate: partitions and peers": {
+			entry: &SamenessGroupConfigEntry{
+				Name:               "group",
+				DefaultForFailover: true,
+				Members: []SamenessGroupMember{
+					{Partition: "default"},
+					{Peer: "cluster-01"},
+					{Peer: "cluster-02"},
+				},
+			},
+		},
+	}
+
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestSamenessGroupConfigEntry_QueryFailoverTargets(t *testing.T) {
+	entry := &SamenessGroupConfigEntry{
+		Name: "group",
+		Members: []SamenessGroupMember{
+			{Partition: "default"},
+			{Peer: "cluster-01"},
+			{Peer: "cluster-02"},
+		},
+	}
+
+	expect := []QueryFailoverTarget{
+		{PeerName: "cluster-01"},
+		{PeerName: "cluster-02"},
+	}
+	require.Equal(t, expect, entry.QueryFailoverTargets())
+
+	var nilEntry *SamenessGroupConfigEntry
+	require.Nil(t, nilEntry.QueryFailoverTargets())
+}
@@ -303,6 +303,117 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "proxy-defaults: access logs",
+			snake: `
+				kind = "proxy-defaults"
+				name = "main"
+				access_logs {
+					enabled = true
+					disable_listener_logs = true
+					type = "file"
+					path = "/var/log/envoy.log"
+					json_format = "{\"start\": \"%START_TIME%\"}"
+				}
+			`,
+			camel: `
+				Kind = "proxy-defaults"
+				Name = "main"
+				AccessLogs {
+					Enabled = true
+					DisableListenerLogs = true
+					Type = "file"
+					Path = "/var/log/envoy.log"
+					JSONFormat = "{\"start\": \"%START_TIME%\"}"
+				}
+			`,
+			expect: &ProxyConfigEntry{
+				Kind: "proxy-defaults",
+				Name: "main",
+				AccessLogs: AccessLogsConfig{
+					Enabled:             true,
+					DisableListenerLogs: true,
+					Type:                FileLogSinkType,
+					Path:                "/var/log/envoy.log",
+					JSONFormat:          `{"start": "%START_TIME%"}`,
+				},
+			},
+		},
+		{
+			name: "proxy-defaults: tracing and metrics",
+			snake: `
+				kind = "proxy-defaults"
+				name = "main"
+				tracing {
+					provider = "zipkin"
+					collector_address = "zipkin.service.consul:9411"
+					collector_path = "/api/v2/spans"
+					sampling_percentage = 12.5
+					custom_tags = [
+						{
+							tag = "team"
+							literal = "payments"
+						},
+						{
+							tag = "request-id"
+							request_header = "x-request-id"
+						},
+					]
+				}
+				metrics {
+					dogstatsd_url = "udp://127.0.0.1:8125"
+					prometheus_bind_addr = "0.0.0.0:9102"
+					stats_tags = ["team=payments"]
+					stats_flush_interval = "10s"
+				}
+			`,
+			camel: `
+				Kind = "proxy-defaults"
+				Name = "main"
+				Tracing {
+					Provider = "zipkin"
+					CollectorAddress = "zipkin.service.consul:9411"
+					CollectorPath = "/api/v2/spans"
+					SamplingPercentage = 12.5
+					CustomTags = [
+						{
+							Tag = "team"
+							Literal = "payments"
+						},
+						{
+							Tag = "request-id"
+							RequestHeader = "x-request-id"
+						},
+					]
+				}
+				Metrics {
+					DogstatsdURL = "udp://127.0.0.1:8125"
+					PrometheusBindAddr = "0.0.0.0:9102"
+					StatsTags = ["team=payments"]
+					StatsFlushInterval = "10s"
+				}
+			`,
+			expect: &ProxyConfigEntry{
+				Kind: "proxy-defaults",
+				Name: "main",
+				Tracing: TracingConfig{
+					Provider:           ZipkinTracingProvider,
+					CollectorAddress:   "zipkin.service.consul:9411",
+					CollectorPath:      "/api/v2/spans",
+					SamplingPercentage: 12.5,
+					CustomTags: []TracingCustomTag{
+						{Tag: "team", Literal: "payments"},
+						{Tag: "request-id", RequestHeader: "x-request-id"},
+					},
+				},
+				Metrics: MetricsConfig{
+					DogstatsdURL:       "udp://127.0.0.1:8125",
+					PrometheusBindAddr: "0.0.0.0:9102",
+					StatsTags:          []string{"team=payments"},
+					StatsFlushInterval: "10s",
+				},
+			},
+		},
 		{
 			name: "service-defaults",
 			snake: `
@@ -324,6 +435,7 @@ func TestDecodeConfigEntry(t *testing.T) {
 							passive_health_check {
 								interval = "2s"
 								max_failures = 3
+								report_ejections = true
 							}
 						},
 						{
@@ -365,6 +477,7 @@ func TestDecodeConfigEntry(t *testing.T) {
 							PassiveHealthCheck {
 								MaxFailures = 3
 								Interval = "2s"
+								ReportEjections = true
 							}
 						},
 						{
@@ -404,8 +517,9 @@ func TestDecodeConfigEntry(t *testing.T) {
 						{
 							Name: "redis",
 							PassiveHealthCheck: &PassiveHealthCheck{
-								MaxFailures: 3,
-								Interval:    2 * time.Second,
+								MaxFailures:     3,
+								Interval:        2 * time.Second,
+								ReportEjections: true,
 							},
 						},
 						{
@@ -1009,6 +1123,30 @@ func TestDecodeConfigEntry(t *testing.T) {
 				Name: "main",
 			},
 		},
+		{
+			name: "service-resolver: prioritize by locality",
+			snake: `
+				kind = "service-resolver"
+				name = "main"
+				prioritize_by_locality {
+					mode = "failover"
+				}
+			`,
+			camel: `
+				Kind = "service-resolver"
+				Name = "main"
+				PrioritizeByLocality {
+					Mode = "failover"
+				}
+			`,
+			expect: &ServiceResolverConfigEntry{
+				Kind: "service-resolver",
+				Name: "main",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{
+					Mode: "failover",
+				},
+			},
+		},
 		{
 			name: "service-resolver: envoy hash lb kitchen sink",
 			snake: `
@@ -1361,6 +1499,172 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ingress-gateway: jwt and ext authz",
+			snake: `
+				kind = "ingress-gateway"
+				name = "ingress-web"
+				listeners = [
+					{
+						port = 8080
+						protocol = "http"
+						jwt {
+							providers = [
+								{
+									name = "okta"
+									issuer = "https://okta.example.com/"
+									audiences = ["ingress"]
+									json_web_key_set {
+										remote {
+											uri = "https://okta.example.com/jwks.json"
+											cluster_name = "okta_jwks"
+											cache_duration = "10m"
+										}
+									}
+									forward_token = true
+									claims_to_headers = [
+										{
+											claim = "sub"
+											header = "x-user"
+										}
+									]
+								}
+							]
+						}
+						ext_authz {
+							service = "authz"
+							protocol = "http"
+							timeout = "500ms"
+							failure_mode_allow = true
+						}
+						services = [
+							{
+								name = "web"
+								jwt {
+									providers = [
+										{
+											name = "partner"
+											json_web_key_set {
+												local {
+													filename = "/etc/envoy/jwks.json"
+												}
+											}
+										}
+									]
+								}
+							}
+						]
+					}
+				]
+			`,
+			camel: `
+				Kind = "ingress-gateway"
+				Name = "ingress-web"
+				Listeners = [
+					{
+						Port = 8080
+						Protocol = "http"
+						JWT {
+							Providers = [
+								{
+									Name = "okta"
+									Issuer = "https://okta.example.com/"
+									Audiences = ["ingress"]
+									JSONWebKeySet {
+										Remote {
+											URI = "https://okta.example.com/jwks.json"
+											ClusterName = "okta_jwks"
+											CacheDuration = "10m"
+										}
+									}
+									ForwardToken = true
+									ClaimsToHeaders = [
+										{
+											Claim = "sub"
+											Header = "x-user"
+										}
+									]
+								}
+							]
+						}
+						ExtAuthz {
+							Service = "authz"
+							Protocol = "http"
+							Timeout = "500ms"
+							FailureModeAllow = true
+						}
+						Services = [
+							{
+								Name = "web"
+								JWT {
+									Providers = [
+										{
+											Name = "partner"
+											JSONWebKeySet {
+												Local {
+													Filename = "/etc/envoy/jwks.json"
+												}
+											}
+										}
+									]
+								}
+							}
+						]
+					}
+				]
+			`,
+			expect: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     8080,
+						Protocol: "http",
+						JWT: &IngressJWTConfig{
+							Providers: []IngressJWTProvider{
+								{
+									Name:      "okta",
+									Issuer:    "https://okta.example.com/",
+									Audiences: []string{"ingress"},
+									JSONWebKeySet: &IngressJWKS{
+										Remote: &IngressRemoteJWKS{
+											URI:           "https://okta.example.com/jwks.json",
+											ClusterName:   "okta_jwks",
+											CacheDuration: 10 * time.Minute,
+										},
+									},
+									ForwardToken: true,
+									ClaimsToHeaders: []IngressJWTClaimToHeader{
+										{Claim: "sub", Header: "x-user"},
+									},
+								},
+							},
+						},
+						ExtAuthz: &IngressExtAuthzConfig{
+							Service:          "authz",
+							Protocol:         "http",
+							Timeout:          500 * time.Millisecond,
+							FailureModeAllow: true,
+						},
+						Services: []IngressService{
+							{
+								Name: "web",
+								JWT: &IngressJWTConfig{
+									Providers: []IngressJWTProvider{
+										{
+											Name: "partner",
+											JSONWebKeySet: &IngressJWKS{
+												Local: &IngressLocalJWKS{Filename: "/etc/envoy/jwks.json"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "terminating-gateway: kitchen sink",
 			snake: `
@@ -1377,6 +1681,12 @@ func TestDecodeConfigEntry(t *testing.T) {
 						cert_file = "/etc/payments/cert.pem",
 						key_file = "/etc/payments/tls.key",
 						sni = "mydomain",
+						dns {
+							discovery_type = "strict_dns"
+							refresh_rate = "30s"
+							respect_ttl = true
+							lookup_family = "v4_preferred"
+						}
 					},
 					{
 						name = "*",
@@ -1401,6 +1711,12 @@ func TestDecodeConfigEntry(t *testing.T) {
 						CertFile = "/etc/payments/cert.pem",
 						KeyFile = "/etc/payments/tls.key",
 						SNI = "mydomain",
+						DNS {
+							DiscoveryType = "strict_dns"
+							RefreshRate = "30s"
+							RespectTTL = true
+							LookupFamily = "v4_preferred"
+						}
 					},
 					{
 						Name = "*",
@@ -1425,6 +1741,12 @@ func TestDecodeConfigEntry(t *testing.T) {
 						CertFile: "/etc/payments/cert.pem",
 						KeyFile:  "/etc/payments/tls.key",
 						SNI:      "mydomain",
+						DNS: &LinkedServiceDNSConfig{
+							DiscoveryType: "strict_dns",
+							RefreshRate:   30 * time.Second,
+							RespectTTL:    true,
+							LookupFamily:  "v4_preferred",
+						},
 					},
 					{
 						Name:     "*",
@@ -1736,6 +2058,13 @@ func TestDecodeConfigEntry(t *testing.T) {
 				http {
 					sanitize_x_forwarded_client_cert = true
 				}
+				peering {
+					peer_through_mesh_gateways = true
+				}
+				locality {
+					region_meta_key = "region"
+					zone_meta_key = "zone"
+				}
 			`,
 			camel: `
 				Kind = "mesh"
@@ -1766,7 +2095,14 @@ func TestDecodeConfigEntry(t *testing.T) {
 				}
 				HTTP {
 					SanitizeXForwardedClientCert = true
-				}	
+				}
+				Peering {
+					PeerThroughMeshGateways = true
+				}
+				Locality {
+					RegionMetaKey = "region"
+					ZoneMetaKey = "zone"
+				}
 			`,
 			expect: &MeshConfigEntry{
 				Meta: map[string]string{
@@ -1797,6 +2133,13 @@ func TestDecodeConfigEntry(t *testing.T) {
 				HTTP: &MeshHTTPConfig{
 					SanitizeXForwardedClientCert: true,
 				},
+				Peering: &PeeringMeshConfig{
+					PeerThroughMeshGateways: true,
+				},
+				Locality: &MeshLocalityConfig{
+					RegionMetaKey: "region",
+					ZoneMetaKey:   "zone",
+				},
 			},
 		},
 		{
@@ -1821,6 +2164,7 @@ func TestDecodeConfigEntry(t *testing.T) {
 							},
 							{
 								peer_name = "flarm"
+								filter = "Service.Meta.env == prod"
 							}
 						]
 					},
@@ -1855,6 +2199,7 @@ func TestDecodeConfigEntry(t *testing.T) {
 							},
 							{
 								PeerName = "flarm"
+								Filter = "Service.Meta.env == prod"
 							}
 						]
 					},
@@ -1888,6 +2233,7 @@ func TestDecodeConfigEntry(t *testing.T) {
 							},
 							{
 								PeerName: "flarm",
+								Filter:   "Service.Meta.env == prod",
 							},
 						},
 					},
@@ -1903,6 +2249,145 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "imported-services",
+			snake: `
+				kind = "imported-services"
+				name = "default"
+				meta {
+					"foo" = "bar"
+				}
+				services = [
+					{
+						peer = "east"
+						name = "web"
+						local_name = "east-web"
+					}
+				]
+			`,
+			camel: `
+				Kind = "imported-services"
+				Name = "default"
+				Meta {
+					"foo" = "bar"
+				}
+				Services = [
+					{
+						Peer = "east"
+						Name = "web"
+						LocalName = "east-web"
+					}
+				]
+			`,
+			expect: &ImportedServicesConfigEntry{
+				Name: "default",
+				Meta: map[string]string{
+					"foo": "bar",
+				},
+				Services: []ImportedService{
+					{
+						Peer:      "east",
+						Name:      "web",
+						LocalName: "east-web",
+					},
+				},
+			},
+		},
+		{
+			name: "sameness-group",
+			snake: `
+				kind = "sameness-group"
+				name = "group"
+				default_for_failover = true
+				meta {
+					"foo" = "bar"
+				}
+				members = [
+					{ partition = "default" },
+					{ peer = "cluster-01" },
+				]
+			`,
+			camel: `
+				Kind = "sameness-group"
+				Name = "group"
+				DefaultForFailover = true
+				Meta {
+					"foo" = "bar"
+				}
+				Members = [
+					{ Partition = "default" },
+					{ Peer = "cluster-01" },
+				]
+			`,
+			expect: &SamenessGroupConfigEntry{
+				Name:               "group",
+				DefaultForFailover: true,
+				Meta: map[string]string{
+					"foo": "bar",
+				},
+				Members: []SamenessGroupMember{
+					{Partition: "default"},
+					{Peer: "cluster-01"},
+				},
+			},
+		},
+		{
+			name: "service-rollout",
+			snake: `
+				kind = "service-rollout"
+				name = "web"
+				meta {
+					"foo" = "bar"
+				}
+				baseline_subset = "v1"
+				canary_subset = "v2"
+				steps = [10, 50, 100]
+				step_interval = "5m"
+				max_critical_percentage = 20
+				metrics {
+					provider = "prometheus"
+					address = "http://prometheus:9090"
+					query = "errors"
+					threshold = 0.5
+				}
+			`,
+			camel: `
+				Kind = "service-rollout"
+				Name = "web"
+				Meta {
+					"foo" = "bar"
+				}
+				BaselineSubset = "v1"
+				CanarySubset = "v2"
+				Steps = [10, 50, 100]
+				StepInterval = "5m"
+				MaxCriticalPercentage = 20
+				Metrics {
+					Provider = "prometheus"
+					Address = "http://prometheus:9090"
+					Query = "errors"
+					Threshold = 0.5
+				}
+			`,
+			expect: &ServiceRolloutConfigEntry{
+				Kind: "service-rollout",
+				Name: "web",
+				Meta: map[string]string{
+					"foo": "bar",
+				},
+				BaselineSubset:        "v1",
+				CanarySubset:          "v2",
+				Steps:                 []float32{10, 50, 100},
+				StepInterval:          5 * time.Minute,
+				MaxCriticalPercentage: 20,
+				Metrics: &RolloutMetricsQuery{
+					Provider:  "prometheus",
+					Address:   "http://prometheus:9090",
+					Query:     "errors",
+					Threshold: 0.5,
+				},
+			},
+		},
 	} {
 		tc := tc
 
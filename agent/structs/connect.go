@@ -19,6 +19,20 @@ type ConnectAuthorizeRequest struct {
 	// lists.
 	ClientCertURI    string
 	ClientCertSerial string
+
+	// HTTP is the request being authorized, for callers that proxy the
+	// requests of a service with an HTTP-based protocol. The permissions of
+	// L7 intentions are evaluated against it; without it, L7 intentions
+	// always deny.
+	HTTP *ConnectAuthorizeHTTPRequest `json:",omitempty"`
+}
+
+// ConnectAuthorizeHTTPRequest holds the attributes of an HTTP request that L7
+// intentions can match on.
+type ConnectAuthorizeHTTPRequest struct {
+	Method string
+	Path   string
+	Header map[string][]string `json:",omitempty"`
 }
 
 func (req *ConnectAuthorizeRequest) TargetPartition() string {
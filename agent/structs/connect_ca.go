@@ -3,13 +3,17 @@ package structs
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/consul/lib/stringslice"
 
+	"github.com/mitchellh/hashstructure"
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/lib"
 )
 
@@ -240,6 +244,105 @@ type IssuedCert struct {
 	RaftIndex
 }
 
+const (
+	// DefaultJWTSVIDTTL is the lifetime of a JWT-SVID when the request does
+	// not specify one.
+	DefaultJWTSVIDTTL = 5 * time.Minute
+
+	// MaxJWTSVIDTTL is the longest lifetime a JWT-SVID can be issued with.
+	MaxJWTSVIDTTL = time.Hour
+)
+
+// JWTSVIDRequest is the request for signing a JWT-SVID for a service.
+type JWTSVIDRequest struct {
+	// Datacenter is the target for this request.
+	Datacenter string
+
+	// Service is the name of the service the JWT-SVID identifies.
+	Service string
+
+	// Audience is the list of audiences the JWT-SVID is valid for. At least
+	// one is required.
+	Audience []string
+
+	// TTL is the lifetime of the JWT-SVID. DefaultJWTSVIDTTL is used if it
+	// is zero.
+	TTL time.Duration
+
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	QueryOptions
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (r *JWTSVIDRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// CacheInfo implements cache.Request. Cached JWT-SVIDs are re-issued once
+// half of their lifetime has passed.
+func (r *JWTSVIDRequest) CacheInfo() cache.RequestInfo {
+	ttl := r.TTL
+	if ttl == 0 {
+		ttl = DefaultJWTSVIDTTL
+	}
+	info := cache.RequestInfo{
+		Token:          r.Token,
+		Datacenter:     r.Datacenter,
+		MaxAge:         ttl / 2,
+		MustRevalidate: r.MustRevalidate,
+	}
+
+	audience := make([]string, len(r.Audience))
+	copy(audience, r.Audience)
+	sort.Strings(audience)
+	v, err := hashstructure.Hash([]interface{}{
+		r.Service,
+		audience,
+		ttl,
+		r.EnterpriseMeta,
+	}, nil)
+	if err == nil {
+		// If there is an error, we don't set the key. A blank key forces
+		// no cache for this request so the request is forwarded directly
+		// to the server.
+		info.Key = strconv.FormatUint(v, 10)
+	}
+	return info
+}
+
+// IssuedJWT is a JWT-SVID that has been issued for a service.
+type IssuedJWT struct {
+	// Token is the signed JWT-SVID.
+	Token string
+
+	// Service is the name of the service for which the JWT-SVID was issued.
+	// ServiceURI is its SPIFFE ID, which is the subject of the token.
+	Service    string
+	ServiceURI string
+
+	// Audience is the list of audiences the token is valid for.
+	Audience []string
+
+	// ExpiresAt is when the token expires.
+	ExpiresAt time.Time
+}
+
+// CAJWTKey is a public key which JWT-SVIDs are signed with.
+type CAJWTKey struct {
+	// ID is the key ID used in the header of JWT-SVIDs signed by the key.
+	ID string
+
+	// PublicKey is the PEM encoded public key.
+	PublicKey string
+}
+
+// IndexedCAJWTKeys is the list of keys which JWT-SVIDs are signed with.
+type IndexedCAJWTKeys struct {
+	Keys []CAJWTKey
+
+	QueryMeta `json:"-"`
+}
+
 // CAOp is the operation for a request related to intentions.
 type CAOp string
 
@@ -289,6 +392,7 @@ const (
 	ConsulCAProvider = "consul"
 	VaultCAProvider  = "vault"
 	AWSCAProvider    = "aws-pca"
+	FileCAProvider   = "file"
 )
 
 // CAConfiguration is the configuration for the current CA plugin.
@@ -498,6 +602,17 @@ type ConsulCAProviderConfig struct {
 	PrivateKey string
 	RootCert   string
 
+	// PKCS11Lib is the path of a PKCS#11 library. When set, the provider keeps
+	// its private keys in the token with PKCS11TokenLabel instead of the state
+	// store. Each generated key is labelled PKCS11KeyLabel with a unique
+	// suffix; when RootCert is given, PKCS11KeyLabel is the label of its
+	// existing key. The PIN is read from PKCS11PinFile on the leader so that
+	// it isn't stored in the configuration.
+	PKCS11Lib        string
+	PKCS11TokenLabel string
+	PKCS11PinFile    string
+	PKCS11KeyLabel   string
+
 	// DisableCrossSigning is really only useful in test code to use the built in
 	// provider while exercising logic that depends on the CA provider ability to
 	// cross sign. We don't document this config field publicly or make any
@@ -506,13 +621,34 @@ type ConsulCAProviderConfig struct {
 }
 
 func (c *ConsulCAProviderConfig) Validate() error {
+	if c.PKCS11Lib == "" {
+		if c.PKCS11TokenLabel != "" || c.PKCS11PinFile != "" || c.PKCS11KeyLabel != "" {
+			return fmt.Errorf("must provide the PKCS11Lib when configuring a PKCS#11 token")
+		}
+		return nil
+	}
+
+	if c.PKCS11TokenLabel == "" {
+		return fmt.Errorf("must provide the PKCS11TokenLabel when using a PKCS#11 token")
+	}
+	if c.PKCS11KeyLabel == "" {
+		return fmt.Errorf("must provide the PKCS11KeyLabel when using a PKCS#11 token")
+	}
+	if c.PrivateKey != "" {
+		return fmt.Errorf("cannot provide a private key when using a PKCS#11 token")
+	}
 	return nil
 }
 
 // CAConsulProviderState is used to track the built-in Consul CA provider's state.
 type CAConsulProviderState struct {
-	ID               string
-	PrivateKey       string
+	ID         string
+	PrivateKey string
+
+	// PrivateKeyLabel is the label of the private key in the configured
+	// PKCS#11 token. PrivateKey is empty when it is set.
+	PrivateKeyLabel string
+
 	RootCert         string
 	IntermediateCert string
 
@@ -553,6 +689,23 @@ type AWSCAProviderConfig struct {
 	DeleteOnExit bool
 }
 
+type FileCAProviderConfig struct {
+	CommonCAProviderConfig `mapstructure:",squash"`
+
+	// CertFile is the path to the PEM encoded certificate used to sign leaf
+	// certificates, optionally followed by any further intermediates needed
+	// to chain it to the root.
+	CertFile string
+
+	// KeyFile is the path to the PEM encoded private key of the certificate
+	// in CertFile.
+	KeyFile string
+
+	// RootCertFile is the path to the PEM encoded root certificate that
+	// CertFile chains to.
+	RootCertFile string
+}
+
 // CALeafOp is the operation for a request related to leaf certificates.
 type CALeafOp string
 
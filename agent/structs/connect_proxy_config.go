@@ -4,6 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/lib"
@@ -151,6 +155,335 @@ func (c *TransparentProxyConfig) IsZero() bool {
 	return *c == zeroVal
 }
 
+type LogSinkType string
+
+const (
+	// DefaultLogSinkType writes access logs to the proxy's standard output.
+	DefaultLogSinkType LogSinkType = ""
+	FileLogSinkType    LogSinkType = "file"
+	StdErrLogSinkType  LogSinkType = "stderr"
+	StdOutLogSinkType  LogSinkType = "stdout"
+)
+
+// AccessLogsConfig describes the access logs written by the Envoy proxies
+// that make up the mesh.
+type AccessLogsConfig struct {
+	// Enabled turns on access logging for every listener of the proxy.
+	Enabled bool `json:",omitempty"`
+
+	// DisableListenerLogs turns off the logs recording connections that were
+	// rejected by a listener because no filter chain matched them.
+	DisableListenerLogs bool `json:",omitempty" alias:"disable_listener_logs"`
+
+	// Type selects where the logs are written: "file", "stdout" or "stderr".
+	// It defaults to "stdout".
+	Type LogSinkType `json:",omitempty"`
+
+	// Path is the file the logs are written to when Type is "file".
+	Path string `json:",omitempty"`
+
+	// JSONFormat is a JSON object whose values are Envoy format strings.
+	// It is mutually exclusive with TextFormat and a default JSON format is
+	// used when neither is set.
+	JSONFormat string `json:",omitempty" alias:"json_format"`
+
+	// TextFormat is an Envoy format string used to render each log line.
+	TextFormat string `json:",omitempty" alias:"text_format"`
+}
+
+func (c AccessLogsConfig) ToAPI() *api.AccessLogsConfig {
+	if c.IsZero() {
+		return nil
+	}
+	return &api.AccessLogsConfig{
+		Enabled:             c.Enabled,
+		DisableListenerLogs: c.DisableListenerLogs,
+		Type:                api.LogSinkType(c.Type),
+		Path:                c.Path,
+		JSONFormat:          c.JSONFormat,
+		TextFormat:          c.TextFormat,
+	}
+}
+
+func (c *AccessLogsConfig) IsZero() bool {
+	zeroVal := AccessLogsConfig{}
+	return *c == zeroVal
+}
+
+func (c *AccessLogsConfig) Validate() error {
+	switch c.Type {
+	case DefaultLogSinkType, StdOutLogSinkType, StdErrLogSinkType:
+		if c.Path != "" {
+			return fmt.Errorf("path is only valid for %q access logs", FileLogSinkType)
+		}
+	case FileLogSinkType:
+		if c.Path == "" {
+			return fmt.Errorf("path must be specified for %q access logs", FileLogSinkType)
+		}
+	default:
+		return fmt.Errorf("invalid access log type %q, must be one of %q, %q or %q",
+			c.Type, FileLogSinkType, StdOutLogSinkType, StdErrLogSinkType)
+	}
+
+	if c.JSONFormat != "" && c.TextFormat != "" {
+		return fmt.Errorf("only one of json_format or text_format can be specified")
+	}
+	if c.JSONFormat != "" {
+		var format map[string]interface{}
+		if err := json.Unmarshal([]byte(c.JSONFormat), &format); err != nil {
+			return fmt.Errorf("invalid json_format, it must be a JSON object: %v", err)
+		}
+	}
+	return nil
+}
+
+type TracingProvider string
+
+const (
+	ZipkinTracingProvider        TracingProvider = "zipkin"
+	OpenTelemetryTracingProvider TracingProvider = "opentelemetry"
+)
+
+// TracingConfig describes how the Envoy proxies that make up the mesh
+// report traces and which requests they sample.
+type TracingConfig struct {
+	// Provider selects the kind of collector the spans are sent to: "zipkin"
+	// or "opentelemetry". When it is empty no collector is configured by
+	// Consul and only the sampling and tags below are applied.
+	Provider TracingProvider `json:",omitempty"`
+
+	// CollectorAddress is the <host>:<port> of the collector.
+	CollectorAddress string `json:",omitempty" alias:"collector_address"`
+
+	// CollectorPath is the HTTP path spans are posted to. It is only valid
+	// for the "zipkin" provider and defaults to "/api/v2/spans".
+	CollectorPath string `json:",omitempty" alias:"collector_path"`
+
+	// SamplingPercentage is the percentage of requests, between 0 and 100,
+	// that are traced when the client did not already decide to sample it.
+	SamplingPercentage float64 `json:",omitempty" alias:"sampling_percentage"`
+
+	// CustomTags are added to every span reported by the proxy.
+	CustomTags []TracingCustomTag `json:",omitempty" alias:"custom_tags"`
+}
+
+// TracingCustomTag is a tag added to the spans reported by a proxy. Exactly
+// one of Literal, RequestHeader or Environment provides its value.
+type TracingCustomTag struct {
+	Tag string
+
+	// Literal is a static value.
+	Literal string `json:",omitempty"`
+
+	// RequestHeader is the name of the request header holding the value.
+	RequestHeader string `json:",omitempty" alias:"request_header"`
+
+	// Environment is the name of the environment variable of the proxy
+	// process holding the value.
+	Environment string `json:",omitempty"`
+}
+
+func (c TracingConfig) ToAPI() *api.TracingConfig {
+	if c.IsZero() {
+		return nil
+	}
+	out := &api.TracingConfig{
+		Provider:           api.TracingProvider(c.Provider),
+		CollectorAddress:   c.CollectorAddress,
+		CollectorPath:      c.CollectorPath,
+		SamplingPercentage: c.SamplingPercentage,
+	}
+	for _, tag := range c.CustomTags {
+		out.CustomTags = append(out.CustomTags, api.TracingCustomTag(tag))
+	}
+	return out
+}
+
+func (c *TracingConfig) IsZero() bool {
+	return c.Provider == "" &&
+		c.CollectorAddress == "" &&
+		c.CollectorPath == "" &&
+		c.SamplingPercentage == 0 &&
+		len(c.CustomTags) == 0
+}
+
+func (c *TracingConfig) Validate() error {
+	switch c.Provider {
+	case "":
+		if c.CollectorAddress != "" || c.CollectorPath != "" {
+			return fmt.Errorf("provider must be specified along with a collector")
+		}
+	case ZipkinTracingProvider, OpenTelemetryTracingProvider:
+		if c.CollectorAddress == "" {
+			return fmt.Errorf("collector_address must be specified for %q tracing", c.Provider)
+		}
+		if err := validateHostPort(c.CollectorAddress); err != nil {
+			return fmt.Errorf("invalid collector_address: %v", err)
+		}
+		if c.CollectorPath != "" && c.Provider != ZipkinTracingProvider {
+			return fmt.Errorf("collector_path is only valid for %q tracing", ZipkinTracingProvider)
+		}
+	default:
+		return fmt.Errorf("invalid tracing provider %q, must be one of %q or %q",
+			c.Provider, ZipkinTracingProvider, OpenTelemetryTracingProvider)
+	}
+
+	if c.SamplingPercentage < 0 || c.SamplingPercentage > 100 {
+		return fmt.Errorf("sampling_percentage must be between 0 and 100")
+	}
+
+	seen := make(map[string]bool)
+	for i, tag := range c.CustomTags {
+		if tag.Tag == "" {
+			return fmt.Errorf("custom_tags[%d]: tag must be specified", i)
+		}
+		if seen[tag.Tag] {
+			return fmt.Errorf("custom_tags[%d]: duplicate tag %q", i, tag.Tag)
+		}
+		seen[tag.Tag] = true
+
+		sources := 0
+		for _, v := range []string{tag.Literal, tag.RequestHeader, tag.Environment} {
+			if v != "" {
+				sources++
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("custom_tags[%d]: exactly one of literal, request_header or environment must be specified", i)
+		}
+	}
+	return nil
+}
+
+// MetricsConfig describes the metrics sinks and endpoints of the Envoy
+// proxies that make up the mesh. They are part of the bootstrap
+// configuration so changes only apply once the proxy is restarted.
+type MetricsConfig struct {
+	// StatsdURL is the udp://<host>:<port> or unix://<path> of a statsd sink.
+	StatsdURL string `json:",omitempty" alias:"statsd_url"`
+
+	// DogstatsdURL is the udp://<host>:<port> or unix://<path> of a
+	// dogstatsd sink.
+	DogstatsdURL string `json:",omitempty" alias:"dogstatsd_url"`
+
+	// PrometheusBindAddr is the <ip>:<port> the proxy serves the
+	// Prometheus scrape endpoint on.
+	PrometheusBindAddr string `json:",omitempty" alias:"prometheus_bind_addr"`
+
+	// StatsBindAddr is the <ip>:<port> the proxy serves its /stats
+	// endpoint on.
+	StatsBindAddr string `json:",omitempty" alias:"stats_bind_addr"`
+
+	// StatsTags are "<name>=<value>" tags added to every metric.
+	StatsTags []string `json:",omitempty" alias:"stats_tags"`
+
+	// StatsFlushInterval is how often stats are flushed to the sinks, for
+	// example "10s".
+	StatsFlushInterval string `json:",omitempty" alias:"stats_flush_interval"`
+}
+
+func (c MetricsConfig) ToAPI() *api.MetricsConfig {
+	if c.IsZero() {
+		return nil
+	}
+	return &api.MetricsConfig{
+		StatsdURL:          c.StatsdURL,
+		DogstatsdURL:       c.DogstatsdURL,
+		PrometheusBindAddr: c.PrometheusBindAddr,
+		StatsBindAddr:      c.StatsBindAddr,
+		StatsTags:          c.StatsTags,
+		StatsFlushInterval: c.StatsFlushInterval,
+	}
+}
+
+func (c *MetricsConfig) IsZero() bool {
+	return c.StatsdURL == "" &&
+		c.DogstatsdURL == "" &&
+		c.PrometheusBindAddr == "" &&
+		c.StatsBindAddr == "" &&
+		len(c.StatsTags) == 0 &&
+		c.StatsFlushInterval == ""
+}
+
+func (c *MetricsConfig) Validate() error {
+	sinks := []struct {
+		name string
+		addr string
+	}{
+		{"statsd_url", c.StatsdURL},
+		{"dogstatsd_url", c.DogstatsdURL},
+	}
+	for _, sink := range sinks {
+		if sink.addr == "" || strings.HasPrefix(sink.addr, "$") {
+			// Environment variables are resolved by the proxy's bootstrap.
+			continue
+		}
+		u, err := url.Parse(sink.addr)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", sink.name, err)
+		}
+		switch u.Scheme {
+		case "udp":
+			if err := validateHostPort(u.Host); err != nil {
+				return fmt.Errorf("invalid %s: %v", sink.name, err)
+			}
+		case "unix":
+			if u.Path == "" {
+				return fmt.Errorf("invalid %s: missing socket path", sink.name)
+			}
+		default:
+			return fmt.Errorf("invalid %s: unsupported scheme %q, must be one of \"udp\" or \"unix\"", sink.name, u.Scheme)
+		}
+	}
+
+	binds := []struct {
+		name string
+		addr string
+	}{
+		{"prometheus_bind_addr", c.PrometheusBindAddr},
+		{"stats_bind_addr", c.StatsBindAddr},
+	}
+	for _, bind := range binds {
+		if bind.addr == "" {
+			continue
+		}
+		if err := validateHostPort(bind.addr); err != nil {
+			return fmt.Errorf("invalid %s: %v", bind.name, err)
+		}
+	}
+
+	for i, tag := range c.StatsTags {
+		if parts := strings.SplitN(tag, "=", 2); len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("stats_tags[%d]: %q must be of the form <name>=<value>", i, tag)
+		}
+	}
+
+	if c.StatsFlushInterval != "" {
+		d, err := time.ParseDuration(c.StatsFlushInterval)
+		if err != nil {
+			return fmt.Errorf("invalid stats_flush_interval: %v", err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("stats_flush_interval must be positive")
+		}
+	}
+	return nil
+}
+
+func validateHostPort(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "" {
+		return fmt.Errorf("missing host in %q", addr)
+	}
+	if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 65535 {
+		return fmt.Errorf("invalid port in %q", addr)
+	}
+	return nil
+}
+
 // ConnectProxyConfig describes the configuration needed for any proxy managed
 // or unmanaged. It describes a single logical service's listener and optionally
 // upstreams and sidecar-related config for a single instance. To describe a
@@ -205,6 +538,15 @@ type ConnectProxyConfig struct {
 	// TransparentProxy defines configuration for when the proxy is in
 	// transparent mode.
 	TransparentProxy TransparentProxyConfig `json:",omitempty" alias:"transparent_proxy"`
+
+	// AccessLogs configures the access logs written by the proxy.
+	AccessLogs AccessLogsConfig `json:",omitempty" alias:"access_logs"`
+
+	// Tracing configures the spans reported by the proxy.
+	Tracing TracingConfig `json:",omitempty"`
+
+	// Metrics configures the metrics sinks and endpoints of the proxy.
+	Metrics MetricsConfig `json:",omitempty"`
 }
 
 func (t *ConnectProxyConfig) UnmarshalJSON(data []byte) (err error) {
@@ -217,6 +559,7 @@ func (t *ConnectProxyConfig) UnmarshalJSON(data []byte) (err error) {
 		LocalServiceSocketPathSnake string                 `json:"local_service_socket_path"`
 		MeshGatewaySnake            MeshGatewayConfig      `json:"mesh_gateway"`
 		TransparentProxySnake       TransparentProxyConfig `json:"transparent_proxy"`
+		AccessLogsSnake             AccessLogsConfig       `json:"access_logs"`
 		*Alias
 	}{
 		Alias: (*Alias)(t),
@@ -248,6 +591,9 @@ func (t *ConnectProxyConfig) UnmarshalJSON(data []byte) (err error) {
 	if !t.TransparentProxy.DialedDirectly {
 		t.TransparentProxy.DialedDirectly = aux.TransparentProxySnake.DialedDirectly
 	}
+	if t.AccessLogs.IsZero() {
+		t.AccessLogs = aux.AccessLogsSnake
+	}
 
 	return nil
 }
@@ -256,6 +602,9 @@ func (c *ConnectProxyConfig) MarshalJSON() ([]byte, error) {
 	type Alias ConnectProxyConfig
 	out := struct {
 		TransparentProxy *TransparentProxyConfig `json:",omitempty"`
+		AccessLogs       *AccessLogsConfig       `json:",omitempty"`
+		Tracing          *TracingConfig          `json:",omitempty"`
+		Metrics          *MetricsConfig          `json:",omitempty"`
 		Alias
 	}{
 		Alias: (Alias)(*c),
@@ -270,6 +619,15 @@ func (c *ConnectProxyConfig) MarshalJSON() ([]byte, error) {
 	if !c.TransparentProxy.IsZero() {
 		out.TransparentProxy = &out.Alias.TransparentProxy
 	}
+	if !c.AccessLogs.IsZero() {
+		out.AccessLogs = &out.Alias.AccessLogs
+	}
+	if !c.Tracing.IsZero() {
+		out.Tracing = &out.Alias.Tracing
+	}
+	if !c.Metrics.IsZero() {
+		out.Metrics = &out.Alias.Metrics
+	}
 
 	return json.Marshal(&out)
 }
@@ -287,6 +645,9 @@ func (c *ConnectProxyConfig) ToAPI() *api.AgentServiceConnectProxyConfig {
 		LocalServiceSocketPath: c.LocalServiceSocketPath,
 		Mode:                   api.ProxyMode(c.Mode),
 		TransparentProxy:       c.TransparentProxy.ToAPI(),
+		AccessLogs:             c.AccessLogs.ToAPI(),
+		Tracing:                c.Tracing.ToAPI(),
+		Metrics:                c.Metrics.ToAPI(),
 		Config:                 c.Config,
 		Upstreams:              c.Upstreams.ToAPI(),
 		MeshGateway:            c.MeshGateway.ToAPI(),
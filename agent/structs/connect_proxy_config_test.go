@@ -727,3 +727,228 @@ func TestValidateProxyMode(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessLogsConfig_Validate(t *testing.T) {
+	tt := []struct {
+		name    string
+		input   AccessLogsConfig
+		wantErr string
+	}{
+		{
+			name:  "defaults",
+			input: AccessLogsConfig{Enabled: true},
+		},
+		{
+			name: "file with path",
+			input: AccessLogsConfig{
+				Enabled:    true,
+				Type:       FileLogSinkType,
+				Path:       "/var/log/envoy.log",
+				JSONFormat: `{"start": "%START_TIME%"}`,
+			},
+		},
+		{
+			name:    "file without path",
+			input:   AccessLogsConfig{Enabled: true, Type: FileLogSinkType},
+			wantErr: "path must be specified",
+		},
+		{
+			name:    "stderr with path",
+			input:   AccessLogsConfig{Enabled: true, Type: StdErrLogSinkType, Path: "/var/log/envoy.log"},
+			wantErr: "path is only valid",
+		},
+		{
+			name:    "unknown type",
+			input:   AccessLogsConfig{Enabled: true, Type: "syslog"},
+			wantErr: `invalid access log type "syslog"`,
+		},
+		{
+			name: "both formats",
+			input: AccessLogsConfig{
+				Enabled:    true,
+				JSONFormat: `{"start": "%START_TIME%"}`,
+				TextFormat: "%START_TIME%",
+			},
+			wantErr: "only one of json_format or text_format",
+		},
+		{
+			name:    "json format not an object",
+			input:   AccessLogsConfig{Enabled: true, JSONFormat: `"%START_TIME%"`},
+			wantErr: "invalid json_format",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestTracingConfig_Validate(t *testing.T) {
+	tt := []struct {
+		name    string
+		input   TracingConfig
+		wantErr string
+	}{
+		{
+			name:  "sampling only",
+			input: TracingConfig{SamplingPercentage: 50},
+		},
+		{
+			name: "zipkin",
+			input: TracingConfig{
+				Provider:           ZipkinTracingProvider,
+				CollectorAddress:   "zipkin.service.consul:9411",
+				CollectorPath:      "/api/v2/spans",
+				SamplingPercentage: 100,
+				CustomTags: []TracingCustomTag{
+					{Tag: "team", Literal: "payments"},
+					{Tag: "request-id", RequestHeader: "x-request-id"},
+				},
+			},
+		},
+		{
+			name:    "collector without provider",
+			input:   TracingConfig{CollectorAddress: "zipkin:9411"},
+			wantErr: "provider must be specified",
+		},
+		{
+			name:    "unknown provider",
+			input:   TracingConfig{Provider: "jaeger", CollectorAddress: "jaeger:6831"},
+			wantErr: `invalid tracing provider "jaeger"`,
+		},
+		{
+			name:    "missing collector",
+			input:   TracingConfig{Provider: OpenTelemetryTracingProvider},
+			wantErr: "collector_address must be specified",
+		},
+		{
+			name:    "collector without port",
+			input:   TracingConfig{Provider: ZipkinTracingProvider, CollectorAddress: "zipkin"},
+			wantErr: "invalid collector_address",
+		},
+		{
+			name: "path for opentelemetry",
+			input: TracingConfig{
+				Provider:         OpenTelemetryTracingProvider,
+				CollectorAddress: "otel:4317",
+				CollectorPath:    "/v1/traces",
+			},
+			wantErr: "collector_path is only valid",
+		},
+		{
+			name:    "sampling out of range",
+			input:   TracingConfig{SamplingPercentage: 101},
+			wantErr: "sampling_percentage must be between 0 and 100",
+		},
+		{
+			name: "tag without value",
+			input: TracingConfig{
+				CustomTags: []TracingCustomTag{{Tag: "team"}},
+			},
+			wantErr: "exactly one of literal, request_header or environment",
+		},
+		{
+			name: "tag with two values",
+			input: TracingConfig{
+				CustomTags: []TracingCustomTag{{Tag: "team", Literal: "a", Environment: "TEAM"}},
+			},
+			wantErr: "exactly one of literal, request_header or environment",
+		},
+		{
+			name: "duplicate tag",
+			input: TracingConfig{
+				CustomTags: []TracingCustomTag{
+					{Tag: "team", Literal: "a"},
+					{Tag: "team", Literal: "b"},
+				},
+			},
+			wantErr: `duplicate tag "team"`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestMetricsConfig_Validate(t *testing.T) {
+	tt := []struct {
+		name    string
+		input   MetricsConfig
+		wantErr string
+	}{
+		{
+			name: "valid",
+			input: MetricsConfig{
+				StatsdURL:          "udp://127.0.0.1:8125",
+				DogstatsdURL:       "unix:///var/run/dogstatsd.sock",
+				PrometheusBindAddr: "0.0.0.0:9102",
+				StatsBindAddr:      "0.0.0.0:9103",
+				StatsTags:          []string{"team=payments"},
+				StatsFlushInterval: "10s",
+			},
+		},
+		{
+			name:  "sink from environment",
+			input: MetricsConfig{StatsdURL: "$STATSD_URL"},
+		},
+		{
+			name:    "unsupported sink scheme",
+			input:   MetricsConfig{StatsdURL: "tcp://127.0.0.1:8125"},
+			wantErr: `invalid statsd_url: unsupported scheme "tcp"`,
+		},
+		{
+			name:    "udp sink without port",
+			input:   MetricsConfig{DogstatsdURL: "udp://127.0.0.1"},
+			wantErr: "invalid dogstatsd_url",
+		},
+		{
+			name:    "invalid bind address",
+			input:   MetricsConfig{PrometheusBindAddr: "0.0.0.0"},
+			wantErr: "invalid prometheus_bind_addr",
+		},
+		{
+			name:    "invalid tag",
+			input:   MetricsConfig{StatsTags: []string{"payments"}},
+			wantErr: "must be of the form <name>=<value>",
+		},
+		{
+			name:    "invalid flush interval",
+			input:   MetricsConfig{StatsFlushInterval: "10"},
+			wantErr: "invalid stats_flush_interval",
+		},
+		{
+			name:    "negative flush interval",
+			input:   MetricsConfig{StatsFlushInterval: "-1s"},
+			wantErr: "stats_flush_interval must be positive",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
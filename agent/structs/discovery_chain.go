@@ -62,6 +62,11 @@ func (c *CompiledDiscoveryChain) WillFailoverThroughMeshGateway(node *DiscoveryG
 	if failover != nil && len(failover.Targets) > 0 {
 		for _, failTargetID := range failover.Targets {
 			failTarget := c.Targets[failTargetID]
+			if failTarget.Peer != "" {
+				// Imported services are always dialed through the mesh
+				// gateways of the peer.
+				return true
+			}
 			switch failTarget.MeshGateway.Mode {
 			case MeshGatewayModeLocal, MeshGatewayModeRemote:
 				return true
@@ -124,10 +129,11 @@ func (s *DiscoveryGraphNode) MapKey() string {
 
 // compiled form of ServiceResolverConfigEntry
 type DiscoveryResolver struct {
-	Default        bool               `json:",omitempty"`
-	ConnectTimeout time.Duration      `json:",omitempty"`
-	Target         string             `json:",omitempty"`
-	Failover       *DiscoveryFailover `json:",omitempty"`
+	Default              bool                                 `json:",omitempty"`
+	ConnectTimeout       time.Duration                        `json:",omitempty"`
+	Target               string                               `json:",omitempty"`
+	Failover             *DiscoveryFailover                   `json:",omitempty"`
+	PrioritizeByLocality *ServiceResolverPrioritizeByLocality `json:",omitempty"`
 }
 
 func (r *DiscoveryResolver) MarshalJSON() ([]byte, error) {
@@ -204,6 +210,10 @@ type DiscoveryTarget struct {
 	Partition     string `json:",omitempty"`
 	Datacenter    string `json:",omitempty"`
 
+	// Peer is the name of the cluster peer the service is imported from. The
+	// Datacenter of peer targets is empty.
+	Peer string `json:",omitempty"`
+
 	MeshGateway MeshGatewayConfig     `json:",omitempty"`
 	Subset      ServiceResolverSubset `json:",omitempty"`
 
@@ -270,6 +280,19 @@ func NewDiscoveryTarget(service, serviceSubset, namespace, partition, datacenter
 	return t
 }
 
+// NewPeerDiscoveryTarget returns a target for a service imported from a
+// cluster peer into the given namespace and partition.
+func NewPeerDiscoveryTarget(service, namespace, partition, peer string) *DiscoveryTarget {
+	t := &DiscoveryTarget{
+		Service:   service,
+		Namespace: namespace,
+		Partition: partition,
+		Peer:      peer,
+	}
+	t.setID()
+	return t
+}
+
 func chainID(subset, service, namespace, partition, dc string) string {
 	// NOTE: this format is similar to the SNI syntax for simplicity
 	if subset == "" {
@@ -278,7 +301,15 @@ func chainID(subset, service, namespace, partition, dc string) string {
 	return fmt.Sprintf("%s.%s.%s.%s.%s", subset, service, namespace, partition, dc)
 }
 
+func peerChainID(service, namespace, partition, peer string) string {
+	return fmt.Sprintf("%s.%s.%s.external.%s", service, namespace, partition, peer)
+}
+
 func (t *DiscoveryTarget) setID() {
+	if t.Peer != "" {
+		t.ID = peerChainID(t.Service, t.Namespace, t.Partition, t.Peer)
+		return
+	}
 	t.ID = chainID(t.ServiceSubset, t.Service, t.Namespace, t.Partition, t.Datacenter)
 }
 
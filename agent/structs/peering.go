@@ -6,6 +6,11 @@ type PeeringToken struct {
 	ServerAddresses []string
 	ServerName      string
 	PeerID          string
+
+	// EstablishmentSecret is a single-use secret that the dialing cluster
+	// exchanges for a long-lived stream secret. Tokens generated before
+	// secrets were introduced leave this empty.
+	EstablishmentSecret string
 }
 
 type IndexedExportedServiceList struct {
@@ -23,6 +28,20 @@ type ExportedServiceList struct {
 	// for service mesh purposes as defined in the exported-services
 	// configuration entry.
 	DiscoChains map[ServiceName]ExportedDiscoveryChainInfo
+
+	// Filters is a map of service names to the boolean expression limiting
+	// which of their instances are replicated to the peer. Services without a
+	// filter are omitted.
+	Filters map[ServiceName]string
+}
+
+// Filter returns the filter expression for the instances of the given service,
+// or an empty string if all instances are exported.
+func (list *ExportedServiceList) Filter(sn ServiceName) string {
+	if list == nil {
+		return ""
+	}
+	return list.Filters[sn]
 }
 
 // NOTE: this is not serialized via msgpack so it can be changed without concern.
@@ -25,6 +25,11 @@ type QueryFailoverOptions struct {
 	// Targets is a fixed list of datacenters and peers to try. This field cannot
 	// be populated with NearestN or Datacenters.
 	Targets []QueryFailoverTarget
+
+	// SamenessGroup is the name of a sameness group whose peers are tried in
+	// order. This field cannot be populated with NearestN, Datacenters, or
+	// Targets.
+	SamenessGroup string `json:",omitempty"`
 }
 
 // AsTargets either returns Targets as is or Datacenters converted into
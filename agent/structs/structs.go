@@ -84,6 +84,8 @@ const (
 	PeeringTerminateByIDType                    = 37
 	PeeringTrustBundleWriteType                 = 38
 	PeeringTrustBundleDeleteType                = 39
+	PeeringSecretsWriteType                     = 40
+	PeeringStreamStatusWriteType                = 41
 )
 
 const (
@@ -149,6 +151,8 @@ var requestTypeStrings = map[MessageType]string{
 	PeeringDeleteType:               "PeeringDelete",
 	PeeringTrustBundleWriteType:     "PeeringTrustBundle",
 	PeeringTrustBundleDeleteType:    "PeeringTrustBundleDelete",
+	PeeringSecretsWriteType:         "PeeringSecret",
+	PeeringStreamStatusWriteType:    "PeeringStreamStatus",
 }
 
 const (
@@ -252,6 +256,11 @@ type QueryOptions struct {
 	// token is assumed for backwards compatibility.
 	Token string
 
+	// SourceAddr is the address of the client that sent the request to the
+	// cluster. It is set by the server which received the request and is used
+	// to enforce the token's BoundCIDRs.
+	SourceAddr string
+
 	// If set, wait until query exceeds given index. Must be provided
 	// with MaxQueryTime.
 	MinQueryIndex uint64
@@ -342,6 +351,14 @@ func (q *QueryOptions) SetTokenSecret(s string) {
 	q.Token = s
 }
 
+func (q QueryOptions) RequestSourceAddr() string {
+	return q.SourceAddr
+}
+
+func (q *QueryOptions) SetRequestSourceAddr(addr string) {
+	q.SourceAddr = addr
+}
+
 func (q QueryOptions) Timeout(rpcHoldTimeout, maxQueryTime, defaultQueryTime time.Duration) time.Duration {
 	// Match logic in Server.blockingQuery.
 	if q.MinQueryIndex > 0 {
@@ -366,6 +383,11 @@ type WriteRequest struct {
 	// Token is the ACL token ID. If not provided, the 'anonymous'
 	// token is assumed for backwards compatibility.
 	Token string
+
+	// SourceAddr is the address of the client that sent the request to the
+	// cluster. It is set by the server which received the request and is used
+	// to enforce the token's BoundCIDRs.
+	SourceAddr string
 }
 
 // WriteRequest only applies to writes, always false
@@ -385,6 +407,14 @@ func (w *WriteRequest) SetTokenSecret(s string) {
 	w.Token = s
 }
 
+func (w WriteRequest) RequestSourceAddr() string {
+	return w.SourceAddr
+}
+
+func (w *WriteRequest) SetRequestSourceAddr(addr string) {
+	w.SourceAddr = addr
+}
+
 func (w WriteRequest) HasTimedOut(start time.Time, rpcHoldTimeout, maxQueryTime, defaultQueryTime time.Duration) (bool, error) {
 	return time.Since(start) > w.Timeout(rpcHoldTimeout, maxQueryTime, defaultQueryTime), nil
 }
@@ -1521,6 +1551,13 @@ func (s *NodeService) Validate() error {
 						path.Protocol, path.Path, protocols))
 			}
 		}
+
+		if err := s.Proxy.Tracing.Validate(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid tracing configuration: %v", err))
+		}
+		if err := s.Proxy.Metrics.Validate(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid metrics configuration: %v", err))
+		}
 	}
 
 	// Gateway validation
@@ -109,6 +109,123 @@ var expectedFieldConfigTransparentProxyConfig bexpr.FieldConfigurations = bexpr.
 	},
 }
 
+var expectedFieldConfigAccessLogsConfig bexpr.FieldConfigurations = bexpr.FieldConfigurations{
+	"Enabled": &bexpr.FieldConfiguration{
+		StructFieldName:     "Enabled",
+		CoerceFn:            bexpr.CoerceBool,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual},
+	},
+	"DisableListenerLogs": &bexpr.FieldConfiguration{
+		StructFieldName:     "DisableListenerLogs",
+		CoerceFn:            bexpr.CoerceBool,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual},
+	},
+	"Type": &bexpr.FieldConfiguration{
+		StructFieldName:     "Type",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"Path": &bexpr.FieldConfiguration{
+		StructFieldName:     "Path",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"JSONFormat": &bexpr.FieldConfiguration{
+		StructFieldName:     "JSONFormat",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"TextFormat": &bexpr.FieldConfiguration{
+		StructFieldName:     "TextFormat",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+}
+
+var expectedFieldConfigTracingConfig bexpr.FieldConfigurations = bexpr.FieldConfigurations{
+	"Provider": &bexpr.FieldConfiguration{
+		StructFieldName:     "Provider",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"CollectorAddress": &bexpr.FieldConfiguration{
+		StructFieldName:     "CollectorAddress",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"CollectorPath": &bexpr.FieldConfiguration{
+		StructFieldName:     "CollectorPath",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"SamplingPercentage": &bexpr.FieldConfiguration{
+		StructFieldName:     "SamplingPercentage",
+		CoerceFn:            bexpr.CoerceFloat64,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual},
+	},
+	"CustomTags": &bexpr.FieldConfiguration{
+		StructFieldName:     "CustomTags",
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchIsEmpty, bexpr.MatchIsNotEmpty},
+		SubFields:           expectedFieldConfigTracingCustomTags,
+	},
+}
+
+var expectedFieldConfigTracingCustomTags bexpr.FieldConfigurations = bexpr.FieldConfigurations{
+	"Tag": &bexpr.FieldConfiguration{
+		StructFieldName:     "Tag",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"Literal": &bexpr.FieldConfiguration{
+		StructFieldName:     "Literal",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"RequestHeader": &bexpr.FieldConfiguration{
+		StructFieldName:     "RequestHeader",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"Environment": &bexpr.FieldConfiguration{
+		StructFieldName:     "Environment",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+}
+
+var expectedFieldConfigMetricsConfig bexpr.FieldConfigurations = bexpr.FieldConfigurations{
+	"StatsdURL": &bexpr.FieldConfiguration{
+		StructFieldName:     "StatsdURL",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"DogstatsdURL": &bexpr.FieldConfiguration{
+		StructFieldName:     "DogstatsdURL",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"PrometheusBindAddr": &bexpr.FieldConfiguration{
+		StructFieldName:     "PrometheusBindAddr",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"StatsBindAddr": &bexpr.FieldConfiguration{
+		StructFieldName:     "StatsBindAddr",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+	"StatsTags": &bexpr.FieldConfiguration{
+		StructFieldName:     "StatsTags",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchIsEmpty, bexpr.MatchIsNotEmpty, bexpr.MatchIn, bexpr.MatchNotIn},
+	},
+	"StatsFlushInterval": &bexpr.FieldConfiguration{
+		StructFieldName:     "StatsFlushInterval",
+		CoerceFn:            bexpr.CoerceString,
+		SupportedOperations: []bexpr.MatchOperator{bexpr.MatchEqual, bexpr.MatchNotEqual, bexpr.MatchIn, bexpr.MatchNotIn, bexpr.MatchMatches, bexpr.MatchNotMatches},
+	},
+}
+
 var expectedFieldConfigExposeConfig bexpr.FieldConfigurations = bexpr.FieldConfigurations{
 	"Checks": &bexpr.FieldConfiguration{
 		StructFieldName:     "Checks",
@@ -250,6 +367,18 @@ var expectedFieldConfigConnectProxyConfig bexpr.FieldConfigurations = bexpr.Fiel
 		StructFieldName: "TransparentProxy",
 		SubFields:       expectedFieldConfigTransparentProxyConfig,
 	},
+	"AccessLogs": &bexpr.FieldConfiguration{
+		StructFieldName: "AccessLogs",
+		SubFields:       expectedFieldConfigAccessLogsConfig,
+	},
+	"Tracing": &bexpr.FieldConfiguration{
+		StructFieldName: "Tracing",
+		SubFields:       expectedFieldConfigTracingConfig,
+	},
+	"Metrics": &bexpr.FieldConfiguration{
+		StructFieldName: "Metrics",
+		SubFields:       expectedFieldConfigMetricsConfig,
+	},
 	"Mode": &bexpr.FieldConfiguration{
 		StructFieldName:     "Mode",
 		CoerceFn:            bexpr.CoerceString,
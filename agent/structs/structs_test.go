@@ -1139,6 +1139,34 @@ func TestStructs_NodeService_ValidateConnectProxy(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"connect-proxy: valid tracing and metrics",
+			func(x *NodeService) {
+				x.Proxy.Tracing = TracingConfig{
+					Provider:         OpenTelemetryTracingProvider,
+					CollectorAddress: "otel-collector:4317",
+				}
+				x.Proxy.Metrics = MetricsConfig{StatsdURL: "udp://127.0.0.1:8125"}
+			},
+			"",
+		},
+		{
+			"connect-proxy: invalid tracing",
+			func(x *NodeService) {
+				x.Proxy.Tracing = TracingConfig{
+					Provider:         OpenTelemetryTracingProvider,
+					CollectorAddress: `otel-collector:4317"}`,
+				}
+			},
+			"invalid tracing configuration",
+		},
+		{
+			"connect-proxy: invalid metrics",
+			func(x *NodeService) {
+				x.Proxy.Metrics = MetricsConfig{StatsdURL: "tcp://127.0.0.1:8125"}
+			},
+			"invalid metrics configuration",
+		},
 	}
 
 	for _, tc := range cases {
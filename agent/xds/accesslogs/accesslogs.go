@@ -0,0 +1,140 @@
+// Package accesslogs translates the access log configuration of a proxy into
+// the Envoy access loggers attached to its listeners.
+package accesslogs
+
+import (
+	"fmt"
+
+	envoy_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_fileaccesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_streamaccesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// defaultJSONFormat is used when neither a JSON nor a text format is
+	// configured. It covers both HTTP and TCP connections, fields that do not
+	// apply to a connection are rendered as "-" by Envoy.
+	defaultJSONFormat = `{
+  "start_time": "%START_TIME%",
+  "route_name": "%ROUTE_NAME%",
+  "method": "%REQ(:METHOD)%",
+  "path": "%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%",
+  "protocol": "%PROTOCOL%",
+  "response_code": "%RESPONSE_CODE%",
+  "response_flags": "%RESPONSE_FLAGS%",
+  "response_code_details": "%RESPONSE_CODE_DETAILS%",
+  "connection_termination_details": "%CONNECTION_TERMINATION_DETAILS%",
+  "bytes_received": "%BYTES_RECEIVED%",
+  "bytes_sent": "%BYTES_SENT%",
+  "duration": "%DURATION%",
+  "upstream_service_time": "%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%",
+  "x_forwarded_for": "%REQ(X-FORWARDED-FOR)%",
+  "user_agent": "%REQ(USER-AGENT)%",
+  "request_id": "%REQ(X-REQUEST-ID)%",
+  "authority": "%REQ(:AUTHORITY)%",
+  "upstream_host": "%UPSTREAM_HOST%",
+  "upstream_cluster": "%UPSTREAM_CLUSTER%",
+  "upstream_local_address": "%UPSTREAM_LOCAL_ADDRESS%",
+  "downstream_local_address": "%DOWNSTREAM_LOCAL_ADDRESS%",
+  "downstream_remote_address": "%DOWNSTREAM_REMOTE_ADDRESS%",
+  "requested_server_name": "%REQUESTED_SERVER_NAME%",
+  "upstream_transport_failure_reason": "%UPSTREAM_TRANSPORT_FAILURE_REASON%"
+}`
+
+	filterLogName   = "Consul Listener Filter Log"
+	listenerLogName = "Consul Listener Log"
+
+	// noRouteFoundFlag is the response flag set by Envoy when a listener
+	// rejects a connection because none of its filter chains matched.
+	noRouteFoundFlag = "NR"
+)
+
+// MakeAccessLogs returns the Envoy access loggers for the given config, or
+// nil when access logs are disabled.
+//
+// The loggers returned for a listener (as opposed to the network filters of
+// its filter chains) only record the connections the listener rejected, the
+// others are logged by the filters themselves.
+func MakeAccessLogs(logs *structs.AccessLogsConfig, isListener bool) ([]*envoy_accesslog_v3.AccessLog, error) {
+	if logs == nil || !logs.Enabled {
+		return nil, nil
+	}
+	if isListener && logs.DisableListenerLogs {
+		return nil, nil
+	}
+
+	format, err := makeLogFormat(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	var config proto.Message
+	switch logs.Type {
+	case structs.FileLogSinkType:
+		config = &envoy_fileaccesslog_v3.FileAccessLog{
+			Path:            logs.Path,
+			AccessLogFormat: &envoy_fileaccesslog_v3.FileAccessLog_LogFormat{LogFormat: format},
+		}
+	case structs.StdErrLogSinkType:
+		config = &envoy_streamaccesslog_v3.StderrAccessLog{
+			AccessLogFormat: &envoy_streamaccesslog_v3.StderrAccessLog_LogFormat{LogFormat: format},
+		}
+	case structs.DefaultLogSinkType, structs.StdOutLogSinkType:
+		config = &envoy_streamaccesslog_v3.StdoutAccessLog{
+			AccessLogFormat: &envoy_streamaccesslog_v3.StdoutAccessLog_LogFormat{LogFormat: format},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported access log type %q", logs.Type)
+	}
+
+	typedConfig, err := ptypes.MarshalAny(config)
+	if err != nil {
+		return nil, err
+	}
+
+	accessLog := &envoy_accesslog_v3.AccessLog{
+		Name:       filterLogName,
+		ConfigType: &envoy_accesslog_v3.AccessLog_TypedConfig{TypedConfig: typedConfig},
+	}
+	if isListener {
+		accessLog.Name = listenerLogName
+		accessLog.Filter = &envoy_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog_v3.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &envoy_accesslog_v3.ResponseFlagFilter{
+					Flags: []string{noRouteFoundFlag},
+				},
+			},
+		}
+	}
+	return []*envoy_accesslog_v3.AccessLog{accessLog}, nil
+}
+
+func makeLogFormat(logs *structs.AccessLogsConfig) (*envoy_core_v3.SubstitutionFormatString, error) {
+	if logs.TextFormat != "" {
+		return &envoy_core_v3.SubstitutionFormatString{
+			Format: &envoy_core_v3.SubstitutionFormatString_TextFormatSource{
+				TextFormatSource: &envoy_core_v3.DataSource{
+					Specifier: &envoy_core_v3.DataSource_InlineString{InlineString: logs.TextFormat},
+				},
+			},
+		}, nil
+	}
+
+	jsonFormat := logs.JSONFormat
+	if jsonFormat == "" {
+		jsonFormat = defaultJSONFormat
+	}
+	format := &structpb.Struct{}
+	if err := format.UnmarshalJSON([]byte(jsonFormat)); err != nil {
+		return nil, fmt.Errorf("invalid access log json_format: %v", err)
+	}
+	return &envoy_core_v3.SubstitutionFormatString{
+		Format: &envoy_core_v3.SubstitutionFormatString_JsonFormat{JsonFormat: format},
+	}, nil
+}
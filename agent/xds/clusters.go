@@ -3,7 +3,9 @@ package xds
 import (
 	"errors"
 	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,30 +41,36 @@ func (s *ResourceGenerator) clustersFromSnapshot(cfgSnap *proxycfg.ConfigSnapsho
 		return nil, errors.New("nil config given")
 	}
 
+	var (
+		res []proto.Message
+		err error
+	)
 	switch cfgSnap.Kind {
 	case structs.ServiceKindConnectProxy:
-		return s.clustersFromSnapshotConnectProxy(cfgSnap)
+		res, err = s.clustersFromSnapshotConnectProxy(cfgSnap)
 	case structs.ServiceKindTerminatingGateway:
-		res, err := s.clustersFromSnapshotTerminatingGateway(cfgSnap)
-		if err != nil {
-			return nil, err
-		}
-		return res, nil
+		res, err = s.clustersFromSnapshotTerminatingGateway(cfgSnap)
 	case structs.ServiceKindMeshGateway:
-		res, err := s.clustersFromSnapshotMeshGateway(cfgSnap)
-		if err != nil {
-			return nil, err
-		}
-		return res, nil
+		res, err = s.clustersFromSnapshotMeshGateway(cfgSnap)
 	case structs.ServiceKindIngressGateway:
-		res, err := s.clustersFromSnapshotIngressGateway(cfgSnap)
-		if err != nil {
-			return nil, err
-		}
-		return res, nil
+		res, err = s.clustersFromSnapshotIngressGateway(cfgSnap)
 	default:
 		return nil, fmt.Errorf("Invalid service kind: %v", cfgSnap.Kind)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The collector referenced by the tracing settings of the HTTP
+	// connection managers.
+	collector, err := makeTracingCollectorCluster(&cfgSnap.Proxy.Tracing)
+	if err != nil {
+		return nil, err
+	}
+	if collector != nil {
+		res = append(res, collector)
+	}
+	return res, nil
 }
 
 // clustersFromSnapshot returns the xDS API representation of the "clusters"
@@ -399,6 +407,9 @@ func (s *ResourceGenerator) clustersFromSnapshotMeshGateway(cfgSnap *proxycfg.Co
 		}
 	}
 
+	// Generate the clusters routing the control plane traffic of peerings.
+	clusters = append(clusters, s.makePeerServerClusters(cfgSnap)...)
+
 	// generate the per-service/subset clusters
 	c, err := s.makeGatewayServiceClusters(cfgSnap, cfgSnap.MeshGateway.ServiceGroups, cfgSnap.MeshGateway.ServiceResolvers)
 	if err != nil {
@@ -416,6 +427,92 @@ func (s *ResourceGenerator) clustersFromSnapshotMeshGateway(cfgSnap *proxycfg.Co
 	return clusters, nil
 }
 
+// makePeerServerClusters returns the clusters used by a mesh gateway to route
+// the control plane traffic of peerings: a single cluster for the local servers
+// accepting peering streams and one cluster per dialed peer pointing at the
+// addresses of its servers.
+func (s *ResourceGenerator) makePeerServerClusters(cfgSnap *proxycfg.ConfigSnapshot) []proto.Message {
+	if len(cfgSnap.MeshGateway.Peerings) == 0 {
+		return nil
+	}
+
+	var clusters []proto.Message
+	if meshGatewayAcceptsPeerings(cfgSnap) {
+		clusters = append(clusters, s.makeGatewayCluster(cfgSnap, clusterOpts{
+			name: LocalPeeringServersClusterName,
+		}))
+	}
+
+	cfg, err := ParseGatewayConfig(cfgSnap.Proxy.Config)
+	if err != nil {
+		// Don't hard fail on a config typo, just warn. The parse func returns
+		// default config if there is an error so it's safe to continue.
+		s.Logger.Warn("failed to parse gateway config", "error", err)
+	}
+
+	for _, peering := range cfgSnap.MeshGateway.Peerings {
+		if !peering.IsActive() || !peering.ShouldDial() {
+			continue
+		}
+
+		var (
+			endpoints    []*envoy_endpoint_v3.LbEndpoint
+			useHostnames bool
+		)
+		for _, address := range peering.PeerServerAddresses {
+			host, portStr, err := net.SplitHostPort(address)
+			if err != nil {
+				s.Logger.Warn("invalid peer server address", "peer", peering.Name, "address", address, "error", err)
+				continue
+			}
+			port, err := strconv.Atoi(portStr)
+			if err != nil {
+				s.Logger.Warn("invalid peer server address", "peer", peering.Name, "address", address, "error", err)
+				continue
+			}
+			if net.ParseIP(host) == nil {
+				useHostnames = true
+			}
+			endpoints = append(endpoints, makeLbEndpoint(host, port, envoy_core_v3.HealthStatus_UNKNOWN, 1))
+		}
+		if len(endpoints) == 0 {
+			continue
+		}
+
+		name := connect.PeeringServerSNI(peering.PeerID)
+		cluster := &envoy_cluster_v3.Cluster{
+			Name:                 name,
+			ConnectTimeout:       durationpb.New(time.Duration(cfg.ConnectTimeoutMs) * time.Millisecond),
+			ClusterDiscoveryType: &envoy_cluster_v3.Cluster_Type{Type: envoy_cluster_v3.Cluster_STATIC},
+			LoadAssignment: &envoy_endpoint_v3.ClusterLoadAssignment{
+				ClusterName: name,
+				Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{{
+					LbEndpoints: endpoints,
+				}},
+			},
+		}
+		if useHostnames {
+			cluster.ClusterDiscoveryType = &envoy_cluster_v3.Cluster_Type{Type: envoy_cluster_v3.Cluster_STRICT_DNS}
+			cluster.DnsRefreshRate = durationpb.New(10 * time.Second)
+			cluster.DnsLookupFamily = envoy_cluster_v3.Cluster_V4_ONLY
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
+
+// meshGatewayAcceptsPeerings returns whether the control plane traffic of at
+// least one peering accepted by the local servers is routed through the mesh
+// gateway.
+func meshGatewayAcceptsPeerings(cfgSnap *proxycfg.ConfigSnapshot) bool {
+	for _, peering := range cfgSnap.MeshGateway.Peerings {
+		if peering.IsActive() && !peering.ShouldDial() {
+			return true
+		}
+	}
+	return false
+}
+
 // clustersFromSnapshotTerminatingGateway returns the xDS API representation of the "clusters"
 // for a terminating gateway. This will include 1 cluster per Destination associated with this terminating gateway.
 func (s *ResourceGenerator) clustersFromSnapshotTerminatingGateway(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
@@ -440,7 +537,10 @@ func (s *ResourceGenerator) makeGatewayServiceClusters(
 	services map[structs.ServiceName]structs.CheckServiceNodes,
 	resolvers map[structs.ServiceName]*structs.ServiceResolverConfigEntry,
 ) ([]proto.Message, error) {
-	var hostnameEndpoints structs.CheckServiceNodes
+	var (
+		hostnameEndpoints structs.CheckServiceNodes
+		dnsCfg            *structs.LinkedServiceDNSConfig
+	)
 
 	switch cfgSnap.Kind {
 	case structs.ServiceKindTerminatingGateway, structs.ServiceKindMeshGateway:
@@ -468,6 +568,7 @@ func (s *ResourceGenerator) makeGatewayServiceClusters(
 		// This is because the services a mesh gateway will route to are not external services and are not addressed by a hostname.
 		if cfgSnap.Kind == structs.ServiceKindTerminatingGateway {
 			hostnameEndpoints = cfgSnap.TerminatingGateway.HostnameServices[svc]
+			dnsCfg = cfgSnap.TerminatingGateway.GatewayServices[svc].DNS
 		}
 
 		var isRemote bool
@@ -478,6 +579,7 @@ func (s *ResourceGenerator) makeGatewayServiceClusters(
 		opts := clusterOpts{
 			name:              clusterName,
 			hostnameEndpoints: hostnameEndpoints,
+			dns:               dnsCfg,
 			connectTimeout:    resolver.ConnectTimeout,
 			isRemote:          isRemote,
 		}
@@ -516,6 +618,7 @@ func (s *ResourceGenerator) makeGatewayServiceClusters(
 			opts := clusterOpts{
 				name:              connect.ServiceSNI(svc.Name, name, svc.NamespaceOrDefault(), svc.PartitionOrDefault(), cfgSnap.Datacenter, cfgSnap.Roots.TrustDomain),
 				hostnameEndpoints: subsetHostnameEndpoints,
+				dns:               dnsCfg,
 				onlyPassing:       subset.OnlyPassing,
 				connectTimeout:    resolver.ConnectTimeout,
 				isRemote:          isRemote,
@@ -674,6 +777,42 @@ func (s *ResourceGenerator) clustersFromSnapshotIngressGateway(cfgSnap *proxycfg
 			createdClusters[uid] = true
 		}
 	}
+
+	// External authorization services are only referenced from the listeners'
+	// HTTP filters. Their listeners aren't generated until the chain and
+	// endpoints are known, so skip them until then rather than failing.
+	for _, u := range cfgSnap.IngressGateway.ExtAuthzUpstreams {
+		uid := proxycfg.NewUpstreamID(&u)
+		if createdClusters[uid] {
+			continue
+		}
+
+		chain, ok := cfgSnap.IngressGateway.DiscoveryChain[uid]
+		if !ok {
+			continue
+		}
+		chainEndpoints, ok := cfgSnap.IngressGateway.WatchedUpstreamEndpoints[uid]
+		if !ok {
+			continue
+		}
+
+		upstreamClusters, err := s.makeUpstreamClustersForDiscoveryChain(
+			uid,
+			&u,
+			chain,
+			chainEndpoints,
+			cfgSnap,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range upstreamClusters {
+			clusters = append(clusters, c)
+		}
+		createdClusters[uid] = true
+	}
 	return clusters, nil
 }
 
@@ -814,6 +953,7 @@ func (s *ResourceGenerator) makeUpstreamClusterForPeerService(
 				s.Logger,
 				c,
 				"", /*TODO:make configurable?*/
+				nil,
 				ep,
 				true,  /*isRemote*/
 				false, /*onlyPassing*/
@@ -853,6 +993,17 @@ func (s *ResourceGenerator) makeUpstreamClusterForPeerService(
 	return c, nil
 }
 
+// peerTargetMeta returns the peering metadata of the instances of a service
+// imported from a peer, which is the same for all instances.
+func peerTargetMeta(endpoints structs.CheckServiceNodes) structs.PeeringServiceMeta {
+	for _, e := range endpoints {
+		if e.Service.Connect.PeerMeta != nil {
+			return *e.Service.Connect.PeerMeta
+		}
+	}
+	return structs.PeeringServiceMeta{}
+}
+
 func (s *ResourceGenerator) makeUpstreamClusterForPreparedQuery(upstream structs.Upstream, cfgSnap *proxycfg.ConfigSnapshot) (*envoy_cluster_v3.Cluster, error) {
 	var c *envoy_cluster_v3.Cluster
 	var err error
@@ -1064,6 +1215,10 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 			if actualTargetID != targetID {
 				actualTarget := chain.Targets[actualTargetID]
 				sni = actualTarget.SNI
+				if actualTarget.Peer != "" {
+					peerMeta := peerTargetMeta(chainEndpoints[actualTargetID])
+					sni = peerMeta.PrimarySNI()
+				}
 			}
 		}
 
@@ -1072,30 +1227,47 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 			targetSpiffeID: {},
 		}
 
+		rootPEMs := cfgSnap.RootPEMs()
 		if failover != nil {
 			// When failovers are present we need to add them as valid SANs to validate against.
 			// Envoy makes the failover decision independently based on the endpoint health it has available.
+			seenPeers := make(map[string]struct{})
 			for _, tid := range failover.Targets {
 				target, ok := chain.Targets[tid]
 				if !ok {
 					continue
 				}
 
-				id := connect.SpiffeIDService{
-					Host:       cfgSnap.Roots.TrustDomain,
-					Namespace:  target.Namespace,
-					Partition:  target.Partition,
-					Datacenter: target.Datacenter,
-					Service:    target.Service,
-				}.URI().String()
-
-				// Failover targets might be subsets of the same service, so these are deduplicated.
-				if _, ok := seenIDs[id]; ok {
-					continue
+				var ids []string
+				if target.Peer != "" {
+					// Imported services are validated against the SpiffeIDs
+					// and roots of the peer they are imported from.
+					ids = peerTargetMeta(chainEndpoints[tid]).SpiffeID
+					if _, ok := seenPeers[target.Peer]; !ok {
+						seenPeers[target.Peer] = struct{}{}
+						if tbs, ok := cfgSnap.UpstreamPeerTrustBundle(target.Peer); ok {
+							rootPEMs += tbs.ConcatenatedRootPEMs()
+						}
+					}
+				} else {
+					ids = []string{connect.SpiffeIDService{
+						Host:       cfgSnap.Roots.TrustDomain,
+						Namespace:  target.Namespace,
+						Partition:  target.Partition,
+						Datacenter: target.Datacenter,
+						Service:    target.Service,
+					}.URI().String()}
 				}
-				seenIDs[id] = struct{}{}
 
-				spiffeIDs = append(spiffeIDs, id)
+				for _, id := range ids {
+					// Failover targets might be subsets of the same service, so these are deduplicated.
+					if _, ok := seenIDs[id]; ok {
+						continue
+					}
+					seenIDs[id] = struct{}{}
+
+					spiffeIDs = append(spiffeIDs, id)
+				}
 			}
 		}
 		sort.Strings(spiffeIDs)
@@ -1161,7 +1333,7 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 		if configureTLS {
 			commonTLSContext := makeCommonTLSContext(
 				cfgSnap.Leaf(),
-				cfgSnap.RootPEMs(),
+				rootPEMs,
 				makeTLSParametersFromProxyTLSConfig(cfgSnap.MeshConfigTLSOutgoing()),
 			)
 
@@ -1311,6 +1483,9 @@ type clusterOpts struct {
 	// hostnameEndpoints is a list of endpoints with a hostname as their address
 	hostnameEndpoints structs.CheckServiceNodes
 
+	// dns optionally overrides how the hostnameEndpoints are resolved
+	dns *structs.LinkedServiceDNSConfig
+
 	// Corresponds to a valid ip/port in a Destination
 	address string
 	port    int
@@ -1357,6 +1532,7 @@ func (s *ResourceGenerator) makeGatewayCluster(snap *proxycfg.ConfigSnapshot, op
 			s.Logger,
 			cluster,
 			cfg.DNSDiscoveryType,
+			opts.dns,
 			opts.hostnameEndpoints,
 			opts.isRemote,
 			opts.onlyPassing,
@@ -1370,6 +1546,8 @@ func configureClusterWithHostnames(
 	logger hclog.Logger,
 	cluster *envoy_cluster_v3.Cluster,
 	dnsDiscoveryType string,
+	// dnsCfg optionally overrides how the hostname is resolved
+	dnsCfg *structs.LinkedServiceDNSConfig,
 	// hostnameEndpoints is a list of endpoints with a hostname as their address
 	hostnameEndpoints structs.CheckServiceNodes,
 	// isRemote determines whether the cluster is in a remote DC or partition and we should prefer a WAN address
@@ -1380,8 +1558,21 @@ func configureClusterWithHostnames(
 	// When a service instance is addressed by a hostname we have Envoy do the DNS resolution
 	// by setting a DNS cluster type and passing the hostname endpoints via CDS.
 	rate := 10 * time.Second
+	lookupFamily := envoy_cluster_v3.Cluster_V4_ONLY
+	if dnsCfg != nil {
+		if dnsCfg.DiscoveryType != "" {
+			dnsDiscoveryType = dnsCfg.DiscoveryType
+		}
+		if dnsCfg.RefreshRate > 0 {
+			rate = dnsCfg.RefreshRate
+		}
+		if dnsCfg.LookupFamily != "" {
+			lookupFamily = makeDNSLookupFamily(dnsCfg.LookupFamily)
+		}
+		cluster.RespectDnsTtl = dnsCfg.RespectTTL
+	}
 	cluster.DnsRefreshRate = durationpb.New(rate)
-	cluster.DnsLookupFamily = envoy_cluster_v3.Cluster_V4_ONLY
+	cluster.DnsLookupFamily = lookupFamily
 
 	discoveryType := envoy_cluster_v3.Cluster_Type{Type: envoy_cluster_v3.Cluster_LOGICAL_DNS}
 	if dnsDiscoveryType == "strict_dns" {
@@ -1441,6 +1632,21 @@ func configureClusterWithHostnames(
 	}
 }
 
+func makeDNSLookupFamily(family string) envoy_cluster_v3.Cluster_DnsLookupFamily {
+	switch family {
+	case "v6_only":
+		return envoy_cluster_v3.Cluster_V6_ONLY
+	case "v4_preferred":
+		return envoy_cluster_v3.Cluster_V4_PREFERRED
+	case "auto":
+		return envoy_cluster_v3.Cluster_AUTO
+	case "all":
+		return envoy_cluster_v3.Cluster_ALL
+	default:
+		return envoy_cluster_v3.Cluster_V4_ONLY
+	}
+}
+
 // makeTerminatingIPCluster creates an Envoy cluster for a terminating gateway with an ip destination
 func (s *ResourceGenerator) makeTerminatingIPCluster(snap *proxycfg.ConfigSnapshot, opts clusterOpts) *envoy_cluster_v3.Cluster {
 	cfg, err := ParseGatewayConfig(snap.Proxy.Config)
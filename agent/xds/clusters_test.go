@@ -124,6 +124,17 @@ func TestClustersFromSnapshot(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "tracing-zipkin",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Tracing = structs.TracingConfig{
+						Provider:         structs.ZipkinTracingProvider,
+						CollectorAddress: "zipkin.example.com:9411",
+					}
+				}, nil)
+			},
+		},
 		{
 			name: "custom-upstream",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -269,6 +280,18 @@ func TestClustersFromSnapshot(t *testing.T) {
 				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover-through-remote-gateway-triggered", nil, nil)
 			},
 		},
+		{
+			name: "connect-proxy-with-tcp-chain-failover-to-cluster-peer",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover-to-cluster-peer", nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-tcp-chain-failover-to-cluster-peer-triggered",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover-to-cluster-peer-triggered", nil, nil)
+			},
+		},
 		{
 			name: "connect-proxy-with-tcp-chain-double-failover-through-remote-gateway",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -585,6 +608,10 @@ func TestClustersFromSnapshot(t *testing.T) {
 			name:   "terminating-gateway-hostname-service-subsets",
 			create: proxycfg.TestConfigSnapshotTerminatingGatewayHostnameSubsets,
 		},
+		{
+			name:   "terminating-gateway-hostname-dns-config",
+			create: proxycfg.TestConfigSnapshotTerminatingGatewayHostnameDNSConfig,
+		},
 		{
 			name:   "terminating-gateway-sni",
 			create: proxycfg.TestConfigSnapshotTerminatingGatewaySNI,
@@ -609,6 +636,14 @@ func TestClustersFromSnapshot(t *testing.T) {
 			name:   "ingress-multiple-listeners-duplicate-service",
 			create: proxycfg.TestConfigSnapshotIngress_MultipleListenersDuplicateService,
 		},
+		{
+			name:   "ingress-with-jwt-and-ext-authz",
+			create: proxycfg.TestConfigSnapshotIngress_JWTAndExtAuthz,
+		},
+		{
+			name:   "ingress-tcp-sni-passthrough",
+			create: proxycfg.TestConfigSnapshotIngressGateway_TCPSNIPassthrough,
+		},
 		{
 			name:   "transparent-proxy-catalog-destinations-only",
 			create: proxycfg.TestConfigSnapshotTransparentProxyCatalogDestinationsOnly,
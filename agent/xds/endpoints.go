@@ -3,6 +3,8 @@ package xds
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 
 	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -71,6 +73,7 @@ func (s *ResourceGenerator) endpointsFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			uid,
 			chain,
 			cfgSnap.Locality,
+			newLocalityOptions(cfgSnap),
 			upstreamConfigMap,
 			cfgSnap.ConnectProxy.WatchedUpstreamEndpoints[uid],
 			cfgSnap.ConnectProxy.WatchedGatewayEndpoints[uid],
@@ -289,6 +292,27 @@ func (s *ResourceGenerator) endpointsFromSnapshotMeshGateway(cfgSnap *proxycfg.C
 		})
 	}
 
+	// generate endpoints for the gRPC port of our servers if they accept
+	// peering streams through this gateway
+	if meshGatewayAcceptsPeerings(cfgSnap) {
+		var lbEndpoints []*envoy_endpoint_v3.LbEndpoint
+		for _, srv := range cfgSnap.MeshGateway.ConsulServers {
+			grpcPort, err := strconv.Atoi(srv.Service.Meta["grpc_port"])
+			if err != nil || grpcPort <= 0 {
+				continue // the gRPC port is not enabled on this server
+			}
+			_, addr, _ := srv.BestAddress(false /*wan*/)
+			lbEndpoints = append(lbEndpoints, makeLbEndpoint(addr, grpcPort, envoy_core_v3.HealthStatus_UNKNOWN, 1))
+		}
+
+		resources = append(resources, &envoy_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: LocalPeeringServersClusterName,
+			Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: lbEndpoints,
+			}},
+		})
+	}
+
 	// Generate the endpoints for each service and its subsets
 	e, err := s.endpointsFromServicesAndResolvers(cfgSnap, cfgSnap.MeshGateway.ServiceGroups, cfgSnap.MeshGateway.ServiceResolvers)
 	if err != nil {
@@ -344,13 +368,19 @@ func (s *ResourceGenerator) endpointsFromServicesAndResolvers(
 			}
 		}
 
+		locality := newLocalityOptions(cfgSnap)
+		if resolver, ok := resolvers[svc]; ok {
+			locality.prioritize = resolver.PrioritizeByLocality.Failover()
+		}
+
 		// now generate the load assignment for all subsets
 		for subsetName, groups := range clusterEndpoints {
 			clusterName := connect.ServiceSNI(svc.Name, subsetName, svc.NamespaceOrDefault(), svc.PartitionOrDefault(), cfgSnap.Datacenter, cfgSnap.Roots.TrustDomain)
-			la := makeLoadAssignment(
+			la := makeLocalityLoadAssignment(
 				clusterName,
 				groups,
 				cfgSnap.Locality,
+				locality,
 			)
 			resources = append(resources, la)
 		}
@@ -376,6 +406,7 @@ func (s *ResourceGenerator) endpointsFromSnapshotIngressGateway(cfgSnap *proxycf
 				uid,
 				cfgSnap.IngressGateway.DiscoveryChain[uid],
 				proxycfg.GatewayKey{Datacenter: cfgSnap.Datacenter, Partition: u.DestinationPartition},
+				newLocalityOptions(cfgSnap),
 				u.Config,
 				cfgSnap.IngressGateway.WatchedUpstreamEndpoints[uid],
 				cfgSnap.IngressGateway.WatchedGatewayEndpoints[uid],
@@ -388,6 +419,34 @@ func (s *ResourceGenerator) endpointsFromSnapshotIngressGateway(cfgSnap *proxycf
 			createdClusters[uid] = true
 		}
 	}
+
+	for _, u := range cfgSnap.IngressGateway.ExtAuthzUpstreams {
+		uid := proxycfg.NewUpstreamID(&u)
+		if createdClusters[uid] {
+			continue
+		}
+
+		chain, ok := cfgSnap.IngressGateway.DiscoveryChain[uid]
+		if !ok {
+			continue
+		}
+
+		es, err := s.endpointsFromDiscoveryChain(
+			uid,
+			chain,
+			proxycfg.GatewayKey{Datacenter: cfgSnap.Datacenter, Partition: u.DestinationPartition},
+			newLocalityOptions(cfgSnap),
+			u.Config,
+			cfgSnap.IngressGateway.WatchedUpstreamEndpoints[uid],
+			cfgSnap.IngressGateway.WatchedGatewayEndpoints[uid],
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, es...)
+		createdClusters[uid] = true
+	}
 	return resources, nil
 }
 
@@ -416,6 +475,7 @@ func (s *ResourceGenerator) endpointsFromDiscoveryChain(
 	uid proxycfg.UpstreamID,
 	chain *structs.CompiledDiscoveryChain,
 	gatewayKey proxycfg.GatewayKey,
+	locality localityOptions,
 	upstreamConfigMap map[string]interface{},
 	upstreamEndpoints map[string]structs.CheckServiceNodes,
 	gatewayEndpoints map[string]structs.CheckServiceNodes,
@@ -533,10 +593,12 @@ func (s *ResourceGenerator) endpointsFromDiscoveryChain(
 			}
 		}
 
-		la := makeLoadAssignment(
+		locality.prioritize = node.Resolver.PrioritizeByLocality.Failover()
+		la := makeLocalityLoadAssignment(
 			clusterName,
 			endpointGroups,
 			gatewayKey,
+			locality,
 		)
 		resources = append(resources, la)
 	}
@@ -592,6 +654,7 @@ func (s *ResourceGenerator) makeExportedUpstreamEndpointsForMeshGateway(cfgSnap
 			proxycfg.NewUpstreamIDFromServiceName(svc),
 			chain,
 			cfgSnap.Locality,
+			newLocalityOptions(cfgSnap),
 			nil,
 			chainEndpoints,
 			nil,
@@ -619,22 +682,63 @@ type loadAssignmentEndpointGroup struct {
 }
 
 func makeLoadAssignment(clusterName string, endpointGroups []loadAssignmentEndpointGroup, localKey proxycfg.GatewayKey) *envoy_endpoint_v3.ClusterLoadAssignment {
+	return makeLocalityLoadAssignment(clusterName, endpointGroups, localKey, localityOptions{})
+}
+
+// localityOptions controls how the endpoints of a load assignment are split
+// into Envoy localities.
+type localityOptions struct {
+	// config is the mesh-wide locality config. Endpoints are not assigned a
+	// locality when it is nil.
+	config *structs.MeshLocalityConfig
+
+	// local is the locality of the proxy the load assignment is generated for.
+	local structs.Locality
+
+	// prioritize assigns a lower priority to endpoints outside of the local
+	// zone and region so that Envoy only fails over to them when the local
+	// endpoints are unhealthy.
+	prioritize bool
+}
+
+func newLocalityOptions(cfgSnap *proxycfg.ConfigSnapshot) localityOptions {
+	return localityOptions{
+		config: cfgSnap.MeshConfig().LocalityConfig(),
+		local:  cfgSnap.ProxyLocality(),
+	}
+}
+
+// tier returns the relative priority of endpoints in locality l: 0 for the
+// local zone, 1 for the local region and 2 for everything else.
+func (o localityOptions) tier(l structs.Locality) int {
+	switch {
+	case o.local.Zone != "" && l.Zone == o.local.Zone && l.Region == o.local.Region:
+		return 0
+	case o.local.Region != "" && l.Region == o.local.Region:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func makeLocalityLoadAssignment(
+	clusterName string,
+	endpointGroups []loadAssignmentEndpointGroup,
+	localKey proxycfg.GatewayKey,
+	locality localityOptions,
+) *envoy_endpoint_v3.ClusterLoadAssignment {
 	cla := &envoy_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: clusterName,
 		Endpoints:   make([]*envoy_endpoint_v3.LocalityLbEndpoints, 0, len(endpointGroups)),
 	}
 
-	if len(endpointGroups) > 1 {
-		cla.Policy = &envoy_endpoint_v3.ClusterLoadAssignment_Policy{
-			// We choose such a large value here that the failover math should
-			// in effect not happen until zero instances are healthy.
-			OverprovisioningFactor: makeUint32Value(100000),
-		}
-	}
+	prioritize := locality.prioritize && !locality.local.IsEmpty()
 
-	for priority, endpointGroup := range endpointGroups {
+	var priority uint32
+	for _, endpointGroup := range endpointGroups {
 		endpoints := endpointGroup.Endpoints
 		es := make([]*envoy_endpoint_v3.LbEndpoint, 0, len(endpoints))
+		localities := make([]structs.Locality, 0, len(endpoints))
 
 		for _, ep := range endpoints {
 			// TODO (mesh-gateway) - should we respect the translate_wan_addrs configuration here or just always use the wan for cross-dc?
@@ -654,12 +758,95 @@ func makeLoadAssignment(clusterName string, endpointGroups []loadAssignmentEndpo
 				HealthStatus:        healthStatus,
 				LoadBalancingWeight: makeUint32Value(weight),
 			})
+
+			var nodeMeta map[string]string
+			if ep.Node != nil {
+				nodeMeta = ep.Node.Meta
+			}
+			localities = append(localities, locality.config.Locality(ep.Service.Meta, nodeMeta))
+		}
+
+		// Endpoints reached through a mesh gateway all share the gateway's
+		// locality rather than that of the service instances behind it.
+		if locality.config == nil || endpointGroup.OverrideHealth != envoy_core_v3.HealthStatus_UNKNOWN {
+			cla.Endpoints = append(cla.Endpoints, &envoy_endpoint_v3.LocalityLbEndpoints{
+				Priority:    priority,
+				LbEndpoints: es,
+			})
+			priority++
+			continue
 		}
 
-		cla.Endpoints = append(cla.Endpoints, &envoy_endpoint_v3.LocalityLbEndpoints{
-			Priority:    uint32(priority),
-			LbEndpoints: es,
+		byLocality := make(map[structs.Locality][]*envoy_endpoint_v3.LbEndpoint)
+		for i, l := range localities {
+			byLocality[l] = append(byLocality[l], es[i])
+		}
+		keys := make([]structs.Locality, 0, len(byLocality))
+		for l := range byLocality {
+			keys = append(keys, l)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].Region != keys[j].Region {
+				return keys[i].Region < keys[j].Region
+			}
+			return keys[i].Zone < keys[j].Zone
 		})
+
+		// Map the tiers present in this group onto consecutive priorities so
+		// that there are no gaps between this group and the next.
+		tierPriority := make(map[int]uint32)
+		if prioritize {
+			var tiers []int
+			for _, l := range keys {
+				t := locality.tier(l)
+				if _, ok := tierPriority[t]; !ok {
+					tierPriority[t] = 0
+					tiers = append(tiers, t)
+				}
+			}
+			sort.Ints(tiers)
+			for i, t := range tiers {
+				tierPriority[t] = uint32(i)
+			}
+		}
+
+		var maxOffset uint32
+		for _, l := range keys {
+			var offset uint32
+			if prioritize {
+				offset = tierPriority[locality.tier(l)]
+			}
+			if offset > maxOffset {
+				maxOffset = offset
+			}
+
+			lle := &envoy_endpoint_v3.LocalityLbEndpoints{
+				Priority:    priority + offset,
+				LbEndpoints: byLocality[l],
+			}
+			if !l.IsEmpty() {
+				lle.Locality = &envoy_core_v3.Locality{
+					Region: l.Region,
+					Zone:   l.Zone,
+				}
+			}
+			cla.Endpoints = append(cla.Endpoints, lle)
+		}
+		if len(keys) == 0 {
+			cla.Endpoints = append(cla.Endpoints, &envoy_endpoint_v3.LocalityLbEndpoints{
+				Priority:    priority,
+				LbEndpoints: es,
+			})
+		}
+		priority += maxOffset + 1
+	}
+
+	if priority > 1 {
+		cla.Policy = &envoy_endpoint_v3.ClusterLoadAssignment_Policy{
+			// We choose such a large value here that the failover math should
+			// in effect not happen until zero instances are healthy.
+			OverprovisioningFactor: makeUint32Value(100000),
+		}
 	}
 
 	return cla
@@ -680,6 +867,14 @@ func makeLoadAssignmentEndpointGroup(
 	}
 	target := targets[targetID]
 
+	if target.Peer != "" {
+		// The endpoints of imported services are the mesh gateways of the
+		// peer already.
+		return loadAssignmentEndpointGroup{
+			Endpoints: realEndpoints,
+		}, true
+	}
+
 	var gatewayKey proxycfg.GatewayKey
 
 	switch target.MeshGateway.Mode {
@@ -217,6 +217,122 @@ func Test_makeLoadAssignment(t *testing.T) {
 	}
 }
 
+func Test_makeLocalityLoadAssignment(t *testing.T) {
+	makeNode := func(name, addr, region, zone string) structs.CheckServiceNode {
+		return structs.CheckServiceNode{
+			Node: &structs.Node{
+				Node:       name,
+				Address:    addr,
+				Datacenter: "dc1",
+				Meta:       map[string]string{"region": region, "zone": zone},
+			},
+			Service: &structs.NodeService{
+				Service: "web",
+				Port:    1234,
+			},
+		}
+	}
+	makeEndpoint := func(addr string) *envoy_endpoint_v3.LbEndpoint {
+		return &envoy_endpoint_v3.LbEndpoint{
+			HostIdentifier: &envoy_endpoint_v3.LbEndpoint_Endpoint{
+				Endpoint: &envoy_endpoint_v3.Endpoint{
+					Address: makeAddress(addr, 1234),
+				}},
+			HealthStatus:        envoy_core_v3.HealthStatus_HEALTHY,
+			LoadBalancingWeight: makeUint32Value(1),
+		}
+	}
+
+	nodes := structs.CheckServiceNodes{
+		makeNode("node1", "10.10.10.10", "us-west-2", "us-west-2a"),
+		makeNode("node2", "10.10.10.20", "us-east-1", "us-east-1b"),
+		makeNode("node3", "10.10.10.30", "us-east-1", "us-east-1a"),
+	}
+	failoverNodes := structs.CheckServiceNodes{
+		makeNode("node4", "10.10.10.40", "us-east-1", "us-east-1a"),
+	}
+	config := &structs.MeshLocalityConfig{RegionMetaKey: "region", ZoneMetaKey: "zone"}
+	local := structs.Locality{Region: "us-east-1", Zone: "us-east-1a"}
+
+	eastA := &envoy_core_v3.Locality{Region: "us-east-1", Zone: "us-east-1a"}
+	eastB := &envoy_core_v3.Locality{Region: "us-east-1", Zone: "us-east-1b"}
+	westA := &envoy_core_v3.Locality{Region: "us-west-2", Zone: "us-west-2a"}
+
+	tests := []struct {
+		name      string
+		endpoints []loadAssignmentEndpointGroup
+		locality  localityOptions
+		want      *envoy_endpoint_v3.ClusterLoadAssignment
+	}{
+		{
+			name:      "localities",
+			endpoints: []loadAssignmentEndpointGroup{{Endpoints: nodes}},
+			locality:  localityOptions{config: config, local: local},
+			want: &envoy_endpoint_v3.ClusterLoadAssignment{
+				ClusterName: "service:test",
+				Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{
+					{Locality: eastA, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.30")}},
+					{Locality: eastB, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.20")}},
+					{Locality: westA, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.10")}},
+				},
+			},
+		},
+		{
+			name:      "prioritized localities",
+			endpoints: []loadAssignmentEndpointGroup{{Endpoints: nodes}, {Endpoints: failoverNodes}},
+			locality:  localityOptions{config: config, local: local, prioritize: true},
+			want: &envoy_endpoint_v3.ClusterLoadAssignment{
+				ClusterName: "service:test",
+				Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{
+					{Locality: eastA, Priority: 0, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.30")}},
+					{Locality: eastB, Priority: 1, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.20")}},
+					{Locality: westA, Priority: 2, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.10")}},
+					{Locality: eastA, Priority: 3, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.40")}},
+				},
+				Policy: &envoy_endpoint_v3.ClusterLoadAssignment_Policy{
+					OverprovisioningFactor: makeUint32Value(100000),
+				},
+			},
+		},
+		{
+			name:      "prioritize without proxy locality",
+			endpoints: []loadAssignmentEndpointGroup{{Endpoints: nodes[:2]}},
+			locality:  localityOptions{config: config, prioritize: true},
+			want: &envoy_endpoint_v3.ClusterLoadAssignment{
+				ClusterName: "service:test",
+				Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{
+					{Locality: eastB, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.20")}},
+					{Locality: westA, LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.10")}},
+				},
+			},
+		},
+		{
+			name: "mesh gateway group",
+			endpoints: []loadAssignmentEndpointGroup{
+				{Endpoints: nodes[:2], OverrideHealth: envoy_core_v3.HealthStatus_HEALTHY},
+			},
+			locality: localityOptions{config: config, local: local, prioritize: true},
+			want: &envoy_endpoint_v3.ClusterLoadAssignment{
+				ClusterName: "service:test",
+				Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{
+					{LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{makeEndpoint("10.10.10.10"), makeEndpoint("10.10.10.20")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := makeLocalityLoadAssignment(
+				"service:test",
+				tt.endpoints,
+				proxycfg.GatewayKey{Datacenter: "dc1"},
+				tt.locality,
+			)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestEndpointsFromSnapshot(t *testing.T) {
 	// TODO: we should move all of these to TestAllResourcesFromSnapshot
 	// eventually to test all of the xDS types at once with the same input,
@@ -296,6 +412,18 @@ func TestEndpointsFromSnapshot(t *testing.T) {
 				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover-through-remote-gateway-triggered", nil, nil)
 			},
 		},
+		{
+			name: "connect-proxy-with-tcp-chain-failover-to-cluster-peer",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover-to-cluster-peer", nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-tcp-chain-failover-to-cluster-peer-triggered",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover-to-cluster-peer-triggered", nil, nil)
+			},
+		},
 		{
 			name: "connect-proxy-with-tcp-chain-double-failover-through-remote-gateway",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -483,6 +611,10 @@ func TestEndpointsFromSnapshot(t *testing.T) {
 			name:   "ingress-multiple-listeners-duplicate-service",
 			create: proxycfg.TestConfigSnapshotIngress_MultipleListenersDuplicateService,
 		},
+		{
+			name:   "ingress-with-jwt-and-ext-authz",
+			create: proxycfg.TestConfigSnapshotIngress_JWTAndExtAuthz,
+		},
 	}
 
 	latestEnvoyVersion := proxysupport.EnvoyVersions[0]
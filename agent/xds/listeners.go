@@ -24,7 +24,6 @@ import (
 	envoy_sni_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/sni_cluster/v3"
 	envoy_tcp_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
-	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
@@ -38,6 +37,7 @@ import (
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/proxycfg"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/xds/accesslogs"
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/lib/stringslice"
 	"github.com/hashicorp/consul/proto/pbpeering"
@@ -53,18 +53,40 @@ func (s *ResourceGenerator) listenersFromSnapshot(cfgSnap *proxycfg.ConfigSnapsh
 		return nil, errors.New("nil config given")
 	}
 
+	var (
+		resources []proto.Message
+		err       error
+	)
 	switch cfgSnap.Kind {
 	case structs.ServiceKindConnectProxy:
-		return s.listenersFromSnapshotConnectProxy(cfgSnap)
+		resources, err = s.listenersFromSnapshotConnectProxy(cfgSnap)
 	case structs.ServiceKindTerminatingGateway:
-		return s.listenersFromSnapshotGateway(cfgSnap)
+		resources, err = s.listenersFromSnapshotGateway(cfgSnap)
 	case structs.ServiceKindMeshGateway:
-		return s.listenersFromSnapshotGateway(cfgSnap)
+		resources, err = s.listenersFromSnapshotGateway(cfgSnap)
 	case structs.ServiceKindIngressGateway:
-		return s.listenersFromSnapshotGateway(cfgSnap)
+		resources, err = s.listenersFromSnapshotGateway(cfgSnap)
 	default:
 		return nil, fmt.Errorf("Invalid service kind: %v", cfgSnap.Kind)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The filters log the connections they handle, the listeners themselves
+	// only log the ones that did not match any filter chain. Listeners that
+	// already define access logs through an escape hatch are left alone.
+	for _, resource := range resources {
+		l, ok := resource.(*envoy_listener_v3.Listener)
+		if !ok || len(l.AccessLog) > 0 {
+			continue
+		}
+		l.AccessLog, err = accesslogs.MakeAccessLogs(&cfgSnap.Proxy.AccessLogs, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
 }
 
 // listenersFromSnapshotConnectProxy returns the "listeners" for a connect proxy service
@@ -152,6 +174,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				filterName:  filterName,
 				protocol:    cfg.Protocol,
 				useRDS:      useRDS,
+				accessLogs:  &cfgSnap.Proxy.AccessLogs,
+				tracing:     &cfgSnap.Proxy.Tracing,
 			})
 			if err != nil {
 				return nil, err
@@ -177,6 +201,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			filterName:  filterName,
 			protocol:    cfg.Protocol,
 			useRDS:      useRDS,
+			accessLogs:  &cfgSnap.Proxy.AccessLogs,
+			tracing:     &cfgSnap.Proxy.Tracing,
 		})
 		if err != nil {
 			return nil, err
@@ -242,6 +268,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				filterName:  clusterName,
 				protocol:    svcConfig.Protocol,
 				useRDS:      structs.IsProtocolHTTPLike(svcConfig.Protocol),
+				accessLogs:  &cfgSnap.Proxy.AccessLogs,
+				tracing:     &cfgSnap.Proxy.Tracing,
 			})
 			if err != nil {
 				return err
@@ -315,6 +343,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				protocol:   cfg.Protocol,
 				useRDS:     false,
 				statPrefix: "upstream_peered.",
+				accessLogs: &cfgSnap.Proxy.AccessLogs,
+				tracing:    &cfgSnap.Proxy.Tracing,
 			})
 			if err != nil {
 				return nil, err
@@ -344,6 +374,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			protocol:   cfg.Protocol,
 			useRDS:     false,
 			statPrefix: "upstream_peered.",
+			accessLogs: &cfgSnap.Proxy.AccessLogs,
+			tracing:    &cfgSnap.Proxy.Tracing,
 		})
 		if err != nil {
 			return nil, err
@@ -403,6 +435,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 					clusterName: "passthrough~" + sni,
 					filterName:  filterName,
 					protocol:    "tcp",
+					accessLogs:  &cfgSnap.Proxy.AccessLogs,
+					tracing:     &cfgSnap.Proxy.Tracing,
 				})
 				if err != nil {
 					return nil, err
@@ -448,6 +482,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				clusterName: OriginalDestinationClusterName,
 				filterName:  OriginalDestinationClusterName,
 				protocol:    "tcp",
+				accessLogs:  &cfgSnap.Proxy.AccessLogs,
+				tracing:     &cfgSnap.Proxy.Tracing,
 			})
 			if err != nil {
 				return nil, err
@@ -495,6 +531,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			filterName:  uid.EnvoyID(),
 			routeName:   uid.EnvoyID(),
 			protocol:    cfg.Protocol,
+			accessLogs:  &cfgSnap.Proxy.AccessLogs,
+			tracing:     &cfgSnap.Proxy.Tracing,
 		})
 		if err != nil {
 			return nil, err
@@ -1151,6 +1189,8 @@ func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot
 		routeName:        name,
 		cluster:          LocalAppClusterName,
 		requestTimeoutMs: cfg.LocalRequestTimeoutMs,
+		accessLogs:       &cfgSnap.Proxy.AccessLogs,
+		tracing:          &cfgSnap.Proxy.Tracing,
 	}
 	if useHTTPFilter {
 		filterOpts.httpAuthzFilter, err = makeRBACHTTPFilter(
@@ -1256,6 +1296,8 @@ func (s *ResourceGenerator) makeExposedCheckListener(cfgSnap *proxycfg.ConfigSna
 		statPrefix:      "",
 		routePath:       path.Path,
 		httpAuthzFilter: nil,
+		accessLogs:      &cfgSnap.Proxy.AccessLogs,
+		tracing:         &cfgSnap.Proxy.Tracing,
 	}
 	f, err := makeListenerFilter(opts)
 	if err != nil {
@@ -1417,7 +1459,7 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 
 	// This fallback catch-all filter ensures a listener will be present for health checks to pass
 	// Envoy will reset these connections since known endpoints are caught by filter chain matches above
-	tcpProxy, err := makeTCPProxyFilter(name, "", "terminating_gateway.")
+	tcpProxy, err := makeTCPProxyFilter(name, "", "terminating_gateway.", &cfgSnap.Proxy.AccessLogs)
 	if err != nil {
 		return nil, err
 	}
@@ -1498,6 +1540,8 @@ func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.
 		cluster:    tgtwyOpts.cluster,
 		statPrefix: "upstream.",
 		routePath:  "",
+		accessLogs: &cfgSnap.Proxy.AccessLogs,
+		tracing:    &cfgSnap.Proxy.Tracing,
 	}
 
 	if useHTTPFilter {
@@ -1549,7 +1593,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 
 	// The cluster name here doesn't matter as the sni_cluster
 	// filter will fill it in for us.
-	tcpProxy, err := makeTCPProxyFilter(name, "", "mesh_gateway_local.")
+	tcpProxy, err := makeTCPProxyFilter(name, "", "mesh_gateway_local.", &cfgSnap.Proxy.AccessLogs)
 	if err != nil {
 		return nil, err
 	}
@@ -1587,7 +1631,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 
 		clusterName := connect.GatewaySNI(key.Datacenter, key.Partition, cfgSnap.Roots.TrustDomain)
 		filterName := fmt.Sprintf("%s.%s", name, key.String())
-		dcTCPProxy, err := makeTCPProxyFilter(filterName, clusterName, "mesh_gateway_remote.")
+		dcTCPProxy, err := makeTCPProxyFilter(filterName, clusterName, "mesh_gateway_remote.", &cfgSnap.Proxy.AccessLogs)
 		if err != nil {
 			return nil, err
 		}
@@ -1612,7 +1656,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 			}
 			clusterName := cfgSnap.ServerSNIFn(key.Datacenter, "")
 			filterName := fmt.Sprintf("%s.%s", name, key.String())
-			dcTCPProxy, err := makeTCPProxyFilter(filterName, clusterName, "mesh_gateway_remote.")
+			dcTCPProxy, err := makeTCPProxyFilter(filterName, clusterName, "mesh_gateway_remote.", &cfgSnap.Proxy.AccessLogs)
 			if err != nil {
 				return nil, err
 			}
@@ -1632,7 +1676,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 			clusterName := cfgSnap.ServerSNIFn(cfgSnap.Datacenter, srv.Node.Node)
 
 			filterName := fmt.Sprintf("%s.%s", name, cfgSnap.Datacenter)
-			dcTCPProxy, err := makeTCPProxyFilter(filterName, clusterName, "mesh_gateway_local_server.")
+			dcTCPProxy, err := makeTCPProxyFilter(filterName, clusterName, "mesh_gateway_local_server.", &cfgSnap.Proxy.AccessLogs)
 			if err != nil {
 				return nil, err
 			}
@@ -1648,6 +1692,36 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 		}
 	}
 
+	// Route the control plane traffic of peerings to the local servers when
+	// they accept the peering and to the servers of the peer otherwise.
+	for _, peering := range cfgSnap.MeshGateway.Peerings {
+		if !peering.IsActive() {
+			continue
+		}
+
+		serverName := connect.PeeringServerSNI(peering.ID)
+		clusterName := LocalPeeringServersClusterName
+		if peering.ShouldDial() {
+			serverName = connect.PeeringServerSNI(peering.PeerID)
+			clusterName = serverName
+		}
+
+		filterName := fmt.Sprintf("%s.%s", name, serverName)
+		peerTCPProxy, err := makeTCPProxyFilter(filterName, clusterName, "mesh_gateway_peering_server.", &cfgSnap.Proxy.AccessLogs)
+		if err != nil {
+			return nil, err
+		}
+
+		l.FilterChains = append(l.FilterChains, &envoy_listener_v3.FilterChain{
+			FilterChainMatch: &envoy_listener_v3.FilterChainMatch{
+				ServerNames: []string{serverName},
+			},
+			Filters: []*envoy_listener_v3.Filter{
+				peerTCPProxy,
+			},
+		})
+	}
+
 	// This needs to get tacked on at the end as it has no
 	// matching and will act as a catch all
 	l.FilterChains = append(l.FilterChains, sniClusterChain)
@@ -1695,6 +1769,8 @@ func (s *ResourceGenerator) makeMeshGatewayPeerFilterChain(
 		statPrefix:           "mesh_gateway_local_peered.",
 		forwardClientDetails: true,
 		forwardClientPolicy:  envoy_http_v3.HttpConnectionManager_SANITIZE_SET,
+		accessLogs:           &cfgSnap.Proxy.AccessLogs,
+		tracing:              &cfgSnap.Proxy.Tracing,
 	})
 	if err != nil {
 		return nil, err
@@ -1744,6 +1820,8 @@ type filterChainOpts struct {
 	statPrefix           string
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
+	accessLogs           *structs.AccessLogsConfig
+	tracing              *structs.TracingConfig
 }
 
 func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envoy_listener_v3.FilterChain, error) {
@@ -1759,6 +1837,8 @@ func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envo
 		statPrefix:           opts.statPrefix,
 		forwardClientDetails: opts.forwardClientDetails,
 		forwardClientPolicy:  opts.forwardClientPolicy,
+		accessLogs:           opts.accessLogs,
+		tracing:              opts.tracing,
 	})
 	if err != nil {
 		return nil, err
@@ -1899,8 +1979,11 @@ type listenerFilterOpts struct {
 	requestTimeoutMs     *int
 	ingressGateway       bool
 	httpAuthzFilter      *envoy_http_v3.HttpFilter
+	httpFilters          []*envoy_http_v3.HttpFilter
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
+	accessLogs           *structs.AccessLogsConfig
+	tracing              *structs.TracingConfig
 }
 
 func makeListenerFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error) {
@@ -1915,7 +1998,7 @@ func makeListenerFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, err
 		} else if opts.cluster == "" {
 			return nil, fmt.Errorf("cluster name is required for a tcp proxy filter")
 		}
-		return makeTCPProxyFilter(opts.filterName, opts.cluster, opts.statPrefix)
+		return makeTCPProxyFilter(opts.filterName, opts.cluster, opts.statPrefix, opts.accessLogs)
 	}
 }
 
@@ -1933,10 +2016,15 @@ func makeSNIClusterFilter() (*envoy_listener_v3.Filter, error) {
 	return makeFilter("envoy.filters.network.sni_cluster", &envoy_sni_cluster_v3.SniCluster{})
 }
 
-func makeTCPProxyFilter(filterName, cluster, statPrefix string) (*envoy_listener_v3.Filter, error) {
+func makeTCPProxyFilter(filterName, cluster, statPrefix string, accessLogs *structs.AccessLogsConfig) (*envoy_listener_v3.Filter, error) {
+	logs, err := accesslogs.MakeAccessLogs(accessLogs, false)
+	if err != nil {
+		return nil, err
+	}
 	cfg := &envoy_tcp_proxy_v3.TcpProxy{
 		StatPrefix:       makeStatPrefix(statPrefix, filterName),
 		ClusterSpecifier: &envoy_tcp_proxy_v3.TcpProxy_Cluster{Cluster: cluster},
+		AccessLog:        logs,
 	}
 	return makeFilter("envoy.filters.network.tcp_proxy", cfg)
 }
@@ -1961,18 +2049,28 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 		return nil, err
 	}
 
+	logs, err := accesslogs.MakeAccessLogs(opts.accessLogs, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tracing, err := makeTracing(opts.tracing)
+	if err != nil {
+		return nil, err
+	}
+
+	// Any additional filters, such as an ingress listener's authentication
+	// filters, run in order just before the router.
+	httpFilters := make([]*envoy_http_v3.HttpFilter, 0, len(opts.httpFilters)+1)
+	httpFilters = append(httpFilters, opts.httpFilters...)
+	httpFilters = append(httpFilters, router)
+
 	cfg := &envoy_http_v3.HttpConnectionManager{
-		StatPrefix: makeStatPrefix(opts.statPrefix, opts.filterName),
-		CodecType:  envoy_http_v3.HttpConnectionManager_AUTO,
-		HttpFilters: []*envoy_http_v3.HttpFilter{
-			router,
-		},
-		Tracing: &envoy_http_v3.HttpConnectionManager_Tracing{
-			// Don't trace any requests by default unless the client application
-			// explicitly propagates trace headers that indicate this should be
-			// sampled.
-			RandomSampling: &envoy_type_v3.Percent{Value: 0.0},
-		},
+		StatPrefix:  makeStatPrefix(opts.statPrefix, opts.filterName),
+		CodecType:   envoy_http_v3.HttpConnectionManager_AUTO,
+		AccessLog:   logs,
+		HttpFilters: httpFilters,
+		Tracing:     tracing,
 	}
 
 	if opts.useRDS {
@@ -2,14 +2,23 @@ package xds
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_jwt_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoy_lua_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/hashicorp/consul/agent/proxycfg"
 	"github.com/hashicorp/consul/agent/structs"
@@ -25,6 +34,17 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 			return nil, fmt.Errorf("no listener config found for listener on proto/port %s/%d", listenerKey.Protocol, listenerKey.Port)
 		}
 
+		if listenerKey.Protocol == "tcp" && ingressUpstreamsHaveHosts(upstreams) {
+			l, err := s.makeIngressSNIPassthroughListener(cfgSnap, address, listenerKey, upstreams)
+			if err != nil {
+				return nil, err
+			}
+			if l != nil {
+				resources = append(resources, l)
+			}
+			continue
+		}
+
 		tlsContext, err := makeDownstreamTLSContextFromSnapshotListenerConfig(cfgSnap, listenerCfg)
 		if err != nil {
 			return nil, err
@@ -71,6 +91,8 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 				filterName:  filterName,
 				protocol:    cfg.Protocol,
 				tlsContext:  tlsContext,
+				accessLogs:  &cfgSnap.Proxy.AccessLogs,
+				tracing:     &cfgSnap.Proxy.Tracing,
 			})
 			if err != nil {
 				return nil, err
@@ -81,6 +103,16 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 			resources = append(resources, l)
 
 		} else {
+			authFilters, ok, err := makeIngressAuthFilters(cfgSnap, listenerKey, listenerCfg)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				// Wait until the external authorization service is known rather
+				// than serve requests that haven't been authorized.
+				continue
+			}
+
 			// If multiple upstreams share this port, make a special listener for the protocol.
 			listener := makePortListener(listenerKey.Protocol, address, listenerKey.Port, envoy_core_v3.TrafficDirection_OUTBOUND)
 			opts := listenerFilterOpts{
@@ -92,6 +124,9 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 				statPrefix:      "ingress_upstream_",
 				routePath:       "",
 				httpAuthzFilter: nil,
+				httpFilters:     authFilters,
+				accessLogs:      &cfgSnap.Proxy.AccessLogs,
+				tracing:         &cfgSnap.Proxy.Tracing,
 			}
 
 			// Generate any filter chains needed for services with custom TLS certs
@@ -140,6 +175,384 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 	return resources, nil
 }
 
+// ingressUpstreamsHaveHosts returns true if any of the upstreams on a listener
+// declares hosts.
+func ingressUpstreamsHaveHosts(upstreams structs.Upstreams) bool {
+	for _, u := range upstreams {
+		if len(u.IngressHosts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// makeIngressSNIPassthroughListener returns the listener for a TCP ingress
+// listener whose services declare hosts. The gateway doesn't terminate TLS on
+// these listeners: it inspects the SNI of each connection and proxies it as-is
+// to the service that declared a matching host. It returns nil if none of the
+// services' discovery chains are known yet.
+func (s *ResourceGenerator) makeIngressSNIPassthroughListener(
+	cfgSnap *proxycfg.ConfigSnapshot,
+	address string,
+	listenerKey proxycfg.IngressListenerKey,
+	upstreams structs.Upstreams,
+) (*envoy_listener_v3.Listener, error) {
+	l := makePortListenerWithDefault(listenerKey.Protocol, address, listenerKey.Port, envoy_core_v3.TrafficDirection_OUTBOUND)
+
+	for _, u := range upstreams {
+		uid := proxycfg.NewUpstreamID(&u)
+
+		chain := cfgSnap.IngressGateway.DiscoveryChain[uid]
+		if chain == nil {
+			// Wait until a chain is present in the snapshot.
+			continue
+		}
+
+		target, err := simpleChainTarget(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
+			clusterName: CustomizeClusterName(target.Name, chain),
+			filterName:  fmt.Sprintf("%s.%s.%s.%s", chain.ServiceName, chain.Namespace, chain.Partition, chain.Datacenter),
+			protocol:    "tcp",
+			accessLogs:  &cfgSnap.Proxy.AccessLogs,
+			tracing:     &cfgSnap.Proxy.Tracing,
+		})
+		if err != nil {
+			return nil, err
+		}
+		filterChain.FilterChainMatch = makeSNIFilterChainMatch(u.IngressHosts...)
+
+		l.FilterChains = append(l.FilterChains, filterChain)
+	}
+
+	if len(l.FilterChains) == 0 {
+		return nil, nil
+	}
+
+	tlsInspector, err := makeTLSInspectorListenerFilter()
+	if err != nil {
+		return nil, err
+	}
+	l.ListenerFilters = []*envoy_listener_v3.ListenerFilter{tlsInspector}
+
+	return l, nil
+}
+
+const jwtAuthnFilterName = "envoy.filters.http.jwt_authn"
+
+// makeIngressAuthFilters returns the HTTP filters that authenticate and
+// authorize requests on an ingress listener, in the order they must run. It
+// returns false if the listener uses an external authorization service whose
+// cluster isn't known yet, in which case the listener must not be served.
+func makeIngressAuthFilters(
+	cfgSnap *proxycfg.ConfigSnapshot,
+	listenerKey proxycfg.IngressListenerKey,
+	listenerCfg structs.IngressListener,
+) ([]*envoy_http_v3.HttpFilter, bool, error) {
+	var filters []*envoy_http_v3.HttpFilter
+
+	providers := ingressJWTProviders(listenerCfg)
+	if len(providers) > 0 {
+		jwtFilter, err := makeJWTAuthnFilter(listenerCfg, providers)
+		if err != nil {
+			return nil, false, err
+		}
+		filters = append(filters, jwtFilter)
+
+		claimsFilter, err := makeJWTClaimsToHeadersFilter(providers)
+		if err != nil {
+			return nil, false, err
+		}
+		if claimsFilter != nil {
+			filters = append(filters, claimsFilter)
+		}
+	}
+
+	if listenerCfg.ExtAuthz != nil {
+		u, ok := cfgSnap.IngressGateway.ExtAuthzUpstreams[listenerKey]
+		if !ok {
+			return nil, false, nil
+		}
+		uid := proxycfg.NewUpstreamID(&u)
+
+		chain := cfgSnap.IngressGateway.DiscoveryChain[uid]
+		if chain == nil {
+			return nil, false, nil
+		}
+		if _, ok := cfgSnap.IngressGateway.WatchedUpstreamEndpoints[uid]; !ok {
+			return nil, false, nil
+		}
+
+		target, err := simpleChainTarget(chain)
+		if err != nil {
+			return nil, false, fmt.Errorf("unsupported discovery chain for ext authz service %q (listener on port %d): %v",
+				u.DestinationID(), listenerKey.Port, err)
+		}
+
+		extAuthzFilter, err := makeExtAuthzFilter(listenerCfg.ExtAuthz, CustomizeClusterName(target.Name, chain))
+		if err != nil {
+			return nil, false, err
+		}
+		filters = append(filters, extAuthzFilter)
+	}
+
+	return filters, true, nil
+}
+
+// ingressJWTProviders returns every JWT provider declared on the listener or
+// any of its services, keyed by name. Validation guarantees that providers
+// sharing a name are identical.
+func ingressJWTProviders(l structs.IngressListener) map[string]structs.IngressJWTProvider {
+	providers := make(map[string]structs.IngressJWTProvider)
+	add := func(cfg *structs.IngressJWTConfig) {
+		if cfg == nil {
+			return
+		}
+		for _, p := range cfg.Providers {
+			providers[p.Name] = p
+		}
+	}
+
+	add(l.JWT)
+	for _, svc := range l.Services {
+		add(svc.JWT)
+	}
+	return providers
+}
+
+// jwtRequirementName names the requirement that a request carries a valid
+// token from any one of the config's providers. Configs listing the same
+// providers share a requirement.
+func jwtRequirementName(cfg *structs.IngressJWTConfig) string {
+	names := make([]string, 0, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func makeJWTRequirement(cfg *structs.IngressJWTConfig) *envoy_jwt_v3.JwtRequirement {
+	if len(cfg.Providers) == 1 {
+		return &envoy_jwt_v3.JwtRequirement{
+			RequiresType: &envoy_jwt_v3.JwtRequirement_ProviderName{ProviderName: cfg.Providers[0].Name},
+		}
+	}
+
+	var reqs []*envoy_jwt_v3.JwtRequirement
+	for _, p := range cfg.Providers {
+		reqs = append(reqs, &envoy_jwt_v3.JwtRequirement{
+			RequiresType: &envoy_jwt_v3.JwtRequirement_ProviderName{ProviderName: p.Name},
+		})
+	}
+	return &envoy_jwt_v3.JwtRequirement{
+		RequiresType: &envoy_jwt_v3.JwtRequirement_RequiresAny{
+			RequiresAny: &envoy_jwt_v3.JwtRequirementOrList{Requirements: reqs},
+		},
+	}
+}
+
+// makeJWTAuthnFilter builds the jwt_authn filter for a listener. Every
+// listener and service JWT config becomes a named requirement; the routes
+// select the one that applies to each service, falling back to the listener's
+// requirement via the filter's rules.
+func makeJWTAuthnFilter(l structs.IngressListener, providers map[string]structs.IngressJWTProvider) (*envoy_http_v3.HttpFilter, error) {
+	cfg := &envoy_jwt_v3.JwtAuthentication{
+		Providers:      make(map[string]*envoy_jwt_v3.JwtProvider, len(providers)),
+		RequirementMap: make(map[string]*envoy_jwt_v3.JwtRequirement),
+	}
+
+	for name, p := range providers {
+		provider, err := makeJWTProvider(p)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Providers[name] = provider
+	}
+
+	if l.JWT != nil {
+		name := jwtRequirementName(l.JWT)
+		cfg.RequirementMap[name] = makeJWTRequirement(l.JWT)
+		cfg.Rules = []*envoy_jwt_v3.RequirementRule{
+			{
+				Match: &envoy_route_v3.RouteMatch{
+					PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{Prefix: "/"},
+				},
+				RequirementType: &envoy_jwt_v3.RequirementRule_RequirementName{RequirementName: name},
+			},
+		}
+	}
+	for _, svc := range l.Services {
+		if svc.JWT != nil {
+			cfg.RequirementMap[jwtRequirementName(svc.JWT)] = makeJWTRequirement(svc.JWT)
+		}
+	}
+
+	return makeEnvoyHTTPFilter(jwtAuthnFilterName, cfg)
+}
+
+func makeJWTProvider(p structs.IngressJWTProvider) (*envoy_jwt_v3.JwtProvider, error) {
+	provider := &envoy_jwt_v3.JwtProvider{
+		Issuer:    p.Issuer,
+		Audiences: p.Audiences,
+		Forward:   p.ForwardToken,
+	}
+
+	// Claims are copied into headers from the verified payload by the filter
+	// built in makeJWTClaimsToHeadersFilter.
+	if len(p.ClaimsToHeaders) > 0 {
+		provider.PayloadInMetadata = p.Name
+	}
+
+	jwks := p.JSONWebKeySet
+	switch {
+	case jwks != nil && jwks.Local != nil:
+		source := &envoy_core_v3.DataSource{}
+		if jwks.Local.Filename != "" {
+			source.Specifier = &envoy_core_v3.DataSource_Filename{Filename: jwks.Local.Filename}
+		} else {
+			source.Specifier = &envoy_core_v3.DataSource_InlineString{InlineString: jwks.Local.JWKS}
+		}
+		provider.JwksSourceSpecifier = &envoy_jwt_v3.JwtProvider_LocalJwks{LocalJwks: source}
+
+	case jwks != nil && jwks.Remote != nil:
+		remote := &envoy_jwt_v3.RemoteJwks{
+			HttpUri: &envoy_core_v3.HttpUri{
+				Uri:              jwks.Remote.URI,
+				HttpUpstreamType: &envoy_core_v3.HttpUri_Cluster{Cluster: jwks.Remote.ClusterName},
+				Timeout:          &duration.Duration{Seconds: 5},
+			},
+		}
+		if jwks.Remote.CacheDuration > 0 {
+			remote.CacheDuration = durationpb.New(jwks.Remote.CacheDuration)
+		}
+		provider.JwksSourceSpecifier = &envoy_jwt_v3.JwtProvider_RemoteJwks{RemoteJwks: remote}
+
+	default:
+		// Shouldn't be possible with validation but be careful
+		return nil, fmt.Errorf("JWT provider %q has no JSON web key set", p.Name)
+	}
+
+	return provider, nil
+}
+
+// makeJWTClaimsToHeadersFilter returns a Lua filter which copies claims from
+// verified tokens into request headers. The jwt_authn filter in the supported
+// Envoy versions can't do this itself, but it can store each provider's
+// verified payload in dynamic metadata for this filter to read. Any values for
+// the headers sent by the client are always removed so they can't be spoofed.
+func makeJWTClaimsToHeadersFilter(providers map[string]structs.IngressJWTProvider) (*envoy_http_v3.HttpFilter, error) {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var claims strings.Builder
+	for _, name := range names {
+		for _, c := range providers[name].ClaimsToHeaders {
+			fmt.Fprintf(&claims, "  {provider = %s, claim = %s, header = %s},\n",
+				luaQuote(name), luaQuote(c.Claim), luaQuote(strings.ToLower(c.Header)))
+		}
+	}
+	if claims.Len() == 0 {
+		return nil, nil
+	}
+
+	code := fmt.Sprintf(jwtClaimsToHeadersLua, claims.String(), luaQuote(jwtAuthnFilterName))
+	return makeEnvoyHTTPFilter("envoy.filters.http.lua", &envoy_lua_v3.Lua{InlineCode: code})
+}
+
+const jwtClaimsToHeadersLua = `local claims = {
+%s}
+
+function envoy_on_request(request_handle)
+  local headers = request_handle:headers()
+  for _, c in ipairs(claims) do
+    headers:remove(c.header)
+  end
+
+  local payloads = request_handle:streamInfo():dynamicMetadata():get(%s)
+  if payloads == nil then
+    return
+  end
+  for _, c in ipairs(claims) do
+    local payload = payloads[c.provider]
+    if payload ~= nil then
+      local value = payload[c.claim]
+      if value ~= nil and type(value) ~= "table" then
+        headers:replace(c.header, tostring(value))
+      end
+    end
+  end
+end
+`
+
+// luaQuote returns s as a double-quoted Lua string literal, escaping anything
+// other than printable ASCII as a decimal byte escape.
+func luaQuote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c > 0x7e || c == '"' || c == '\\' {
+			fmt.Fprintf(&b, "\\%03d", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// makeExtAuthzFilter returns the ext_authz filter which asks the external
+// authorization service, reached through clusterName, to allow each request.
+func makeExtAuthzFilter(cfg *structs.IngressExtAuthzConfig, clusterName string) (*envoy_http_v3.HttpFilter, error) {
+	extAuthz := &envoy_ext_authz_v3.ExtAuthz{
+		TransportApiVersion: envoy_core_v3.ApiVersion_V3,
+		FailureModeAllow:    cfg.FailureModeAllow,
+		// Let the authorization service see the payloads of verified tokens.
+		MetadataContextNamespaces: []string{jwtAuthnFilterName},
+	}
+
+	var timeout *duration.Duration
+	if cfg.Timeout > 0 {
+		timeout = durationpb.New(cfg.Timeout)
+	}
+
+	switch cfg.Protocol {
+	case "http":
+		// Unlike the gRPC service the HTTP URI requires a timeout, so use
+		// Envoy's gRPC default when none is configured.
+		if timeout == nil {
+			timeout = durationpb.New(200 * time.Millisecond)
+		}
+		extAuthz.Services = &envoy_ext_authz_v3.ExtAuthz_HttpService{
+			HttpService: &envoy_ext_authz_v3.HttpService{
+				ServerUri: &envoy_core_v3.HttpUri{
+					Uri:              "http://" + cfg.Service,
+					HttpUpstreamType: &envoy_core_v3.HttpUri_Cluster{Cluster: clusterName},
+					Timeout:          timeout,
+				},
+			},
+		}
+	default:
+		extAuthz.Services = &envoy_ext_authz_v3.ExtAuthz_GrpcService{
+			GrpcService: &envoy_core_v3.GrpcService{
+				TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{ClusterName: clusterName},
+				},
+				Timeout: timeout,
+			},
+		}
+	}
+
+	return makeEnvoyHTTPFilter("envoy.filters.http.ext_authz", extAuthz)
+}
+
 func makeDownstreamTLSContextFromSnapshotListenerConfig(cfgSnap *proxycfg.ConfigSnapshot, listenerCfg structs.IngressListener) (*envoy_tls_v3.DownstreamTlsContext, error) {
 	var downstreamContext *envoy_tls_v3.DownstreamTlsContext
 
@@ -207,6 +207,74 @@ func TestListenersFromSnapshot(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "access-logs-defaults",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled: true,
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "access-logs-json-file",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled:             true,
+						DisableListenerLogs: true,
+						Type:                structs.FileLogSinkType,
+						Path:                "/tmp/envoy-access.log",
+						JSONFormat:          `{"custom_start_time": "%START_TIME%"}`,
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "access-logs-text-stderr",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled:    true,
+						Type:       structs.StdErrLogSinkType,
+						TextFormat: "MY START TIME %START_TIME%\n",
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "tracing-zipkin",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.Tracing = structs.TracingConfig{
+						Provider:           structs.ZipkinTracingProvider,
+						CollectorAddress:   "zipkin.example.com:9411",
+						SamplingPercentage: 12.5,
+						CustomTags: []structs.TracingCustomTag{
+							{Tag: "team", Literal: "payments"},
+							{Tag: "request-id", RequestHeader: "x-request-id"},
+							{Tag: "pod", Environment: "POD_NAME"},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "tracing-opentelemetry",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.Tracing = structs.TracingConfig{
+						Provider:           structs.OpenTelemetryTracingProvider,
+						CollectorAddress:   "otel-collector:4317",
+						SamplingPercentage: 100,
+					}
+				}, nil)
+			},
+		},
 		{
 			name: "custom-public-listener",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -446,6 +514,16 @@ func TestListenersFromSnapshot(t *testing.T) {
 				return proxycfg.TestConfigSnapshotMeshGateway(t, "default", nil, nil)
 			},
 		},
+		{
+			name: "mesh-gateway-with-access-logs",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotMeshGateway(t, "default", func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled: true,
+					}
+				}, nil)
+			},
+		},
 		{
 			name: "mesh-gateway-using-federation-states",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -498,6 +576,16 @@ func TestListenersFromSnapshot(t *testing.T) {
 				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "tcp", "default", nil, nil, nil)
 			},
 		},
+		{
+			name: "ingress-gateway-with-access-logs",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "tcp", "default", func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled: true,
+					}
+				}, nil, nil)
+			},
+		},
 		{
 			name: "ingress-gateway-bind-addrs",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -658,6 +746,14 @@ func TestListenersFromSnapshot(t *testing.T) {
 			name:   "ingress-grpc-multiple-services",
 			create: proxycfg.TestConfigSnapshotIngress_GRPCMultipleServices,
 		},
+		{
+			name:   "ingress-with-jwt-and-ext-authz",
+			create: proxycfg.TestConfigSnapshotIngress_JWTAndExtAuthz,
+		},
+		{
+			name:   "ingress-tcp-sni-passthrough",
+			create: proxycfg.TestConfigSnapshotIngressGateway_TCPSNIPassthrough,
+		},
 		{
 			name: "terminating-gateway-no-api-cert",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -9,9 +9,12 @@ import (
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_jwt_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
 	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/hashicorp/consul/agent/connect"
@@ -296,6 +299,10 @@ func (s *ResourceGenerator) routesForIngressGateway(cfgSnap *proxycfg.ConfigSnap
 				return nil, err
 			}
 
+			if err := injectJWTRequirementToVirtualHost(lCfg, svc, virtualHost); err != nil {
+				return nil, err
+			}
+
 			// See if this upstream has its own route/filter chain
 			svcRouteName := routeNameForUpstream(lCfg, *svc)
 
@@ -852,6 +859,40 @@ func injectHeaderManipToRoute(dest *structs.ServiceRouteDestination, r *envoy_ro
 	return nil
 }
 
+// injectJWTRequirementToVirtualHost selects which JWT providers the
+// listener's jwt_authn filter verifies for requests to dest. Services without
+// JWT config of their own inherit the listener's, and if neither has any the
+// filter is disabled for the service.
+func injectJWTRequirementToVirtualHost(l structs.IngressListener, dest *structs.IngressService, vh *envoy_route_v3.VirtualHost) error {
+	if len(ingressJWTProviders(l)) == 0 {
+		return nil
+	}
+
+	jwt := l.JWT
+	if dest.JWT != nil {
+		jwt = dest.JWT
+	}
+
+	cfg := &envoy_jwt_v3.PerRouteConfig{
+		RequirementSpecifier: &envoy_jwt_v3.PerRouteConfig_Disabled{Disabled: true},
+	}
+	if jwt != nil {
+		cfg.RequirementSpecifier = &envoy_jwt_v3.PerRouteConfig_RequirementName{
+			RequirementName: jwtRequirementName(jwt),
+		}
+	}
+
+	any, err := ptypes.MarshalAny(cfg)
+	if err != nil {
+		return err
+	}
+	if vh.TypedPerFilterConfig == nil {
+		vh.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	vh.TypedPerFilterConfig[jwtAuthnFilterName] = any
+	return nil
+}
+
 func injectHeaderManipToVirtualHost(dest *structs.IngressService, vh *envoy_route_v3.VirtualHost) error {
 	if !dest.RequestHeaders.IsZero() {
 		vh.RequestHeadersToAdd = append(
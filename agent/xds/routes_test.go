@@ -146,6 +146,10 @@ func TestRoutesFromSnapshot(t *testing.T) {
 			name:   "ingress-grpc-multiple-services",
 			create: proxycfg.TestConfigSnapshotIngress_GRPCMultipleServices,
 		},
+		{
+			name:   "ingress-with-jwt-and-ext-authz",
+			create: proxycfg.TestConfigSnapshotIngress_JWTAndExtAuthz,
+		},
 		{
 			name: "ingress-with-chain-and-router-header-manip",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
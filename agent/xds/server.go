@@ -74,6 +74,11 @@ const (
 	// proxy mode.
 	OriginalDestinationClusterName = "original-destination"
 
+	// LocalPeeringServersClusterName is the name we give to the cluster of a
+	// mesh gateway which routes the control plane traffic of accepted peerings
+	// to the gRPC port of the local servers.
+	LocalPeeringServersClusterName = "local-peering-servers"
+
 	// DefaultAuthCheckFrequency is the default value for
 	// Server.AuthCheckFrequency to use when the zero value is provided.
 	DefaultAuthCheckFrequency = 5 * time.Minute
@@ -0,0 +1,122 @@
+package xds
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tracing_v3 "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// tracingCollectorClusterName is the cluster that spans are sent to when
+	// the tracing provider of a proxy is delivered over xDS.
+	tracingCollectorClusterName = "tracing_collector"
+
+	defaultZipkinCollectorPath = "/api/v2/spans"
+)
+
+// makeTracing returns the tracing settings of an HTTP connection manager.
+//
+// Only the Zipkin provider is configured here. OpenTelemetry collectors are
+// part of the bootstrap configuration generated by `consul connect envoy`,
+// and Envoy falls back to that tracer when the connection manager doesn't
+// name one, so sampling and custom tags still apply to them.
+func makeTracing(cfg *structs.TracingConfig) (*envoy_http_v3.HttpConnectionManager_Tracing, error) {
+	tracing := &envoy_http_v3.HttpConnectionManager_Tracing{
+		// Don't trace any requests by default unless the client application
+		// explicitly propagates trace headers that indicate this should be
+		// sampled.
+		RandomSampling: &envoy_type_v3.Percent{Value: 0.0},
+	}
+	if cfg == nil {
+		return tracing, nil
+	}
+
+	tracing.RandomSampling.Value = cfg.SamplingPercentage
+
+	for _, tag := range cfg.CustomTags {
+		customTag := &envoy_tracing_v3.CustomTag{Tag: tag.Tag}
+		switch {
+		case tag.Literal != "":
+			customTag.Type = &envoy_tracing_v3.CustomTag_Literal_{
+				Literal: &envoy_tracing_v3.CustomTag_Literal{Value: tag.Literal},
+			}
+		case tag.RequestHeader != "":
+			customTag.Type = &envoy_tracing_v3.CustomTag_RequestHeader{
+				RequestHeader: &envoy_tracing_v3.CustomTag_Header{Name: tag.RequestHeader},
+			}
+		case tag.Environment != "":
+			customTag.Type = &envoy_tracing_v3.CustomTag_Environment_{
+				Environment: &envoy_tracing_v3.CustomTag_Environment{Name: tag.Environment},
+			}
+		default:
+			return nil, fmt.Errorf("custom tag %q has no value", tag.Tag)
+		}
+		tracing.CustomTags = append(tracing.CustomTags, customTag)
+	}
+
+	if cfg.Provider == structs.ZipkinTracingProvider {
+		path := cfg.CollectorPath
+		if path == "" {
+			path = defaultZipkinCollectorPath
+		}
+		zipkin, err := anypb.New(&envoy_trace_v3.ZipkinConfig{
+			CollectorCluster:         tracingCollectorClusterName,
+			CollectorEndpoint:        path,
+			CollectorEndpointVersion: envoy_trace_v3.ZipkinConfig_HTTP_JSON,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tracing.Provider = &envoy_trace_v3.Tracing_Http{
+			Name:       "envoy.tracers.zipkin",
+			ConfigType: &envoy_trace_v3.Tracing_Http_TypedConfig{TypedConfig: zipkin},
+		}
+	}
+
+	return tracing, nil
+}
+
+// makeTracingCollectorCluster returns the cluster of the collector used by
+// makeTracing, or nil when the proxy doesn't report spans over xDS.
+func makeTracingCollectorCluster(cfg *structs.TracingConfig) (*envoy_cluster_v3.Cluster, error) {
+	if cfg == nil || cfg.Provider != structs.ZipkinTracingProvider {
+		return nil, nil
+	}
+
+	host, portStr, err := net.SplitHostPort(cfg.CollectorAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracing collector address %q: %v", cfg.CollectorAddress, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracing collector address %q: %v", cfg.CollectorAddress, err)
+	}
+
+	return &envoy_cluster_v3.Cluster{
+		Name:                 tracingCollectorClusterName,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &envoy_cluster_v3.Cluster_Type{Type: envoy_cluster_v3.Cluster_LOGICAL_DNS},
+		DnsLookupFamily:      envoy_cluster_v3.Cluster_AUTO,
+		DnsRefreshRate:       durationpb.New(10 * time.Second),
+		LoadAssignment: &envoy_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: tracingCollectorClusterName,
+			Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{
+					makeEndpoint(host, port),
+				},
+			}},
+		},
+	}, nil
+}
@@ -42,6 +42,7 @@ type ACLToken struct {
 	AuthMethod        string        `json:",omitempty"`
 	ExpirationTTL     time.Duration `json:",omitempty"`
 	ExpirationTime    *time.Time    `json:",omitempty"`
+	BoundCIDRs        []string      `json:",omitempty"`
 	CreateTime        time.Time     `json:",omitempty"`
 	Hash              []byte        `json:",omitempty"`
 
@@ -89,6 +90,7 @@ type ACLTokenListEntry struct {
 	Local             bool
 	AuthMethod        string     `json:",omitempty"`
 	ExpirationTime    *time.Time `json:",omitempty"`
+	BoundCIDRs        []string   `json:",omitempty"`
 	CreateTime        time.Time
 	Hash              []byte
 	Legacy            bool
@@ -225,6 +227,7 @@ type ACLBindingRule struct {
 	Selector    string
 	BindType    BindingRuleBindType
 	BindName    string
+	BoundCIDRs  []string `json:",omitempty"`
 
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -482,6 +485,31 @@ type ACLLoginParams struct {
 	Meta        map[string]string `json:",omitempty"`
 }
 
+// ACLLoginValidation describes the outcome of checking a bearer token against
+// an auth method without logging in.
+type ACLLoginValidation struct {
+	// Valid is true if logging in with the bearer token would succeed.
+	Valid bool
+
+	// Error explains why the bearer token was rejected.
+	Error string `json:",omitempty"`
+
+	// ProjectedVars are the variables extracted from the verified identity
+	// which are available for interpolation into binding rule bind names.
+	ProjectedVars map[string]string `json:",omitempty"`
+
+	// Roles, ServiceIdentities and NodeIdentities are what a token created by
+	// logging in with the bearer token would be linked to.
+	Roles             []*ACLTokenRoleLink   `json:",omitempty"`
+	ServiceIdentities []*ACLServiceIdentity `json:",omitempty"`
+	NodeIdentities    []*ACLNodeIdentity    `json:",omitempty"`
+
+	// JWTHeader and JWTClaims are the unverified contents of the bearer token
+	// when it is a JWT.
+	JWTHeader map[string]interface{} `json:",omitempty"`
+	JWTClaims map[string]interface{} `json:",omitempty"`
+}
+
 type ACLOIDCAuthURLParams struct {
 	AuthMethod  string
 	RedirectURI string
@@ -519,6 +547,37 @@ func (a *ACL) Bootstrap() (*ACLToken, *WriteMeta, error) {
 	return &out, wm, nil
 }
 
+// BootstrapRotate issues a new management token to replace the token with
+// the given accessor ID, or the token used to make the request when empty.
+// The replaced token is not deleted but expires after expirationTTL, or a
+// server chosen default when zero.
+func (a *ACL) BootstrapRotate(accessorID string, expirationTTL time.Duration, q *WriteOptions) (*ACLToken, *WriteMeta, error) {
+	r := a.c.newRequest("PUT", "/v1/acl/bootstrap/rotate")
+	r.setWriteOptions(q)
+	body := struct {
+		AccessorID    string `json:",omitempty"`
+		ExpirationTTL string `json:",omitempty"`
+	}{AccessorID: accessorID}
+	if expirationTTL > 0 {
+		body.ExpirationTTL = expirationTTL.String()
+	}
+	r.obj = body
+	rtt, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+	wm := &WriteMeta{RequestTime: rtt}
+	var out ACLToken
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
+
 // Create is used to generate a new token with the given parameters
 //
 // Deprecated: Use TokenCreate instead.
@@ -1520,6 +1579,29 @@ func (a *ACL) Login(auth *ACLLoginParams, q *WriteOptions) (*ACLToken, *WriteMet
 	return &out, wm, nil
 }
 
+// LoginValidate checks a bearer token against an auth method without creating
+// a token, to explain why logging in with it would be rejected.
+func (a *ACL) LoginValidate(auth *ACLLoginParams, q *WriteOptions) (*ACLLoginValidation, *WriteMeta, error) {
+	r := a.c.newRequest("POST", "/v1/acl/login/validate")
+	r.setWriteOptions(q)
+	r.obj = auth
+
+	rtt, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+	wm := &WriteMeta{RequestTime: rtt}
+	var out ACLLoginValidation
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
+
 // Logout is used to destroy a Consul Token created via Login().
 func (a *ACL) Logout(q *WriteOptions) (*WriteMeta, error) {
 	r := a.c.newRequest("POST", "/v1/acl/logout")
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 // ServiceKind is the kind of service being registered.
@@ -129,6 +130,9 @@ type AgentServiceConnectProxyConfig struct {
 	Upstreams              []Upstream              `json:",omitempty"`
 	MeshGateway            MeshGatewayConfig       `json:",omitempty"`
 	Expose                 ExposeConfig            `json:",omitempty"`
+	AccessLogs             *AccessLogsConfig       `json:",omitempty"`
+	Tracing                *TracingConfig          `json:",omitempty"`
+	Metrics                *MetricsConfig          `json:",omitempty"`
 }
 
 const (
@@ -404,6 +408,19 @@ type AgentAuthorizeParams struct {
 	Target           string
 	ClientCertURI    string
 	ClientCertSerial string
+
+	// HTTP is the request being authorized, for proxies of services with an
+	// HTTP-based protocol. L7 intentions are evaluated against it; without
+	// it, they always deny.
+	HTTP *AgentAuthorizeHTTPRequest `json:",omitempty"`
+}
+
+// AgentAuthorizeHTTPRequest holds the attributes of an HTTP request that L7
+// intentions can match on.
+type AgentAuthorizeHTTPRequest struct {
+	Method string
+	Path   string
+	Header map[string][]string `json:",omitempty"`
 }
 
 // AgentAuthorize is the response structure for Connect authorization.
@@ -1131,6 +1148,36 @@ func (a *Agent) ConnectCALeaf(serviceID string, q *QueryOptions) (*LeafCert, *Qu
 	return &out, qm, nil
 }
 
+// ConnectCAJWT gets a JWT-SVID for the given service name, valid for the
+// given audiences. If ttl is zero the server's default lifetime is used.
+func (a *Agent) ConnectCAJWT(service string, audience []string, ttl time.Duration, q *QueryOptions) (*JWTSVID, *QueryMeta, error) {
+	r := a.c.newRequest("GET", "/v1/agent/connect/ca/jwt/"+service)
+	r.setQueryOptions(q)
+	for _, aud := range audience {
+		r.params.Add("aud", aud)
+	}
+	if ttl != 0 {
+		r.params.Set("ttl", ttl.String())
+	}
+	rtt, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out JWTSVID
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
+
 // EnableServiceMaintenance toggles service maintenance mode on
 // for the given service ID.
 func (a *Agent) EnableServiceMaintenance(serviceID, reason string) error {
@@ -1672,6 +1672,29 @@ func TestAPI_AgentConnectCALeaf(t *testing.T) {
 	require.True(t, leaf.ValidBefore.After(time.Now()))
 }
 
+func TestAPI_AgentConnectCAJWT(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForActiveCARoot(t)
+
+	agent := c.Agent()
+	jwt, _, err := agent.ConnectCAJWT("foo", []string{"db"}, time.Minute, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, jwt.Token)
+	require.Equal(t, "foo", jwt.Service)
+	require.True(t, strings.HasSuffix(jwt.ServiceURI, "/svc/foo"))
+	require.Equal(t, []string{"db"}, jwt.Audience)
+	require.True(t, jwt.ExpiresAt.After(time.Now()))
+	require.True(t, jwt.ExpiresAt.Before(time.Now().Add(2*time.Minute)))
+
+	_, _, err = agent.ConnectCAJWT("foo", nil, 0, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Missing audience")
+}
+
 func TestAPI_AgentConnectAuthorize(t *testing.T) {
 	t.Parallel()
 	c, s := makeClient(t)
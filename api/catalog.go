@@ -108,6 +108,9 @@ type GatewayService struct {
 	KeyFile      string   `json:",omitempty"`
 	SNI          string   `json:",omitempty"`
 	FromWildcard bool     `json:",omitempty"`
+
+	// DNS is the DNS config of a service linked to a terminating gateway.
+	DNS *LinkedServiceDNSConfig `json:",omitempty"`
 }
 
 // Catalog can be used to query the Catalog endpoints
@@ -23,6 +23,9 @@ const (
 	ServiceIntentions  string = "service-intentions"
 	MeshConfig         string = "mesh"
 	ExportedServices   string = "exported-services"
+	ServiceRollout     string = "service-rollout"
+	ImportedServices   string = "imported-services"
+	SamenessGroup      string = "sameness-group"
 
 	ProxyConfigGlobal string = "global"
 	MeshConfigMesh    string = "mesh"
@@ -93,6 +96,96 @@ type TransparentProxyConfig struct {
 	DialedDirectly bool `json:",omitempty" alias:"dialed_directly"`
 }
 
+type LogSinkType string
+
+const (
+	DefaultLogSinkType LogSinkType = ""
+	FileLogSinkType    LogSinkType = "file"
+	StdErrLogSinkType  LogSinkType = "stderr"
+	StdOutLogSinkType  LogSinkType = "stdout"
+)
+
+// AccessLogsConfig describes the access logs written by Envoy proxies.
+type AccessLogsConfig struct {
+	// Enabled turns on access logging for every listener of the proxy.
+	Enabled bool `json:",omitempty"`
+
+	// DisableListenerLogs turns off the logs recording connections that were
+	// rejected by a listener because no filter chain matched them.
+	DisableListenerLogs bool `json:",omitempty" alias:"disable_listener_logs"`
+
+	// Type selects where the logs are written: "file", "stdout" or "stderr".
+	Type LogSinkType `json:",omitempty"`
+
+	// Path is the file the logs are written to when Type is "file".
+	Path string `json:",omitempty"`
+
+	// JSONFormat is a JSON object whose values are Envoy format strings.
+	JSONFormat string `json:",omitempty" alias:"json_format"`
+
+	// TextFormat is an Envoy format string used to render each log line.
+	TextFormat string `json:",omitempty" alias:"text_format"`
+}
+
+type TracingProvider string
+
+const (
+	ZipkinTracingProvider        TracingProvider = "zipkin"
+	OpenTelemetryTracingProvider TracingProvider = "opentelemetry"
+)
+
+// TracingConfig describes how Envoy proxies report traces.
+type TracingConfig struct {
+	// Provider selects the kind of collector: "zipkin" or "opentelemetry".
+	Provider TracingProvider `json:",omitempty"`
+
+	// CollectorAddress is the <host>:<port> of the collector.
+	CollectorAddress string `json:",omitempty" alias:"collector_address"`
+
+	// CollectorPath is the HTTP path spans are posted to by the "zipkin"
+	// provider.
+	CollectorPath string `json:",omitempty" alias:"collector_path"`
+
+	// SamplingPercentage is the percentage of requests, between 0 and 100,
+	// that are traced.
+	SamplingPercentage float64 `json:",omitempty" alias:"sampling_percentage"`
+
+	// CustomTags are added to every span reported by the proxy.
+	CustomTags []TracingCustomTag `json:",omitempty" alias:"custom_tags"`
+}
+
+// TracingCustomTag is a tag added to the spans reported by a proxy. Exactly
+// one of Literal, RequestHeader or Environment provides its value.
+type TracingCustomTag struct {
+	Tag           string
+	Literal       string `json:",omitempty"`
+	RequestHeader string `json:",omitempty" alias:"request_header"`
+	Environment   string `json:",omitempty"`
+}
+
+// MetricsConfig describes the metrics sinks and endpoints of Envoy proxies.
+type MetricsConfig struct {
+	// StatsdURL is the udp://<host>:<port> or unix://<path> of a statsd sink.
+	StatsdURL string `json:",omitempty" alias:"statsd_url"`
+
+	// DogstatsdURL is the udp://<host>:<port> or unix://<path> of a
+	// dogstatsd sink.
+	DogstatsdURL string `json:",omitempty" alias:"dogstatsd_url"`
+
+	// PrometheusBindAddr is the <ip>:<port> the Prometheus scrape endpoint
+	// is served on.
+	PrometheusBindAddr string `json:",omitempty" alias:"prometheus_bind_addr"`
+
+	// StatsBindAddr is the <ip>:<port> the /stats endpoint is served on.
+	StatsBindAddr string `json:",omitempty" alias:"stats_bind_addr"`
+
+	// StatsTags are "<name>=<value>" tags added to every metric.
+	StatsTags []string `json:",omitempty" alias:"stats_tags"`
+
+	// StatsFlushInterval is how often stats are flushed to the sinks.
+	StatsFlushInterval string `json:",omitempty" alias:"stats_flush_interval"`
+}
+
 // ExposeConfig describes HTTP paths to expose through Envoy outside of Connect.
 // Users can expose individual paths and/or all HTTP/GRPC paths for checks.
 type ExposeConfig struct {
@@ -196,6 +289,10 @@ type PassiveHealthCheck struct {
 	// MaxFailures is the count of consecutive failures that results in a host
 	// being removed from the pool.
 	MaxFailures uint32 `alias:"max_failures"`
+
+	// ReportEjections reflects the hosts ejected by the proxy as a warning
+	// health check on the matching service instance in the catalog.
+	ReportEjections bool `json:",omitempty" alias:"report_ejections"`
 }
 
 // UpstreamLimits describes the limits that are associated with a specific
@@ -230,6 +327,7 @@ type ServiceConfigEntry struct {
 	ExternalSNI      string                  `json:",omitempty" alias:"external_sni"`
 	UpstreamConfig   *UpstreamConfiguration  `json:",omitempty" alias:"upstream_config"`
 	Destination      *DestinationConfig      `json:",omitempty"`
+	AccessLogs       *AccessLogsConfig       `json:",omitempty" alias:"access_logs"`
 	Meta             map[string]string       `json:",omitempty"`
 	CreateIndex      uint64
 	ModifyIndex      uint64
@@ -253,6 +351,9 @@ type ProxyConfigEntry struct {
 	Config           map[string]interface{}  `json:",omitempty"`
 	MeshGateway      MeshGatewayConfig       `json:",omitempty" alias:"mesh_gateway"`
 	Expose           ExposeConfig            `json:",omitempty"`
+	AccessLogs       *AccessLogsConfig       `json:",omitempty" alias:"access_logs"`
+	Tracing          *TracingConfig          `json:",omitempty"`
+	Metrics          *MetricsConfig          `json:",omitempty"`
 
 	Meta        map[string]string `json:",omitempty"`
 	CreateIndex uint64
@@ -289,6 +390,12 @@ func makeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &MeshConfigEntry{}, nil
 	case ExportedServices:
 		return &ExportedServicesConfigEntry{Name: name}, nil
+	case ServiceRollout:
+		return &ServiceRolloutConfigEntry{Kind: kind, Name: name}, nil
+	case ImportedServices:
+		return &ImportedServicesConfigEntry{Name: name}, nil
+	case SamenessGroup:
+		return &SamenessGroupConfigEntry{Name: name}, nil
 	default:
 		return nil, fmt.Errorf("invalid config entry kind: %s", kind)
 	}
@@ -159,6 +159,10 @@ type ServiceResolverConfigEntry struct {
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
 
+	// PrioritizeByLocality controls whether instances of this service closest
+	// to the calling proxy are preferred.
+	PrioritizeByLocality *ServiceResolverPrioritizeByLocality `json:",omitempty" alias:"prioritize_by_locality"`
+
 	Meta        map[string]string `json:",omitempty"`
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -227,6 +231,17 @@ type ServiceResolverFailover struct {
 	// Referencing other partitions is not supported.
 	Namespace   string   `json:",omitempty"`
 	Datacenters []string `json:",omitempty"`
+
+	// SamenessGroup fails over to the members of the named sameness group.
+	// It cannot be combined with the other fields.
+	SamenessGroup string `json:",omitempty" alias:"sameness_group"`
+}
+
+// ServiceResolverPrioritizeByLocality controls whether a service's instances
+// are prioritized by their proximity to the calling proxy.
+type ServiceResolverPrioritizeByLocality struct {
+	// Mode is either "none" or "failover".
+	Mode string `json:",omitempty"`
 }
 
 // LoadBalancer determines the load balancing policy and configuration for services
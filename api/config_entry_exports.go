@@ -52,6 +52,11 @@ type ServiceConsumer struct {
 
 	// PeerName is the name of the peer to export the service to.
 	PeerName string `json:",omitempty" alias:"peer_name"`
+
+	// Filter is a bexpr filter expression that limits the instances of the
+	// service replicated to the catalog of the peer. Mesh traffic from the
+	// peer is not limited by it. Filter can only be set for peers.
+	Filter string `json:",omitempty"`
 }
 
 func (e *ExportedServicesConfigEntry) GetKind() string            { return ExportedServices }
@@ -52,6 +52,7 @@ func TestAPI_ConfigEntries_ExportedServices(t *testing.T) {
 					Consumers: []ServiceConsumer{
 						{
 							PeerName: "alpha",
+							Filter:   `Service.Meta.env == "prod"`,
 						},
 					},
 				},
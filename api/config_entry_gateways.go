@@ -1,5 +1,10 @@
 package api
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // IngressGatewayConfigEntry manages the configuration for an ingress service
 // with the given name.
 type IngressGatewayConfigEntry struct {
@@ -76,12 +81,23 @@ type IngressListener struct {
 	// Services declares the set of services to which the listener forwards
 	// traffic.
 	//
-	// For "tcp" protocol listeners, only a single service is allowed.
+	// For "tcp" protocol listeners, only a single service is allowed unless
+	// every service declares Hosts, in which case connections are passed
+	// through to the service matching their TLS SNI.
 	// For "http" listeners, multiple services can be declared.
 	Services []IngressService
 
 	// TLS allows specifying some TLS configuration per listener.
 	TLS *GatewayTLSConfig `json:",omitempty"`
+
+	// JWT configures the JSON Web Token providers used to authenticate requests
+	// to every service on this listener. Services may override it with their
+	// own JWT config. Only allowed on layer 7 protocols.
+	JWT *IngressJWTConfig `json:",omitempty"`
+
+	// ExtAuthz configures an external authorization service which must allow
+	// each request before it is forwarded. Only allowed on layer 7 protocols.
+	ExtAuthz *IngressExtAuthzConfig `json:",omitempty" alias:"ext_authz"`
 }
 
 // IngressService manages configuration for services that are exposed to
@@ -98,16 +114,19 @@ type IngressService struct {
 	Name string
 
 	// Hosts is a list of hostnames which should be associated to this service on
-	// the defined listener. Only allowed on layer 7 protocols, this will be used
-	// to route traffic to the service by matching the Host header of the HTTP
-	// request.
+	// the defined listener. On layer 7 protocols, this will be used to route
+	// traffic to the service by matching the Host header of the HTTP request.
+	//
+	// On "tcp" listeners, this will be used to route TLS connections to the
+	// service by matching the SNI of the client's handshake. The gateway does
+	// not terminate these connections, so TLS must not be enabled for the
+	// listener.
 	//
 	// If a host is provided for a service that also has a wildcard specifier
 	// defined, the host will override the wildcard-specifier-provided
 	// "<service-name>.*" domain for that listener.
 	//
-	// This cannot be specified when using the wildcard specifier, "*", or when
-	// using a "tcp" listener.
+	// This cannot be specified when using the wildcard specifier, "*".
 	Hosts []string
 
 	// Namespace is the namespace where the service is located.
@@ -124,6 +143,175 @@ type IngressService struct {
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// JWT overrides the listener's JWT configuration for this service. Only
+	// allowed on layer 7 protocols.
+	JWT *IngressJWTConfig `json:",omitempty"`
+}
+
+// IngressJWTConfig requires requests to carry a JSON Web Token that is valid
+// according to at least one of the listed providers.
+type IngressJWTConfig struct {
+	Providers []IngressJWTProvider
+}
+
+type IngressJWTProvider struct {
+	// Name identifies the provider. Providers with the same name on a listener
+	// and its services must be identical.
+	Name string
+
+	// Issuer is the expected value of the token's "iss" claim. If empty the
+	// issuer is not checked.
+	Issuer string `json:",omitempty"`
+
+	// Audiences restricts the accepted values of the token's "aud" claim. If
+	// empty the audience is not checked.
+	Audiences []string `json:",omitempty"`
+
+	// JSONWebKeySet is where to find the keys used to verify token signatures.
+	JSONWebKeySet *IngressJWKS `json:",omitempty" alias:"json_web_key_set"`
+
+	// ForwardToken keeps the token on the request forwarded to the service. By
+	// default it is removed once verified.
+	ForwardToken bool `json:",omitempty" alias:"forward_token"`
+
+	// ClaimsToHeaders copies claims from a verified token into request headers.
+	ClaimsToHeaders []IngressJWTClaimToHeader `json:",omitempty" alias:"claims_to_headers"`
+}
+
+// IngressJWKS holds exactly one of a local or remote JSON Web Key Set.
+type IngressJWKS struct {
+	Local  *IngressLocalJWKS  `json:",omitempty"`
+	Remote *IngressRemoteJWKS `json:",omitempty"`
+}
+
+type IngressLocalJWKS struct {
+	// JWKS is an inline JSON Web Key Set.
+	JWKS string `json:",omitempty"`
+
+	// Filename is the path to a file containing a JSON Web Key Set, read by the
+	// gateway's Envoy process.
+	Filename string `json:",omitempty"`
+}
+
+type IngressRemoteJWKS struct {
+	// URI is the HTTP(S) endpoint serving the JSON Web Key Set.
+	URI string
+
+	// ClusterName is the name of an Envoy cluster, defined in the gateway's
+	// bootstrap config, used to reach URI.
+	ClusterName string `alias:"cluster_name"`
+
+	// CacheDuration is how long fetched keys are cached. Envoy defaults to
+	// five minutes.
+	CacheDuration time.Duration `json:",omitempty" alias:"cache_duration"`
+}
+
+func (r *IngressRemoteJWKS) MarshalJSON() ([]byte, error) {
+	type Alias IngressRemoteJWKS
+	exported := &struct {
+		CacheDuration string `json:",omitempty"`
+		*Alias
+	}{
+		CacheDuration: r.CacheDuration.String(),
+		Alias:         (*Alias)(r),
+	}
+	if r.CacheDuration == 0 {
+		exported.CacheDuration = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (r *IngressRemoteJWKS) UnmarshalJSON(data []byte) error {
+	type Alias IngressRemoteJWKS
+	aux := &struct {
+		CacheDuration string
+		*Alias
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.CacheDuration != "" {
+		if r.CacheDuration, err = time.ParseDuration(aux.CacheDuration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type IngressJWTClaimToHeader struct {
+	// Claim is the name of a top-level claim in the token.
+	Claim string
+
+	// Header is the request header the claim value is written to.
+	Header string
+}
+
+// IngressExtAuthzConfig delegates authorization of each request to a Consul
+// service implementing Envoy's external authorization API.
+type IngressExtAuthzConfig struct {
+	// Service is the name of the authorization service. The gateway connects to
+	// it over mTLS like any other upstream.
+	Service string
+
+	// Namespace is the namespace where the authorization service is located.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+
+	// Partition is the partition where the authorization service is located.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Protocol is the API the authorization service implements, either "grpc"
+	// (the default) or "http".
+	Protocol string `json:",omitempty"`
+
+	// Timeout bounds each authorization check. Envoy defaults to 200ms.
+	Timeout time.Duration `json:",omitempty"`
+
+	// FailureModeAllow lets requests through when the authorization service
+	// cannot be reached or returns an error.
+	FailureModeAllow bool `json:",omitempty" alias:"failure_mode_allow"`
+}
+
+func (c *IngressExtAuthzConfig) MarshalJSON() ([]byte, error) {
+	type Alias IngressExtAuthzConfig
+	exported := &struct {
+		Timeout string `json:",omitempty"`
+		*Alias
+	}{
+		Timeout: c.Timeout.String(),
+		Alias:   (*Alias)(c),
+	}
+	if c.Timeout == 0 {
+		exported.Timeout = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (c *IngressExtAuthzConfig) UnmarshalJSON(data []byte) error {
+	type Alias IngressExtAuthzConfig
+	aux := &struct {
+		Timeout string
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.Timeout != "" {
+		if c.Timeout, err = time.ParseDuration(aux.Timeout); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (i *IngressGatewayConfigEntry) GetKind() string            { return i.Kind }
@@ -191,6 +379,69 @@ type LinkedService struct {
 
 	// SNI is the optional name to specify during the TLS handshake with a linked service.
 	SNI string `json:",omitempty"`
+
+	// DNS configures how the gateway resolves instances of the linked service
+	// that are registered with a hostname rather than an IP address.
+	DNS *LinkedServiceDNSConfig `json:",omitempty"`
+}
+
+// LinkedServiceDNSConfig configures the DNS resolution of hostname addressed
+// instances of a service linked to a terminating gateway.
+type LinkedServiceDNSConfig struct {
+	// DiscoveryType is the Envoy service discovery type used to resolve the
+	// hostname: "logical_dns" connects to a single resolved address at a time
+	// while "strict_dns" load balances across every resolved address. Defaults
+	// to the gateway's envoy_dns_discovery_type proxy config.
+	DiscoveryType string `json:",omitempty" alias:"discovery_type"`
+
+	// RefreshRate is the interval at which the hostname is resolved again.
+	// Defaults to 10s.
+	RefreshRate time.Duration `json:",omitempty" alias:"refresh_rate"`
+
+	// RespectTTL resolves the hostname again when the TTL of its DNS records
+	// expires, rather than at RefreshRate.
+	RespectTTL bool `json:",omitempty" alias:"respect_ttl"`
+
+	// LookupFamily is the IP address family to resolve the hostname to. One of
+	// "v4_only", "v6_only", "v4_preferred", "auto" or "all". Defaults to
+	// "v4_only".
+	LookupFamily string `json:",omitempty" alias:"lookup_family"`
+}
+
+func (c *LinkedServiceDNSConfig) MarshalJSON() ([]byte, error) {
+	type Alias LinkedServiceDNSConfig
+	exported := &struct {
+		RefreshRate string `json:",omitempty"`
+		*Alias
+	}{
+		RefreshRate: c.RefreshRate.String(),
+		Alias:       (*Alias)(c),
+	}
+	if c.RefreshRate == 0 {
+		exported.RefreshRate = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (c *LinkedServiceDNSConfig) UnmarshalJSON(data []byte) error {
+	type Alias LinkedServiceDNSConfig
+	aux := &struct {
+		RefreshRate string
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RefreshRate != "" {
+		if c.RefreshRate, err = time.ParseDuration(aux.RefreshRate); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (g *TerminatingGatewayConfigEntry) GetKind() string            { return g.Kind }
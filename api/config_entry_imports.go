@@ -0,0 +1,68 @@
+package api
+
+import "encoding/json"
+
+// ImportedServicesConfigEntry configures how services imported from cluster
+// peers are registered in the local partition.
+type ImportedServicesConfigEntry struct {
+	// Name is the name of the partition the ImportedServicesConfigEntry applies to.
+	// Partitioning is a Consul Enterprise feature.
+	Name string `json:",omitempty"`
+
+	// Partition is the partition where the ImportedServicesConfigEntry is stored.
+	// If the partition does not match the name, the name will overwrite the partition.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Services is a list of imported services to register under a local name.
+	Services []ImportedService `json:",omitempty"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+}
+
+// ImportedService registers a service imported from a peer under a local
+// alias instead of the name it was exported with.
+type ImportedService struct {
+	// Peer is the name of the peer the service is imported from.
+	Peer string
+
+	// Name is the name of the service in the exporting peer.
+	Name string
+
+	// Namespace is the namespace of the imported service.
+	Namespace string `json:",omitempty"`
+
+	// LocalName is the name the service is registered under locally.
+	LocalName string `alias:"local_name"`
+}
+
+func (e *ImportedServicesConfigEntry) GetKind() string            { return ImportedServices }
+func (e *ImportedServicesConfigEntry) GetName() string            { return e.Name }
+func (e *ImportedServicesConfigEntry) GetPartition() string       { return e.Name }
+func (e *ImportedServicesConfigEntry) GetNamespace() string       { return splitDefaultNamespace }
+func (e *ImportedServicesConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *ImportedServicesConfigEntry) GetCreateIndex() uint64     { return e.CreateIndex }
+func (e *ImportedServicesConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
+
+// MarshalJSON adds the Kind field so that the JSON can be decoded back into the
+// correct type.
+func (e *ImportedServicesConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ImportedServicesConfigEntry
+	source := &struct {
+		Kind string
+		*Alias
+	}{
+		Kind:  ImportedServices,
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(source)
+}
@@ -23,6 +23,10 @@ type MeshConfigEntry struct {
 
 	HTTP *MeshHTTPConfig `json:",omitempty"`
 
+	Peering *PeeringMeshConfig `json:",omitempty"`
+
+	Locality *MeshLocalityConfig `json:",omitempty"`
+
 	Meta map[string]string `json:",omitempty"`
 
 	// CreateIndex is the Raft index this entry was created at. This is a
@@ -54,6 +58,17 @@ type MeshHTTPConfig struct {
 	SanitizeXForwardedClientCert bool `alias:"sanitize_x_forwarded_client_cert"`
 }
 
+type PeeringMeshConfig struct {
+	PeerThroughMeshGateways bool `alias:"peer_through_mesh_gateways"`
+}
+
+// MeshLocalityConfig determines which service or node metadata keys hold the
+// region and zone of service instances.
+type MeshLocalityConfig struct {
+	RegionMetaKey string `json:",omitempty" alias:"region_meta_key"`
+	ZoneMetaKey   string `json:",omitempty" alias:"zone_meta_key"`
+}
+
 func (e *MeshConfigEntry) GetKind() string            { return MeshConfig }
 func (e *MeshConfigEntry) GetName() string            { return MeshConfigMesh }
 func (e *MeshConfigEntry) GetPartition() string       { return e.Partition }
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ServiceRolloutState is the state of the rollout driven by a service-rollout
+// config entry.
+type ServiceRolloutState string
+
+const (
+	ServiceRolloutProgressing ServiceRolloutState = "progressing"
+	ServiceRolloutCompleted   ServiceRolloutState = "completed"
+	ServiceRolloutRolledBack  ServiceRolloutState = "rolled-back"
+)
+
+// ServiceRolloutConfigEntry progressively moves the traffic of a service from
+// a baseline subset to a canary subset by updating the weights of its
+// service-splitter, and rolls back when the analysis of a step fails.
+type ServiceRolloutConfigEntry struct {
+	Kind      string
+	Name      string
+	Partition string `json:",omitempty"`
+	Namespace string `json:",omitempty"`
+
+	// BaselineSubset is the subset serving the current version of the
+	// service. An empty string refers to the default subset.
+	BaselineSubset string `json:",omitempty" alias:"baseline_subset"`
+
+	// CanarySubset is the subset serving the new version of the service.
+	CanarySubset string `alias:"canary_subset"`
+
+	// Steps are the successive weights of the canary split.
+	Steps []float32
+
+	// StepInterval is how long each step must pass the analysis before
+	// moving to the next one.
+	StepInterval time.Duration `json:",omitempty" alias:"step_interval"`
+
+	// MaxCriticalPercentage is the percentage of instances of the canary
+	// subset that may have a critical health check before rolling back.
+	MaxCriticalPercentage float32 `json:",omitempty" alias:"max_critical_percentage"`
+
+	// Metrics is an optional query returning the error rate of the canary
+	// subset.
+	Metrics *RolloutMetricsQuery `json:",omitempty"`
+
+	// Status is maintained by the servers. Writing the entry without a status
+	// starts the rollout over.
+	Status ServiceRolloutStatus
+
+	Meta        map[string]string `json:",omitempty"`
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// RolloutMetricsQuery is a query to a metrics provider whose result is
+// compared to a threshold during the analysis of a rollout step.
+type RolloutMetricsQuery struct {
+	Provider  string
+	Address   string
+	Query     string
+	Threshold float64
+}
+
+// ServiceRolloutStatus is the progress of a rollout.
+type ServiceRolloutStatus struct {
+	State          ServiceRolloutState `json:",omitempty"`
+	Step           int
+	CanaryWeight   float32
+	LastTransition time.Time
+	Message        string `json:",omitempty"`
+}
+
+func (e *ServiceRolloutConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ServiceRolloutConfigEntry
+	exported := &struct {
+		StepInterval string `json:",omitempty"`
+		*Alias
+	}{
+		StepInterval: e.StepInterval.String(),
+		Alias:        (*Alias)(e),
+	}
+	if e.StepInterval == 0 {
+		exported.StepInterval = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *ServiceRolloutConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias ServiceRolloutConfigEntry
+	aux := &struct {
+		StepInterval string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.StepInterval != "" {
+		if e.StepInterval, err = time.ParseDuration(aux.StepInterval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *ServiceRolloutConfigEntry) GetKind() string            { return e.Kind }
+func (e *ServiceRolloutConfigEntry) GetName() string            { return e.Name }
+func (e *ServiceRolloutConfigEntry) GetPartition() string       { return e.Partition }
+func (e *ServiceRolloutConfigEntry) GetNamespace() string       { return e.Namespace }
+func (e *ServiceRolloutConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *ServiceRolloutConfigEntry) GetCreateIndex() uint64     { return e.CreateIndex }
+func (e *ServiceRolloutConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
@@ -0,0 +1,61 @@
+package api
+
+import "encoding/json"
+
+// SamenessGroupConfigEntry lists the partitions and cluster peers that host
+// equivalent services, so that services can fail over across them.
+type SamenessGroupConfigEntry struct {
+	Name string
+
+	// Partition is the partition the SamenessGroupConfigEntry applies to.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// DefaultForFailover makes the group the failover policy of every service
+	// in the partition whose service-resolver does not define one.
+	DefaultForFailover bool `json:",omitempty" alias:"default_for_failover"`
+
+	// Members are the partitions and peers of the group, in the order they
+	// are failed over to.
+	Members []SamenessGroupMember `json:",omitempty"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+}
+
+// SamenessGroupMember is either a partition of the local datacenter or a
+// cluster peer. Exactly one of the fields must be set.
+type SamenessGroupMember struct {
+	Partition string `json:",omitempty"`
+	Peer      string `json:",omitempty"`
+}
+
+func (e *SamenessGroupConfigEntry) GetKind() string            { return SamenessGroup }
+func (e *SamenessGroupConfigEntry) GetName() string            { return e.Name }
+func (e *SamenessGroupConfigEntry) GetPartition() string       { return e.Partition }
+func (e *SamenessGroupConfigEntry) GetNamespace() string       { return splitDefaultNamespace }
+func (e *SamenessGroupConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *SamenessGroupConfigEntry) GetCreateIndex() uint64     { return e.CreateIndex }
+func (e *SamenessGroupConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
+
+// MarshalJSON adds the Kind field so that the JSON can be decoded back into the
+// correct type.
+func (e *SamenessGroupConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias SamenessGroupConfigEntry
+	source := &struct {
+		Kind string
+		*Alias
+	}{
+		Kind:  SamenessGroup,
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(source)
+}
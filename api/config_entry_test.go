@@ -393,6 +393,28 @@ func TestDecodeConfigEntry(t *testing.T) {
 				"TransparentProxy": {
 					"OutboundListenerPort": 808,
 					"DialedDirectly": true
+				},
+				"AccessLogs": {
+					"Enabled": true,
+					"DisableListenerLogs": true,
+					"Type": "file",
+					"Path": "/var/log/envoy.log",
+					"TextFormat": "MY START TIME %START_TIME%"
+				},
+				"Tracing": {
+					"Provider": "opentelemetry",
+					"CollectorAddress": "otel-collector:4317",
+					"SamplingPercentage": 25,
+					"CustomTags": [
+						{
+							"Tag": "team",
+							"Literal": "payments"
+						}
+					]
+				},
+				"Metrics": {
+					"PrometheusBindAddr": "0.0.0.0:9102",
+					"StatsFlushInterval": "10s"
 				}
 			}
 			`,
@@ -418,6 +440,25 @@ func TestDecodeConfigEntry(t *testing.T) {
 					OutboundListenerPort: 808,
 					DialedDirectly:       true,
 				},
+				AccessLogs: &AccessLogsConfig{
+					Enabled:             true,
+					DisableListenerLogs: true,
+					Type:                FileLogSinkType,
+					Path:                "/var/log/envoy.log",
+					TextFormat:          "MY START TIME %START_TIME%",
+				},
+				Tracing: &TracingConfig{
+					Provider:           OpenTelemetryTracingProvider,
+					CollectorAddress:   "otel-collector:4317",
+					SamplingPercentage: 25,
+					CustomTags: []TracingCustomTag{
+						{Tag: "team", Literal: "payments"},
+					},
+				},
+				Metrics: &MetricsConfig{
+					PrometheusBindAddr: "0.0.0.0:9102",
+					StatsFlushInterval: "10s",
+				},
 			},
 		},
 		{
@@ -994,6 +1035,32 @@ func TestDecodeConfigEntry(t *testing.T) {
 					{
 						"Port": 8080,
 						"Protocol": "http",
+						"JWT": {
+							"Providers": [
+								{
+									"Name": "okta",
+									"Issuer": "https://okta.example.com/",
+									"JSONWebKeySet": {
+										"Remote": {
+											"URI": "https://okta.example.com/jwks.json",
+											"ClusterName": "okta_jwks",
+											"CacheDuration": "10m"
+										}
+									},
+									"ClaimsToHeaders": [
+										{
+											"Claim": "sub",
+											"Header": "x-user"
+										}
+									]
+								}
+							]
+						},
+						"ExtAuthz": {
+							"Service": "authz",
+							"Protocol": "grpc",
+							"Timeout": "250ms"
+						},
 						"Services": [
 							{
 								"Name": "web",
@@ -1037,6 +1104,29 @@ func TestDecodeConfigEntry(t *testing.T) {
 					{
 						Port:     8080,
 						Protocol: "http",
+						JWT: &IngressJWTConfig{
+							Providers: []IngressJWTProvider{
+								{
+									Name:   "okta",
+									Issuer: "https://okta.example.com/",
+									JSONWebKeySet: &IngressJWKS{
+										Remote: &IngressRemoteJWKS{
+											URI:           "https://okta.example.com/jwks.json",
+											ClusterName:   "okta_jwks",
+											CacheDuration: 10 * time.Minute,
+										},
+									},
+									ClaimsToHeaders: []IngressJWTClaimToHeader{
+										{Claim: "sub", Header: "x-user"},
+									},
+								},
+							},
+						},
+						ExtAuthz: &IngressExtAuthzConfig{
+							Service:  "authz",
+							Protocol: "grpc",
+							Timeout:  250 * time.Millisecond,
+						},
 						Services: []IngressService{
 							{
 								Name:      "web",
@@ -1080,7 +1170,13 @@ func TestDecodeConfigEntry(t *testing.T) {
 						"SNI": "mydomain"
 					},
 					{
-						"Name": "api"
+						"Name": "api",
+						"DNS": {
+							"DiscoveryType": "strict_dns",
+							"RefreshRate": "30s",
+							"RespectTTL": true,
+							"LookupFamily": "v4_preferred"
+						}
 					},
 					{
 						"Namespace": "bar",
@@ -1106,6 +1202,12 @@ func TestDecodeConfigEntry(t *testing.T) {
 					},
 					{
 						Name: "api",
+						DNS: &LinkedServiceDNSConfig{
+							DiscoveryType: "strict_dns",
+							RefreshRate:   30 * time.Second,
+							RespectTTL:    true,
+							LookupFamily:  "v4_preferred",
+						},
 					},
 					{
 						Namespace: "bar",
@@ -1308,6 +1410,13 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 				"HTTP": {
 					"SanitizeXForwardedClientCert": true
+				},
+				"Peering": {
+					"PeerThroughMeshGateways": true
+				},
+				"Locality": {
+					"RegionMetaKey": "region",
+					"ZoneMetaKey": "zone"
 				}
 			}
 			`,
@@ -1340,6 +1449,126 @@ func TestDecodeConfigEntry(t *testing.T) {
 				HTTP: &MeshHTTPConfig{
 					SanitizeXForwardedClientCert: true,
 				},
+				Peering: &PeeringMeshConfig{
+					PeerThroughMeshGateways: true,
+				},
+				Locality: &MeshLocalityConfig{
+					RegionMetaKey: "region",
+					ZoneMetaKey:   "zone",
+				},
+			},
+		},
+		{
+			name: "service-rollout",
+			body: `
+			{
+				"Kind": "service-rollout",
+				"Name": "web",
+				"BaselineSubset": "v1",
+				"CanarySubset": "v2",
+				"Steps": [10, 50, 100],
+				"StepInterval": "5m",
+				"MaxCriticalPercentage": 20,
+				"Metrics": {
+					"Provider": "prometheus",
+					"Address": "http://prometheus:9090",
+					"Query": "errors",
+					"Threshold": 0.5
+				},
+				"Status": {
+					"State": "progressing",
+					"Step": 1,
+					"CanaryWeight": 50,
+					"LastTransition": "2022-06-01T10:00:00Z"
+				}
+			}
+			`,
+			expect: &ServiceRolloutConfigEntry{
+				Kind:                  "service-rollout",
+				Name:                  "web",
+				BaselineSubset:        "v1",
+				CanarySubset:          "v2",
+				Steps:                 []float32{10, 50, 100},
+				StepInterval:          5 * time.Minute,
+				MaxCriticalPercentage: 20,
+				Metrics: &RolloutMetricsQuery{
+					Provider:  "prometheus",
+					Address:   "http://prometheus:9090",
+					Query:     "errors",
+					Threshold: 0.5,
+				},
+				Status: ServiceRolloutStatus{
+					State:          ServiceRolloutProgressing,
+					Step:           1,
+					CanaryWeight:   50,
+					LastTransition: time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "imported-services",
+			body: `
+			{
+				"Kind": "imported-services",
+				"Name": "default",
+				"Meta": {
+					"foo": "bar",
+					"gir": "zim"
+				},
+				"Services": [
+					{
+						"Peer": "billing",
+						"Name": "api",
+						"LocalName": "billing-api"
+					}
+				]
+			}
+			`,
+			expect: &ImportedServicesConfigEntry{
+				Name: "default",
+				Meta: map[string]string{
+					"foo": "bar",
+					"gir": "zim",
+				},
+				Services: []ImportedService{
+					{
+						Peer:      "billing",
+						Name:      "api",
+						LocalName: "billing-api",
+					},
+				},
+			},
+		},
+		{
+			name: "sameness-group",
+			body: `
+			{
+				"Kind": "sameness-group",
+				"Name": "group",
+				"DefaultForFailover": true,
+				"Meta": {
+					"foo": "bar",
+					"gir": "zim"
+				},
+				"Members": [
+					{ "Partition": "default" },
+					{ "Peer": "cluster-01" },
+					{ "Peer": "cluster-02" }
+				]
+			}
+			`,
+			expect: &SamenessGroupConfigEntry{
+				Name:               "group",
+				DefaultForFailover: true,
+				Meta: map[string]string{
+					"foo": "bar",
+					"gir": "zim",
+				},
+				Members: []SamenessGroupMember{
+					{Partition: "default"},
+					{Peer: "cluster-01"},
+					{Peer: "cluster-02"},
+				},
 			},
 		},
 	} {
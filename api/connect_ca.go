@@ -104,6 +104,44 @@ type CARoot struct {
 	ModifyIndex uint64
 }
 
+// SPIFFEBundle is a SPIFFE trust bundle for the Connect CA roots. It is a JSON
+// Web Key Set with an x509-svid key for each root certificate and a jwt-svid
+// key for each key JWT-SVIDs are signed with, which can be used by
+// SPIFFE-aware systems to verify Connect identities.
+type SPIFFEBundle struct {
+	Keys []SPIFFEBundleKey `json:"keys"`
+
+	// Sequence increases each time the roots change.
+	Sequence uint64 `json:"spiffe_sequence,omitempty"`
+
+	// RefreshHint is how often in seconds consumers should check for a new
+	// bundle.
+	RefreshHint int `json:"spiffe_refresh_hint,omitempty"`
+}
+
+// SPIFFEBundleKey is a JSON Web Key for a root certificate.
+type SPIFFEBundleKey struct {
+	Use     string `json:"use"`
+	KeyType string `json:"kty"`
+
+	// KeyID is set for jwt-svid keys, and matches the kid header of the
+	// JWT-SVIDs signed by the key.
+	KeyID string `json:"kid,omitempty"`
+
+	// Curve, X and Y are set for EC keys.
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+
+	// N and E are set for RSA keys.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// Certificates holds the base64 encoded DER root certificate of
+	// x509-svid keys.
+	Certificates []string `json:"x5c,omitempty"`
+}
+
 // LeafCert is a certificate that has been issued by a Connect CA.
 type LeafCert struct {
 	// SerialNumber is the unique serial number for this certificate.
@@ -130,6 +168,23 @@ type LeafCert struct {
 	ModifyIndex uint64
 }
 
+// JWTSVID is a JWT-SVID issued for a service.
+type JWTSVID struct {
+	// Token is the signed JWT-SVID.
+	Token string
+
+	// Service is the name of the service for which the token was issued.
+	// ServiceURI is its SPIFFE ID, which is the subject of the token.
+	Service    string
+	ServiceURI string
+
+	// Audience is the list of audiences the token is valid for.
+	Audience []string
+
+	// ExpiresAt is when the token expires.
+	ExpiresAt time.Time
+}
+
 // CARoots queries the list of available roots.
 func (h *Connect) CARoots(q *QueryOptions) (*CARootList, *QueryMeta, error) {
 	r := h.c.newRequest("GET", "/v1/connect/ca/roots")
@@ -154,6 +209,30 @@ func (h *Connect) CARoots(q *QueryOptions) (*CARootList, *QueryMeta, error) {
 	return &out, qm, nil
 }
 
+// CABundle returns the CA roots as a SPIFFE trust bundle.
+func (h *Connect) CABundle(q *QueryOptions) (*SPIFFEBundle, *QueryMeta, error) {
+	r := h.c.newRequest("GET", "/v1/connect/ca/bundle")
+	r.setQueryOptions(q)
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out SPIFFEBundle
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
+
 // CAGetConfig returns the current CA configuration.
 func (h *Connect) CAGetConfig(q *QueryOptions) (*CAConfig, *QueryMeta, error) {
 	r := h.c.newRequest("GET", "/v1/connect/ca/configuration")
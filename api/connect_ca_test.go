@@ -54,6 +54,29 @@ func TestAPI_ConnectCARoots_list(t *testing.T) {
 
 }
 
+func TestAPI_ConnectCABundle(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	retry.Run(t, func(r *retry.R) {
+		bundle, meta, err := c.Connect().CABundle(nil)
+		r.Check(err)
+		if meta.LastIndex == 0 {
+			r.Fatalf("expected roots raft index to be > 0")
+		}
+		if v := len(bundle.Keys); v != 2 {
+			r.Fatalf("expected 2 keys, got %d", v)
+		}
+		require.Equal(r, "x509-svid", bundle.Keys[0].Use)
+		require.Len(r, bundle.Keys[0].Certificates, 1)
+		require.Equal(r, "jwt-svid", bundle.Keys[1].Use)
+		require.NotEmpty(r, bundle.Keys[1].KeyID)
+		require.Equal(r, meta.LastIndex, bundle.Sequence)
+	})
+}
+
 func TestAPI_ConnectCAConfig_get_set(t *testing.T) {
 	t.Parallel()
 
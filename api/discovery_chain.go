@@ -233,6 +233,7 @@ type DiscoveryTarget struct {
 	ServiceSubset string
 	Namespace     string
 	Datacenter    string
+	Peer          string
 
 	MeshGateway    MeshGatewayConfig
 	Subset         ServiceResolverSubset
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -66,12 +67,46 @@ type Peering struct {
 	ImportedServiceCount uint64
 	// ExportedServiceCount is the count of how many services are exported to this peering.
 	ExportedServiceCount uint64
+	// StreamStatus describes the replication stream with the peer.
+	StreamStatus PeeringStreamStatus
 	// CreateIndex is the Raft index at which the Peering was created.
 	CreateIndex uint64
 	// ModifyIndex is the latest Raft index at which the Peering. was modified.
 	ModifyIndex uint64
 }
 
+// PeeringStreamStatus describes the replication stream with a peer.
+type PeeringStreamStatus struct {
+	// LastHeartbeat is the time when the last heartbeat was received from the peer.
+	LastHeartbeat *time.Time `json:",omitempty"`
+	// LastReceive is the time when the last message was received from the peer.
+	LastReceive *time.Time `json:",omitempty"`
+	// LastSend is the time when the last message was sent to the peer.
+	LastSend *time.Time `json:",omitempty"`
+	// LastError is the time of the most recent error on the stream.
+	LastError *time.Time `json:",omitempty"`
+	// LastErrorMessage is the message of the error at LastError.
+	LastErrorMessage string `json:",omitempty"`
+	// DisconnectTime is the time when the stream was closed, if it is not
+	// currently connected.
+	DisconnectTime *time.Time `json:",omitempty"`
+	// DisconnectErrorMessage is the error that caused the stream to close, if
+	// it did not close gracefully.
+	DisconnectErrorMessage string `json:",omitempty"`
+	// ImportedServices are the names of the services imported from the peer.
+	ImportedServices []string `json:",omitempty"`
+	// ExportedServices are the names of the services exported to the peer.
+	ExportedServices []string `json:",omitempty"`
+	// SentResourceVersions maps each resource type to the number of updates
+	// of that type sent to the peer.
+	SentResourceVersions map[string]uint64 `json:",omitempty"`
+	// ReceivedResourceVersions maps each resource type to the number of
+	// updates of that type received from the peer.
+	ReceivedResourceVersions map[string]uint64 `json:",omitempty"`
+	// UpdatedAt is the time when the status was recorded by the leader.
+	UpdatedAt *time.Time `json:",omitempty"`
+}
+
 type PeeringReadResponse struct {
 	Peering *Peering
 }
@@ -87,6 +122,45 @@ type PeeringGenerateTokenRequest struct {
 	// load balancer(s) or external IPs to reach the servers from the dialing side, and will override any server
 	// addresses obtained from the "consul" service.
 	ServerExternalAddresses []string `json:",omitempty"`
+	// ExpiresAfter is how long the generated token can be used to establish
+	// the peering. Defaults to 24h when it is not set.
+	ExpiresAfter time.Duration `json:",omitempty"`
+}
+
+func (r PeeringGenerateTokenRequest) MarshalJSON() ([]byte, error) {
+	type Alias PeeringGenerateTokenRequest
+	exported := &struct {
+		ExpiresAfter string `json:",omitempty"`
+		*Alias
+	}{
+		ExpiresAfter: r.ExpiresAfter.String(),
+		Alias:        (*Alias)(&r),
+	}
+	if r.ExpiresAfter == 0 {
+		exported.ExpiresAfter = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (r *PeeringGenerateTokenRequest) UnmarshalJSON(data []byte) error {
+	type Alias PeeringGenerateTokenRequest
+	aux := &struct {
+		ExpiresAfter string
+		*Alias
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.ExpiresAfter != "" {
+		if r.ExpiresAfter, err = time.ParseDuration(aux.ExpiresAfter); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type PeeringGenerateTokenResponse struct {
@@ -109,6 +183,21 @@ type PeeringEstablishRequest struct {
 type PeeringEstablishResponse struct {
 }
 
+// PeeringTokenInfo describes a peering token that was generated but not yet
+// used to establish the peering.
+type PeeringTokenInfo struct {
+	// PeerName is the name of the peering the token was generated for.
+	PeerName string
+	// PeeringID is the ID of the peering.
+	PeeringID string
+	// Partition is the local partition of the peering.
+	Partition string `json:",omitempty"`
+	// CreatedAt is the time when the token was generated.
+	CreatedAt time.Time
+	// ExpiresAt is the time after which the token can no longer be used.
+	ExpiresAt time.Time
+}
+
 type PeeringListRequest struct {
 	// future proofing in case we extend List functionality
 }
@@ -260,3 +349,55 @@ func (p *Peerings) List(ctx context.Context, q *QueryOptions) ([]*Peering, *Quer
 
 	return out, qm, nil
 }
+
+// ListTokens returns the peering tokens that were generated but not yet used
+// to establish their peering. The secrets of the tokens are never returned.
+func (p *Peerings) ListTokens(ctx context.Context, q *QueryOptions) ([]*PeeringTokenInfo, *QueryMeta, error) {
+	req := p.c.newRequest("GET", "/v1/peering/token")
+	req.setQueryOptions(q)
+	req.ctx = ctx
+
+	rtt, resp, err := p.c.doRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out []*PeeringTokenInfo
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+
+	return out, qm, nil
+}
+
+// RevokeToken revokes the outstanding peering token of a peering so that it
+// can no longer be used to establish the peering.
+func (p *Peerings) RevokeToken(ctx context.Context, name string, q *WriteOptions) (*WriteMeta, error) {
+	if name == "" {
+		return nil, fmt.Errorf("peering name cannot be empty")
+	}
+
+	req := p.c.newRequest("DELETE", fmt.Sprintf("/v1/peering/token/%s", name))
+	req.setWriteOptions(q)
+	req.ctx = ctx
+
+	rtt, resp, err := p.c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, err
+	}
+
+	wm := &WriteMeta{RequestTime: rtt}
+	return wm, nil
+}
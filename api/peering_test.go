@@ -235,6 +235,44 @@ func TestAPI_Peering_GenerateToken_ExternalAddresses(t *testing.T) {
 	require.Contains(t, string(tokenJSON), externalAddress)
 }
 
+func TestAPI_Peering_ListTokens_RevokeToken(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+	s.WaitForSerfCheck(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	peerings := c.Peerings()
+
+	before := time.Now()
+	_, _, err := peerings.GenerateToken(ctx, PeeringGenerateTokenRequest{
+		PeerName:     "peer1",
+		ExpiresAfter: time.Hour,
+	}, nil)
+	require.NoError(t, err)
+
+	tokens, qm, err := peerings.ListTokens(ctx, nil)
+	require.NoError(t, err)
+	require.NotNil(t, qm)
+	require.Len(t, tokens, 1)
+	require.Equal(t, "peer1", tokens[0].PeerName)
+	require.WithinDuration(t, before.Add(time.Hour), tokens[0].ExpiresAt, 5*time.Second)
+
+	wm, err := peerings.RevokeToken(ctx, "peer1", nil)
+	require.NoError(t, err)
+	require.NotNil(t, wm)
+
+	tokens, _, err = peerings.ListTokens(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+
+	_, err = peerings.RevokeToken(ctx, "peer1", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "404")
+}
+
 // TestAPI_Peering_GenerateToken_Read_Establish_Delete tests the following use case:
 // a server creates a peering token, reads the token, then another server calls establish peering
 // finally, we delete the token on the first server
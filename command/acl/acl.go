@@ -47,6 +47,11 @@ Usage: consul acl <subcommand> [options] [args]
                                  -datacenter "dc2" \
                                  -rules @rules.hcl
 
+  Copy the ACL configuration of one cluster to another:
+
+      $ consul acl export -http-addr=dc1.example.com:8500 > acls.json
+      $ consul acl import -http-addr=dc2.example.com:8500 -plan @acls.json
+
   Set the default agent token:
 
       $ consul acl set-agent-token default 0bc6bc46-f25e-4262-b2d9-ffbe1d96be6f
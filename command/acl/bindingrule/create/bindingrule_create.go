@@ -28,6 +28,7 @@ type cmd struct {
 	selector       string
 	bindType       string
 	bindName       string
+	boundCIDRs     []string
 
 	showMeta bool
 	format   string
@@ -77,6 +78,12 @@ func (c *cmd) init() {
 		"Name to bind on match. Can use ${var} interpolation. "+
 			"This flag is required.",
 	)
+	c.flags.Var(
+		(*flags.AppendSliceValue)(&c.boundCIDRs),
+		"bound-cidr",
+		"CIDR block or IP address that tokens created by logging in with this "+
+			"binding rule may be used from. May be specified multiple times.",
+	)
 	c.flags.StringVar(
 		&c.format,
 		"format",
@@ -116,6 +123,7 @@ func (c *cmd) Run(args []string) int {
 		BindType:    api.BindingRuleBindType(c.bindType),
 		BindName:    c.bindName,
 		Selector:    c.selector,
+		BoundCIDRs:  c.boundCIDRs,
 	}
 
 	client, err := c.http.APIClient()
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/consul/api"
 )
@@ -61,6 +62,9 @@ func (f *prettyFormatter) FormatBindingRule(rule *api.ACLBindingRule) (string, e
 	buffer.WriteString(fmt.Sprintf("BindType:     %s\n", rule.BindType))
 	buffer.WriteString(fmt.Sprintf("BindName:     %s\n", rule.BindName))
 	buffer.WriteString(fmt.Sprintf("Selector:     %s\n", rule.Selector))
+	if len(rule.BoundCIDRs) > 0 {
+		buffer.WriteString(fmt.Sprintf("BoundCIDRs:   %s\n", strings.Join(rule.BoundCIDRs, ", ")))
+	}
 	if f.showMeta {
 		buffer.WriteString(fmt.Sprintf("Create Index: %d\n", rule.CreateIndex))
 		buffer.WriteString(fmt.Sprintf("Modify Index: %d\n", rule.ModifyIndex))
@@ -94,6 +98,9 @@ func (f *prettyFormatter) formatBindingRuleListEntry(rule *api.ACLBindingRule) s
 	buffer.WriteString(fmt.Sprintf("   BindType:     %s\n", rule.BindType))
 	buffer.WriteString(fmt.Sprintf("   BindName:     %s\n", rule.BindName))
 	buffer.WriteString(fmt.Sprintf("   Selector:     %s\n", rule.Selector))
+	if len(rule.BoundCIDRs) > 0 {
+		buffer.WriteString(fmt.Sprintf("   BoundCIDRs:   %s\n", strings.Join(rule.BoundCIDRs, ", ")))
+	}
 	if f.showMeta {
 		buffer.WriteString(fmt.Sprintf("   Create Index: %d\n", rule.CreateIndex))
 		buffer.WriteString(fmt.Sprintf("   Modify Index: %d\n", rule.ModifyIndex))
@@ -31,6 +31,7 @@ type cmd struct {
 	selector    string
 	bindType    string
 	bindName    string
+	boundCIDRs  []string
 
 	noMerge  bool
 	showMeta bool
@@ -82,6 +83,12 @@ func (c *cmd) init() {
 		"Name to bind on match. Can use ${var} interpolation. "+
 			"This flag is required.",
 	)
+	c.flags.Var(
+		(*flags.AppendSliceValue)(&c.boundCIDRs),
+		"bound-cidr",
+		"CIDR block or IP address that tokens created by logging in with this "+
+			"binding rule may be used from. May be specified multiple times.",
+	)
 
 	c.flags.BoolVar(
 		&c.noMerge,
@@ -157,6 +164,7 @@ func (c *cmd) Run(args []string) int {
 			BindType:    api.BindingRuleBindType(c.bindType),
 			BindName:    c.bindName,
 			Selector:    c.selector,
+			BoundCIDRs:  c.boundCIDRs,
 		}
 
 	} else {
@@ -174,6 +182,9 @@ func (c *cmd) Run(args []string) int {
 		if isFlagSet(c.flags, "selector") {
 			rule.Selector = c.selector // empty is valid
 		}
+		if len(c.boundCIDRs) > 0 {
+			rule.BoundCIDRs = c.boundCIDRs
+		}
 	}
 
 	rule, _, err = client.ACL().BindingRuleUpdate(rule, nil)
@@ -0,0 +1,126 @@
+package rotate
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/command/acl"
+	"github.com/hashicorp/consul/command/acl/token"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	tokenID       string
+	expirationTTL time.Duration
+	format        string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.tokenID, "id", "", "The Accessor ID of the management token to rotate. "+
+		"It may be specified as a unique ID prefix but will error if the prefix "+
+		"matches multiple token Accessor IDs. Defaults to the token used to make the request")
+	c.flags.DurationVar(&c.expirationTTL, "expires-ttl", 0, "Duration for which the rotated "+
+		"token remains valid. Defaults to one hour, bounded by the servers' token TTL limits")
+	c.flags.StringVar(
+		&c.format,
+		"format",
+		token.PrettyFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join(token.GetSupportedFormats(), "|")),
+	)
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if c.expirationTTL < 0 {
+		c.UI.Error("The -expires-ttl parameter must not be negative")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	tokenID := c.tokenID
+	if tokenID != "" {
+		tokenID, err = acl.GetTokenIDFromPartial(client, c.tokenID)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error determining token ID: %v", err))
+			return 1
+		}
+	}
+
+	t, _, err := client.ACL().BootstrapRotate(tokenID, c.expirationTTL, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Failed rotating the management token: %v", err))
+		return 1
+	}
+
+	formatter, err := token.NewFormatter(c.format, false)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	out, err := formatter.FormatToken(t)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	if out != "" {
+		c.UI.Info(out)
+	}
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Rotate a management token"
+	help     = `
+Usage: consul acl bootstrap rotate [options]
+
+  The rotate command will request Consul to generate a new token with unlimited
+  privileges to replace an existing management token and output its details.
+  The previous token is not deleted immediately but expires after the given
+  TTL, giving anything still using it time to switch to the new token.
+
+  Rotate the token used to make the request:
+
+      $ consul acl bootstrap rotate -expires-ttl=2h
+
+  Rotate a specific management token:
+
+      $ consul acl bootstrap rotate -id abcd
+`
+)
@@ -0,0 +1,68 @@
+package rotate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestRotateCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestRotateCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, `
+	primary_datacenter = "dc1"
+	acl {
+		enabled = true
+		tokens {
+			initial_management = "root"
+		}
+	}`)
+
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	ui := cli.NewMockUi()
+	cmd := New(ui)
+
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-token=root",
+		"-expires-ttl=10m",
+		"-format=json",
+	}
+
+	code := cmd.Run(args)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Empty(t, ui.ErrorWriter.String())
+
+	var token api.ACLToken
+	require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &token))
+	require.Len(t, token.Policies, 1)
+	require.Equal(t, structs.ACLPolicyGlobalManagementID, token.Policies[0].ID)
+	require.Nil(t, token.ExpirationTime)
+
+	client := a.Client()
+	previous, _, err := client.ACL().TokenReadSelf(&api.QueryOptions{Token: "root"})
+	require.NoError(t, err)
+	require.NotNil(t, previous.ExpirationTime)
+}
@@ -0,0 +1,107 @@
+package aclexport
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/hashicorp/consul/command/acl/impexp"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	format         string
+	includeTokens  bool
+	includeSecrets bool
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.format, "format", impexp.FormatJSON,
+		fmt.Sprintf("Output format {%s|%s}", impexp.FormatJSON, impexp.FormatHCL))
+	c.flags.BoolVar(&c.includeTokens, "include-tokens", false, "Include tokens in the "+
+		"bundle. Their secrets are never exported, so tokens created by importing the "+
+		"bundle are given new secrets.")
+	c.flags.BoolVar(&c.includeSecrets, "include-secrets", false, "Include the secrets "+
+		"in auth method configs, such as OIDCClientSecret and ServiceAccountJWT. They "+
+		"are left out by default, and importing a bundle without them keeps the "+
+		"secrets of existing auth methods.")
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if len(c.flags.Args()) > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", len(c.flags.Args())))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	bundle, err := impexp.Export(client, c.includeTokens, c.includeSecrets, nil)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	out, err := impexp.Encode(bundle, c.format)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(string(out))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Export ACL policies, roles, auth methods and binding rules"
+	help     = `
+Usage: consul acl export [options]
+
+  Writes the ACL policies, roles, auth methods and binding rules of the cluster
+  to stdout as a single JSON or HCL bundle. The bundle can be applied to another
+  cluster with "consul acl import".
+
+  Export the ACL configuration as HCL:
+
+      $ consul acl export -format=hcl > acl.hcl
+
+  Export the ACL configuration including tokens, without their secrets:
+
+      $ consul acl export -include-tokens > acl.json
+
+  Secrets in auth method configs are not exported unless -include-secrets is
+  set, since the bundle is written in plain text.
+`
+)
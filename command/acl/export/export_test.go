@@ -0,0 +1,88 @@
+package aclexport
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/acl/impexp"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestExportCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestExportCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, `
+	primary_datacenter = "dc1"
+	acl {
+		enabled = true
+		tokens {
+			initial_management = "root"
+		}
+	}`)
+
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	client := a.Client()
+	wq := &api.WriteOptions{Token: "root"}
+	_, _, err := client.ACL().PolicyCreate(&api.ACLPolicy{Name: "kv", Rules: `key_prefix "" { policy = "read" }`}, wq)
+	require.NoError(t, err)
+	_, _, err = client.ACL().RoleCreate(&api.ACLRole{Name: "reader", Policies: []*api.ACLRolePolicyLink{{Name: "kv"}}}, wq)
+	require.NoError(t, err)
+	token, _, err := client.ACL().TokenCreate(&api.ACLToken{Roles: []*api.ACLTokenRoleLink{{Name: "reader"}}}, wq)
+	require.NoError(t, err)
+
+	export := func(t *testing.T, args ...string) *impexp.Bundle {
+		ui := cli.NewMockUi()
+		code := New(ui).Run(append([]string{"-http-addr=" + a.HTTPAddr(), "-token=root"}, args...))
+		require.Equal(t, 0, code)
+		require.Empty(t, ui.ErrorWriter.String())
+
+		out := ui.OutputWriter.String()
+		require.NotContains(t, out, token.SecretID)
+
+		var bundle impexp.Bundle
+		require.NoError(t, json.Unmarshal([]byte(out), &bundle))
+		return &bundle
+	}
+
+	t.Run("without tokens", func(t *testing.T) {
+		bundle := export(t)
+		require.Len(t, bundle.Policies, 1)
+		require.Equal(t, "kv", bundle.Policies[0].Name)
+		require.Len(t, bundle.Roles, 1)
+		require.Equal(t, []string{"kv"}, bundle.Roles[0].Policies)
+		require.Empty(t, bundle.Tokens)
+	})
+
+	t.Run("with tokens", func(t *testing.T) {
+		bundle := export(t, "-include-tokens")
+		var found bool
+		for _, tok := range bundle.Tokens {
+			require.NotEqual(t, "00000000-0000-0000-0000-000000000002", tok.AccessorID)
+			if tok.AccessorID == token.AccessorID {
+				found = true
+				require.Equal(t, []string{"reader"}, tok.Roles)
+			}
+		}
+		require.True(t, found)
+	})
+}
@@ -0,0 +1,467 @@
+// Package impexp contains the bundle format shared by the "consul acl export"
+// and "consul acl import" commands, and the logic to reconcile a cluster with
+// a bundle.
+package impexp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/hcl/ast"
+	"github.com/hashicorp/hcl/hcl/printer"
+	"github.com/hashicorp/hcl/hcl/token"
+	jsonparser "github.com/hashicorp/hcl/json/parser"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/helpers"
+	"github.com/hashicorp/consul/lib/decode"
+)
+
+const (
+	FormatJSON = "json"
+	FormatHCL  = "hcl"
+
+	// anonymousTokenID is the accessor ID of the builtin anonymous token.
+	anonymousTokenID = "00000000-0000-0000-0000-000000000002"
+
+	// builtinPolicyIDPrefix is shared by the IDs of all builtin policies, which
+	// have the first 120 bits set to zero.
+	builtinPolicyIDPrefix = "00000000-0000-0000-0000-0000000000"
+)
+
+// authMethodSecretFields are the auth method config fields which hold secrets.
+// They are left out of bundles unless secrets are explicitly included.
+var authMethodSecretFields = []string{
+	"OIDCClientSecret",  // oidc
+	"ServiceAccountJWT", // kubernetes
+}
+
+// Bundle is the ACL configuration of a cluster. Resources refer to each other
+// by name rather than ID so that a bundle can be imported into other clusters.
+type Bundle struct {
+	Policies     []*Policy      `json:"policy,omitempty"`
+	Roles        []*Role        `json:"role,omitempty"`
+	AuthMethods  []*AuthMethod  `json:"auth_method,omitempty"`
+	BindingRules []*BindingRule `json:"binding_rule,omitempty"`
+	Tokens       []*Token       `json:"token,omitempty"`
+}
+
+type Policy struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Rules       string   `json:"rules,omitempty"`
+	Datacenters []string `json:"datacenters,omitempty"`
+
+	id string
+}
+
+type Role struct {
+	Name              string             `json:"name"`
+	Description       string             `json:"description,omitempty"`
+	Policies          []string           `json:"policies,omitempty"`
+	ServiceIdentities []*ServiceIdentity `json:"service_identity,omitempty"`
+	NodeIdentities    []*NodeIdentity    `json:"node_identity,omitempty"`
+
+	id string
+}
+
+type AuthMethod struct {
+	Name          string                 `json:"name"`
+	Type          string                 `json:"type"`
+	DisplayName   string                 `json:"display_name,omitempty"`
+	Description   string                 `json:"description,omitempty"`
+	MaxTokenTTL   string                 `json:"max_token_ttl,omitempty"`
+	TokenLocality string                 `json:"token_locality,omitempty"`
+	Config        map[string]interface{} `json:"config,omitempty"`
+}
+
+type BindingRule struct {
+	AuthMethod  string   `json:"auth_method"`
+	Description string   `json:"description,omitempty"`
+	Selector    string   `json:"selector,omitempty"`
+	BindType    string   `json:"bind_type"`
+	BindName    string   `json:"bind_name"`
+	BoundCIDRs  []string `json:"bound_cidrs,omitempty"`
+
+	id string
+}
+
+// Token is an ACL token without its secret. Tokens imported from a bundle are
+// given new secrets.
+type Token struct {
+	AccessorID        string             `json:"accessor_id"`
+	Description       string             `json:"description,omitempty"`
+	Policies          []string           `json:"policies,omitempty"`
+	Roles             []string           `json:"roles,omitempty"`
+	ServiceIdentities []*ServiceIdentity `json:"service_identity,omitempty"`
+	NodeIdentities    []*NodeIdentity    `json:"node_identity,omitempty"`
+	Local             bool               `json:"local,omitempty"`
+	BoundCIDRs        []string           `json:"bound_cidrs,omitempty"`
+	ExpirationTime    *time.Time         `json:"expiration_time,omitempty"`
+}
+
+type ServiceIdentity struct {
+	ServiceName string   `json:"service_name"`
+	Datacenters []string `json:"datacenters,omitempty"`
+}
+
+type NodeIdentity struct {
+	NodeName   string `json:"node_name"`
+	Datacenter string `json:"datacenter"`
+}
+
+// Export reads the ACL configuration of the cluster. Builtin policies and
+// tokens, and tokens created by logging in with an auth method, are not
+// included. Tokens are only included if includeTokens is set, and the secrets
+// in auth method configs only if includeSecrets is set.
+func Export(client *api.Client, includeTokens, includeSecrets bool, q *api.QueryOptions) (*Bundle, error) {
+	acl := client.ACL()
+	var b Bundle
+
+	policies, _, err := acl.PolicyList(q)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list policies: %w", err)
+	}
+	for _, entry := range policies {
+		if strings.HasPrefix(entry.ID, builtinPolicyIDPrefix) {
+			continue
+		}
+		policy, _, err := acl.PolicyRead(entry.ID, q)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read policy %q: %w", entry.Name, err)
+		}
+		if policy == nil {
+			// Deleted since it was listed.
+			continue
+		}
+		b.Policies = append(b.Policies, &Policy{
+			Name:        policy.Name,
+			Description: policy.Description,
+			Rules:       policy.Rules,
+			Datacenters: policy.Datacenters,
+			id:          policy.ID,
+		})
+	}
+
+	roles, _, err := acl.RoleList(q)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list roles: %w", err)
+	}
+	for _, role := range roles {
+		b.Roles = append(b.Roles, &Role{
+			Name:              role.Name,
+			Description:       role.Description,
+			Policies:          linkNames(role.Policies),
+			ServiceIdentities: fromServiceIdentities(role.ServiceIdentities),
+			NodeIdentities:    fromNodeIdentities(role.NodeIdentities),
+			id:                role.ID,
+		})
+	}
+
+	methods, _, err := acl.AuthMethodList(q)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list auth methods: %w", err)
+	}
+	for _, entry := range methods {
+		method, _, err := acl.AuthMethodRead(entry.Name, q)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read auth method %q: %w", entry.Name, err)
+		}
+		if method == nil {
+			continue
+		}
+		var ttl string
+		if method.MaxTokenTTL != 0 {
+			ttl = method.MaxTokenTTL.String()
+		}
+		config := method.Config
+		if !includeSecrets {
+			config = redactSecrets(config)
+		}
+		b.AuthMethods = append(b.AuthMethods, &AuthMethod{
+			Name:          method.Name,
+			Type:          method.Type,
+			DisplayName:   method.DisplayName,
+			Description:   method.Description,
+			MaxTokenTTL:   ttl,
+			TokenLocality: method.TokenLocality,
+			Config:        config,
+		})
+	}
+
+	rules, _, err := acl.BindingRuleList("", q)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list binding rules: %w", err)
+	}
+	for _, rule := range rules {
+		b.BindingRules = append(b.BindingRules, &BindingRule{
+			AuthMethod:  rule.AuthMethod,
+			Description: rule.Description,
+			Selector:    rule.Selector,
+			BindType:    string(rule.BindType),
+			BindName:    rule.BindName,
+			BoundCIDRs:  rule.BoundCIDRs,
+			id:          rule.ID,
+		})
+	}
+
+	if includeTokens {
+		tokens, _, err := acl.TokenList(q)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list tokens: %w", err)
+		}
+		for _, t := range tokens {
+			if t.AccessorID == anonymousTokenID || t.AuthMethod != "" {
+				continue
+			}
+			b.Tokens = append(b.Tokens, &Token{
+				AccessorID:        t.AccessorID,
+				Description:       t.Description,
+				Policies:          linkNames(t.Policies),
+				Roles:             linkNames(t.Roles),
+				ServiceIdentities: fromServiceIdentities(t.ServiceIdentities),
+				NodeIdentities:    fromNodeIdentities(t.NodeIdentities),
+				Local:             t.Local,
+				BoundCIDRs:        t.BoundCIDRs,
+				ExpirationTime:    t.ExpirationTime,
+			})
+		}
+	}
+
+	b.normalize()
+	return &b, nil
+}
+
+// redactSecrets returns a copy of the auth method config without the fields
+// which hold secrets.
+func redactSecrets(config map[string]interface{}) map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		out[k] = v
+	}
+	for _, field := range authMethodSecretFields {
+		delete(out, field)
+	}
+	return out
+}
+
+// withSecretsFrom returns a copy of the auth method where the secrets which are
+// missing from its config are taken from the existing auth method, so that
+// importing a bundle without secrets does not clear them.
+func (m *AuthMethod) withSecretsFrom(existing *AuthMethod) *AuthMethod {
+	out := *m
+	out.Config = make(map[string]interface{}, len(m.Config))
+	for k, v := range m.Config {
+		out.Config[k] = v
+	}
+	for _, field := range authMethodSecretFields {
+		if _, ok := out.Config[field]; ok {
+			continue
+		}
+		if v, ok := existing.Config[field]; ok {
+			out.Config[field] = v
+		}
+	}
+	return &out
+}
+
+// Encode returns the bundle in the given format.
+func Encode(b *Bundle, format string) ([]byte, error) {
+	buf, err := json.MarshalIndent(b, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatJSON:
+		return buf, nil
+	case FormatHCL:
+		return jsonToHCL(buf)
+	default:
+		return nil, fmt.Errorf("Invalid format %q, must be one of %q or %q", format, FormatJSON, FormatHCL)
+	}
+}
+
+// jsonToHCL converts JSON to the equivalent HCL, writing objects as blocks
+// and unquoting keys where possible.
+func jsonToHCL(data []byte) ([]byte, error) {
+	f, err := jsonparser.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ast.Walk(f.Node, func(n ast.Node) (ast.Node, bool) {
+		item, ok := n.(*ast.ObjectItem)
+		if !ok {
+			return n, true
+		}
+		if _, ok := item.Val.(*ast.ObjectType); ok {
+			item.Assign = token.Pos{}
+		}
+		for _, key := range item.Keys {
+			if s, ok := key.Token.Value().(string); ok && isIdent(s) {
+				key.Token.Type = token.IDENT
+				key.Token.Text = s
+			}
+		}
+		return n, true
+	})
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, f.Node); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Parse decodes a bundle written in HCL or JSON.
+func Parse(data string) (*Bundle, error) {
+	var raw map[string]interface{}
+	if err := helpers.DecodeHCLOrJSON(&raw, data); err != nil {
+		return nil, fmt.Errorf("Failed to decode bundle: %w", err)
+	}
+
+	var b Bundle
+	var md mapstructure.Metadata
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			decodeFromSlice,
+			mapstructure.StringToTimeHookFunc(time.RFC3339),
+		),
+		Metadata:         &md,
+		Result:           &b,
+		TagName:          "json",
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("Failed to decode bundle: %w", err)
+	}
+	if len(md.Unused) > 0 {
+		sort.Strings(md.Unused)
+		return nil, fmt.Errorf("Invalid keys in bundle: %s", strings.Join(md.Unused, ", "))
+	}
+
+	for _, method := range b.AuthMethods {
+		if method.MaxTokenTTL != "" {
+			ttl, err := time.ParseDuration(method.MaxTokenTTL)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid max_token_ttl for auth method %q: %w", method.Name, err)
+			}
+			method.MaxTokenTTL = ttl.String()
+		}
+	}
+
+	b.normalize()
+	return &b, nil
+}
+
+// decodeFromSlice unwraps the single element lists HCL decodes blocks as,
+// like decode.HookWeakDecodeFromSlice, but leaves lists of values in auth
+// method configs alone so that a list of one string stays a list.
+func decodeFromSlice(from, to reflect.Type, data interface{}) (interface{}, error) {
+	if _, ok := data.([]interface{}); ok && to.Kind() == reflect.Interface {
+		return data, nil
+	}
+	return decode.HookWeakDecodeFromSlice(from, to, data)
+}
+
+// normalize puts the bundle in a canonical order so that bundles can be
+// compared and exports are stable.
+func (b *Bundle) normalize() {
+	for _, r := range b.Roles {
+		sort.Strings(r.Policies)
+	}
+	for _, t := range b.Tokens {
+		sort.Strings(t.Policies)
+		sort.Strings(t.Roles)
+	}
+	sort.Slice(b.Policies, func(i, j int) bool { return b.Policies[i].Name < b.Policies[j].Name })
+	sort.Slice(b.Roles, func(i, j int) bool { return b.Roles[i].Name < b.Roles[j].Name })
+	sort.Slice(b.AuthMethods, func(i, j int) bool { return b.AuthMethods[i].Name < b.AuthMethods[j].Name })
+	sort.Slice(b.BindingRules, func(i, j int) bool { return b.BindingRules[i].key() < b.BindingRules[j].key() })
+	sort.Slice(b.Tokens, func(i, j int) bool { return b.Tokens[i].AccessorID < b.Tokens[j].AccessorID })
+}
+
+// key identifies a binding rule, which unlike the other resources has no name.
+// Rules with the same key but a different description or bound CIDRs are
+// updated in place.
+func (r *BindingRule) key() string {
+	return strings.Join([]string{r.AuthMethod, r.BindType, r.BindName, r.Selector}, "\x00")
+}
+
+func linkNames(links []*api.ACLLink) []string {
+	var names []string
+	for _, link := range links {
+		names = append(names, link.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func toLinks(names []string) []*api.ACLLink {
+	var links []*api.ACLLink
+	for _, name := range names {
+		links = append(links, &api.ACLLink{Name: name})
+	}
+	return links
+}
+
+func fromServiceIdentities(idents []*api.ACLServiceIdentity) []*ServiceIdentity {
+	var out []*ServiceIdentity
+	for _, ident := range idents {
+		out = append(out, &ServiceIdentity{ServiceName: ident.ServiceName, Datacenters: ident.Datacenters})
+	}
+	return out
+}
+
+func toServiceIdentities(idents []*ServiceIdentity) []*api.ACLServiceIdentity {
+	var out []*api.ACLServiceIdentity
+	for _, ident := range idents {
+		out = append(out, &api.ACLServiceIdentity{ServiceName: ident.ServiceName, Datacenters: ident.Datacenters})
+	}
+	return out
+}
+
+func fromNodeIdentities(idents []*api.ACLNodeIdentity) []*NodeIdentity {
+	var out []*NodeIdentity
+	for _, ident := range idents {
+		out = append(out, &NodeIdentity{NodeName: ident.NodeName, Datacenter: ident.Datacenter})
+	}
+	return out
+}
+
+func toNodeIdentities(idents []*NodeIdentity) []*api.ACLNodeIdentity {
+	var out []*api.ACLNodeIdentity
+	for _, ident := range idents {
+		out = append(out, &api.ACLNodeIdentity{NodeName: ident.NodeName, Datacenter: ident.Datacenter})
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+package impexp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testBundle() *Bundle {
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &Bundle{
+		Policies: []*Policy{
+			{Name: "kv-read", Rules: "key_prefix \"\" {\n  policy = \"read\"\n}\n", Datacenters: []string{"dc1"}},
+			{Name: "agent", Description: "agent policy", Rules: `agent_prefix "" { policy = "write" }`},
+		},
+		Roles: []*Role{
+			{
+				Name:              "ops",
+				Policies:          []string{"kv-read", "agent"},
+				ServiceIdentities: []*ServiceIdentity{{ServiceName: "web", Datacenters: []string{"dc1"}}},
+				NodeIdentities:    []*NodeIdentity{{NodeName: "node1", Datacenter: "dc1"}},
+			},
+		},
+		AuthMethods: []*AuthMethod{
+			{
+				Name:        "jwt",
+				Type:        "jwt",
+				MaxTokenTTL: "1h0m0s",
+				Config: map[string]interface{}{
+					"BoundAudiences": []interface{}{"consul"},
+					"ClaimMappings":  map[string]interface{}{"sub": "subject"},
+				},
+			},
+		},
+		BindingRules: []*BindingRule{
+			{AuthMethod: "jwt", BindType: "role", BindName: "ops", Selector: "value.subject==ops", BoundCIDRs: []string{"10.0.0.0/8"}},
+		},
+		Tokens: []*Token{
+			{AccessorID: "b0a5b4a4-1d3a-4c5c-9a54-4f9f2f2c3c3d", Roles: []string{"ops"}, ExpirationTime: &expiry},
+		},
+	}
+}
+
+func TestEncodeParse(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatHCL} {
+		t.Run(format, func(t *testing.T) {
+			expected := testBundle()
+			expected.normalize()
+
+			data, err := Encode(testBundle(), format)
+			require.NoError(t, err)
+
+			actual, err := Parse(string(data))
+			require.NoError(t, err)
+			require.Empty(t, NewPlan(expected, actual, true, "").Changes)
+			require.Equal(t, expected.AuthMethods[0].Config, actual.AuthMethods[0].Config)
+		})
+	}
+
+	_, err := Encode(testBundle(), "yaml")
+	require.Error(t, err)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse(`policy { name = "foo" rulez = "" }`)
+	require.EqualError(t, err, "Invalid keys in bundle: policy[0].rulez")
+
+	_, err = Parse(`auth_method { name = "foo" type = "jwt" max_token_ttl = "forever" }`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `Invalid max_token_ttl for auth method "foo"`)
+}
+
+func TestNewPlan(t *testing.T) {
+	current := testBundle()
+	current.normalize()
+	current.Policies[0].id = "agent-id"
+	current.Tokens = append(current.Tokens, &Token{AccessorID: "self"})
+
+	desired := testBundle()
+	desired.Policies[1].Description = "updated"
+	desired.Roles = nil
+	desired.AuthMethods = append(desired.AuthMethods, &AuthMethod{Name: "k8s", Type: "kubernetes"})
+	desired.normalize()
+
+	changes := func(p *Plan) []string {
+		var out []string
+		for _, c := range p.Changes {
+			out = append(out, c.String())
+		}
+		return out
+	}
+
+	t.Run("without prune", func(t *testing.T) {
+		plan := NewPlan(current, desired, false, "")
+		require.Equal(t, []string{
+			`~ update policy "agent"`,
+			`+ create auth-method "k8s"`,
+		}, changes(plan))
+		require.Equal(t, "1 to create, 1 to update, 0 to delete", plan.Summary())
+	})
+
+	t.Run("with prune", func(t *testing.T) {
+		plan := NewPlan(current, desired, true, "self")
+		require.Equal(t, []string{
+			`~ update policy "agent"`,
+			`+ create auth-method "k8s"`,
+			`- delete role "ops"`,
+		}, changes(plan))
+	})
+
+	t.Run("prune tokens", func(t *testing.T) {
+		plan := NewPlan(current, desired, true, "")
+		require.Equal(t, []string{
+			`~ update policy "agent"`,
+			`+ create auth-method "k8s"`,
+			`- delete token "self"`,
+			`- delete role "ops"`,
+		}, changes(plan))
+	})
+
+	t.Run("redacted secrets are kept", func(t *testing.T) {
+		current := testBundle()
+		current.AuthMethods[0].Config["ServiceAccountJWT"] = "secret"
+		current.normalize()
+
+		desired := testBundle()
+		desired.normalize()
+		plan := NewPlan(current, desired, false, "")
+		require.Empty(t, plan.Changes)
+
+		desired.AuthMethods[0].Description = "updated"
+		plan = NewPlan(current, desired, false, "")
+		require.Equal(t, []string{`~ update auth-method "jwt"`}, changes(plan))
+		require.NotContains(t, desired.AuthMethods[0].Config, "ServiceAccountJWT")
+	})
+
+	t.Run("prune without tokens", func(t *testing.T) {
+		desired := testBundle()
+		desired.Tokens = nil
+		desired.normalize()
+		require.Empty(t, NewPlan(current, desired, true, "").Changes)
+	})
+}
+
+func TestRedactSecrets(t *testing.T) {
+	config := map[string]interface{}{
+		"OIDCClientID":     "consul",
+		"OIDCClientSecret": "secret",
+	}
+	require.Equal(t, map[string]interface{}{"OIDCClientID": "consul"}, redactSecrets(config))
+	// The original config is not modified.
+	require.Equal(t, "secret", config["OIDCClientSecret"])
+	require.Nil(t, redactSecrets(nil))
+}
@@ -0,0 +1,350 @@
+package impexp
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a single write needed to reconcile a cluster with a bundle.
+type Change struct {
+	Action Action
+
+	// Kind is the type of resource changed, e.g. "policy".
+	Kind string
+
+	// Name describes the resource changed. For binding rules and tokens,
+	// which have no name, it is built from their other fields.
+	Name string
+
+	apply func(acl *api.ACL, q *api.WriteOptions) error
+}
+
+func (c *Change) String() string {
+	symbol := map[Action]string{
+		ActionCreate: "+",
+		ActionUpdate: "~",
+		ActionDelete: "-",
+	}[c.Action]
+	return fmt.Sprintf("%s %s %s %q", symbol, c.Action, c.Kind, c.Name)
+}
+
+// Plan is the list of changes needed to reconcile a cluster with a bundle, in
+// the order they must be applied.
+type Plan struct {
+	Changes []*Change
+}
+
+// Summary counts the changes in the plan by action.
+func (p *Plan) Summary() string {
+	counts := make(map[Action]int)
+	for _, c := range p.Changes {
+		counts[c.Action]++
+	}
+	return fmt.Sprintf("%d to create, %d to update, %d to delete",
+		counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete])
+}
+
+// Apply makes the changes in the plan, stopping at the first error.
+func (p *Plan) Apply(client *api.Client, q *api.WriteOptions) error {
+	acl := client.ACL()
+	for _, c := range p.Changes {
+		if err := c.apply(acl, q); err != nil {
+			return fmt.Errorf("Failed to %s %s %q: %w", c.Action, c.Kind, c.Name, err)
+		}
+	}
+	return nil
+}
+
+// NewPlan returns the changes which make current, as returned by Export, match
+// desired. Resources missing from desired are only deleted if prune is set,
+// and tokens are only deleted if desired includes any tokens. The token with
+// the accessor ID keepToken is never deleted so that the import cannot lock
+// out the operator running it.
+func NewPlan(current, desired *Bundle, prune bool, keepToken string) *Plan {
+	var creates, deletes []*Change
+
+	currentPolicies := make(map[string]*Policy)
+	for _, p := range current.Policies {
+		currentPolicies[p.Name] = p
+	}
+	for _, p := range desired.Policies {
+		p := p
+		existing, ok := currentPolicies[p.Name]
+		delete(currentPolicies, p.Name)
+		switch {
+		case !ok:
+			creates = append(creates, &Change{Action: ActionCreate, Kind: "policy", Name: p.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.PolicyCreate(p.toAPI(""), q)
+					return err
+				}})
+		case !equal(existing, p):
+			creates = append(creates, &Change{Action: ActionUpdate, Kind: "policy", Name: p.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.PolicyUpdate(p.toAPI(existing.id), q)
+					return err
+				}})
+		}
+	}
+
+	currentRoles := make(map[string]*Role)
+	for _, r := range current.Roles {
+		currentRoles[r.Name] = r
+	}
+	for _, r := range desired.Roles {
+		r := r
+		existing, ok := currentRoles[r.Name]
+		delete(currentRoles, r.Name)
+		switch {
+		case !ok:
+			creates = append(creates, &Change{Action: ActionCreate, Kind: "role", Name: r.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.RoleCreate(r.toAPI(""), q)
+					return err
+				}})
+		case !equal(existing, r):
+			creates = append(creates, &Change{Action: ActionUpdate, Kind: "role", Name: r.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.RoleUpdate(r.toAPI(existing.id), q)
+					return err
+				}})
+		}
+	}
+
+	currentMethods := make(map[string]*AuthMethod)
+	for _, m := range current.AuthMethods {
+		currentMethods[m.Name] = m
+	}
+	for _, m := range desired.AuthMethods {
+		m := m
+		existing, ok := currentMethods[m.Name]
+		delete(currentMethods, m.Name)
+		if ok {
+			m = m.withSecretsFrom(existing)
+		}
+		switch {
+		case !ok:
+			creates = append(creates, &Change{Action: ActionCreate, Kind: "auth-method", Name: m.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.AuthMethodCreate(m.toAPI(), q)
+					return err
+				}})
+		case !equal(existing, m):
+			creates = append(creates, &Change{Action: ActionUpdate, Kind: "auth-method", Name: m.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.AuthMethodUpdate(m.toAPI(), q)
+					return err
+				}})
+		}
+	}
+
+	currentRules := make(map[string]*BindingRule)
+	for _, r := range current.BindingRules {
+		currentRules[r.key()] = r
+	}
+	for _, r := range desired.BindingRules {
+		r := r
+		existing, ok := currentRules[r.key()]
+		delete(currentRules, r.key())
+		switch {
+		case !ok:
+			creates = append(creates, &Change{Action: ActionCreate, Kind: "binding-rule", Name: r.String(),
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.BindingRuleCreate(r.toAPI(""), q)
+					return err
+				}})
+		case !equal(existing, r):
+			creates = append(creates, &Change{Action: ActionUpdate, Kind: "binding-rule", Name: r.String(),
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.BindingRuleUpdate(r.toAPI(existing.id), q)
+					return err
+				}})
+		}
+	}
+
+	currentTokens := make(map[string]*Token)
+	for _, t := range current.Tokens {
+		currentTokens[t.AccessorID] = t
+	}
+	for _, t := range desired.Tokens {
+		t := t
+		existing, ok := currentTokens[t.AccessorID]
+		delete(currentTokens, t.AccessorID)
+		switch {
+		case !ok:
+			creates = append(creates, &Change{Action: ActionCreate, Kind: "token", Name: t.AccessorID,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.TokenCreate(t.toAPI(), q)
+					return err
+				}})
+		case !equal(existing, t):
+			creates = append(creates, &Change{Action: ActionUpdate, Kind: "token", Name: t.AccessorID,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, _, err := acl.TokenUpdate(t.toAPI(), q)
+					return err
+				}})
+		}
+	}
+
+	if prune {
+		// Delete in the reverse order of creation so that nothing is left
+		// referring to a deleted resource.
+		if len(desired.Tokens) > 0 {
+			for _, t := range current.Tokens {
+				if _, ok := currentTokens[t.AccessorID]; !ok || t.AccessorID == keepToken {
+					continue
+				}
+				id := t.AccessorID
+				deletes = append(deletes, &Change{Action: ActionDelete, Kind: "token", Name: id,
+					apply: func(acl *api.ACL, q *api.WriteOptions) error {
+						_, err := acl.TokenDelete(id, q)
+						return err
+					}})
+			}
+		}
+		for _, r := range current.BindingRules {
+			if _, ok := currentRules[r.key()]; !ok {
+				continue
+			}
+			id := r.id
+			deletes = append(deletes, &Change{Action: ActionDelete, Kind: "binding-rule", Name: r.String(),
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, err := acl.BindingRuleDelete(id, q)
+					return err
+				}})
+		}
+		for _, m := range current.AuthMethods {
+			if _, ok := currentMethods[m.Name]; !ok {
+				continue
+			}
+			name := m.Name
+			deletes = append(deletes, &Change{Action: ActionDelete, Kind: "auth-method", Name: name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, err := acl.AuthMethodDelete(name, q)
+					return err
+				}})
+		}
+		for _, r := range current.Roles {
+			if _, ok := currentRoles[r.Name]; !ok {
+				continue
+			}
+			id := r.id
+			deletes = append(deletes, &Change{Action: ActionDelete, Kind: "role", Name: r.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, err := acl.RoleDelete(id, q)
+					return err
+				}})
+		}
+		for _, p := range current.Policies {
+			if _, ok := currentPolicies[p.Name]; !ok {
+				continue
+			}
+			id := p.id
+			deletes = append(deletes, &Change{Action: ActionDelete, Kind: "policy", Name: p.Name,
+				apply: func(acl *api.ACL, q *api.WriteOptions) error {
+					_, err := acl.PolicyDelete(id, q)
+					return err
+				}})
+		}
+	}
+
+	return &Plan{Changes: append(creates, deletes...)}
+}
+
+// equal compares resources by their encoding so that nil and empty values,
+// which are treated the same by the API, are considered equal.
+func equal(a, b interface{}) bool {
+	x, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	y, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	var xv, yv interface{}
+	if json.Unmarshal(x, &xv) != nil || json.Unmarshal(y, &yv) != nil {
+		return false
+	}
+	return reflect.DeepEqual(xv, yv)
+}
+
+func (r *BindingRule) String() string {
+	if r.Selector == "" {
+		return fmt.Sprintf("%s: %s %s", r.AuthMethod, r.BindType, r.BindName)
+	}
+	return fmt.Sprintf("%s: %s %s if %s", r.AuthMethod, r.BindType, r.BindName, r.Selector)
+}
+
+func (p *Policy) toAPI(id string) *api.ACLPolicy {
+	return &api.ACLPolicy{
+		ID:          id,
+		Name:        p.Name,
+		Description: p.Description,
+		Rules:       p.Rules,
+		Datacenters: p.Datacenters,
+	}
+}
+
+func (r *Role) toAPI(id string) *api.ACLRole {
+	return &api.ACLRole{
+		ID:                id,
+		Name:              r.Name,
+		Description:       r.Description,
+		Policies:          toLinks(r.Policies),
+		ServiceIdentities: toServiceIdentities(r.ServiceIdentities),
+		NodeIdentities:    toNodeIdentities(r.NodeIdentities),
+	}
+}
+
+func (m *AuthMethod) toAPI() *api.ACLAuthMethod {
+	// The TTL was validated when the bundle was parsed.
+	ttl, _ := time.ParseDuration(m.MaxTokenTTL)
+	return &api.ACLAuthMethod{
+		Name:          m.Name,
+		Type:          m.Type,
+		DisplayName:   m.DisplayName,
+		Description:   m.Description,
+		MaxTokenTTL:   ttl,
+		TokenLocality: m.TokenLocality,
+		Config:        m.Config,
+	}
+}
+
+func (r *BindingRule) toAPI(id string) *api.ACLBindingRule {
+	return &api.ACLBindingRule{
+		ID:          id,
+		AuthMethod:  r.AuthMethod,
+		Description: r.Description,
+		Selector:    r.Selector,
+		BindType:    api.BindingRuleBindType(r.BindType),
+		BindName:    r.BindName,
+		BoundCIDRs:  r.BoundCIDRs,
+	}
+}
+
+func (t *Token) toAPI() *api.ACLToken {
+	return &api.ACLToken{
+		AccessorID:        t.AccessorID,
+		Description:       t.Description,
+		Policies:          toLinks(t.Policies),
+		Roles:             toLinks(t.Roles),
+		ServiceIdentities: toServiceIdentities(t.ServiceIdentities),
+		NodeIdentities:    toNodeIdentities(t.NodeIdentities),
+		Local:             t.Local,
+		BoundCIDRs:        t.BoundCIDRs,
+		ExpirationTime:    t.ExpirationTime,
+	}
+}
@@ -0,0 +1,148 @@
+package aclimport
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/consul/command/acl/impexp"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/consul/command/helpers"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	plan  bool
+	prune bool
+
+	// testStdin is the input for testing.
+	testStdin io.Reader
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.BoolVar(&c.plan, "plan", false, "Only show the changes needed to make "+
+		"the cluster match the bundle, without making them.")
+	c.flags.BoolVar(&c.prune, "prune", false, "Delete policies, roles, auth methods "+
+		"and binding rules which are not in the bundle. Tokens are only deleted if the "+
+		"bundle includes tokens, and the token used to run the import is never deleted.")
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = c.flags.Args()
+	if len(args) != 1 {
+		c.UI.Error(fmt.Sprintf("Expected exactly one argument, got %d", len(args)))
+		return 1
+	}
+
+	data, err := helpers.LoadDataSource(args[0], c.testStdin)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to read bundle: %v", err))
+		return 1
+	}
+
+	desired, err := impexp.Parse(data)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	// The current auth methods include their secrets so that the secrets left
+	// out of the bundle are kept.
+	current, err := impexp.Export(client, len(desired.Tokens) > 0, true, nil)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	var keepToken string
+	if c.prune && len(desired.Tokens) > 0 {
+		self, _, err := client.ACL().TokenReadSelf(nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Failed to read the token used for the import: %v", err))
+			return 1
+		}
+		keepToken = self.AccessorID
+	}
+
+	plan := impexp.NewPlan(current, desired, c.prune, keepToken)
+	if len(plan.Changes) == 0 {
+		c.UI.Info("No changes. The ACL configuration of the cluster matches the bundle.")
+		return 0
+	}
+
+	for _, change := range plan.Changes {
+		c.UI.Output(change.String())
+	}
+	c.UI.Output("")
+	c.UI.Output(fmt.Sprintf("Plan: %s.", plan.Summary()))
+
+	if c.plan {
+		return 0
+	}
+
+	if err := plan.Apply(client, nil); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	c.UI.Info("Import complete.")
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Reconcile ACL policies, roles, auth methods and binding rules with a bundle"
+	help     = `
+Usage: consul acl import [options] DATA
+
+  Makes the ACL configuration of the cluster match a bundle written by
+  "consul acl export", creating and updating policies, roles, auth methods,
+  binding rules and tokens as needed. Resources are matched by name, binding
+  rules by their auth method, bind type, bind name and selector, and tokens by
+  their accessor ID. The changes are listed before they are made.
+
+  DATA is either a path prefixed with '@', '-' to read from stdin, or the
+  bundle itself.
+
+  Show the changes needed to match a bundle without making them:
+
+      $ consul acl import -plan @acl.hcl
+
+  Apply a bundle, deleting anything not included in it:
+
+      $ consul acl import -prune @acl.hcl
+`
+)
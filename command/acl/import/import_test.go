@@ -0,0 +1,146 @@
+package aclimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	aclexport "github.com/hashicorp/consul/command/acl/export"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestImportCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestImportCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, `
+	primary_datacenter = "dc1"
+	acl {
+		enabled = true
+		tokens {
+			initial_management = "root"
+		}
+	}`)
+
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	client := a.Client()
+	wq := &api.WriteOptions{Token: "root"}
+	_, _, err := client.ACL().PolicyCreate(&api.ACLPolicy{Name: "stale", Rules: `node_prefix "" { policy = "read" }`}, wq)
+	require.NoError(t, err)
+	_, _, err = client.ACL().PolicyCreate(&api.ACLPolicy{Name: "kv", Rules: `key_prefix "" { policy = "read" }`}, wq)
+	require.NoError(t, err)
+
+	bundle := `
+policy {
+  name  = "kv"
+  rules = "key_prefix \"\" { policy = \"write\" }"
+}
+
+policy {
+  name  = "web"
+  rules = "service \"web\" { policy = \"write\" }"
+}
+
+role {
+  name     = "web"
+  policies = ["web", "kv"]
+}
+`
+
+	run := func(t *testing.T, extra ...string) (int, string) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+		cmd.testStdin = strings.NewReader(bundle)
+		args := append([]string{
+			"-http-addr=" + a.HTTPAddr(),
+			"-token=root",
+		}, extra...)
+		code := cmd.Run(append(args, "-"))
+		require.Empty(t, ui.ErrorWriter.String())
+		return code, ui.OutputWriter.String()
+	}
+
+	t.Run("plan", func(t *testing.T) {
+		code, out := run(t, "-plan", "-prune")
+		require.Equal(t, 0, code)
+		require.Contains(t, out, `~ update policy "kv"`)
+		require.Contains(t, out, `+ create policy "web"`)
+		require.Contains(t, out, `+ create role "web"`)
+		require.Contains(t, out, `- delete policy "stale"`)
+		require.Contains(t, out, "Plan: 2 to create, 1 to update, 1 to delete.")
+
+		_, _, err = client.ACL().PolicyReadByName("web", &api.QueryOptions{Token: "root"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "ACL not found")
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		code, out := run(t)
+		require.Equal(t, 0, code)
+		require.Contains(t, out, "Plan: 2 to create, 1 to update, 0 to delete.")
+
+		qo := &api.QueryOptions{Token: "root"}
+		policy, _, err := client.ACL().PolicyReadByName("kv", qo)
+		require.NoError(t, err)
+		require.Equal(t, `key_prefix "" { policy = "write" }`, policy.Rules)
+
+		role, _, err := client.ACL().RoleReadByName("web", qo)
+		require.NoError(t, err)
+		require.NotNil(t, role)
+		require.Len(t, role.Policies, 2)
+
+		stale, _, err := client.ACL().PolicyReadByName("stale", qo)
+		require.NoError(t, err)
+		require.NotNil(t, stale)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		code, out := run(t, "-prune")
+		require.Equal(t, 0, code)
+		require.Contains(t, out, "Plan: 0 to create, 0 to update, 1 to delete.")
+
+		_, _, err = client.ACL().PolicyReadByName("stale", &api.QueryOptions{Token: "root"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "ACL not found")
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		code, out := run(t, "-prune")
+		require.Equal(t, 0, code)
+		require.Contains(t, out, "No changes.")
+	})
+
+	t.Run("round trip through export", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := aclexport.New(ui).Run([]string{
+			"-http-addr=" + a.HTTPAddr(),
+			"-token=root",
+			"-format=hcl",
+		})
+		require.Equal(t, 0, code)
+		require.Empty(t, ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), `name = "web"`)
+
+		bundle = ui.OutputWriter.String()
+		code, out := run(t, "-prune", "-plan")
+		require.Equal(t, 0, code)
+		require.Contains(t, out, "No changes.")
+	})
+}
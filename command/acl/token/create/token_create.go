@@ -35,6 +35,7 @@ type cmd struct {
 	roleNames     []string
 	serviceIdents []string
 	nodeIdents    []string
+	boundCIDRs    []string
 	expirationTTL time.Duration
 	local         bool
 	showMeta      bool
@@ -67,6 +68,9 @@ func (c *cmd) init() {
 		"NODENAME:DATACENTER")
 	c.flags.DurationVar(&c.expirationTTL, "expires-ttl", 0, "Duration of time this "+
 		"token should be valid for")
+	c.flags.Var((*flags.AppendSliceValue)(&c.boundCIDRs), "bound-cidr", "CIDR block or IP "+
+		"address this token may be used from. May be specified multiple times. If not "+
+		"specified the token may be used from any address")
 	c.flags.StringVar(
 		&c.format,
 		"format",
@@ -103,6 +107,7 @@ func (c *cmd) Run(args []string) int {
 		Local:       c.local,
 		AccessorID:  c.accessor,
 		SecretID:    c.secret,
+		BoundCIDRs:  c.boundCIDRs,
 	}
 	if c.expirationTTL > 0 {
 		newToken.ExpirationTTL = c.expirationTTL
@@ -73,6 +73,9 @@ func (f *prettyFormatter) FormatToken(token *api.ACLToken) (string, error) {
 	if token.ExpirationTime != nil && !token.ExpirationTime.IsZero() {
 		buffer.WriteString(fmt.Sprintf("Expiration Time:  %v\n", *token.ExpirationTime))
 	}
+	if len(token.BoundCIDRs) > 0 {
+		buffer.WriteString(fmt.Sprintf("Bound CIDRs:      %s\n", strings.Join(token.BoundCIDRs, ", ")))
+	}
 	if f.showMeta {
 		buffer.WriteString(fmt.Sprintf("Hash:             %x\n", token.Hash))
 		buffer.WriteString(fmt.Sprintf("Create Index:     %d\n", token.CreateIndex))
@@ -134,6 +137,9 @@ func (f *prettyFormatter) FormatTokenExpanded(token *api.ACLTokenExpanded) (stri
 	if token.ExpirationTime != nil && !token.ExpirationTime.IsZero() {
 		buffer.WriteString(fmt.Sprintf("Expiration Time:  %v\n", *token.ExpirationTime))
 	}
+	if len(token.BoundCIDRs) > 0 {
+		buffer.WriteString(fmt.Sprintf("Bound CIDRs:      %s\n", strings.Join(token.BoundCIDRs, ", ")))
+	}
 	if f.showMeta {
 		buffer.WriteString(fmt.Sprintf("Hash:             %x\n", token.Hash))
 		buffer.WriteString(fmt.Sprintf("Create Index:     %d\n", token.CreateIndex))
@@ -303,6 +309,9 @@ func (f *prettyFormatter) formatTokenListEntry(token *api.ACLTokenListEntry) str
 	if token.ExpirationTime != nil && !token.ExpirationTime.IsZero() {
 		buffer.WriteString(fmt.Sprintf("Expiration Time:  %v\n", *token.ExpirationTime))
 	}
+	if len(token.BoundCIDRs) > 0 {
+		buffer.WriteString(fmt.Sprintf("Bound CIDRs:      %s\n", strings.Join(token.BoundCIDRs, ", ")))
+	}
 	buffer.WriteString(fmt.Sprintf("Legacy:           %t\n", token.Legacy))
 	if f.showMeta {
 		buffer.WriteString(fmt.Sprintf("Hash:             %x\n", token.Hash))
@@ -32,6 +32,7 @@ type cmd struct {
 	roleNames          []string
 	serviceIdents      []string
 	nodeIdents         []string
+	boundCIDRs         []string
 	description        string
 	mergePolicies      bool
 	mergeRoles         bool
@@ -72,6 +73,9 @@ func (c *cmd) init() {
 	c.flags.Var((*flags.AppendSliceValue)(&c.nodeIdents), "node-identity", "Name of a "+
 		"node identity to use for this token. May be specified multiple times. Format is "+
 		"NODENAME:DATACENTER")
+	c.flags.Var((*flags.AppendSliceValue)(&c.boundCIDRs), "bound-cidr", "CIDR block or IP "+
+		"address this token may be used from. May be specified multiple times. If "+
+		"specified the existing bound CIDRs are replaced")
 	c.flags.BoolVar(&c.upgradeLegacy, "upgrade-legacy", false, "Add new polices "+
 		"to a legacy token replacing all existing rules. This will cause the legacy "+
 		"token to behave exactly like a new token but keep the same Secret.\n"+
@@ -141,6 +145,10 @@ func (c *cmd) Run(args []string) int {
 		t.Description = c.description
 	}
 
+	if len(c.boundCIDRs) > 0 {
+		t.BoundCIDRs = c.boundCIDRs
+	}
+
 	parsedServiceIdents, err := acl.ExtractServiceIdentities(c.serviceIdents)
 	if err != nil {
 		c.UI.Error(err.Error())
@@ -0,0 +1,87 @@
+package bundle
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	// Set up a client.
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	opts := &api.QueryOptions{
+		AllowStale: c.http.Stale(),
+	}
+	bundle, _, err := client.Connect().CABundle(opts)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error querying CA bundle: %s", err))
+		return 1
+	}
+	output, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error formatting CA bundle: %s", err))
+		return 1
+	}
+	c.UI.Output(string(output))
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Display the Connect CA roots as a SPIFFE trust bundle"
+const help = `
+Usage: consul connect ca bundle [options]
+
+  Displays the Connect Certificate Authority (CA) root certificates as a
+  SPIFFE trust bundle, which SPIFFE-aware systems outside of the service mesh
+  can use to verify certificates issued by Consul.
+
+  Write the bundle to a file:
+
+      $ consul connect ca bundle > bundle.json
+`
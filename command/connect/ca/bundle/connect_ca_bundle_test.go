@@ -0,0 +1,50 @@
+package bundle
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestConnectCABundleCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestConnectCABundleCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	testrpc.WaitForActiveCARoot(t, a.RPC, "dc1", nil)
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	args := []string{"-http-addr=" + a.HTTPAddr()}
+
+	code := c.Run(args)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	var bundle api.SPIFFEBundle
+	require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &bundle))
+	require.Len(t, bundle.Keys, 2)
+	require.Equal(t, "x509-svid", bundle.Keys[0].Use)
+	require.Equal(t, "EC", bundle.Keys[0].KeyType)
+	require.Len(t, bundle.Keys[0].Certificates, 1)
+	require.Equal(t, "jwt-svid", bundle.Keys[1].Use)
+	require.Equal(t, "EC", bundle.Keys[1].KeyType)
+	require.NotEmpty(t, bundle.Keys[1].KeyID)
+	require.Empty(t, bundle.Keys[1].Certificates)
+}
@@ -41,5 +41,9 @@ Usage: consul connect ca <subcommand> [options] [args]
 
       $ consul connect ca set-config -config-file ca.json
 
+  Display the roots as a SPIFFE trust bundle:
+
+      $ consul connect ca bundle
+
   For more examples, ask for subcommand help or view the documentation.
 `
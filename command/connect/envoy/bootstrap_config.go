@@ -7,14 +7,18 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 )
 
 const (
 	selfAdminName = "self_admin"
+
+	openTelemetryCollectorName = "opentelemetry_collector"
 )
 
 // BootstrapConfig is the set of keys we care about in a Connect.Proxy.Config
@@ -149,6 +153,111 @@ type BootstrapConfig struct {
 	// the bootstrap config. It's format may vary based on Envoy version used.
 	// See https://www.envoyproxy.io/docs/envoy/v1.9.0/api-v2/config/trace/v2/trace.proto.
 	TracingConfigJSON string `mapstructure:"envoy_tracing_json"`
+
+	// OutlierEventLogPath is the path of the file Envoy writes its outlier
+	// detection events to. New events can be sent to the local agent's
+	// /v1/agent/connect/outlier-events endpoint as they are written to
+	// reflect ejections in the catalog.
+	OutlierEventLogPath string `mapstructure:"envoy_outlier_event_log_path"`
+}
+
+// ApplyProxyConfig fills in the settings left unset by the Proxy.Config keys
+// from the structured metrics and tracing configuration of the proxy, which
+// usually comes from proxy-defaults. The opaque keys always take precedence
+// so existing escape hatches keep working.
+func (c *BootstrapConfig) ApplyProxyConfig(proxy *api.AgentServiceConnectProxyConfig) error {
+	if m := proxy.Metrics; m != nil {
+		if c.StatsdURL == "" {
+			c.StatsdURL = m.StatsdURL
+		}
+		if c.DogstatsdURL == "" {
+			c.DogstatsdURL = m.DogstatsdURL
+		}
+		if c.PrometheusBindAddr == "" {
+			c.PrometheusBindAddr = m.PrometheusBindAddr
+		}
+		if c.StatsBindAddr == "" {
+			c.StatsBindAddr = m.StatsBindAddr
+		}
+		if len(c.StatsTags) == 0 {
+			c.StatsTags = m.StatsTags
+		}
+		if c.StatsFlushInterval == "" && m.StatsFlushInterval != "" {
+			d, err := time.ParseDuration(m.StatsFlushInterval)
+			if err != nil {
+				return fmt.Errorf("invalid stats flush interval %q: %s", m.StatsFlushInterval, err)
+			}
+			// Envoy expects the proto3 JSON representation of a duration.
+			c.StatsFlushInterval = strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+		}
+	}
+
+	// Zipkin collectors are delivered over xDS, only OpenTelemetry ones need
+	// to be part of the bootstrap.
+	if t := proxy.Tracing; t != nil && t.Provider == api.OpenTelemetryTracingProvider && c.TracingConfigJSON == "" {
+		host, port, err := net.SplitHostPort(t.CollectorAddress)
+		if err != nil {
+			return fmt.Errorf("invalid tracing collector address: %s", err)
+		}
+
+		c.TracingConfigJSON = `{
+			"http": {
+				"name": "envoy.tracers.opentelemetry",
+				"typedConfig": {
+					"@type": "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig",
+					"grpc_service": {
+						"envoy_grpc": {
+							"cluster_name": "` + openTelemetryCollectorName + `"
+						}
+					}
+				}
+			}
+		}`
+
+		portNum, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || portNum == 0 {
+			return fmt.Errorf("invalid tracing collector address: invalid port %q", port)
+		}
+
+		// The collector address is user input, so the cluster is marshalled
+		// rather than interpolated into the JSON.
+		cluster, err := json.Marshal(map[string]interface{}{
+			"name":                   openTelemetryCollectorName,
+			"connect_timeout":        "5s",
+			"type":                   "STRICT_DNS",
+			"http2_protocol_options": map[string]interface{}{},
+			"loadAssignment": map[string]interface{}{
+				"clusterName": openTelemetryCollectorName,
+				"endpoints": []interface{}{
+					map[string]interface{}{
+						"lbEndpoints": []interface{}{
+							map[string]interface{}{
+								"endpoint": map[string]interface{}{
+									"address": map[string]interface{}{
+										"socket_address": map[string]interface{}{
+											"address":    host,
+											"port_value": portNum,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		clusterJSON := string(cluster)
+		if c.StaticClustersJSON == "" {
+			c.StaticClustersJSON = clusterJSON
+		} else {
+			c.StaticClustersJSON += ",\n" + clusterJSON
+		}
+	}
+
+	return nil
 }
 
 // Template returns the bootstrap template to use as a base.
@@ -238,6 +347,10 @@ func (c *BootstrapConfig) ConfigureArgs(args *BootstrapTplArgs, omitDeprecatedTa
 		args.StatsFlushInterval = c.StatsFlushInterval
 	}
 
+	if c.OutlierEventLogPath != "" {
+		args.OutlierEventLogPath = c.OutlierEventLogPath
+	}
+
 	return nil
 }
 
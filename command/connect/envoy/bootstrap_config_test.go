@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
 )
 
 const (
@@ -1004,6 +1006,17 @@ func TestBootstrapConfig_ConfigureArgs(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "outlier-event-log-path",
+			input: BootstrapConfig{
+				OutlierEventLogPath: "/var/log/envoy/outlier.log",
+			},
+			wantArgs: BootstrapTplArgs{
+				StatsConfigJSON:     defaultStatsConfigJSON,
+				OutlierEventLogPath: "/var/log/envoy/outlier.log",
+			},
+			wantErr: false,
+		},
 		{
 			name: "err-bad-prometheus-addr",
 			input: BootstrapConfig{
@@ -1165,6 +1178,156 @@ func TestBootstrapConfig_ConfigureArgs(t *testing.T) {
 	}
 }
 
+func TestBootstrapConfig_ApplyProxyConfig(t *testing.T) {
+	metrics := &api.MetricsConfig{
+		StatsdURL:          "udp://127.0.0.1:8125",
+		DogstatsdURL:       "unix:///var/run/dogstatsd.sock",
+		PrometheusBindAddr: "0.0.0.0:9102",
+		StatsBindAddr:      "0.0.0.0:9103",
+		StatsTags:          []string{"team=payments"},
+		StatsFlushInterval: "1m30s",
+	}
+
+	tests := map[string]struct {
+		input   BootstrapConfig
+		proxy   api.AgentServiceConnectProxyConfig
+		want    BootstrapConfig
+		wantErr string
+	}{
+		"nothing configured": {},
+		"metrics": {
+			proxy: api.AgentServiceConnectProxyConfig{Metrics: metrics},
+			want: BootstrapConfig{
+				StatsdURL:          "udp://127.0.0.1:8125",
+				DogstatsdURL:       "unix:///var/run/dogstatsd.sock",
+				PrometheusBindAddr: "0.0.0.0:9102",
+				StatsBindAddr:      "0.0.0.0:9103",
+				StatsTags:          []string{"team=payments"},
+				StatsFlushInterval: "90s",
+			},
+		},
+		"proxy config keys take precedence": {
+			input: BootstrapConfig{
+				StatsdURL:          "udp://10.0.0.1:8125",
+				StatsTags:          []string{"team=billing"},
+				StatsFlushInterval: "5s",
+			},
+			proxy: api.AgentServiceConnectProxyConfig{Metrics: metrics},
+			want: BootstrapConfig{
+				StatsdURL:          "udp://10.0.0.1:8125",
+				DogstatsdURL:       "unix:///var/run/dogstatsd.sock",
+				PrometheusBindAddr: "0.0.0.0:9102",
+				StatsBindAddr:      "0.0.0.0:9103",
+				StatsTags:          []string{"team=billing"},
+				StatsFlushInterval: "5s",
+			},
+		},
+		"invalid flush interval": {
+			proxy: api.AgentServiceConnectProxyConfig{
+				Metrics: &api.MetricsConfig{StatsFlushInterval: "often"},
+			},
+			wantErr: "invalid stats flush interval",
+		},
+		"zipkin tracing is not part of the bootstrap": {
+			proxy: api.AgentServiceConnectProxyConfig{
+				Tracing: &api.TracingConfig{
+					Provider:         api.ZipkinTracingProvider,
+					CollectorAddress: "zipkin:9411",
+				},
+			},
+		},
+		"opentelemetry tracing with an existing tracer": {
+			input: BootstrapConfig{TracingConfigJSON: `{"http": {}}`},
+			proxy: api.AgentServiceConnectProxyConfig{
+				Tracing: &api.TracingConfig{
+					Provider:         api.OpenTelemetryTracingProvider,
+					CollectorAddress: "otel-collector:4317",
+				},
+			},
+			want: BootstrapConfig{TracingConfigJSON: `{"http": {}}`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := tc.input
+			err := cfg.ApplyProxyConfig(&tc.proxy)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, cfg)
+		})
+	}
+}
+
+func TestBootstrapConfig_ApplyProxyConfig_OpenTelemetry(t *testing.T) {
+	cfg := BootstrapConfig{
+		StaticClustersJSON: `{"name": "custom"}`,
+	}
+	err := cfg.ApplyProxyConfig(&api.AgentServiceConnectProxyConfig{
+		Tracing: &api.TracingConfig{
+			Provider:         api.OpenTelemetryTracingProvider,
+			CollectorAddress: "otel-collector:4317",
+		},
+	})
+	require.NoError(t, err)
+
+	var tracing map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(cfg.TracingConfigJSON), &tracing))
+	require.Contains(t, cfg.TracingConfigJSON, `"envoy.tracers.opentelemetry"`)
+	require.Contains(t, cfg.TracingConfigJSON, `"cluster_name": "opentelemetry_collector"`)
+
+	var clusters []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte("["+cfg.StaticClustersJSON+"]"), &clusters))
+	require.Len(t, clusters, 2)
+	require.Equal(t, "custom", clusters[0]["name"])
+	require.Equal(t, "opentelemetry_collector", clusters[1]["name"])
+	require.Equal(t, map[string]interface{}{
+		"address":    "otel-collector",
+		"port_value": float64(4317),
+	}, collectorSocketAddress(t, clusters[1]))
+
+	t.Run("collector address is escaped", func(t *testing.T) {
+		var cfg BootstrapConfig
+		err := cfg.ApplyProxyConfig(&api.AgentServiceConnectProxyConfig{
+			Tracing: &api.TracingConfig{
+				Provider:         api.OpenTelemetryTracingProvider,
+				CollectorAddress: `evil"}}, {"name", "x:4317`,
+			},
+		})
+		require.NoError(t, err)
+
+		var cluster map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(cfg.StaticClustersJSON), &cluster))
+		require.Equal(t, `evil"}}, {"name", "x`, collectorSocketAddress(t, cluster)["address"])
+	})
+
+	t.Run("invalid port", func(t *testing.T) {
+		for _, addr := range []string{"otel-collector:4317}", "otel-collector:0", "otel-collector:70000"} {
+			var cfg BootstrapConfig
+			err := cfg.ApplyProxyConfig(&api.AgentServiceConnectProxyConfig{
+				Tracing: &api.TracingConfig{
+					Provider:         api.OpenTelemetryTracingProvider,
+					CollectorAddress: addr,
+				},
+			})
+			require.Error(t, err, addr)
+			require.Contains(t, err.Error(), "invalid port")
+		}
+	})
+}
+
+func collectorSocketAddress(t *testing.T, cluster map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	endpoints := cluster["loadAssignment"].(map[string]interface{})["endpoints"].([]interface{})
+	lbEndpoints := endpoints[0].(map[string]interface{})["lbEndpoints"].([]interface{})
+	endpoint := lbEndpoints[0].(map[string]interface{})["endpoint"].(map[string]interface{})
+	return endpoint["address"].(map[string]interface{})["socket_address"].(map[string]interface{})
+}
+
 func TestConsulTagSpecifiers(t *testing.T) {
 	// Conveniently both envoy and Go use the re2 dialect of regular
 	// expressions, so we can actually test the stats tag extraction regular
@@ -92,6 +92,10 @@ type BootstrapTplArgs struct {
 	// See https://www.envoyproxy.io/docs/envoy/v1.9.0/api-v2/config/trace/v2/trace.proto.
 	TracingConfigJSON string
 
+	// OutlierEventLogPath is the path of the file Envoy writes its outlier
+	// detection events to.
+	OutlierEventLogPath string
+
 	// Namespace is the Consul Enterprise Namespace of the proxy service instance
 	// as registered with the Consul agent.
 	Namespace string
@@ -247,6 +251,13 @@ const bootstrapTemplate = `{
   {{- if .TracingConfigJSON }}
   "tracing": {{ .TracingConfigJSON }},
   {{- end }}
+  {{- if .OutlierEventLogPath }}
+  "cluster_manager": {
+    "outlier_detection": {
+      "event_log_path": "{{ .OutlierEventLogPath }}"
+    }
+  },
+  {{- end }}
   "dynamic_resources": {
     "lds_config": {
       "ads": {},
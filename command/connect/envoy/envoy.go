@@ -628,6 +628,9 @@ func (c *cmd) generateConfig() ([]byte, error) {
 		if err := mapstructure.WeakDecode(svcProxyConfig.Config, &bsCfg); err != nil {
 			return nil, fmt.Errorf("failed parsing Proxy.Config: %s", err)
 		}
+		if err := bsCfg.ApplyProxyConfig(svcProxyConfig); err != nil {
+			return nil, err
+		}
 	}
 
 	return bsCfg.GenerateJSON(args, c.omitDeprecatedTags)
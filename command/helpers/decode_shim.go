@@ -106,3 +106,9 @@ func isHCL(v []byte) bool {
 
 	return true
 }
+
+// DecodeHCLOrJSON decodes data, which may be either HCL or JSON, into out
+// with the same handling as is used for config entries.
+func DecodeHCLOrJSON(out interface{}, data string) error {
+	return hclDecode(out, data)
+}
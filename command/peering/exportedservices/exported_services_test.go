@@ -0,0 +1,130 @@
+package exportedservices
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestExportedServicesCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestExportedServicesCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	acceptor := agent.NewTestAgent(t, ``)
+	t.Cleanup(func() { _ = acceptor.Shutdown() })
+
+	dialer := agent.NewTestAgent(t, `datacenter = "dc2"`)
+	t.Cleanup(func() { _ = dialer.Shutdown() })
+
+	testrpc.WaitForTestAgent(t, acceptor.RPC, "dc1")
+	testrpc.WaitForTestAgent(t, dialer.RPC, "dc2")
+
+	acceptingClient := acceptor.Client()
+	dialingClient := dialer.Client()
+
+	t.Run("name is required", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 1, code, "err: %s", ui.ErrorWriter.String())
+		require.Contains(t, ui.ErrorWriter.String(), "Missing the required -name flag")
+	})
+
+	t.Run("peering does not exist", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+			"-name=foo",
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 1, code, "err: %s", ui.ErrorWriter.String())
+		require.Contains(t, ui.ErrorWriter.String(), "No peering with name")
+	})
+
+	// Establish a peering from dc2 to dc1 and export a service to it.
+	res, _, err := acceptingClient.Peerings().GenerateToken(context.Background(),
+		api.PeeringGenerateTokenRequest{PeerName: "foo"}, &api.WriteOptions{})
+	require.NoError(t, err)
+
+	_, _, err = dialingClient.Peerings().Establish(context.Background(),
+		api.PeeringEstablishRequest{PeerName: "bar", PeeringToken: res.PeeringToken}, &api.WriteOptions{})
+	require.NoError(t, err)
+
+	_, _, err = acceptingClient.ConfigEntries().Set(&api.ExportedServicesConfigEntry{
+		Name: "default",
+		Services: []api.ExportedService{
+			{
+				Name:      "web",
+				Consumers: []api.ServiceConsumer{{PeerName: "foo"}},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, acceptingClient.Agent().ServiceRegister(&api.AgentServiceRegistration{
+		Name: "web",
+		Port: 8080,
+	}))
+
+	t.Run("pretty print", func(t *testing.T) {
+		retry.Run(t, func(r *retry.R) {
+			ui := cli.NewMockUi()
+			cmd := New(ui)
+
+			args := []string{
+				"-http-addr=" + acceptor.HTTPAddr(),
+				"-name=foo",
+			}
+
+			code := cmd.Run(args)
+			require.Equal(r, 0, code, "err: %s", ui.ErrorWriter.String())
+			require.Equal(r, "web", strings.TrimSpace(ui.OutputWriter.String()))
+		})
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+			"-name=foo",
+			"-format=json",
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 0, code, "err: %s", ui.ErrorWriter.String())
+
+		var services []string
+		require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &services))
+		require.Equal(t, []string{"web"}, services)
+	})
+}
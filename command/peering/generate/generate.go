@@ -0,0 +1,147 @@
+package generate
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/consul/command/peering"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	name              string
+	externalAddresses []string
+	expiresAfter      time.Duration
+	meta              map[string]string
+	format            string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+
+	c.flags.StringVar(&c.name, "name", "", "(Required) The local name assigned to the peer cluster.")
+
+	c.flags.Var((*flags.FlagMapValue)(&c.meta), "meta",
+		"Peering metadata to tag the peering with. This flag may be specified multiple times to set multiple meta fields.")
+
+	c.flags.Var((*flags.AppendSliceValue)(&c.externalAddresses), "server-external-addresses",
+		"A list of addresses to put into the generated token. Formatted as comma-separate list of addresses. "+
+			"This flag may be specified multiple times.")
+
+	c.flags.DurationVar(&c.expiresAfter, "expires-after", 0,
+		"How long the generated token can be used to establish the peering. Defaults to 24h.")
+
+	c.flags.StringVar(
+		&c.format,
+		"format",
+		peering.PrettyFormat,
+		fmt.Sprintf("Output format {%s} (default: %s)", strings.Join(peering.GetSupportedFormats(), "|"), peering.PrettyFormat),
+	)
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.PartitionFlag())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if c.name == "" {
+		c.UI.Error("Missing the required -name flag")
+		return 1
+	}
+
+	if c.format != peering.PrettyFormat && c.format != peering.JSONFormat {
+		c.UI.Error(fmt.Sprintf("Invalid format, valid formats are {%s}", strings.Join(peering.GetSupportedFormats(), "|")))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	peerings := client.Peerings()
+
+	req := api.PeeringGenerateTokenRequest{
+		PeerName:     c.name,
+		Meta:         c.meta,
+		ExpiresAfter: c.expiresAfter,
+	}
+	for _, addrs := range c.externalAddresses {
+		for _, addr := range strings.Split(addrs, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				req.ServerExternalAddresses = append(req.ServerExternalAddresses, addr)
+			}
+		}
+	}
+
+	res, _, err := peerings.GenerateToken(context.Background(), req, &api.WriteOptions{})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error generating peering token: %s", err))
+		return 1
+	}
+
+	if c.format == peering.JSONFormat {
+		output, err := json.Marshal(res)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshalling JSON: %s", err))
+			return 1
+		}
+		c.UI.Output(string(output))
+		return 0
+	}
+
+	c.UI.Info(res.PeeringToken)
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Generate a peering token"
+	help     = `
+Usage: consul peering generate-token [options] -name <peer name>
+
+  Generate a peering token. The name provided will be used locally by
+  this cluster to refer to the peering connection. Re-generating a token
+  for a given name will not interrupt any active connection, but will
+  invalidate any unused token for that name.
+
+  Example:
+
+    $ consul peering generate-token -name west-dc
+
+  Example using a load balancer in front of Consul servers:
+
+    $ consul peering generate-token -name west-dc -server-external-addresses load-balancer.elb.us-west-1.amazonaws.com:8502
+`
+)
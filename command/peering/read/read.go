@@ -0,0 +1,228 @@
+package read
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/consul/command/peering"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	name   string
+	format string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+
+	c.flags.StringVar(&c.name, "name", "", "(Required) The local name assigned to the peer cluster.")
+
+	c.flags.StringVar(
+		&c.format,
+		"format",
+		peering.PrettyFormat,
+		fmt.Sprintf("Output format {%s} (default: %s)", strings.Join(peering.GetSupportedFormats(), "|"), peering.PrettyFormat),
+	)
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.PartitionFlag())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if c.name == "" {
+		c.UI.Error("Missing the required -name flag")
+		return 1
+	}
+
+	if c.format != peering.PrettyFormat && c.format != peering.JSONFormat {
+		c.UI.Error(fmt.Sprintf("Invalid format, valid formats are {%s}", strings.Join(peering.GetSupportedFormats(), "|")))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	peerings := client.Peerings()
+
+	res, _, err := peerings.Read(context.Background(), c.name, &api.QueryOptions{})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading peering: %s", err))
+		return 1
+	}
+
+	if res == nil {
+		c.UI.Error(fmt.Sprintf("No peering with name %s found.", c.name))
+		return 1
+	}
+
+	if c.format == peering.JSONFormat {
+		output, err := json.MarshalIndent(res, "", "\t")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshalling JSON: %s", err))
+			return 1
+		}
+		c.UI.Output(string(output))
+		return 0
+	}
+
+	c.UI.Output(formatPeering(res))
+
+	return 0
+}
+
+func formatPeering(p *api.Peering) string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("Name:         %s\n", p.Name))
+	buffer.WriteString(fmt.Sprintf("ID:           %s\n", p.ID))
+	if p.Partition != "" {
+		buffer.WriteString(fmt.Sprintf("Partition:    %s\n", p.Partition))
+	}
+	if p.DeletedAt != nil {
+		buffer.WriteString(fmt.Sprintf("DeletedAt:    %s\n", formatTime(p.DeletedAt)))
+	}
+	buffer.WriteString(fmt.Sprintf("State:        %s\n", p.State))
+	if len(p.Meta) > 0 {
+		keys := make([]string, 0, len(p.Meta))
+		for k := range p.Meta {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		buffer.WriteString("Meta:\n")
+		for _, k := range keys {
+			buffer.WriteString(fmt.Sprintf("    %s=%s\n", k, p.Meta[k]))
+		}
+	}
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("Peer ID:               %s\n", p.PeerID))
+	buffer.WriteString(fmt.Sprintf("Peer Server Name:      %s\n", p.PeerServerName))
+	buffer.WriteString(fmt.Sprintf("Peer CA Pems:          %d\n", len(p.PeerCAPems)))
+	if len(p.PeerServerAddresses) > 0 {
+		buffer.WriteString("Peer Server Addresses:\n")
+		for _, v := range p.PeerServerAddresses {
+			buffer.WriteString(fmt.Sprintf("    %s\n", v))
+		}
+	}
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("Imported Services: %d\n", p.ImportedServiceCount))
+	buffer.WriteString(fmt.Sprintf("Exported Services: %d\n", p.ExportedServiceCount))
+
+	status := p.StreamStatus
+	buffer.WriteString("\n")
+	buffer.WriteString("Stream Status:\n")
+	buffer.WriteString(fmt.Sprintf("    Last Heartbeat:  %s\n", formatTime(status.LastHeartbeat)))
+	buffer.WriteString(fmt.Sprintf("    Last Receive:    %s\n", formatTime(status.LastReceive)))
+	buffer.WriteString(fmt.Sprintf("    Last Send:       %s\n", formatTime(status.LastSend)))
+	if status.LastError != nil {
+		buffer.WriteString(fmt.Sprintf("    Last Error:      %s\n", formatTime(status.LastError)))
+		buffer.WriteString(fmt.Sprintf("    Last Error Msg:  %s\n", status.LastErrorMessage))
+	}
+	if status.DisconnectTime != nil {
+		buffer.WriteString(fmt.Sprintf("    Disconnected:    %s\n", formatTime(status.DisconnectTime)))
+		if status.DisconnectErrorMessage != "" {
+			buffer.WriteString(fmt.Sprintf("    Disconnect Msg:  %s\n", status.DisconnectErrorMessage))
+		}
+	}
+	if status.UpdatedAt != nil {
+		buffer.WriteString(fmt.Sprintf("    Updated At:      %s\n", formatTime(status.UpdatedAt)))
+	}
+	writeList(&buffer, "Imported Services", status.ImportedServices)
+	writeList(&buffer, "Exported Services", status.ExportedServices)
+	writeVersions(&buffer, "Sent Resource Versions", status.SentResourceVersions)
+	writeVersions(&buffer, "Received Resource Versions", status.ReceivedResourceVersions)
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("Create Index: %d\n", p.CreateIndex))
+	buffer.WriteString(fmt.Sprintf("Modify Index: %d\n", p.ModifyIndex))
+
+	return buffer.String()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "never"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func writeList(buffer *bytes.Buffer, title string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	buffer.WriteString(fmt.Sprintf("    %s:\n", title))
+	for _, v := range values {
+		buffer.WriteString(fmt.Sprintf("        %s\n", v))
+	}
+}
+
+func writeVersions(buffer *bytes.Buffer, title string, versions map[string]uint64) {
+	if len(versions) == 0 {
+		return
+	}
+	urls := make([]string, 0, len(versions))
+	for url := range versions {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	buffer.WriteString(fmt.Sprintf("    %s:\n", title))
+	for _, url := range urls {
+		buffer.WriteString(fmt.Sprintf("        %s: %d\n", url, versions[url]))
+	}
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Read a peering connection"
+	help     = `
+Usage: consul peering read [options] -name <peer name>
+
+  Read a peering connection with the provided name, including the status of
+  its replication stream. If one is not found, the command exits with a
+  non-zero code.
+
+  Example:
+
+    $ consul peering read -name west-dc
+`
+)
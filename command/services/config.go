@@ -71,6 +71,19 @@ func serviceToAgentService(svc *structs.ServiceDefinition) (*api.AgentServiceReg
 		result.Check = nil
 	}
 
+	// The same applies to the optional proxy configuration blocks.
+	if p := result.Proxy; p != nil {
+		if p.AccessLogs != nil && reflect.DeepEqual(*p.AccessLogs, api.AccessLogsConfig{}) {
+			p.AccessLogs = nil
+		}
+		if p.Tracing != nil && reflect.DeepEqual(*p.Tracing, api.TracingConfig{}) {
+			p.Tracing = nil
+		}
+		if p.Metrics != nil && reflect.DeepEqual(*p.Metrics, api.MetricsConfig{}) {
+			p.Metrics = nil
+		}
+	}
+
 	return &result, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/consul/connect"
 	"github.com/hashicorp/consul/ipaddr"
 	"github.com/hashicorp/consul/lib"
+	"github.com/hashicorp/consul/sdk/iptables"
 	"github.com/hashicorp/go-hclog"
 )
 
@@ -38,6 +39,11 @@ type Config struct {
 	// Upstreams configures outgoing proxies for remote connect services.
 	Upstreams []UpstreamConfig `json:"upstreams" hcl:"upstreams"`
 
+	// TransparentProxy configures the listener for outbound connections that
+	// are redirected to the proxy. It is nil unless the proxy is in
+	// transparent mode.
+	TransparentProxy *TransparentListenerConfig `json:"transparent_proxy" hcl:"transparent_proxy"`
+
 	// Telemetry stores configuration for go-metrics. It is typically populated
 	// from the agent's runtime config via the proxy config endpoint so that the
 	// proxy will log metrics to the same location(s) as the agent.
@@ -70,6 +76,11 @@ type PublicListenerConfig struct {
 	// handshake. Setting this low avoids DOS by malicious clients holding
 	// resources open. Defaults to 10000 (10s).
 	HandshakeTimeoutMs int `json:"handshake_timeout_ms" hcl:"handshake_timeout_ms" mapstructure:"handshake_timeout_ms"`
+
+	// Protocol is the protocol of the proxied application. For http, http2
+	// and grpc the listener proxies HTTP requests and enforces L7
+	// intentions on each; otherwise it proxies TCP connections.
+	Protocol string `json:"protocol" hcl:"protocol" mapstructure:"protocol"`
 }
 
 // applyDefaults sets zero-valued params to a reasonable default.
@@ -85,6 +96,35 @@ func (plc *PublicListenerConfig) applyDefaults() {
 	}
 }
 
+// TransparentListenerConfig contains the parameters needed for the listener
+// of outbound connections that `consul connect redirect-traffic` redirects to
+// the proxy.
+type TransparentListenerConfig struct {
+	// BindAddress is the host/IP the outbound listener will bind to.
+	//
+	// BindPort is the port the outbound listener will bind to. It must be the
+	// port that outbound traffic is redirected to.
+	BindAddress string `json:"bind_address" hcl:"bind_address"`
+	BindPort    int    `json:"bind_port" hcl:"bind_port"`
+
+	// ConnectTimeoutMs is the timeout for establishing connections with
+	// upstream instances. Defaults to 10000 (10s).
+	ConnectTimeoutMs int `json:"connect_timeout_ms" hcl:"connect_timeout_ms"`
+}
+
+// applyDefaults sets zero-valued params to a reasonable default.
+func (tlc *TransparentListenerConfig) applyDefaults() {
+	if tlc.BindAddress == "" {
+		tlc.BindAddress = "127.0.0.1"
+	}
+	if tlc.BindPort == 0 {
+		tlc.BindPort = iptables.DefaultTProxyOutboundPort
+	}
+	if tlc.ConnectTimeoutMs == 0 {
+		tlc.ConnectTimeoutMs = 10000
+	}
+}
+
 // UpstreamConfig is an alias for api.Upstream so we can parse in a compatible
 // way but define custom methods for accessing the opaque config metadata.
 type UpstreamConfig api.Upstream
@@ -98,6 +138,13 @@ func (uc *UpstreamConfig) ConnectTimeout() time.Duration {
 	return 10000 * time.Millisecond
 }
 
+// Protocol returns the protocol field of the nested config struct, which the
+// agent sets from the upstream's service-defaults.
+func (uc *UpstreamConfig) Protocol() string {
+	protocol, _ := uc.Config["protocol"].(string)
+	return protocol
+}
+
 // applyDefaults sets zero-valued params to a reasonable default.
 func (uc *UpstreamConfig) applyDefaults() {
 	if uc.DestinationType == "" {
@@ -260,6 +307,14 @@ func (w *AgentConfigWatcher) handler(blockVal watch.BlockingParamVal,
 
 	cfg.PublicListener.applyDefaults()
 
+	if resp.Proxy.Mode == api.ProxyModeTransparent {
+		cfg.TransparentProxy = &TransparentListenerConfig{}
+		if tp := resp.Proxy.TransparentProxy; tp != nil {
+			cfg.TransparentProxy.BindPort = tp.OutboundListenerPort
+		}
+		cfg.TransparentProxy.applyDefaults()
+	}
+
 	for _, u := range resp.Proxy.Upstreams {
 		uc := UpstreamConfig(u)
 		uc.applyDefaults()
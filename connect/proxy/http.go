@@ -0,0 +1,427 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"golang.org/x/net/http2"
+
+	agConnect "github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/lib/retry"
+)
+
+// isHTTPProtocol returns whether connections to a service with the protocol
+// carry HTTP requests, which the proxy routes and authorizes individually.
+func isHTTPProtocol(protocol string) bool {
+	switch protocol {
+	case "http", "http2", "grpc":
+		return true
+	}
+	return false
+}
+
+// isHTTP2Protocol returns whether the protocol speaks HTTP/2. Connect proxies
+// and gRPC clients use HTTP/2 with prior knowledge since it isn't negotiated
+// with ALPN.
+func isHTTP2Protocol(protocol string) bool {
+	return protocol == "http2" || protocol == "grpc"
+}
+
+// newHTTPTransport returns a transport which sends requests with the protocol
+// over connections from dial. Connections may be TLS connections that are
+// already established, which requires requests to use the https scheme.
+func newHTTPTransport(protocol string, dial func(addr string) (net.Conn, error)) http.RoundTripper {
+	if isHTTP2Protocol(protocol) {
+		return &http2.Transport{
+			DialTLS: func(_, addr string, _ *tls.Config) (net.Conn, error) {
+				return dial(addr)
+			},
+		}
+	}
+	return &http.Transport{
+		DialTLSContext: func(_ context.Context, _, addr string) (net.Conn, error) {
+			return dial(addr)
+		},
+		MaxIdleConnsPerHost: 16,
+		IdleConnTimeout:     90 * time.Second,
+		// Connect peers may offer h2 with ALPN, but the protocol of the
+		// service decides whether HTTP/2 is spoken.
+		TLSNextProto: make(map[string]func(string, *tls.Conn) http.RoundTripper),
+	}
+}
+
+// authorizeFunc authorizes a request from the client with the certificate.
+type authorizeFunc func(r *http.Request, cert *x509.Certificate) (authorized bool, reason string, err error)
+
+// authorizeFuncFromClient returns an authorizeFunc which evaluates the
+// intentions of the service, including their L7 permissions, with the agent.
+func authorizeFuncFromClient(client *api.Client, service string) authorizeFunc {
+	return func(r *http.Request, cert *x509.Certificate) (bool, string, error) {
+		if len(cert.URIs) < 1 {
+			return false, "", errors.New("client certificate has no URI")
+		}
+		header := r.Header.Clone()
+		header.Set("Host", r.Host)
+		resp, err := client.Agent().ConnectAuthorize(&api.AgentAuthorizeParams{
+			Target:           service,
+			ClientCertURI:    cert.URIs[0].String(),
+			ClientCertSerial: agConnect.EncodeSerialNumber(cert.SerialNumber),
+			HTTP: &api.AgentAuthorizeHTTPRequest{
+				Method: r.Method,
+				Path:   r.URL.Path,
+				Header: header,
+			},
+		})
+		if err != nil {
+			return false, "", err
+		}
+		return resp.Authorized, resp.Reason, nil
+	}
+}
+
+// publicHTTPHandler authorizes the requests received by the public listener
+// and proxies them to the local application.
+type publicHTTPHandler struct {
+	authorize authorizeFunc
+	proxy     *httputil.ReverseProxy
+	logger    hclog.Logger
+}
+
+func newPublicHTTPHandler(cfg PublicListenerConfig, authorize authorizeFunc, logger hclog.Logger) *publicHTTPHandler {
+	timeout := time.Duration(cfg.LocalConnectTimeoutMs) * time.Millisecond
+	return &publicHTTPHandler{
+		authorize: authorize,
+		logger:    logger,
+		proxy: &httputil.ReverseProxy{
+			Director: func(r *http.Request) {
+				r.URL.Scheme = "https"
+				r.URL.Host = cfg.LocalServiceAddress
+			},
+			Transport: newHTTPTransport(cfg.Protocol, func(string) (net.Conn, error) {
+				return net.DialTimeout("tcp", cfg.LocalServiceAddress, timeout)
+			}),
+			FlushInterval: -1,
+			ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+				logger.Error("failed to proxy request", "error", err)
+				w.WriteHeader(http.StatusBadGateway)
+			},
+		},
+	}
+}
+
+func (h *publicHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		http.Error(w, "client certificate required", http.StatusForbidden)
+		return
+	}
+
+	authorized, reason, err := h.authorize(r, r.TLS.PeerCertificates[0])
+	if err != nil {
+		h.logger.Error("authz call failed", "error", err)
+		http.Error(w, "authz call failed", http.StatusServiceUnavailable)
+		return
+	}
+	if !authorized {
+		h.logger.Debug("authz call denied", "method", r.Method, "path", r.URL.Path, "reason", reason)
+		http.Error(w, "RBAC: access denied", http.StatusForbidden)
+		return
+	}
+
+	h.proxy.ServeHTTP(w, r)
+}
+
+// discoveryChainSource delivers the discovery chain of an upstream, and each
+// update of it, until stop is closed.
+type discoveryChainSource func(stop <-chan struct{}, update func(*api.CompiledDiscoveryChain))
+
+// discoveryChainSourceFromClient returns a discoveryChainSource which watches
+// the upstream's discovery chain with blocking queries.
+func discoveryChainSourceFromClient(client *api.Client, cfg UpstreamConfig, logger hclog.Logger) discoveryChainSource {
+	return func(stop <-chan struct{}, update func(*api.CompiledDiscoveryChain)) {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			<-stop
+			cancel()
+		}()
+
+		opts := &api.DiscoveryChainOptions{EvaluateInDatacenter: cfg.Datacenter}
+		waiter := &retry.Waiter{MinFailures: 1, MaxWait: time.Minute}
+		var index uint64
+		for ctx.Err() == nil {
+			q := &api.QueryOptions{
+				Namespace: cfg.DestinationNamespace,
+				Partition: cfg.DestinationPartition,
+				WaitIndex: index,
+			}
+			resp, meta, err := client.DiscoveryChain().Get(cfg.DestinationName, opts, q.WithContext(ctx))
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error("failed to fetch discovery chain", "error", err)
+					waiter.Wait(ctx)
+				}
+				continue
+			}
+			waiter.Reset()
+			if meta.LastIndex < index {
+				// The index went backwards, so start over.
+				index = 0
+				continue
+			}
+			index = meta.LastIndex
+			update(resp.Chain)
+		}
+	}
+}
+
+// targetResolverFunc returns the resolver for a discovery chain target.
+type targetResolverFunc func(target *api.DiscoveryTarget) (connect.Resolver, error)
+
+// targetResolverFuncFromClient returns a targetResolverFunc which discovers
+// the healthy instances of the target with the client.
+func targetResolverFuncFromClient(client *api.Client, cfg UpstreamConfig) targetResolverFunc {
+	return func(target *api.DiscoveryTarget) (connect.Resolver, error) {
+		return &connect.ConsulResolver{
+			Client:     client,
+			Namespace:  target.Namespace,
+			Partition:  cfg.DestinationPartition,
+			Name:       target.Service,
+			Type:       connect.ConsulResolverTypeService,
+			Datacenter: target.Datacenter,
+			Filter:     target.Subset.Filter,
+		}, nil
+	}
+}
+
+// httpRouteKey is the context key of the route of a request.
+type httpRouteKey struct{}
+
+// upstreamDialError is returned when the proxy can't connect to any instance of
+// a target, which can be retried.
+type upstreamDialError struct {
+	err error
+}
+
+func (e *upstreamDialError) Error() string { return e.err.Error() }
+func (e *upstreamDialError) Unwrap() error { return e.err }
+
+// upstreamHTTPHandler routes requests from the local application through the
+// discovery chain of the upstream and proxies them to the chosen target.
+type upstreamHTTPHandler struct {
+	svc          *connect.Service
+	resolverFunc targetResolverFunc
+	proxy        *httputil.ReverseProxy
+	logger       hclog.Logger
+
+	// chain is the latest *api.CompiledDiscoveryChain.
+	chain atomic.Value
+}
+
+func newUpstreamHTTPHandler(svc *connect.Service, cfg UpstreamConfig, resolverFunc targetResolverFunc,
+	logger hclog.Logger) *upstreamHTTPHandler {
+	h := &upstreamHTTPHandler{
+		svc:          svc,
+		resolverFunc: resolverFunc,
+		logger:       logger,
+	}
+	h.proxy = &httputil.ReverseProxy{
+		Director: func(r *http.Request) {
+			route := r.Context().Value(httpRouteKey{}).(*httpRoute)
+			// Connections are pooled by host, so use one host per target.
+			r.URL.Scheme = "https"
+			r.URL.Host = route.Target
+			route.rewrite(r)
+		},
+		Transport:     &retryTransport{base: newHTTPTransport(cfg.Protocol(), h.dial)},
+		FlushInterval: -1,
+		ModifyResponse: func(resp *http.Response) error {
+			route := resp.Request.Context().Value(httpRouteKey{}).(*httpRoute)
+			if route.Destination != nil {
+				modifyHeader(resp.Header, route.Destination.ResponseHeaders)
+			}
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			var dialErr *upstreamDialError
+			switch {
+			case errors.As(err, &dialErr):
+				logger.Error("failed to connect to upstream", "error", err)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			case errors.Is(err, context.DeadlineExceeded):
+				w.WriteHeader(http.StatusGatewayTimeout)
+			default:
+				logger.Error("failed to proxy request", "error", err)
+				w.WriteHeader(http.StatusBadGateway)
+			}
+		},
+	}
+	return h
+}
+
+func (h *upstreamHTTPHandler) setChain(chain *api.CompiledDiscoveryChain) {
+	h.chain.Store(chain)
+}
+
+func (h *upstreamHTTPHandler) getChain() *api.CompiledDiscoveryChain {
+	chain, _ := h.chain.Load().(*api.CompiledDiscoveryChain)
+	return chain
+}
+
+func (h *upstreamHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	chain := h.getChain()
+	if chain == nil {
+		http.Error(w, "upstream discovery chain not loaded yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	route, err := routeRequest(chain, r, func() float32 { return rand.Float32() * 100 })
+	if errors.Is(err, errNoRoute) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to route request", "error", err)
+		http.Error(w, "failed to route request", http.StatusServiceUnavailable)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), httpRouteKey{}, route)
+	if route.Destination != nil && route.Destination.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, route.Destination.RequestTimeout)
+		defer cancel()
+	}
+	h.proxy.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// dial connects to an instance of the target whose ID is the host of addr,
+// or of its failover targets if that fails.
+func (h *upstreamHTTPHandler) dial(addr string) (net.Conn, error) {
+	id, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		id = addr
+	}
+
+	chain := h.getChain()
+	if chain == nil {
+		return nil, &upstreamDialError{errors.New("upstream discovery chain not loaded yet")}
+	}
+
+	targets := []string{id}
+	timeout := defaultConnectTimeout
+	if resolver := resolverForTarget(chain, id); resolver != nil {
+		if resolver.Failover != nil {
+			targets = append(targets, resolver.Failover.Targets...)
+		}
+		if resolver.ConnectTimeout > 0 {
+			timeout = resolver.ConnectTimeout
+		}
+	}
+
+	for _, id := range targets {
+		target := chain.Targets[id]
+		if target == nil {
+			err = errors.New("unknown discovery chain target " + id)
+			continue
+		}
+		var resolver connect.Resolver
+		resolver, err = h.resolverFunc(target)
+		if err != nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		var conn net.Conn
+		conn, err = h.svc.Dial(ctx, resolver)
+		cancel()
+		if err == nil {
+			return conn, nil
+		}
+		h.logger.Debug("failed to connect to target", "target", id, "error", err)
+	}
+	return nil, &upstreamDialError{err}
+}
+
+// maxRetryBodySize is the largest request body that is buffered so that the
+// request can be retried. Requests with larger bodies are never retried.
+const maxRetryBodySize = 1 << 20
+
+// retryTransport retries requests as configured by the destination of their
+// route.
+type retryTransport struct {
+	base http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	route, _ := req.Context().Value(httpRouteKey{}).(*httpRoute)
+	if route == nil || route.Destination == nil {
+		return t.base.RoundTrip(req)
+	}
+	dest := route.Destination
+	if dest.NumRetries == 0 && !dest.RetryOnConnectFailure && len(dest.RetryOnStatusCodes) == 0 {
+		return t.base.RoundTrip(req)
+	}
+	retries := int(dest.NumRetries)
+	if retries == 0 {
+		// This is Envoy's default when retries are enabled.
+		retries = 1
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.ContentLength < 0 || req.ContentLength > maxRetryBodySize {
+			return t.base.RoundTrip(req)
+		}
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.Clone(req.Context())
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if attempt == retries {
+			return resp, err
+		}
+
+		if err != nil {
+			var dialErr *upstreamDialError
+			if dest.RetryOnConnectFailure && errors.As(err, &dialErr) {
+				continue
+			}
+			return nil, err
+		}
+		if !retryOnStatus(dest.RetryOnStatusCodes, resp.StatusCode) {
+			return resp, nil
+		}
+		resp.Body.Close()
+	}
+}
+
+func retryOnStatus(codes []uint32, code int) bool {
+	for _, c := range codes {
+		if int(c) == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,273 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	agConnect "github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/ipaddr"
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+// testHTTPApp returns an application which responds with its name and the
+// path and X-Admin header of each request. Applications with an HTTP/2
+// protocol only accept HTTP/2 requests with prior knowledge.
+func testHTTPApp(t *testing.T, name string, protocol string) *httptest.Server {
+	var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isHTTP2Protocol(protocol) && r.ProtoMajor != 2 {
+			w.WriteHeader(http.StatusHTTPVersionNotSupported)
+			return
+		}
+		fmt.Fprintf(w, "%s %s %s", name, r.URL.Path, r.Header.Get("X-Admin"))
+	})
+	if isHTTP2Protocol(protocol) {
+		h = h2c.NewHandler(h, &http2.Server{})
+	}
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// testServePublicHTTPListener runs a public HTTP listener for the service in
+// front of app.
+func testServePublicHTTPListener(t *testing.T, svc *connect.Service, app *httptest.Server,
+	protocol string, authorize authorizeFunc) *Listener {
+	cfg := PublicListenerConfig{
+		BindAddress:         "127.0.0.1",
+		BindPort:            freeport.GetOne(t),
+		LocalServiceAddress: app.Listener.Addr().String(),
+		Protocol:            protocol,
+	}
+	cfg.applyDefaults()
+
+	l := newPublicHTTPListenerWithAuthorizer(svc, cfg, authorize, testutil.Logger(t))
+	go func() {
+		if err := l.Serve(); err != nil {
+			t.Errorf("failed to listen: %v", err.Error())
+		}
+	}()
+	t.Cleanup(func() { l.Close() })
+	l.Wait()
+	return l
+}
+
+// testConnectHTTPClient returns a client which sends requests with the
+// protocol over Connect connections from svc.
+func testConnectHTTPClient(svc *connect.Service, protocol string, resolver connect.Resolver) *http.Client {
+	return &http.Client{
+		Transport: newHTTPTransport(protocol, func(string) (net.Conn, error) {
+			return svc.Dial(context.Background(), resolver)
+		}),
+	}
+}
+
+func testGet(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestPublicHTTPListener(t *testing.T) {
+	for _, protocol := range []string{"http", "http2"} {
+		protocol := protocol
+		t.Run(protocol, func(t *testing.T) {
+			ca := agConnect.TestCA(t, nil)
+			app := testHTTPApp(t, "db", protocol)
+
+			var clientURI string
+			authorize := func(r *http.Request, cert *x509.Certificate) (bool, string, error) {
+				clientURI = cert.URIs[0].String()
+				if r.URL.Path == "/private" {
+					return false, "denied", nil
+				}
+				return true, "allowed", nil
+			}
+
+			svc := connect.TestService(t, "db", ca)
+			l := testServePublicHTTPListener(t, svc, app, protocol, authorize)
+
+			client := testConnectHTTPClient(connect.TestService(t, "web", ca), protocol,
+				&connect.StaticResolver{
+					Addr:    l.BindAddr(),
+					CertURI: agConnect.TestSpiffeIDService(t, "db"),
+				})
+
+			code, body := testGet(t, client, "https://db/public")
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, "db /public ", body)
+			require.Equal(t, agConnect.TestSpiffeIDService(t, "web").URI().String(), clientURI)
+
+			code, _ = testGet(t, client, "https://db/private")
+			require.Equal(t, http.StatusForbidden, code)
+		})
+	}
+}
+
+func TestPublicHTTPListener_RequiresClientCert(t *testing.T) {
+	ca := agConnect.TestCA(t, nil)
+	app := testHTTPApp(t, "db", "http")
+
+	authorize := func(*http.Request, *x509.Certificate) (bool, string, error) {
+		return true, "allowed", nil
+	}
+	l := testServePublicHTTPListener(t, connect.TestService(t, "db", ca), app, "http", authorize)
+
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}}
+	_, err := client.Get("https://" + l.BindAddr() + "/")
+	require.Error(t, err)
+}
+
+func TestUpstreamHTTPListener(t *testing.T) {
+	for _, protocol := range []string{"http", "http2"} {
+		protocol := protocol
+		t.Run(protocol, func(t *testing.T) {
+			ca := agConnect.TestCA(t, nil)
+			allow := func(*http.Request, *x509.Certificate) (bool, string, error) {
+				return true, "allowed", nil
+			}
+
+			// Run two versions of db, each behind its own public listener.
+			backends := make(map[string]string)
+			for _, version := range []string{"v1", "v2"} {
+				app := testHTTPApp(t, version, protocol)
+				l := testServePublicHTTPListener(t, connect.TestService(t, "db", ca), app, protocol, allow)
+				backends[version+".db.default.default.dc1"] = l.BindAddr()
+			}
+			// v3 has no instances that accept connections and fails over to v1.
+			backends["v3.db.default.default.dc1"] = fmt.Sprintf("127.0.0.1:%d", freeport.GetOne(t))
+
+			chain := testUpstreamHTTPChain()
+			chainSource := func(stop <-chan struct{}, update func(*api.CompiledDiscoveryChain)) {
+				update(chain)
+				<-stop
+			}
+			resolverFunc := func(target *api.DiscoveryTarget) (connect.Resolver, error) {
+				return &connect.StaticResolver{
+					Addr:    backends[target.ID],
+					CertURI: agConnect.TestSpiffeIDService(t, target.Service),
+				}, nil
+			}
+
+			cfg := UpstreamConfig{
+				DestinationType:      "service",
+				DestinationNamespace: "default",
+				DestinationName:      "db",
+				Config:               map[string]interface{}{"protocol": protocol},
+				LocalBindAddress:     "127.0.0.1",
+				LocalBindPort:        freeport.GetOne(t),
+			}
+			svc := connect.TestService(t, "web", ca)
+			l := newUpstreamHTTPListenerWithSources(svc, cfg, chainSource, resolverFunc, testutil.Logger(t))
+			go func() {
+				if err := l.Serve(); err != nil {
+					t.Errorf("failed to listen: %v", err.Error())
+				}
+			}()
+			defer l.Close()
+			l.Wait()
+
+			// Play the part of the application, which speaks plaintext HTTP
+			// to its upstreams.
+			client := &http.Client{Transport: newHTTPTransport(protocol, func(addr string) (net.Conn, error) {
+				return net.Dial("tcp", addr)
+			})}
+			url := "https://" + ipaddr.FormatAddressPort(cfg.LocalBindAddress, cfg.LocalBindPort)
+
+			code, body := testGet(t, client, url+"/foo")
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, "v1 /foo ", body)
+
+			code, body = testGet(t, client, url+"/v2/foo")
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, "v2 /foo true", body)
+
+			code, body = testGet(t, client, url+"/v3/foo")
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, "v1 /v3/foo ", body)
+
+			code, _ = testGet(t, client, url+"/missing")
+			require.Equal(t, http.StatusNotFound, code)
+		})
+	}
+}
+
+// testUpstreamHTTPChain returns a discovery chain for "db" which sends /v2/ to
+// the v2 subset, /v3/ to the v3 subset which fails over to v1, and all other
+// requests under /foo to v1.
+func testUpstreamHTTPChain() *api.CompiledDiscoveryChain {
+	chain := &api.CompiledDiscoveryChain{
+		ServiceName: "db",
+		StartNode:   "router:db.default.default",
+		Nodes: map[string]*api.DiscoveryGraphNode{
+			"router:db.default.default": {
+				Type: api.DiscoveryGraphNodeTypeRouter,
+				Name: "db.default.default",
+				Routes: []*api.DiscoveryRoute{
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/v2/"}},
+							Destination: &api.ServiceRouteDestination{
+								PrefixRewrite:  "/",
+								RequestHeaders: &api.HTTPHeaderModifiers{Set: map[string]string{"X-Admin": "true"}},
+							},
+						},
+						NextNode: "resolver:v2.db.default.default.dc1",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/v3/"}},
+						},
+						NextNode: "resolver:v3.db.default.default.dc1",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/foo"}},
+						},
+						NextNode: "resolver:v1.db.default.default.dc1",
+					},
+				},
+			},
+		},
+		Targets: make(map[string]*api.DiscoveryTarget),
+	}
+	for _, version := range []string{"v1", "v2", "v3"} {
+		id := version + ".db.default.default.dc1"
+		resolver := &api.DiscoveryResolver{Target: id}
+		if version == "v3" {
+			resolver.Failover = &api.DiscoveryFailover{Targets: []string{"v1.db.default.default.dc1"}}
+		}
+		chain.Nodes["resolver:"+id] = &api.DiscoveryGraphNode{
+			Type:     api.DiscoveryGraphNodeTypeResolver,
+			Name:     id,
+			Resolver: resolver,
+		}
+		chain.Targets[id] = &api.DiscoveryTarget{
+			ID:            id,
+			Service:       "db",
+			ServiceSubset: version,
+			Namespace:     "default",
+			Datacenter:    "dc1",
+		}
+	}
+	return chain
+}
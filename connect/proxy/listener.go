@@ -5,12 +5,15 @@ import (
 	"crypto/tls"
 	"errors"
 	"net"
+	"net/http"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	metrics "github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
+	"golang.org/x/net/http2"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/connect"
@@ -18,8 +21,9 @@ import (
 )
 
 const (
-	publicListenerPrefix   = "inbound"
-	upstreamListenerPrefix = "upstream"
+	publicListenerPrefix      = "inbound"
+	upstreamListenerPrefix    = "upstream"
+	transparentListenerPrefix = "outbound"
 )
 
 // Listener is the implementation of a specific proxy listener. It has pluggable
@@ -30,10 +34,26 @@ type Listener struct {
 	Service *connect.Service
 
 	// listenFunc, dialFunc, and bindAddr are set by type-specific constructors.
+	// dialFunc is passed the accepted connection to proxy.
 	listenFunc func() (net.Listener, error)
-	dialFunc   func() (net.Conn, error)
+	dialFunc   func(src net.Conn) (net.Conn, error)
 	bindAddr   string
 
+	// httpHandler is set for listeners of services with an HTTP-based
+	// protocol. It serves each request on accepted connections instead of
+	// the connection being proxied to dialFunc. HTTP/1.1 connections are
+	// served by httpServer while HTTP/2 connections are served one by one.
+	httpHandler http.Handler
+	httpServer  *http.Server
+	http2       bool
+
+	// handshakeTimeout bounds the TLS handshake of HTTP/2 connections.
+	handshakeTimeout time.Duration
+
+	// watchFunc, if set, runs while the listener is serving to keep the
+	// state that httpHandler or dialFunc depend on up to date.
+	watchFunc func(stop <-chan struct{})
+
 	stopFlag int32
 	stopChan chan struct{}
 
@@ -69,7 +89,7 @@ func NewPublicListener(svc *connect.Service, cfg PublicListenerConfig,
 		listenFunc: func() (net.Listener, error) {
 			return tls.Listen("tcp", bindAddr, svc.ServerTLSConfig())
 		},
-		dialFunc: func() (net.Conn, error) {
+		dialFunc: func(net.Conn) (net.Conn, error) {
 			return net.DialTimeout("tcp", cfg.LocalServiceAddress,
 				time.Duration(cfg.LocalConnectTimeoutMs)*time.Millisecond)
 		},
@@ -107,7 +127,7 @@ func newUpstreamListenerWithResolver(svc *connect.Service, cfg UpstreamConfig,
 		listenFunc: func() (net.Listener, error) {
 			return net.Listen("tcp", bindAddr)
 		},
-		dialFunc: func() (net.Conn, error) {
+		dialFunc: func(net.Conn) (net.Conn, error) {
 			rf, err := resolverFunc(cfg)
 			if err != nil {
 				return nil, err
@@ -131,6 +151,142 @@ func newUpstreamListenerWithResolver(svc *connect.Service, cfg UpstreamConfig,
 	}
 }
 
+// NewPublicHTTPListener returns a Listener setup to listen for public mTLS
+// connections carrying HTTP requests. Each request is authorized against the
+// intentions of the service, including their HTTP permissions, and then
+// proxied to the configured local application.
+func NewPublicHTTPListener(svc *connect.Service, client *api.Client,
+	cfg PublicListenerConfig, logger hclog.Logger) *Listener {
+	return newPublicHTTPListenerWithAuthorizer(svc, cfg,
+		authorizeFuncFromClient(client, svc.Name()), logger)
+}
+
+func newPublicHTTPListenerWithAuthorizer(svc *connect.Service, cfg PublicListenerConfig,
+	authorize authorizeFunc, logger hclog.Logger) *Listener {
+	l := NewPublicListener(svc, cfg, logger)
+	// Requests are authorized one by one rather than the connection being
+	// authorized during the handshake.
+	l.listenFunc = func() (net.Listener, error) {
+		tlsCfg := svc.ServerTLSConfigWithoutAuthz()
+		if !isHTTP2Protocol(cfg.Protocol) {
+			// The HTTP/1.1 server drops connections which negotiated h2.
+			tlsCfg = withoutALPN(tlsCfg)
+		}
+		return tls.Listen("tcp", l.bindAddr, tlsCfg)
+	}
+	l.handshakeTimeout = time.Duration(cfg.HandshakeTimeoutMs) * time.Millisecond
+	l.setHTTPHandler(cfg.Protocol, newPublicHTTPHandler(cfg, authorize, l.logger))
+	return l
+}
+
+// withoutALPN returns a copy of a Connect server TLS config which doesn't
+// negotiate an application protocol with clients.
+func withoutALPN(cfg *tls.Config) *tls.Config {
+	cfg = cfg.Clone()
+	cfg.NextProtos = nil
+	getConfigForClient := cfg.GetConfigForClient
+	if getConfigForClient != nil {
+		cfg.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			clientCfg, err := getConfigForClient(hello)
+			if err != nil || clientCfg == nil {
+				return clientCfg, err
+			}
+			return withoutALPN(clientCfg), nil
+		}
+	}
+	return cfg
+}
+
+// NewUpstreamHTTPListener returns a Listener setup to listen locally for
+// connections carrying HTTP requests to an upstream service. Each request is
+// routed through the discovery chain of the upstream before it is proxied to
+// a discovered instance of the chosen target.
+func NewUpstreamHTTPListener(svc *connect.Service, client *api.Client,
+	cfg UpstreamConfig, logger hclog.Logger) *Listener {
+	return newUpstreamHTTPListenerWithSources(svc, cfg,
+		discoveryChainSourceFromClient(client, cfg, logger),
+		targetResolverFuncFromClient(client, cfg), logger)
+}
+
+func newUpstreamHTTPListenerWithSources(svc *connect.Service, cfg UpstreamConfig,
+	chainSource discoveryChainSource, resolverFunc targetResolverFunc,
+	logger hclog.Logger) *Listener {
+	l := newUpstreamListenerWithResolver(svc, cfg, nil, logger)
+	h := newUpstreamHTTPHandler(svc, cfg, resolverFunc, l.logger)
+	l.setHTTPHandler(cfg.Protocol(), h)
+	l.watchFunc = func(stop <-chan struct{}) {
+		chainSource(stop, h.setChain)
+	}
+	return l
+}
+
+// setHTTPHandler configures the listener to serve HTTP requests with the
+// protocol using the handler.
+func (l *Listener) setHTTPHandler(protocol string, handler http.Handler) {
+	l.httpHandler = l.instrumentHTTP(handler)
+	l.http2 = isHTTP2Protocol(protocol)
+	if l.http2 {
+		return
+	}
+	l.httpServer = &http.Server{
+		Handler:  l.httpHandler,
+		ErrorLog: l.logger.StandardLogger(&hclog.StandardLoggerOptions{InferLevels: true}),
+		// The protocol is configured rather than negotiated, so don't switch
+		// to HTTP/2 when a client offers it with ALPN.
+		TLSNextProto: make(map[string]func(*http.Server, *tls.Conn, http.Handler)),
+		ConnState: func(_ net.Conn, state http.ConnState) {
+			switch state {
+			case http.StateNew:
+				l.addActiveConns(1)
+			case http.StateHijacked, http.StateClosed:
+				l.addActiveConns(-1)
+			}
+		},
+	}
+}
+
+// instrumentHTTP counts the requests served by the handler by status code.
+func (l *Listener) instrumentHTTP(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(rw, r)
+		labels := append([]metrics.Label{{Name: "code", Value: strconv.Itoa(rw.status)}},
+			l.metricLabels...)
+		metrics.IncrCounterWithLabels([]string{l.metricPrefix, "requests"}, 1, labels)
+	})
+}
+
+// statusRecorder records the status code of a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusRecorder) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer.
+func (w *statusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // Serve runs the listener until it is stopped. It is an error to call Serve
 // more than once for any given Listener instance.
 func (l *Listener) Serve() error {
@@ -150,6 +306,18 @@ func (l *Listener) Serve() error {
 
 	close(l.listeningChan)
 
+	if l.watchFunc != nil {
+		go l.watchFunc(l.stopChan)
+	}
+
+	if l.httpServer != nil {
+		err := l.httpServer.Serve(listener)
+		if atomic.LoadInt32(&l.stopFlag) == 1 {
+			return nil
+		}
+		return err
+	}
+
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
@@ -172,7 +340,12 @@ func (l *Listener) handleConn(src net.Conn) {
 		l.connWG.Done()
 	}()
 
-	dst, err := l.dialFunc()
+	if l.httpHandler != nil {
+		l.serveHTTP2Conn(src)
+		return
+	}
+
+	dst, err := l.dialFunc(src)
 	if err != nil {
 		l.logger.Error("failed to dial", "error", err)
 		return
@@ -233,20 +406,55 @@ func (l *Listener) handleConn(src net.Conn) {
 	}
 }
 
+// serveHTTP2Conn serves the HTTP/2 requests on the connection until either
+// it or the listener is closed.
+func (l *Listener) serveHTTP2Conn(conn net.Conn) {
+	defer l.trackConn()()
+
+	// Complete the handshake first so that the peer certificate is known to
+	// the handler.
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		ctx, cancel := context.WithTimeout(context.Background(), l.handshakeTimeout)
+		err := tlsConn.HandshakeContext(ctx)
+		cancel()
+		if err != nil {
+			l.logger.Debug("TLS handshake failed", "error", err)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-l.stopChan:
+			conn.Close()
+		case <-ctx.Done():
+		}
+	}()
+
+	srv := &http2.Server{}
+	srv.ServeConn(conn, &http2.ServeConnOpts{
+		Context: ctx,
+		Handler: l.httpHandler,
+	})
+}
+
 // trackConn increments the count of active conns and returns a func() that can
 // be deferred on to decrement the counter again on connection close.
 func (l *Listener) trackConn() func() {
-	c := atomic.AddInt32(&l.activeConns, 1)
-	metrics.SetGaugeWithLabels([]string{l.metricPrefix, "conns"}, float32(c),
-		l.metricLabels)
-
+	l.addActiveConns(1)
 	return func() {
-		c := atomic.AddInt32(&l.activeConns, -1)
-		metrics.SetGaugeWithLabels([]string{l.metricPrefix, "conns"}, float32(c),
-			l.metricLabels)
+		l.addActiveConns(-1)
 	}
 }
 
+func (l *Listener) addActiveConns(delta int32) {
+	c := atomic.AddInt32(&l.activeConns, delta)
+	metrics.SetGaugeWithLabels([]string{l.metricPrefix, "conns"}, float32(c),
+		l.metricLabels)
+}
+
 // Close terminates the listener and all active connections.
 func (l *Listener) Close() error {
 	// Prevent the listener from being started.
@@ -262,6 +470,9 @@ func (l *Listener) Close() error {
 
 	// Stop outstanding requests.
 	close(l.stopChan)
+	if l.httpServer != nil {
+		l.httpServer.Close()
+	}
 
 	// Wait for all conns to close
 	l.connWG.Wait()
@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package proxy
+
+import (
+	"errors"
+	"net"
+)
+
+// originalDst returns the destination that a connection redirected to the
+// proxy by iptables was originally sent to. Traffic redirection is only
+// supported on Linux.
+func originalDst(net.Conn) (*net.TCPAddr, error) {
+	return nil, errors.New("transparent proxy is only supported on Linux")
+}
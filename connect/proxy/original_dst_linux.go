@@ -0,0 +1,73 @@
+//go:build linux
+// +build linux
+
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// soOriginalDst is the value of both SO_ORIGINAL_DST and IP6T_SO_ORIGINAL_DST,
+// the socket options netfilter answers with the destination a connection had
+// before it was redirected.
+const soOriginalDst = 80
+
+// originalDst returns the destination that a connection redirected to the
+// proxy by iptables was originally sent to.
+func originalDst(conn net.Conn) (*net.TCPAddr, error) {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return nil, errors.New("connection is not a TCP connection")
+	}
+	raw, err := tcpConn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	local, _ := tcpConn.LocalAddr().(*net.TCPAddr)
+	var addr *net.TCPAddr
+	var sockErr error
+	err = raw.Control(func(fd uintptr) {
+		if local != nil && local.IP.To4() != nil {
+			// The sockaddr_in is returned in the 16 bytes of an ipv6_mreq.
+			var mreq *unix.IPv6Mreq
+			mreq, sockErr = unix.GetsockoptIPv6Mreq(int(fd), unix.SOL_IP, soOriginalDst)
+			if sockErr != nil {
+				return
+			}
+			b := mreq.Multiaddr
+			addr = &net.TCPAddr{
+				IP:   net.IPv4(b[4], b[5], b[6], b[7]),
+				Port: int(b[2])<<8 | int(b[3]),
+			}
+			return
+		}
+
+		// The sockaddr_in6 is returned in the first bytes of an ip6_mtuinfo.
+		var info *unix.IPv6MTUInfo
+		info, sockErr = unix.GetsockoptIPv6MTUInfo(int(fd), unix.SOL_IPV6, soOriginalDst)
+		if sockErr != nil {
+			return
+		}
+		// The port is in network byte order.
+		port := (*[2]byte)(unsafe.Pointer(&info.Addr.Port))
+		ip := make(net.IP, net.IPv6len)
+		copy(ip, info.Addr.Addr[:])
+		addr = &net.TCPAddr{
+			IP:   ip,
+			Port: int(port[0])<<8 | int(port[1]),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sockErr != nil {
+		return nil, fmt.Errorf("failed to get original destination: %w", sockErr)
+	}
+	return addr, nil
+}
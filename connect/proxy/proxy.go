@@ -81,7 +81,12 @@ func (p *Proxy) Serve() error {
 					// the configuration to disable our public listener.
 					if newCfg.PublicListener.BindPort != 0 {
 						newCfg.PublicListener.applyDefaults()
-						l := NewPublicListener(p.service, newCfg.PublicListener, p.logger)
+						var l *Listener
+						if isHTTPProtocol(newCfg.PublicListener.Protocol) {
+							l = NewPublicHTTPListener(p.service, p.client, newCfg.PublicListener, p.logger)
+						} else {
+							l = NewPublicListener(p.service, newCfg.PublicListener, p.logger)
+						}
 						err = p.startListener("public listener", l)
 						if err != nil {
 							// This should probably be fatal.
@@ -90,6 +95,15 @@ func (p *Proxy) Serve() error {
 						}
 
 					}
+
+					if newCfg.TransparentProxy != nil {
+						l := NewTransparentListener(p.service, p.client, *newCfg.TransparentProxy, p.logger)
+						err = p.startListener("transparent listener", l)
+						if err != nil {
+							p.logger.Error("failed to start transparent listener", "error", err)
+							failCh <- err
+						}
+					}
 				}()
 			}
 
@@ -111,7 +125,12 @@ func (p *Proxy) Serve() error {
 					continue
 				}
 
-				l := NewUpstreamListener(p.service, p.client, uc, p.logger)
+				var l *Listener
+				if uc.DestinationType == api.UpstreamDestTypeService && isHTTPProtocol(uc.Protocol()) {
+					l = NewUpstreamHTTPListener(p.service, p.client, uc, p.logger)
+				} else {
+					l = NewUpstreamListener(p.service, p.client, uc, p.logger)
+				}
 				err := p.startListener(uc.String(), l)
 				if err != nil {
 					p.logger.Error("failed to start upstream",
@@ -0,0 +1,237 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib/stringslice"
+)
+
+// errNoRoute is returned when no route of a discovery chain matches a request.
+var errNoRoute = errors.New("no route matches the request")
+
+// httpRoute is where a request is sent, as decided by walking the discovery
+// chain of its upstream.
+type httpRoute struct {
+	// Match and Destination are the definition of the route the request
+	// matched. They are nil when the chain has no router.
+	Match       *api.ServiceRouteHTTPMatch
+	Destination *api.ServiceRouteDestination
+
+	// Target is the ID of the discovery chain target the request is sent to.
+	Target string
+}
+
+// routeRequest walks the discovery chain for the request. split returns a
+// random number in [0, 100) to pick between the splits of splitters.
+func routeRequest(chain *api.CompiledDiscoveryChain, r *http.Request, split func() float32) (*httpRoute, error) {
+	route := &httpRoute{}
+	name := chain.StartNode
+	for {
+		node := chain.Nodes[name]
+		if node == nil {
+			return nil, fmt.Errorf("discovery chain has no node %q", name)
+		}
+
+		switch node.Type {
+		case api.DiscoveryGraphNodeTypeRouter:
+			name = ""
+			for _, rt := range node.Routes {
+				var match *api.ServiceRouteMatch
+				if rt.Definition != nil {
+					match = rt.Definition.Match
+				}
+				if !routeMatches(match, r) {
+					continue
+				}
+				if match != nil {
+					route.Match = match.HTTP
+				}
+				if rt.Definition != nil {
+					route.Destination = rt.Definition.Destination
+				}
+				name = rt.NextNode
+				break
+			}
+			if name == "" {
+				return nil, errNoRoute
+			}
+
+		case api.DiscoveryGraphNodeTypeSplitter:
+			name = pickSplit(node.Splits, split())
+
+		case api.DiscoveryGraphNodeTypeResolver:
+			if node.Resolver == nil {
+				return nil, fmt.Errorf("discovery chain node %q has no resolver", name)
+			}
+			route.Target = node.Resolver.Target
+			return route, nil
+
+		default:
+			return nil, fmt.Errorf("discovery chain node %q has unknown type %q", name, node.Type)
+		}
+	}
+}
+
+// pickSplit returns the next node of the split which n, in [0, 100), falls
+// into. Split weights add up to 100.
+func pickSplit(splits []*api.DiscoverySplit, n float32) string {
+	var total float32
+	for _, split := range splits {
+		total += split.Weight
+		if n < total {
+			return split.NextNode
+		}
+	}
+	if len(splits) == 0 {
+		return ""
+	}
+	// Rounding can leave the weights just short of 100.
+	return splits[len(splits)-1].NextNode
+}
+
+// resolverForTarget returns the resolver node of the discovery chain for the
+// target, whose failover targets and connect timeout apply to it.
+func resolverForTarget(chain *api.CompiledDiscoveryChain, target string) *api.DiscoveryResolver {
+	for _, node := range chain.Nodes {
+		if node.Type == api.DiscoveryGraphNodeTypeResolver && node.Resolver != nil &&
+			node.Resolver.Target == target {
+			return node.Resolver
+		}
+	}
+	return nil
+}
+
+// rewrite applies the destination of the route to an outgoing request.
+func (rt *httpRoute) rewrite(r *http.Request) {
+	dest := rt.Destination
+	if dest == nil {
+		return
+	}
+
+	if dest.PrefixRewrite != "" && rt.Match != nil {
+		switch {
+		case rt.Match.PathPrefix != "":
+			r.URL.Path = dest.PrefixRewrite + strings.TrimPrefix(r.URL.Path, rt.Match.PathPrefix)
+			r.URL.RawPath = ""
+		case rt.Match.PathExact != "":
+			r.URL.Path = dest.PrefixRewrite
+			r.URL.RawPath = ""
+		}
+	}
+
+	modifyHeader(r.Header, dest.RequestHeaders)
+}
+
+// modifyHeader applies the header modifiers to h.
+func modifyHeader(h http.Header, mods *api.HTTPHeaderModifiers) {
+	if mods == nil {
+		return
+	}
+	for k, v := range mods.Add {
+		h.Add(k, v)
+	}
+	for k, v := range mods.Set {
+		h.Set(k, v)
+	}
+	for _, k := range mods.Remove {
+		h.Del(k)
+	}
+}
+
+// routeMatches returns whether the request matches all of the conditions of
+// the route. A route without conditions matches all requests.
+func routeMatches(match *api.ServiceRouteMatch, r *http.Request) bool {
+	if match == nil || match.HTTP == nil {
+		return true
+	}
+	m := match.HTTP
+
+	switch {
+	case m.PathExact != "":
+		if r.URL.Path != m.PathExact {
+			return false
+		}
+	case m.PathPrefix != "":
+		if !strings.HasPrefix(r.URL.Path, m.PathPrefix) {
+			return false
+		}
+	case m.PathRegex != "":
+		if !regexpFullMatch(m.PathRegex, r.URL.Path) {
+			return false
+		}
+	}
+
+	if len(m.Methods) > 0 && !stringslice.Contains(m.Methods, r.Method) {
+		return false
+	}
+
+	for _, hdr := range m.Header {
+		if !headerMatches(hdr, r) {
+			return false
+		}
+	}
+
+	query := r.URL.Query()
+	for _, qp := range m.QueryParam {
+		values, ok := query[qp.Name]
+		var match bool
+		switch {
+		case qp.Present:
+			match = ok
+		case qp.Exact != "":
+			match = ok && values[0] == qp.Exact
+		case qp.Regex != "":
+			match = ok && regexpFullMatch(qp.Regex, values[0])
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// headerMatches returns whether the request header matches. Multiple values of
+// a header are joined with commas, as Envoy does.
+func headerMatches(m api.ServiceRouteHTTPMatchHeader, r *http.Request) bool {
+	values := r.Header.Values(m.Name)
+	if strings.EqualFold(m.Name, "host") || m.Name == ":authority" {
+		// The Host header isn't kept in the header map of server requests.
+		values = []string{r.Host}
+	}
+	value := strings.Join(values, ",")
+
+	var match bool
+	switch {
+	case m.Present:
+		match = len(values) > 0
+	case m.Exact != "":
+		match = len(values) > 0 && value == m.Exact
+	case m.Prefix != "":
+		match = len(values) > 0 && strings.HasPrefix(value, m.Prefix)
+	case m.Suffix != "":
+		match = len(values) > 0 && strings.HasSuffix(value, m.Suffix)
+	case m.Regex != "":
+		match = len(values) > 0 && regexpFullMatch(m.Regex, value)
+	}
+	return match != m.Invert
+}
+
+// regexpFullMatch returns whether the regular expression matches all of s, as
+// Envoy's regex matchers do. Invalid expressions never match; they are
+// rejected when the config entry is written.
+func regexpFullMatch(expr, s string) bool {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return false
+	}
+	return re.MatchString(s)
+}
+
+// defaultConnectTimeout is used for targets whose resolver doesn't set one.
+const defaultConnectTimeout = 5 * time.Second
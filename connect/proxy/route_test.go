@@ -0,0 +1,232 @@
+package proxy
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// testRouteChain returns a discovery chain for "web" which sends /admin to the
+// "admin" subset, canary requests to a 90/10 split between the "v1" and "v2"
+// subsets and everything else to "v1".
+func testRouteChain() *api.CompiledDiscoveryChain {
+	resolver := func(subset string) *api.DiscoveryGraphNode {
+		return &api.DiscoveryGraphNode{
+			Type: api.DiscoveryGraphNodeTypeResolver,
+			Name: subset + ".web.default.default.dc1",
+			Resolver: &api.DiscoveryResolver{
+				Target: subset + ".web.default.default.dc1",
+			},
+		}
+	}
+	return &api.CompiledDiscoveryChain{
+		ServiceName: "web",
+		Protocol:    "http",
+		StartNode:   "router:web.default.default",
+		Nodes: map[string]*api.DiscoveryGraphNode{
+			"router:web.default.default": {
+				Type: api.DiscoveryGraphNodeTypeRouter,
+				Name: "web.default.default",
+				Routes: []*api.DiscoveryRoute{
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{
+								PathPrefix: "/admin/",
+							}},
+							Destination: &api.ServiceRouteDestination{
+								PrefixRewrite: "/",
+								RequestHeaders: &api.HTTPHeaderModifiers{
+									Set:    map[string]string{"x-admin": "true"},
+									Remove: []string{"x-debug"},
+								},
+							},
+						},
+						NextNode: "resolver:admin.web.default.default.dc1",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{
+								Header: []api.ServiceRouteHTTPMatchHeader{
+									{Name: "x-canary", Exact: "1"},
+								},
+								Methods: []string{"GET"},
+							}},
+						},
+						NextNode: "splitter:web.default.default",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{
+								PathPrefix: "/",
+							}},
+						},
+						NextNode: "resolver:v1.web.default.default.dc1",
+					},
+				},
+			},
+			"splitter:web.default.default": {
+				Type: api.DiscoveryGraphNodeTypeSplitter,
+				Name: "web.default.default",
+				Splits: []*api.DiscoverySplit{
+					{Weight: 90, NextNode: "resolver:v1.web.default.default.dc1"},
+					{Weight: 10, NextNode: "resolver:v2.web.default.default.dc1"},
+				},
+			},
+			"resolver:admin.web.default.default.dc1": resolver("admin"),
+			"resolver:v1.web.default.default.dc1":    resolver("v1"),
+			"resolver:v2.web.default.default.dc1":    resolver("v2"),
+		},
+	}
+}
+
+func TestRouteRequest(t *testing.T) {
+	chain := testRouteChain()
+
+	type testcase struct {
+		method string
+		path   string
+		header map[string]string
+		split  float32
+		target string
+	}
+	cases := map[string]testcase{
+		"default route": {
+			method: "GET",
+			path:   "/foo",
+			target: "v1.web.default.default.dc1",
+		},
+		"path prefix": {
+			method: "GET",
+			path:   "/admin/users",
+			target: "admin.web.default.default.dc1",
+		},
+		"canary split low": {
+			method: "GET",
+			path:   "/foo",
+			header: map[string]string{"X-Canary": "1"},
+			split:  89.9,
+			target: "v1.web.default.default.dc1",
+		},
+		"canary split high": {
+			method: "GET",
+			path:   "/foo",
+			header: map[string]string{"X-Canary": "1"},
+			split:  90,
+			target: "v2.web.default.default.dc1",
+		},
+		"canary wrong method": {
+			method: "POST",
+			path:   "/foo",
+			header: map[string]string{"X-Canary": "1"},
+			split:  99,
+			target: "v1.web.default.default.dc1",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.path, nil)
+			for k, v := range tc.header {
+				r.Header.Set(k, v)
+			}
+			route, err := routeRequest(chain, r, func() float32 { return tc.split })
+			require.NoError(t, err)
+			require.Equal(t, tc.target, route.Target)
+		})
+	}
+}
+
+func TestRouteRequest_NoRoute(t *testing.T) {
+	chain := testRouteChain()
+	router := chain.Nodes[chain.StartNode]
+	router.Routes = router.Routes[:1]
+
+	r := httptest.NewRequest("GET", "/foo", nil)
+	_, err := routeRequest(chain, r, func() float32 { return 0 })
+	require.Equal(t, errNoRoute, err)
+}
+
+func TestHTTPRouteRewrite(t *testing.T) {
+	chain := testRouteChain()
+
+	r := httptest.NewRequest("GET", "/admin/users?all=true", nil)
+	r.Header.Set("X-Debug", "1")
+	route, err := routeRequest(chain, r, func() float32 { return 0 })
+	require.NoError(t, err)
+
+	route.rewrite(r)
+	require.Equal(t, "/users", r.URL.Path)
+	require.Equal(t, "all=true", r.URL.RawQuery)
+	require.Equal(t, "true", r.Header.Get("X-Admin"))
+	require.Empty(t, r.Header.Get("X-Debug"))
+}
+
+func TestRouteMatches(t *testing.T) {
+	type testcase struct {
+		match  api.ServiceRouteHTTPMatch
+		url    string
+		host   string
+		header map[string]string
+		expect bool
+	}
+	cases := map[string]testcase{
+		"path exact": {
+			match:  api.ServiceRouteHTTPMatch{PathExact: "/foo"},
+			url:    "/foo",
+			expect: true,
+		},
+		"path exact mismatch": {
+			match: api.ServiceRouteHTTPMatch{PathExact: "/foo"},
+			url:   "/foo/bar",
+		},
+		"path regex must match whole path": {
+			match: api.ServiceRouteHTTPMatch{PathRegex: "/fo+"},
+			url:   "/foobar",
+		},
+		"header present": {
+			match:  api.ServiceRouteHTTPMatch{Header: []api.ServiceRouteHTTPMatchHeader{{Name: "x-foo", Present: true}}},
+			url:    "/",
+			header: map[string]string{"X-Foo": ""},
+			expect: true,
+		},
+		"header inverted": {
+			match:  api.ServiceRouteHTTPMatch{Header: []api.ServiceRouteHTTPMatchHeader{{Name: "x-foo", Exact: "bar", Invert: true}}},
+			url:    "/",
+			header: map[string]string{"X-Foo": "baz"},
+			expect: true,
+		},
+		"host header suffix": {
+			match:  api.ServiceRouteHTTPMatch{Header: []api.ServiceRouteHTTPMatchHeader{{Name: "host", Suffix: ".example.com"}}},
+			url:    "/",
+			host:   "web.example.com",
+			expect: true,
+		},
+		"query param regex": {
+			match:  api.ServiceRouteHTTPMatch{QueryParam: []api.ServiceRouteHTTPMatchQueryParam{{Name: "v", Regex: "[0-9]+"}}},
+			url:    "/?v=12",
+			expect: true,
+		},
+		"query param missing": {
+			match: api.ServiceRouteHTTPMatch{QueryParam: []api.ServiceRouteHTTPMatchQueryParam{{Name: "v", Present: true}}},
+			url:   "/",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.url, nil)
+			if tc.host != "" {
+				r.Host = tc.host
+			}
+			for k, v := range tc.header {
+				r.Header.Set(k, v)
+			}
+			require.Equal(t, tc.expect, routeMatches(&api.ServiceRouteMatch{HTTP: &tc.match}, r))
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/ipaddr"
+	"github.com/hashicorp/consul/lib/retry"
+)
+
+// taggedAddressVirtualIP is the tagged address of Connect service instances
+// which holds the virtual IP of the service.
+const taggedAddressVirtualIP = "consul-virtual"
+
+// virtualIPTable maps the virtual IPs of Connect services back to the
+// services.
+type virtualIPTable struct {
+	sync.RWMutex
+
+	services map[string]string
+
+	// meshDestinationsOnly is set by the mesh config entry. When it's set,
+	// connections to destinations that aren't in the mesh are refused rather
+	// than passed through.
+	meshDestinationsOnly bool
+
+	// loaded is closed once the table was first loaded.
+	loaded     chan struct{}
+	loadedOnce sync.Once
+}
+
+func newVirtualIPTable() *virtualIPTable {
+	return &virtualIPTable{
+		services: make(map[string]string),
+		loaded:   make(chan struct{}),
+	}
+}
+
+// lookup returns the name of the service with the virtual IP.
+func (t *virtualIPTable) lookup(ip net.IP) (string, bool) {
+	t.RLock()
+	defer t.RUnlock()
+	service, ok := t.services[ip.String()]
+	return service, ok
+}
+
+func (t *virtualIPTable) passthroughAllowed() bool {
+	t.RLock()
+	defer t.RUnlock()
+	return !t.meshDestinationsOnly
+}
+
+// update replaces the contents of the table.
+func (t *virtualIPTable) update(services map[string]string, meshDestinationsOnly bool) {
+	t.Lock()
+	t.services = services
+	t.meshDestinationsOnly = meshDestinationsOnly
+	t.Unlock()
+	t.loadedOnce.Do(func() { close(t.loaded) })
+}
+
+// virtualIPSource keeps a virtualIPTable up to date until stop is closed.
+type virtualIPSource func(stop <-chan struct{}, table *virtualIPTable)
+
+// virtualIPSourceFromClient returns a virtualIPSource which reads the virtual
+// IPs of services from the tagged addresses of their Connect instances in the
+// catalog. It watches the catalog's services with blocking queries and
+// fetches the virtual IP of each service it doesn't know yet. Virtual IPs are
+// kept by the catalog for as long as a service has instances.
+func virtualIPSourceFromClient(client *api.Client, logger hclog.Logger) virtualIPSource {
+	return func(stop <-chan struct{}, table *virtualIPTable) {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			<-stop
+			cancel()
+		}()
+
+		// vips is the virtual IP of each service by name.
+		vips := make(map[string]string)
+		waiter := &retry.Waiter{MinFailures: 1, MaxWait: time.Minute}
+		var index uint64
+		for ctx.Err() == nil {
+			q := &api.QueryOptions{WaitIndex: index}
+			services, meta, err := client.Catalog().Services(q.WithContext(ctx))
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error("failed to list services", "error", err)
+					waiter.Wait(ctx)
+				}
+				continue
+			}
+			waiter.Reset()
+			if meta.LastIndex < index {
+				// The index went backwards, so start over.
+				index = 0
+				continue
+			}
+			index = meta.LastIndex
+
+			for name := range vips {
+				if _, ok := services[name]; !ok {
+					delete(vips, name)
+				}
+			}
+			for name := range services {
+				if _, ok := vips[name]; ok {
+					continue
+				}
+				vip, err := fetchVirtualIP(ctx, client, name)
+				if err != nil {
+					logger.Warn("failed to fetch virtual IP", "service", name, "error", err)
+					continue
+				}
+				if vip != "" {
+					vips[name] = vip
+				}
+			}
+
+			meshDestinationsOnly, err := fetchMeshDestinationsOnly(ctx, client)
+			if err != nil {
+				logger.Warn("failed to fetch mesh config entry", "error", err)
+			}
+
+			byVIP := make(map[string]string, len(vips))
+			for name, vip := range vips {
+				byVIP[vip] = name
+			}
+			table.update(byVIP, meshDestinationsOnly)
+		}
+	}
+}
+
+// fetchVirtualIP returns the virtual IP of the service, or "" if it has none
+// because it has no Connect instances.
+func fetchVirtualIP(ctx context.Context, client *api.Client, service string) (string, error) {
+	q := &api.QueryOptions{}
+	instances, _, err := client.Catalog().Connect(service, "", q.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	for _, instance := range instances {
+		if vip := instance.ServiceTaggedAddresses[taggedAddressVirtualIP]; vip.Address != "" {
+			return vip.Address, nil
+		}
+	}
+	return "", nil
+}
+
+// fetchMeshDestinationsOnly returns whether the mesh config entry restricts
+// transparent proxies to destinations in the mesh.
+func fetchMeshDestinationsOnly(ctx context.Context, client *api.Client) (bool, error) {
+	q := &api.QueryOptions{}
+	entry, _, err := client.ConfigEntries().Get(api.MeshConfig, api.MeshConfigMesh, q.WithContext(ctx))
+	var statusErr api.StatusError
+	if errors.As(err, &statusErr) && statusErr.Code == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	mesh, ok := entry.(*api.MeshConfigEntry)
+	return ok && mesh.TransparentProxy.MeshDestinationsOnly, nil
+}
+
+// NewTransparentListener returns a Listener setup to accept outbound
+// connections which iptables redirected to the proxy. Connections to the
+// virtual IP of a Connect service are proxied to a discovered instance of the
+// service, and other connections are passed through to their original
+// destination unless the mesh config entry forbids it.
+func NewTransparentListener(svc *connect.Service, client *api.Client,
+	cfg TransparentListenerConfig, logger hclog.Logger) *Listener {
+	return newTransparentListenerWithSources(svc, cfg,
+		virtualIPSourceFromClient(client, logger), originalDst,
+		func(service string) connect.Resolver {
+			return &connect.ConsulResolver{
+				Client: client,
+				Name:   service,
+				Type:   connect.ConsulResolverTypeService,
+			}
+		}, logger)
+}
+
+func newTransparentListenerWithSources(svc *connect.Service, cfg TransparentListenerConfig,
+	vipSource virtualIPSource, originalDstFunc func(net.Conn) (*net.TCPAddr, error),
+	resolverFunc func(service string) connect.Resolver, logger hclog.Logger) *Listener {
+	bindAddr := ipaddr.FormatAddressPort(cfg.BindAddress, cfg.BindPort)
+	timeout := time.Duration(cfg.ConnectTimeoutMs) * time.Millisecond
+	table := newVirtualIPTable()
+	logger = logger.Named(transparentListenerPrefix)
+	return &Listener{
+		Service: svc,
+		listenFunc: func() (net.Listener, error) {
+			return net.Listen("tcp", bindAddr)
+		},
+		dialFunc: func(src net.Conn) (net.Conn, error) {
+			dst, err := originalDstFunc(src)
+			if err != nil {
+				return nil, err
+			}
+
+			// Wait for the virtual IPs so that connections made right after
+			// the proxy started aren't passed through by mistake.
+			select {
+			case <-table.loaded:
+			case <-time.After(timeout):
+				return nil, errors.New("virtual IPs not loaded yet")
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			if service, ok := table.lookup(dst.IP); ok {
+				logger.Trace("proxying connection", "service", service, "dst", dst)
+				return svc.Dial(ctx, resolverFunc(service))
+			}
+			if !table.passthroughAllowed() {
+				return nil, errors.New("destination " + dst.String() + " is not in the mesh")
+			}
+			logger.Trace("passing connection through", "dst", dst)
+			var d net.Dialer
+			return d.DialContext(ctx, "tcp", dst.String())
+		},
+		watchFunc: func(stop <-chan struct{}) {
+			vipSource(stop, table)
+		},
+		bindAddr:      bindAddr,
+		stopChan:      make(chan struct{}),
+		listeningChan: make(chan struct{}),
+		logger:        logger,
+		metricPrefix:  transparentListenerPrefix,
+		metricLabels:  []metrics.Label{{Name: "src", Value: svc.Name()}},
+	}
+}
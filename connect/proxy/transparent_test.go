@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	agConnect "github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+func TestTransparentListener(t *testing.T) {
+	ca := agConnect.TestCA(t, nil)
+
+	// Run a Connect service for the virtual IP and a plain TCP service to
+	// pass connections through to.
+	testSvr := connect.NewTestServer(t, "db", ca)
+	go func() {
+		err := testSvr.Serve()
+		require.NoError(t, err)
+	}()
+	defer testSvr.Close()
+	<-testSvr.Listening
+
+	external := NewTestTCPServer(t)
+	defer external.Close()
+
+	var meshDestinationsOnly int32
+	vipSource := func(stop <-chan struct{}, table *virtualIPTable) {
+		table.update(map[string]string{"240.0.0.1": "db"}, atomic.LoadInt32(&meshDestinationsOnly) == 1)
+		<-stop
+	}
+
+	// The original destination of the next connection.
+	var dst atomic.Value
+	originalDstFunc := func(net.Conn) (*net.TCPAddr, error) {
+		return dst.Load().(*net.TCPAddr), nil
+	}
+	resolverFunc := func(service string) connect.Resolver {
+		require.Equal(t, "db", service)
+		return &connect.StaticResolver{
+			Addr:    testSvr.Addr,
+			CertURI: agConnect.TestSpiffeIDService(t, "db"),
+		}
+	}
+
+	start := func(t *testing.T) *Listener {
+		cfg := TransparentListenerConfig{BindPort: freeport.GetOne(t)}
+		cfg.applyDefaults()
+		l := newTransparentListenerWithSources(connect.TestService(t, "web", ca), cfg,
+			vipSource, originalDstFunc, resolverFunc, testutil.Logger(t))
+		go func() {
+			if err := l.Serve(); err != nil {
+				t.Errorf("failed to listen: %v", err.Error())
+			}
+		}()
+		t.Cleanup(func() { l.Close() })
+		l.Wait()
+		return l
+	}
+
+	t.Run("virtual IP", func(t *testing.T) {
+		l := start(t)
+		dst.Store(&net.TCPAddr{IP: net.ParseIP("240.0.0.1"), Port: 5432})
+
+		conn, err := net.Dial("tcp", l.BindAddr())
+		require.NoError(t, err)
+		defer conn.Close()
+		TestEchoConn(t, conn, "")
+	})
+
+	t.Run("passthrough", func(t *testing.T) {
+		l := start(t)
+		dst.Store(external.Addr().(*net.TCPAddr))
+
+		conn, err := net.Dial("tcp", l.BindAddr())
+		require.NoError(t, err)
+		defer conn.Close()
+		TestEchoConn(t, conn, "")
+	})
+
+	t.Run("mesh destinations only", func(t *testing.T) {
+		atomic.StoreInt32(&meshDestinationsOnly, 1)
+		l := start(t)
+		dst.Store(external.Addr().(*net.TCPAddr))
+
+		conn, err := net.Dial("tcp", l.BindAddr())
+		require.NoError(t, err)
+		defer conn.Close()
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		_, err = conn.Read(make([]byte, 1))
+		require.Equal(t, io.EOF, err)
+	})
+}
+
+func TestVirtualIPSourceFromClient(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	a := agent.StartTestAgent(t, agent.TestAgent{Name: "agent_smith"})
+	defer a.Shutdown()
+	client := a.Client()
+
+	reg := &api.AgentServiceRegistration{
+		Name: "db",
+		Port: 5432,
+		Connect: &api.AgentServiceConnect{
+			SidecarService: &api.AgentServiceRegistration{},
+		},
+	}
+	// Virtual IPs are assigned on registration once the leader enabled
+	// them, so register until that happened.
+	var vip string
+	retry.Run(t, func(r *retry.R) {
+		require.NoError(r, client.Agent().ServiceRegister(reg))
+		instances, _, err := client.Catalog().Connect("db", "", nil)
+		require.NoError(r, err)
+		require.Len(r, instances, 1)
+		vip = instances[0].ServiceTaggedAddresses[taggedAddressVirtualIP].Address
+		require.NotEmpty(r, vip)
+	})
+
+	table := newVirtualIPTable()
+	stop := make(chan struct{})
+	defer close(stop)
+	go virtualIPSourceFromClient(client, testutil.Logger(t))(stop, table)
+
+	retry.Run(t, func(r *retry.R) {
+		service, ok := table.lookup(net.ParseIP(vip))
+		require.True(r, ok)
+		require.Equal(r, "db", service)
+	})
+	require.True(t, table.passthroughAllowed())
+}
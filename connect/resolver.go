@@ -78,6 +78,11 @@ type ConsulResolver struct {
 
 	// Datacenter to resolve in, empty indicates agent's local DC.
 	Datacenter string
+
+	// Filter is an optional filter expression selecting the service
+	// instances to resolve to, such as the filter of a service resolver
+	// subset. It is only used for ConsulResolverTypeService.
+	Filter string
 }
 
 // Resolve performs service discovery against the local Consul agent and returns
@@ -96,7 +101,9 @@ func (cr *ConsulResolver) Resolve(ctx context.Context) (string, connect.CertURI,
 func (cr *ConsulResolver) resolveService(ctx context.Context) (string, connect.CertURI, error) {
 	health := cr.Client.Health()
 
-	svcs, _, err := health.Connect(cr.Name, "", true, cr.queryOptions(ctx))
+	q := cr.queryOptions(ctx)
+	q.Filter = cr.Filter
+	svcs, _, err := health.Connect(cr.Name, "", true, q)
 	if err != nil {
 		return "", nil, err
 	}
@@ -179,6 +179,14 @@ func (s *Service) ServerTLSConfig() *tls.Config {
 	return s.tlsCfg.Get(newServerSideVerifier(s.logger, s.client, s.service))
 }
 
+// ServerTLSConfigWithoutAuthz returns a *tls.Config like ServerTLSConfig that
+// verifies the certificates of incoming Connect clients but doesn't authorize
+// them. It is for servers that authorize each request they receive instead,
+// for example against L7 intentions, and is insecure to use otherwise.
+func (s *Service) ServerTLSConfigWithoutAuthz() *tls.Config {
+	return s.tlsCfg.Get(newServerSideChainVerifier(s.logger))
+}
+
 // Dial connects to a remote Connect-enabled server. The passed Resolver is used
 // to discover a single candidate instance which will be dialed and have it's
 // TLS certificate verified against the expected identity. Failures are returned
@@ -177,24 +177,11 @@ func verifyServerCertMatchesURI(certs []*x509.Certificate, expected connect.Cert
 // for the Authorization.
 func newServerSideVerifier(logger hclog.Logger, client *api.Client, serviceName string) verifierFunc {
 	return func(tlsCfg *tls.Config, rawCerts [][]byte) error {
-		leaf, err := verifyChain(tlsCfg, rawCerts, false)
+		leaf, certURI, err := verifyServerSideChain(logger, tlsCfg, rawCerts)
 		if err != nil {
-			logger.Error("failed TLS verification", "error", err)
 			return err
 		}
 
-		// Check leaf is a cert we understand
-		if len(leaf.URIs) < 1 {
-			logger.Error("invalid leaf certificate: no URIs set")
-			return errors.New("connect: invalid leaf certificate")
-		}
-
-		certURI, err := connect.ParseCertURI(leaf.URIs[0])
-		if err != nil {
-			logger.Error("invalid leaf certificate URI", "error", err)
-			return errors.New("connect: invalid leaf certificate URI")
-		}
-
 		// No AuthZ if there is no client.
 		if client == nil {
 			logger.Info("nil client provided")
@@ -220,6 +207,39 @@ func newServerSideVerifier(logger hclog.Logger, client *api.Client, serviceName
 	}
 }
 
+// newServerSideChainVerifier returns a verifierFunc that verifies the TLS
+// chain for the server end of the connection without performing AuthZ. It is
+// for servers that authorize each request instead.
+func newServerSideChainVerifier(logger hclog.Logger) verifierFunc {
+	return func(tlsCfg *tls.Config, rawCerts [][]byte) error {
+		_, _, err := verifyServerSideChain(logger, tlsCfg, rawCerts)
+		return err
+	}
+}
+
+// verifyServerSideChain verifies the client's TLS chain and returns its leaf
+// certificate and the identity in it.
+func verifyServerSideChain(logger hclog.Logger, tlsCfg *tls.Config, rawCerts [][]byte) (*x509.Certificate, connect.CertURI, error) {
+	leaf, err := verifyChain(tlsCfg, rawCerts, false)
+	if err != nil {
+		logger.Error("failed TLS verification", "error", err)
+		return nil, nil, err
+	}
+
+	// Check leaf is a cert we understand
+	if len(leaf.URIs) < 1 {
+		logger.Error("invalid leaf certificate: no URIs set")
+		return nil, nil, errors.New("connect: invalid leaf certificate")
+	}
+
+	certURI, err := connect.ParseCertURI(leaf.URIs[0])
+	if err != nil {
+		logger.Error("invalid leaf certificate URI", "error", err)
+		return nil, nil, errors.New("connect: invalid leaf certificate URI")
+	}
+	return leaf, certURI, nil
+}
+
 // clientSideVerifier is a verifierFunc that performs verification of certificates
 // on the client end of the connection. For now it is just basic TLS
 // verification since the identity check needs additional state and becomes
@@ -56,6 +56,7 @@ require (
 	github.com/imdario/mergo v0.3.6
 	github.com/kr/text v0.2.0
 	github.com/miekg/dns v1.1.41
+	github.com/miekg/pkcs11 v1.1.1
 	github.com/mitchellh/cli v1.1.0
 	github.com/mitchellh/copystructure v1.0.0
 	github.com/mitchellh/go-testing-interface v1.14.0
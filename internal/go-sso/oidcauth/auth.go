@@ -109,9 +109,10 @@ func New(c *Config, logger hclog.Logger) (*Authenticator, error) {
 			return nil, fmt.Errorf("error parsing JWKSCACert: %v", err)
 		}
 
-		a.keySet = oidc.NewRemoteKeySet(
+		a.keySet = newJWKSKeySet(
 			contextWithHttpClient(a.backgroundCtx, a.httpClient),
 			a.config.JWKSURL,
+			a.config.JWKSCacheTTL,
 		)
 	}
 
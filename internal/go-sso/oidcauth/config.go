@@ -124,6 +124,15 @@ type Config struct {
 	// Valid only if Type=jwt
 	JWKSCACert string
 
+	// JWKSCacheTTL is how long keys fetched from the JWKS URL are cached
+	// before being fetched again. Regardless of this setting the keys are
+	// fetched again early if a JWT references a key ID that is not cached.
+	//
+	// Defaults to 1 hour if unset.
+	//
+	// Valid only if Type=jwt
+	JWKSCacheTTL time.Duration
+
 	// JWTValidationPubKeys is a list of PEM-encoded public keys to use to
 	// authenticate signatures locally. Cannot be used with "JWKSURL" or
 	// "OIDCDiscoveryURL".
@@ -137,6 +146,13 @@ type Config struct {
 	// Valid only if Type=jwt
 	BoundIssuer string
 
+	// BoundIssuers is a list of values against which to match the 'iss' claim
+	// in a JWT; any match is sufficient. May be combined with BoundIssuer.
+	// Optional.
+	//
+	// Valid only if Type=jwt
+	BoundIssuers []string
+
 	// Duration in seconds of leeway when validating expiration of
 	// a token to account for clock skew.
 	//
@@ -189,10 +205,14 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("'JWKSURL' must not be set for type %q", c.Type)
 		case c.JWKSCACert != "":
 			return fmt.Errorf("'JWKSCACert' must not be set for type %q", c.Type)
+		case c.JWKSCacheTTL != 0:
+			return fmt.Errorf("'JWKSCacheTTL' must not be set for type %q", c.Type)
 		case len(c.JWTValidationPubKeys) != 0:
 			return fmt.Errorf("'JWTValidationPubKeys' must not be set for type %q", c.Type)
 		case c.BoundIssuer != "":
 			return fmt.Errorf("'BoundIssuer' must not be set for type %q", c.Type)
+		case len(c.BoundIssuers) != 0:
+			return fmt.Errorf("'BoundIssuers' must not be set for type %q", c.Type)
 		case c.ExpirationLeeway != 0:
 			return fmt.Errorf("'ExpirationLeeway' must not be set for type %q", c.Type)
 		case c.NotBeforeLeeway != 0:
@@ -250,7 +270,7 @@ func (c *Config) Validate() error {
 			}
 
 			ctx := contextWithHttpClient(validateCtx, httpClient)
-			keyset := oidc.NewRemoteKeySet(ctx, c.JWKSURL)
+			keyset := newJWKSKeySet(ctx, c.JWKSURL, c.JWKSCacheTTL)
 
 			// Try to verify a correctly formatted JWT. The signature will fail
 			// to match, but other errors with fetching the remote keyset
@@ -265,6 +285,12 @@ func (c *Config) Validate() error {
 			}
 		} else if c.JWKSCACert != "" {
 			return fmt.Errorf("'JWKSCACert' should not be set unless 'JWKSURL' is set")
+		} else if c.JWKSCacheTTL != 0 {
+			return fmt.Errorf("'JWKSCacheTTL' should not be set unless 'JWKSURL' is set")
+		}
+
+		if c.JWKSCacheTTL < 0 {
+			return fmt.Errorf("'JWKSCacheTTL' must not be negative")
 		}
 
 		if len(c.JWTValidationPubKeys) != 0 {
@@ -326,6 +352,15 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// boundIssuers returns all of the values against which the 'iss' claim of a
+// JWT may be matched.
+func (c *Config) boundIssuers() []string {
+	if c.BoundIssuer == "" {
+		return c.BoundIssuers
+	}
+	return append([]string{c.BoundIssuer}, c.BoundIssuers...)
+}
+
 const (
 	authUnconfigured = iota
 	authStaticKeys
@@ -123,6 +123,30 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expectErr: "must not be set for type",
 		},
+		"incompatible with BoundIssuers": {
+			config: Config{
+				Type:                TypeOIDC,
+				OIDCDiscoveryURL:    srv.Addr(),
+				OIDCDiscoveryCACert: srv.CACert(),
+				OIDCClientID:        "abc",
+				OIDCClientSecret:    "def",
+				AllowedRedirectURIs: []string{"http://foo.test"},
+				BoundIssuers:        []string{"foo"},
+			},
+			expectErr: "must not be set for type",
+		},
+		"incompatible with JWKSCacheTTL": {
+			config: Config{
+				Type:                TypeOIDC,
+				OIDCDiscoveryURL:    srv.Addr(),
+				OIDCDiscoveryCACert: srv.CACert(),
+				OIDCClientID:        "abc",
+				OIDCClientSecret:    "def",
+				AllowedRedirectURIs: []string{"http://foo.test"},
+				JWKSCacheTTL:        time.Minute,
+			},
+			expectErr: "must not be set for type",
+		},
 		"incompatible with ExpirationLeeway": {
 			config: Config{
 				Type:                TypeOIDC,
@@ -479,6 +503,32 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expectAuthType: authOIDCDiscovery,
 		},
+		"jwks cache ttl without jwks url": {
+			config: Config{
+				Type:                 TypeJWT,
+				JWTValidationPubKeys: []string{testJWTPubKey},
+				JWKSCacheTTL:         time.Minute,
+			},
+			expectErr: "should not be set unless",
+		},
+		"negative jwks cache ttl": {
+			config: Config{
+				Type:         TypeJWT,
+				JWKSURL:      srv.Addr() + "/certs",
+				JWKSCACert:   srv.CACert(),
+				JWKSCacheTTL: -1,
+			},
+			expectErr: "must not be negative",
+		},
+		"good jwks cache ttl": {
+			config: Config{
+				Type:         TypeJWT,
+				JWKSURL:      srv.Addr() + "/certs",
+				JWKSCACert:   srv.CACert(),
+				JWKSCacheTTL: time.Minute,
+			},
+			expectAuthType: authJWKS,
+		},
 		"jwks invalid 404": {
 			config: Config{
 				Type:       TypeJWT,
@@ -0,0 +1,168 @@
+package oidcauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2"
+)
+
+const (
+	// jwksDefaultCacheTTL is how long keys fetched from a JWKS URL are used
+	// before being fetched again if JWKSCacheTTL is not configured.
+	jwksDefaultCacheTTL = time.Hour
+
+	// jwksMinRefreshInterval limits how often a token signed with an unknown
+	// key ID can force the key set to be fetched again, so that a stream of
+	// bogus tokens cannot be used to hammer the JWKS endpoint.
+	jwksMinRefreshInterval = 5 * time.Second
+)
+
+// jwksKeySet is an oidc.KeySet that caches the keys fetched from a JWKS URL
+// for a fixed TTL and refreshes them early when a token references a key ID
+// it has not seen.
+type jwksKeySet struct {
+	ctx      context.Context
+	url      string
+	cacheTTL time.Duration
+
+	// now is overridable for testing.
+	now func() time.Time
+
+	mu        sync.Mutex
+	keys      []jose.JSONWebKey
+	expiry    time.Time
+	lastFetch time.Time
+}
+
+func newJWKSKeySet(ctx context.Context, url string, cacheTTL time.Duration) *jwksKeySet {
+	if cacheTTL <= 0 {
+		cacheTTL = jwksDefaultCacheTTL
+	}
+	return &jwksKeySet{
+		ctx:      ctx,
+		url:      url,
+		cacheTTL: cacheTTL,
+		now:      time.Now,
+	}
+}
+
+// VerifySignature implements oidc.KeySet.
+func (r *jwksKeySet) VerifySignature(ctx context.Context, token string) ([]byte, error) {
+	jws, err := jose.ParseSigned(token)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: malformed jwt: %v", err)
+	}
+
+	// go-jose supports JWS with multiple signatures, but JWTs only ever have
+	// one.
+	keyID := ""
+	if len(jws.Signatures) > 0 {
+		keyID = jws.Signatures[0].Header.KeyID
+	}
+
+	keys, err := r.keysFor(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching keys %v", err)
+	}
+
+	if keyID != "" && len(keys) == 0 {
+		return nil, fmt.Errorf("failed to verify id token signature: no key with kid %q in JWKS", keyID)
+	}
+
+	for _, key := range keys {
+		if payload, err := jws.Verify(&key); err == nil {
+			return payload, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to verify id token signature")
+}
+
+// keysFor returns the cached keys which could have been used to sign a token
+// with the given key ID, fetching them again if the cache has expired or the
+// key ID is unknown.
+func (r *jwksKeySet) keysFor(ctx context.Context, keyID string) ([]jose.JSONWebKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.After(r.expiry) {
+		if err := r.refreshLocked(ctx, now); err != nil {
+			return nil, err
+		}
+	}
+
+	keys := filterKeys(r.keys, keyID)
+	if len(keys) == 0 && keyID != "" && now.Sub(r.lastFetch) >= jwksMinRefreshInterval {
+		// The provider may have rotated its keys since they were cached.
+		if err := r.refreshLocked(ctx, now); err != nil {
+			return nil, err
+		}
+		keys = filterKeys(r.keys, keyID)
+	}
+	return keys, nil
+}
+
+func (r *jwksKeySet) refreshLocked(ctx context.Context, now time.Time) error {
+	keys, err := r.fetchKeys(ctx)
+	r.lastFetch = now
+	if err != nil {
+		return err
+	}
+	r.keys = keys
+	r.expiry = now.Add(r.cacheTTL)
+	return nil
+}
+
+func (r *jwksKeySet) fetchKeys(ctx context.Context) ([]jose.JSONWebKey, error) {
+	req, err := http.NewRequest("GET", r.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: can't create request: %v", err)
+	}
+
+	client := http.DefaultClient
+	if c, ok := r.ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		client = c
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: get keys failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: read response body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc: get keys failed: %s %s", resp.Status, body)
+	}
+
+	var keySet jose.JSONWebKeySet
+	if err := json.Unmarshal(body, &keySet); err != nil {
+		return nil, fmt.Errorf("oidc: failed to decode keys: %v %s", err, body)
+	}
+	return keySet.Keys, nil
+}
+
+// filterKeys returns the keys matching the key ID, or all keys if the key ID
+// is empty.
+func filterKeys(keys []jose.JSONWebKey, keyID string) []jose.JSONWebKey {
+	if keyID == "" {
+		return keys
+	}
+	var matched []jose.JSONWebKey
+	for _, key := range keys {
+		if key.KeyID == keyID {
+			matched = append(matched, key)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,124 @@
+package oidcauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+type testJWKSServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	keys     []jose.JSONWebKey
+	requests int
+}
+
+func startTestJWKSServer(t *testing.T) *testJWKSServer {
+	s := &testJWKSServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests++
+		require.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: s.keys}))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testJWKSServer) setKeys(keys ...jose.JSONWebKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *testJWKSServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func newTestSigningKey(t *testing.T, keyID string) (jose.JSONWebKey, func() string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: key, KeyID: keyID}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+
+	sign := func() string {
+		raw, err := jwt.Signed(signer).Claims(jwt.Claims{Subject: "test"}).CompactSerialize()
+		require.NoError(t, err)
+		return raw
+	}
+	return jose.JSONWebKey{Key: key.Public(), KeyID: keyID, Algorithm: "ES256"}, sign
+}
+
+func TestJWKSKeySet_CacheTTL(t *testing.T) {
+	srv := startTestJWKSServer(t)
+
+	pub, sign := newTestSigningKey(t, "")
+	srv.setKeys(pub)
+
+	now := time.Now()
+	ks := newJWKSKeySet(context.Background(), srv.URL, time.Minute)
+	ks.now = func() time.Time { return now }
+
+	_, err := ks.VerifySignature(context.Background(), sign())
+	require.NoError(t, err)
+	_, err = ks.VerifySignature(context.Background(), sign())
+	require.NoError(t, err)
+	require.Equal(t, 1, srv.requestCount())
+
+	now = now.Add(2 * time.Minute)
+	_, err = ks.VerifySignature(context.Background(), sign())
+	require.NoError(t, err)
+	require.Equal(t, 2, srv.requestCount())
+}
+
+func TestJWKSKeySet_RefreshOnUnknownKeyID(t *testing.T) {
+	srv := startTestJWKSServer(t)
+
+	pub1, sign1 := newTestSigningKey(t, "key-1")
+	pub2, sign2 := newTestSigningKey(t, "key-2")
+	srv.setKeys(pub1)
+
+	now := time.Now()
+	ks := newJWKSKeySet(context.Background(), srv.URL, time.Hour)
+	ks.now = func() time.Time { return now }
+
+	_, err := ks.VerifySignature(context.Background(), sign1())
+	require.NoError(t, err)
+	require.Equal(t, 1, srv.requestCount())
+
+	// The provider rotates in a new key, which isn't fetched again until the
+	// minimum refresh interval has passed.
+	srv.setKeys(pub1, pub2)
+	_, err = ks.VerifySignature(context.Background(), sign2())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `no key with kid "key-2"`)
+	require.Equal(t, 1, srv.requestCount())
+
+	now = now.Add(jwksMinRefreshInterval)
+	_, err = ks.VerifySignature(context.Background(), sign2())
+	require.NoError(t, err)
+	require.Equal(t, 2, srv.requestCount())
+
+	// Known keys are served from the cache.
+	_, err = ks.VerifySignature(context.Background(), sign1())
+	require.NoError(t, err)
+	require.Equal(t, 2, srv.requestCount())
+}
@@ -12,7 +12,10 @@ import (
 	"fmt"
 	"time"
 
+	"gopkg.in/square/go-jose.v2"
 	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/hashicorp/consul/internal/go-sso/oidcauth/internal/strutil"
 )
 
 const claimDefaultLeeway = 150
@@ -70,7 +73,10 @@ func (a *Authenticator) verifyVanillaJWT(ctx context.Context, loginToken string)
 		allClaims = map[string]interface{}{}
 		claims    = jwt.Claims{}
 	)
-	// TODO(sso): handle JWTSupportedAlgs
+	if err := a.validateSigningAlg(loginToken); err != nil {
+		return nil, err
+	}
+
 	switch a.config.authType() {
 	case authJWKS:
 		// Verify signature (and only signature... other elements are checked later)
@@ -151,7 +157,7 @@ func (a *Authenticator) verifyVanillaJWT(ctx context.Context, loginToken string)
 	}
 
 	expected := jwt.Expected{
-		Issuer: a.config.BoundIssuer,
+		// Issuer is checked below against all bound issuers.
 		// Subject: a.config.BoundSubject,
 		Time: time.Now(),
 	}
@@ -167,6 +173,10 @@ func (a *Authenticator) verifyVanillaJWT(ctx context.Context, loginToken string)
 		return nil, fmt.Errorf("error validating claims: %v", err)
 	}
 
+	if err := validateIssuer(a.config.boundIssuers(), claims.Issuer); err != nil {
+		return nil, fmt.Errorf("error validating claims: %v", err)
+	}
+
 	if err := validateAudience(a.config.BoundAudiences, claims.Audience, true); err != nil {
 		return nil, fmt.Errorf("error validating claims: %v", err)
 	}
@@ -174,6 +184,40 @@ func (a *Authenticator) verifyVanillaJWT(ctx context.Context, loginToken string)
 	return allClaims, nil
 }
 
+// validateSigningAlg checks that the token is signed with one of the
+// configured supported algorithms. All algorithms are allowed if none are
+// configured.
+func (a *Authenticator) validateSigningAlg(token string) error {
+	if len(a.config.JWTSupportedAlgs) == 0 {
+		return nil
+	}
+
+	jws, err := jose.ParseSigned(token)
+	if err != nil {
+		return fmt.Errorf("error parsing token: %v", err)
+	}
+
+	for _, sig := range jws.Signatures {
+		if !strutil.StrListContains(a.config.JWTSupportedAlgs, sig.Header.Algorithm) {
+			return fmt.Errorf("token signed with unsupported algorithm %q, supported algorithms are %v",
+				sig.Header.Algorithm, a.config.JWTSupportedAlgs)
+		}
+	}
+	return nil
+}
+
+// validateIssuer checks that the 'iss' claim matches one of the bound issuers,
+// if any are configured.
+func validateIssuer(boundIssuers []string, issuer string) error {
+	if len(boundIssuers) == 0 {
+		return nil
+	}
+	if !strutil.StrListContains(boundIssuers, issuer) {
+		return fmt.Errorf("validation failed, invalid issuer claim (iss) %q, expected one of %v", issuer, boundIssuers)
+	}
+	return nil
+}
+
 // parsePublicKeyPEM is used to parse RSA, ECDSA, and Ed25519 public keys from PEMs
 //
 // Extracted from "github.com/hashicorp/vault/sdk/helper/certutil"
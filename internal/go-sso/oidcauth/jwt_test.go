@@ -414,6 +414,65 @@ func testJWT_ClaimsFromJWT(t *testing.T, authType int) {
 		_, err = oa.ClaimsFromJWT(context.Background(), jwtData)
 		requireErrorContains(t, err, "error validating claims: aud claim does not match any bound audience")
 	})
+
+	if authType == authOIDCDiscovery {
+		// Bound issuers and algorithms are enforced by the OIDC library.
+		return
+	}
+
+	t.Run("bound issuers", func(t *testing.T) {
+		oa, _ := setupForJWT(t, authType, func(c *Config) {
+			c.BoundIssuer = ""
+			c.BoundIssuers = []string{
+				"https://legit.issuer.internal/",
+				"https://other.issuer.internal/",
+			}
+		})
+
+		for _, issuer := range []string{"https://legit.issuer.internal/", "https://other.issuer.internal/"} {
+			cl := jwt.Claims{
+				Subject:   "r3qXcK2bix9eFECzsU3Sbmh0K16fatW6@clients",
+				Issuer:    issuer,
+				NotBefore: jwt.NewNumericDate(time.Now().Add(-5 * time.Second)),
+				Expiry:    jwt.NewNumericDate(time.Now().Add(5 * time.Second)),
+			}
+			jwtData, err := oidcauthtest.SignJWT("", cl, struct{}{})
+			require.NoError(t, err)
+
+			_, err = oa.ClaimsFromJWT(context.Background(), jwtData)
+			require.NoError(t, err)
+		}
+
+		cl := jwt.Claims{
+			Subject:   "r3qXcK2bix9eFECzsU3Sbmh0K16fatW6@clients",
+			Issuer:    "https://not.real.issuer.internal/",
+			NotBefore: jwt.NewNumericDate(time.Now().Add(-5 * time.Second)),
+			Expiry:    jwt.NewNumericDate(time.Now().Add(5 * time.Second)),
+		}
+		jwtData, err := oidcauthtest.SignJWT("", cl, struct{}{})
+		require.NoError(t, err)
+
+		_, err = oa.ClaimsFromJWT(context.Background(), jwtData)
+		requireErrorContains(t, err, "validation failed, invalid issuer claim (iss)")
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		oa, issuer := setupForJWT(t, authType, func(c *Config) {
+			c.JWTSupportedAlgs = []string{oidc.RS256}
+		})
+
+		cl := jwt.Claims{
+			Subject:   "r3qXcK2bix9eFECzsU3Sbmh0K16fatW6@clients",
+			Issuer:    issuer,
+			NotBefore: jwt.NewNumericDate(time.Now().Add(-5 * time.Second)),
+			Expiry:    jwt.NewNumericDate(time.Now().Add(5 * time.Second)),
+		}
+		jwtData, err := oidcauthtest.SignJWT("", cl, struct{}{})
+		require.NoError(t, err)
+
+		_, err = oa.ClaimsFromJWT(context.Background(), jwtData)
+		requireErrorContains(t, err, `token signed with unsupported algorithm "ES256"`)
+	})
 }
 
 func TestJWT_ClaimsFromJWT_ExpiryClaims(t *testing.T) {
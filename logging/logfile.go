@@ -41,10 +41,41 @@ type LogFile struct {
 	// Max rotated files to keep before removing them.
 	MaxFiles int
 
+	//mode is the permission set on newly created files, 0640 if unset
+	mode os.FileMode
+
 	//acquire is the mutex utilized to ensure we have no concurrency issues
 	acquire sync.Mutex
 }
 
+// NewLogFile creates a LogFile which writes to path, rotating it after the
+// given duration or once it grows past maxBytes. Files older than the newest
+// maxFiles are removed on rotation. A mode of zero uses the default of 0640.
+func NewLogFile(path string, mode os.FileMode, duration time.Duration, maxBytes, maxFiles int) (*LogFile, error) {
+	dir, fileName := filepath.Split(path)
+	if fileName == "" {
+		fileName = "consul.log"
+	}
+	if duration == 0 {
+		duration = defaultRotateDuration
+	}
+	logFile := &LogFile{
+		fileName: fileName,
+		logPath:  dir,
+		duration: duration,
+		MaxBytes: maxBytes,
+		MaxFiles: maxFiles,
+		mode:     mode,
+	}
+	if err := logFile.pruneFiles(); err != nil {
+		return nil, fmt.Errorf("Failed to prune log files: %w", err)
+	}
+	if err := logFile.openNew(); err != nil {
+		return nil, err
+	}
+	return logFile, nil
+}
+
 func (l *LogFile) fileNamePattern() string {
 	// Extract the file extension
 	fileExt := filepath.Ext(l.fileName)
@@ -64,7 +95,11 @@ func (l *LogFile) openNew() error {
 	newfilePath := filepath.Join(l.logPath, newfileName)
 
 	// Try creating a file. We truncate the file because we are the only authority to write the logs
-	filePointer, err := os.OpenFile(newfilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	mode := l.mode
+	if mode == 0 {
+		mode = 0640
+	}
+	filePointer, err := os.OpenFile(newfilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
 	if err != nil {
 		return err
 	}
@@ -139,3 +174,15 @@ func (l *LogFile) Write(b []byte) (n int, err error) {
 	l.BytesWritten += int64(len(b))
 	return l.FileInfo.Write(b)
 }
+
+// Close closes the file currently being written to.
+func (l *LogFile) Close() error {
+	l.acquire.Lock()
+	defer l.acquire.Unlock()
+	if l.FileInfo == nil {
+		return nil
+	}
+	err := l.FileInfo.Close()
+	l.FileInfo = nil
+	return err
+}
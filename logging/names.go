@@ -4,6 +4,7 @@ const (
 	ACL                string = "acl"
 	Agent              string = "agent"
 	AntiEntropy        string = "anti_entropy"
+	Audit              string = "audit"
 	AutoEncrypt        string = "auto_encrypt"
 	AutoConfig         string = "auto_config"
 	Autopilot          string = "autopilot"
@@ -126,6 +126,26 @@ func HashPolicyFromStructs(t *structs.HashPolicy, s *HashPolicy) {
 	s.SourceIP = t.SourceIP
 	s.Terminal = t.Terminal
 }
+func IngressExtAuthzConfigToStructs(s *IngressExtAuthzConfig, t *structs.IngressExtAuthzConfig) {
+	if s == nil {
+		return
+	}
+	t.Service = s.Service
+	t.Protocol = s.Protocol
+	t.Timeout = structs.DurationFromProto(s.Timeout)
+	t.FailureModeAllow = s.FailureModeAllow
+	t.EnterpriseMeta = enterpriseMetaToStructs(s.EnterpriseMeta)
+}
+func IngressExtAuthzConfigFromStructs(t *structs.IngressExtAuthzConfig, s *IngressExtAuthzConfig) {
+	if s == nil {
+		return
+	}
+	s.Service = t.Service
+	s.Protocol = t.Protocol
+	s.Timeout = structs.DurationToProto(t.Timeout)
+	s.FailureModeAllow = t.FailureModeAllow
+	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
+}
 func IngressGatewayToStructs(s *IngressGateway, t *structs.IngressGatewayConfigEntry) {
 	if s == nil {
 		return
@@ -164,6 +184,124 @@ func IngressGatewayFromStructs(t *structs.IngressGatewayConfigEntry, s *IngressG
 	}
 	s.Meta = t.Meta
 }
+func IngressJWKSToStructs(s *IngressJWKS, t *structs.IngressJWKS) {
+	if s == nil {
+		return
+	}
+	if s.Local != nil {
+		var x structs.IngressLocalJWKS
+		IngressLocalJWKSToStructs(s.Local, &x)
+		t.Local = &x
+	}
+	if s.Remote != nil {
+		var x structs.IngressRemoteJWKS
+		IngressRemoteJWKSToStructs(s.Remote, &x)
+		t.Remote = &x
+	}
+}
+func IngressJWKSFromStructs(t *structs.IngressJWKS, s *IngressJWKS) {
+	if s == nil {
+		return
+	}
+	if t.Local != nil {
+		var x IngressLocalJWKS
+		IngressLocalJWKSFromStructs(t.Local, &x)
+		s.Local = &x
+	}
+	if t.Remote != nil {
+		var x IngressRemoteJWKS
+		IngressRemoteJWKSFromStructs(t.Remote, &x)
+		s.Remote = &x
+	}
+}
+func IngressJWTClaimToHeaderToStructs(s *IngressJWTClaimToHeader, t *structs.IngressJWTClaimToHeader) {
+	if s == nil {
+		return
+	}
+	t.Claim = s.Claim
+	t.Header = s.Header
+}
+func IngressJWTClaimToHeaderFromStructs(t *structs.IngressJWTClaimToHeader, s *IngressJWTClaimToHeader) {
+	if s == nil {
+		return
+	}
+	s.Claim = t.Claim
+	s.Header = t.Header
+}
+func IngressJWTConfigToStructs(s *IngressJWTConfig, t *structs.IngressJWTConfig) {
+	if s == nil {
+		return
+	}
+	{
+		t.Providers = make([]structs.IngressJWTProvider, len(s.Providers))
+		for i := range s.Providers {
+			if s.Providers[i] != nil {
+				IngressJWTProviderToStructs(s.Providers[i], &t.Providers[i])
+			}
+		}
+	}
+}
+func IngressJWTConfigFromStructs(t *structs.IngressJWTConfig, s *IngressJWTConfig) {
+	if s == nil {
+		return
+	}
+	{
+		s.Providers = make([]*IngressJWTProvider, len(t.Providers))
+		for i := range t.Providers {
+			{
+				var x IngressJWTProvider
+				IngressJWTProviderFromStructs(&t.Providers[i], &x)
+				s.Providers[i] = &x
+			}
+		}
+	}
+}
+func IngressJWTProviderToStructs(s *IngressJWTProvider, t *structs.IngressJWTProvider) {
+	if s == nil {
+		return
+	}
+	t.Name = s.Name
+	t.Issuer = s.Issuer
+	t.Audiences = s.Audiences
+	if s.JSONWebKeySet != nil {
+		var x structs.IngressJWKS
+		IngressJWKSToStructs(s.JSONWebKeySet, &x)
+		t.JSONWebKeySet = &x
+	}
+	t.ForwardToken = s.ForwardToken
+	{
+		t.ClaimsToHeaders = make([]structs.IngressJWTClaimToHeader, len(s.ClaimsToHeaders))
+		for i := range s.ClaimsToHeaders {
+			if s.ClaimsToHeaders[i] != nil {
+				IngressJWTClaimToHeaderToStructs(s.ClaimsToHeaders[i], &t.ClaimsToHeaders[i])
+			}
+		}
+	}
+}
+func IngressJWTProviderFromStructs(t *structs.IngressJWTProvider, s *IngressJWTProvider) {
+	if s == nil {
+		return
+	}
+	s.Name = t.Name
+	s.Issuer = t.Issuer
+	s.Audiences = t.Audiences
+	if t.JSONWebKeySet != nil {
+		var x IngressJWKS
+		IngressJWKSFromStructs(t.JSONWebKeySet, &x)
+		s.JSONWebKeySet = &x
+	}
+	s.ForwardToken = t.ForwardToken
+	{
+		s.ClaimsToHeaders = make([]*IngressJWTClaimToHeader, len(t.ClaimsToHeaders))
+		for i := range t.ClaimsToHeaders {
+			{
+				var x IngressJWTClaimToHeader
+				IngressJWTClaimToHeaderFromStructs(&t.ClaimsToHeaders[i], &x)
+				s.ClaimsToHeaders[i] = &x
+			}
+		}
+	}
+}
 func IngressListenerToStructs(s *IngressListener, t *structs.IngressListener) {
 	if s == nil {
 		return
@@ -183,6 +321,16 @@ func IngressListenerToStructs(s *IngressListener, t *structs.IngressListener) {
 			}
 		}
 	}
+	if s.JWT != nil {
+		var x structs.IngressJWTConfig
+		IngressJWTConfigToStructs(s.JWT, &x)
+		t.JWT = &x
+	}
+	if s.ExtAuthz != nil {
+		var x structs.IngressExtAuthzConfig
+		IngressExtAuthzConfigToStructs(s.ExtAuthz, &x)
+		t.ExtAuthz = &x
+	}
 }
 func IngressListenerFromStructs(t *structs.IngressListener, s *IngressListener) {
 	if s == nil {
@@ -205,6 +353,46 @@ func IngressListenerFromStructs(t *structs.IngressListener, s *IngressListener)
 			}
 		}
 	}
+	if t.JWT != nil {
+		var x IngressJWTConfig
+		IngressJWTConfigFromStructs(t.JWT, &x)
+		s.JWT = &x
+	}
+	if t.ExtAuthz != nil {
+		var x IngressExtAuthzConfig
+		IngressExtAuthzConfigFromStructs(t.ExtAuthz, &x)
+		s.ExtAuthz = &x
+	}
+}
+func IngressLocalJWKSToStructs(s *IngressLocalJWKS, t *structs.IngressLocalJWKS) {
+	if s == nil {
+		return
+	}
+	t.JWKS = s.JWKS
+	t.Filename = s.Filename
+}
+func IngressLocalJWKSFromStructs(t *structs.IngressLocalJWKS, s *IngressLocalJWKS) {
+	if s == nil {
+		return
+	}
+	s.JWKS = t.JWKS
+	s.Filename = t.Filename
+}
+func IngressRemoteJWKSToStructs(s *IngressRemoteJWKS, t *structs.IngressRemoteJWKS) {
+	if s == nil {
+		return
+	}
+	t.URI = s.URI
+	t.ClusterName = s.ClusterName
+	t.CacheDuration = structs.DurationFromProto(s.CacheDuration)
+}
+func IngressRemoteJWKSFromStructs(t *structs.IngressRemoteJWKS, s *IngressRemoteJWKS) {
+	if s == nil {
+		return
+	}
+	s.URI = t.URI
+	s.ClusterName = t.ClusterName
+	s.CacheDuration = structs.DurationToProto(t.CacheDuration)
 }
 func IngressServiceToStructs(s *IngressService, t *structs.IngressService) {
 	if s == nil {
@@ -229,6 +417,11 @@ func IngressServiceToStructs(s *IngressService, t *structs.IngressService) {
 	}
 	t.Meta = s.Meta
 	t.EnterpriseMeta = enterpriseMetaToStructs(s.EnterpriseMeta)
+	if s.JWT != nil {
+		var x structs.IngressJWTConfig
+		IngressJWTConfigToStructs(s.JWT, &x)
+		t.JWT = &x
+	}
 }
 func IngressServiceFromStructs(t *structs.IngressService, s *IngressService) {
 	if s == nil {
@@ -253,6 +446,11 @@ func IngressServiceFromStructs(t *structs.IngressService, s *IngressService) {
 	}
 	s.Meta = t.Meta
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
+	if t.JWT != nil {
+		var x IngressJWTConfig
+		IngressJWTConfigFromStructs(t.JWT, &x)
+		s.JWT = &x
+	}
 }
 func IntentionHTTPHeaderPermissionToStructs(s *IntentionHTTPHeaderPermission, t *structs.IntentionHTTPHeaderPermission) {
 	if s == nil {
@@ -415,6 +613,16 @@ func MeshConfigToStructs(s *MeshConfig, t *structs.MeshConfigEntry) {
 		MeshHTTPConfigToStructs(s.HTTP, &x)
 		t.HTTP = &x
 	}
+	if s.Peering != nil {
+		var x structs.PeeringMeshConfig
+		PeeringMeshConfigToStructs(s.Peering, &x)
+		t.Peering = &x
+	}
+	if s.Locality != nil {
+		var x structs.MeshLocalityConfig
+		MeshLocalityConfigToStructs(s.Locality, &x)
+		t.Locality = &x
+	}
 	t.Meta = s.Meta
 }
 func MeshConfigFromStructs(t *structs.MeshConfigEntry, s *MeshConfig) {
@@ -436,6 +644,16 @@ func MeshConfigFromStructs(t *structs.MeshConfigEntry, s *MeshConfig) {
 		MeshHTTPConfigFromStructs(t.HTTP, &x)
 		s.HTTP = &x
 	}
+	if t.Peering != nil {
+		var x PeeringMeshConfig
+		PeeringMeshConfigFromStructs(t.Peering, &x)
+		s.Peering = &x
+	}
+	if t.Locality != nil {
+		var x MeshLocalityConfig
+		MeshLocalityConfigFromStructs(t.Locality, &x)
+		s.Locality = &x
+	}
 	s.Meta = t.Meta
 }
 func MeshDirectionalTLSConfigToStructs(s *MeshDirectionalTLSConfig, t *structs.MeshDirectionalTLSConfig) {
@@ -466,6 +684,20 @@ func MeshHTTPConfigFromStructs(t *structs.MeshHTTPConfig, s *MeshHTTPConfig) {
 	}
 	s.SanitizeXForwardedClientCert = t.SanitizeXForwardedClientCert
 }
+func MeshLocalityConfigToStructs(s *MeshLocalityConfig, t *structs.MeshLocalityConfig) {
+	if s == nil {
+		return
+	}
+	t.RegionMetaKey = s.RegionMetaKey
+	t.ZoneMetaKey = s.ZoneMetaKey
+}
+func MeshLocalityConfigFromStructs(t *structs.MeshLocalityConfig, s *MeshLocalityConfig) {
+	if s == nil {
+		return
+	}
+	s.RegionMetaKey = t.RegionMetaKey
+	s.ZoneMetaKey = t.ZoneMetaKey
+}
 func MeshTLSConfigToStructs(s *MeshTLSConfig, t *structs.MeshTLSConfig) {
 	if s == nil {
 		return
@@ -496,6 +728,18 @@ func MeshTLSConfigFromStructs(t *structs.MeshTLSConfig, s *MeshTLSConfig) {
 		s.Outgoing = &x
 	}
 }
+func PeeringMeshConfigToStructs(s *PeeringMeshConfig, t *structs.PeeringMeshConfig) {
+	if s == nil {
+		return
+	}
+	t.PeerThroughMeshGateways = s.PeerThroughMeshGateways
+}
+func PeeringMeshConfigFromStructs(t *structs.PeeringMeshConfig, s *PeeringMeshConfig) {
+	if s == nil {
+		return
+	}
+	s.PeerThroughMeshGateways = t.PeerThroughMeshGateways
+}
 func RingHashConfigToStructs(s *RingHashConfig, t *structs.RingHashConfig) {
 	if s == nil {
 		return
@@ -578,6 +822,11 @@ func ServiceResolverToStructs(s *ServiceResolver, t *structs.ServiceResolverConf
 		LoadBalancerToStructs(s.LoadBalancer, &x)
 		t.LoadBalancer = &x
 	}
+	if s.PrioritizeByLocality != nil {
+		var x structs.ServiceResolverPrioritizeByLocality
+		ServiceResolverPrioritizeByLocalityToStructs(s.PrioritizeByLocality, &x)
+		t.PrioritizeByLocality = &x
+	}
 	t.Meta = s.Meta
 }
 func ServiceResolverFromStructs(t *structs.ServiceResolverConfigEntry, s *ServiceResolver) {
@@ -620,6 +869,11 @@ func ServiceResolverFromStructs(t *structs.ServiceResolverConfigEntry, s *Servic
 		LoadBalancerFromStructs(t.LoadBalancer, &x)
 		s.LoadBalancer = &x
 	}
+	if t.PrioritizeByLocality != nil {
+		var x ServiceResolverPrioritizeByLocality
+		ServiceResolverPrioritizeByLocalityFromStructs(t.PrioritizeByLocality, &x)
+		s.PrioritizeByLocality = &x
+	}
 	s.Meta = t.Meta
 }
 func ServiceResolverFailoverToStructs(s *ServiceResolverFailover, t *structs.ServiceResolverFailover) {
@@ -630,6 +884,7 @@ func ServiceResolverFailoverToStructs(s *ServiceResolverFailover, t *structs.Ser
 	t.ServiceSubset = s.ServiceSubset
 	t.Namespace = s.Namespace
 	t.Datacenters = s.Datacenters
+	t.SamenessGroup = s.SamenessGroup
 }
 func ServiceResolverFailoverFromStructs(t *structs.ServiceResolverFailover, s *ServiceResolverFailover) {
 	if s == nil {
@@ -639,6 +894,19 @@ func ServiceResolverFailoverFromStructs(t *structs.ServiceResolverFailover, s *S
 	s.ServiceSubset = t.ServiceSubset
 	s.Namespace = t.Namespace
 	s.Datacenters = t.Datacenters
+	s.SamenessGroup = t.SamenessGroup
+}
+func ServiceResolverPrioritizeByLocalityToStructs(s *ServiceResolverPrioritizeByLocality, t *structs.ServiceResolverPrioritizeByLocality) {
+	if s == nil {
+		return
+	}
+	t.Mode = s.Mode
+}
+func ServiceResolverPrioritizeByLocalityFromStructs(t *structs.ServiceResolverPrioritizeByLocality, s *ServiceResolverPrioritizeByLocality) {
+	if s == nil {
+		return
+	}
+	s.Mode = t.Mode
 }
 func ServiceResolverRedirectToStructs(s *ServiceResolverRedirect, t *structs.ServiceResolverRedirect) {
 	if s == nil {
@@ -2,7 +2,10 @@
 
 package pbpeering
 
-import "github.com/hashicorp/consul/api"
+import (
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
 
 func EstablishRequestToAPI(s *EstablishRequest, t *api.PeeringEstablishRequest) {
 	if s == nil {
@@ -40,6 +43,7 @@ func GenerateTokenRequestToAPI(s *GenerateTokenRequest, t *api.PeeringGenerateTo
 	t.Partition = s.Partition
 	t.Meta = s.Meta
 	t.ServerExternalAddresses = s.ServerExternalAddresses
+	t.ExpiresAfter = structs.DurationFromProto(s.ExpiresAfter)
 }
 func GenerateTokenRequestFromAPI(t *api.PeeringGenerateTokenRequest, s *GenerateTokenRequest) {
 	if s == nil {
@@ -49,6 +53,7 @@ func GenerateTokenRequestFromAPI(t *api.PeeringGenerateTokenRequest, s *Generate
 	s.Partition = t.Partition
 	s.Meta = t.Meta
 	s.ServerExternalAddresses = t.ServerExternalAddresses
+	s.ExpiresAfter = structs.DurationToProto(t.ExpiresAfter)
 }
 func GenerateTokenResponseToAPI(s *GenerateTokenResponse, t *api.PeeringGenerateTokenResponse) {
 	if s == nil {
@@ -78,6 +83,9 @@ func PeeringToAPI(s *Peering, t *api.Peering) {
 	t.PeerServerAddresses = s.PeerServerAddresses
 	t.ImportedServiceCount = s.ImportedServiceCount
 	t.ExportedServiceCount = s.ExportedServiceCount
+	if s.StreamStatus != nil {
+		StreamStatusToAPI(s.StreamStatus, &t.StreamStatus)
+	}
 	t.CreateIndex = s.CreateIndex
 	t.ModifyIndex = s.ModifyIndex
 }
@@ -97,6 +105,65 @@ func PeeringFromAPI(t *api.Peering, s *Peering) {
 	s.PeerServerAddresses = t.PeerServerAddresses
 	s.ImportedServiceCount = t.ImportedServiceCount
 	s.ExportedServiceCount = t.ExportedServiceCount
+	{
+		var x StreamStatus
+		StreamStatusFromAPI(&t.StreamStatus, &x)
+		s.StreamStatus = &x
+	}
 	s.CreateIndex = t.CreateIndex
 	s.ModifyIndex = t.ModifyIndex
 }
+func PeeringTokenInfoToAPI(s *PeeringTokenInfo, t *api.PeeringTokenInfo) {
+	if s == nil {
+		return
+	}
+	t.PeerName = s.PeerName
+	t.PeeringID = s.PeeringID
+	t.Partition = s.Partition
+	t.CreatedAt = structs.TimeFromProto(s.CreatedAt)
+	t.ExpiresAt = structs.TimeFromProto(s.ExpiresAt)
+}
+func PeeringTokenInfoFromAPI(t *api.PeeringTokenInfo, s *PeeringTokenInfo) {
+	if s == nil {
+		return
+	}
+	s.PeerName = t.PeerName
+	s.PeeringID = t.PeeringID
+	s.Partition = t.Partition
+	s.CreatedAt = structs.TimeToProto(t.CreatedAt)
+	s.ExpiresAt = structs.TimeToProto(t.ExpiresAt)
+}
+func StreamStatusToAPI(s *StreamStatus, t *api.PeeringStreamStatus) {
+	if s == nil {
+		return
+	}
+	t.LastHeartbeat = TimePtrFromProto(s.LastHeartbeat)
+	t.LastReceive = TimePtrFromProto(s.LastReceive)
+	t.LastSend = TimePtrFromProto(s.LastSend)
+	t.LastError = TimePtrFromProto(s.LastError)
+	t.LastErrorMessage = s.LastErrorMessage
+	t.DisconnectTime = TimePtrFromProto(s.DisconnectTime)
+	t.DisconnectErrorMessage = s.DisconnectErrorMessage
+	t.ImportedServices = s.ImportedServices
+	t.ExportedServices = s.ExportedServices
+	t.SentResourceVersions = s.SentResourceVersions
+	t.ReceivedResourceVersions = s.ReceivedResourceVersions
+	t.UpdatedAt = TimePtrFromProto(s.UpdatedAt)
+}
+func StreamStatusFromAPI(t *api.PeeringStreamStatus, s *StreamStatus) {
+	if s == nil {
+		return
+	}
+	s.LastHeartbeat = TimePtrToProto(t.LastHeartbeat)
+	s.LastReceive = TimePtrToProto(t.LastReceive)
+	s.LastSend = TimePtrToProto(t.LastSend)
+	s.LastError = TimePtrToProto(t.LastError)
+	s.LastErrorMessage = t.LastErrorMessage
+	s.DisconnectTime = TimePtrToProto(t.DisconnectTime)
+	s.DisconnectErrorMessage = t.DisconnectErrorMessage
+	s.ImportedServices = t.ImportedServices
+	s.ExportedServices = t.ExportedServices
+	s.SentResourceVersions = t.SentResourceVersions
+	s.ReceivedResourceVersions = t.ReceivedResourceVersions
+	s.UpdatedAt = TimePtrToProto(t.UpdatedAt)
+}
@@ -1,9 +1,12 @@
 package pbpeering
 
 import (
+	"encoding/base64"
+	"fmt"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/lib"
@@ -51,6 +54,20 @@ func (req *PeeringDeleteRequest) RequestDatacenter() string {
 	return ""
 }
 
+// RequestDatacenter implements structs.RPCInfo
+func (req *TokenListRequest) RequestDatacenter() string {
+	// Cross-datacenter requests are not allowed for peering actions because
+	// they rely on WAN-federation.
+	return ""
+}
+
+// RequestDatacenter implements structs.RPCInfo
+func (req *TokenRevokeRequest) RequestDatacenter() string {
+	// Cross-datacenter requests are not allowed for peering actions because
+	// they rely on WAN-federation.
+	return ""
+}
+
 // RequestDatacenter implements structs.RPCInfo
 func (req *TrustBundleReadRequest) RequestDatacenter() string {
 	// Cross-datacenter requests are not allowed for peering actions because
@@ -91,6 +108,31 @@ func (b *PeeringTrustBundle) ConcatenatedRootPEMs() string {
 	return rootPEMs
 }
 
+// SPIFFEBundle returns the roots of the trust bundle in the SPIFFE bundle
+// format, with the given sequence number and refresh hint.
+func (b *PeeringTrustBundle) SPIFFEBundle(sequence uint64, refreshHint time.Duration) (*api.SPIFFEBundle, error) {
+	bundle := &api.SPIFFEBundle{
+		Keys:        []api.SPIFFEBundleKey{},
+		Sequence:    sequence,
+		RefreshHint: int(refreshHint / time.Second),
+	}
+
+	for _, rootPEM := range b.RootPEMs {
+		cert, err := connect.ParseCert(rootPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing root certificate: %w", err)
+		}
+
+		key, err := connect.SPIFFEBundleKey(connect.SPIFFEBundleUseX509SVID, cert.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding root certificate: %w", err)
+		}
+		key.Certificates = []string{base64.StdEncoding.EncodeToString(cert.Raw)}
+		bundle.Keys = append(bundle.Keys, key)
+	}
+	return bundle, nil
+}
+
 // enumcover:PeeringState
 func PeeringStateToAPI(s PeeringState) api.PeeringState {
 	switch s {
@@ -162,6 +204,17 @@ func (resp *PeeringListResponse) ToAPI() []*api.Peering {
 	return list
 }
 
+// TODO consider using mog for this
+func (resp *TokenListResponse) ToAPI() []*api.PeeringTokenInfo {
+	list := make([]*api.PeeringTokenInfo, len(resp.Tokens))
+	for i, info := range resp.Tokens {
+		var t api.PeeringTokenInfo
+		PeeringTokenInfoToAPI(info, &t)
+		list[i] = &t
+	}
+	return list
+}
+
 // TODO consider using mog for this
 func (resp *GenerateTokenResponse) ToAPI() *api.PeeringGenerateTokenResponse {
 	var t api.PeeringGenerateTokenResponse
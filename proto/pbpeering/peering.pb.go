@@ -9,6 +9,7 @@ package pbpeering
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -139,6 +140,9 @@ type Peering struct {
 	ImportedServiceCount uint64 `protobuf:"varint,13,opt,name=ImportedServiceCount,proto3" json:"ImportedServiceCount,omitempty"`
 	// ExportedServiceCount is the count of how many services are exported to this peering.
 	ExportedServiceCount uint64 `protobuf:"varint,14,opt,name=ExportedServiceCount,proto3" json:"ExportedServiceCount,omitempty"`
+	// StreamStatus describes the replication stream with the peer. It is
+	// populated when the peering is read and never stored with the peering.
+	StreamStatus *StreamStatus `protobuf:"bytes,15,opt,name=StreamStatus,proto3" json:"StreamStatus,omitempty"`
 	// CreateIndex is the Raft index at which the Peering was created.
 	// @gotags: bexpr:"-"
 	CreateIndex uint64 `protobuf:"varint,11,opt,name=CreateIndex,proto3" json:"CreateIndex,omitempty" bexpr:"-"`
@@ -263,6 +267,13 @@ func (x *Peering) GetExportedServiceCount() uint64 {
 	return 0
 }
 
+func (x *Peering) GetStreamStatus() *StreamStatus {
+	if x != nil {
+		return x.StreamStatus
+	}
+	return nil
+}
+
 func (x *Peering) GetCreateIndex() uint64 {
 	if x != nil {
 		return x.CreateIndex
@@ -277,33 +288,57 @@ func (x *Peering) GetModifyIndex() uint64 {
 	return 0
 }
 
-// PeeringTrustBundle holds the trust information for validating requests from a peer.
-type PeeringTrustBundle struct {
+// StreamStatus describes the replication stream with a peer.
+//
+// mog annotation:
+//
+// target=github.com/hashicorp/consul/api.PeeringStreamStatus
+// output=peering.gen.go
+// name=API
+type StreamStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// TrustDomain is the domain for the bundle, example.com, foo.bar.gov for example. Note that this must not have a prefix such as "spiffe://".
-	TrustDomain string `protobuf:"bytes,1,opt,name=TrustDomain,proto3" json:"TrustDomain,omitempty"`
-	// PeerName associates the trust bundle with a peer.
-	PeerName string `protobuf:"bytes,2,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
-	// Partition isolates the bundle from other trust bundles in separate local partitions.
-	Partition string `protobuf:"bytes,3,opt,name=Partition,proto3" json:"Partition,omitempty"`
-	// RootPEMs holds ASN.1 DER encoded X.509 certificate data for the trust bundle.
-	RootPEMs []string `protobuf:"bytes,4,rep,name=RootPEMs,proto3" json:"RootPEMs,omitempty"`
-	// ExportedPartition references the remote partition of the peer
-	// which sent this trust bundle. Used for generating SpiffeIDs.
-	ExportedPartition string `protobuf:"bytes,5,opt,name=ExportedPartition,proto3" json:"ExportedPartition,omitempty"`
-	// CreateIndex is the Raft index at which the trust domain was created.
-	// @gotags: bexpr:"-"
-	CreateIndex uint64 `protobuf:"varint,6,opt,name=CreateIndex,proto3" json:"CreateIndex,omitempty" bexpr:"-"`
-	// ModifyIndex is the latest Raft index at which the trust bundle was modified.
-	// @gotags: bexpr:"-"
-	ModifyIndex uint64 `protobuf:"varint,7,opt,name=ModifyIndex,proto3" json:"ModifyIndex,omitempty" bexpr:"-"`
+	// LastHeartbeat is the time when the last heartbeat was received from the peer.
+	// mog: func-to=TimePtrFromProto func-from=TimePtrToProto
+	LastHeartbeat *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=LastHeartbeat,proto3" json:"LastHeartbeat,omitempty"`
+	// LastReceive is the time when the last message was received from the peer.
+	// mog: func-to=TimePtrFromProto func-from=TimePtrToProto
+	LastReceive *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=LastReceive,proto3" json:"LastReceive,omitempty"`
+	// LastSend is the time when the last message was sent to the peer.
+	// mog: func-to=TimePtrFromProto func-from=TimePtrToProto
+	LastSend *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=LastSend,proto3" json:"LastSend,omitempty"`
+	// LastError is the time of the most recent error on the stream, be it
+	// sending, receiving, storing a replicated resource or a NACK from the peer.
+	// mog: func-to=TimePtrFromProto func-from=TimePtrToProto
+	LastError *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	// LastErrorMessage is the message of the error at LastError.
+	LastErrorMessage string `protobuf:"bytes,5,opt,name=LastErrorMessage,proto3" json:"LastErrorMessage,omitempty"`
+	// DisconnectTime is the time when the stream was closed, if it is not
+	// currently connected.
+	// mog: func-to=TimePtrFromProto func-from=TimePtrToProto
+	DisconnectTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=DisconnectTime,proto3" json:"DisconnectTime,omitempty"`
+	// DisconnectErrorMessage is the error that caused the stream to close, if
+	// it did not close gracefully.
+	DisconnectErrorMessage string `protobuf:"bytes,7,opt,name=DisconnectErrorMessage,proto3" json:"DisconnectErrorMessage,omitempty"`
+	// ImportedServices are the names of the services imported from the peer.
+	ImportedServices []string `protobuf:"bytes,8,rep,name=ImportedServices,proto3" json:"ImportedServices,omitempty"`
+	// ExportedServices are the names of the services exported to the peer.
+	ExportedServices []string `protobuf:"bytes,9,rep,name=ExportedServices,proto3" json:"ExportedServices,omitempty"`
+	// SentResourceVersions maps each resource type URL to the number of
+	// updates of that type sent to the peer.
+	SentResourceVersions map[string]uint64 `protobuf:"bytes,10,rep,name=SentResourceVersions,proto3" json:"SentResourceVersions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// ReceivedResourceVersions maps each resource type URL to the number of
+	// updates of that type received from the peer and stored.
+	ReceivedResourceVersions map[string]uint64 `protobuf:"bytes,11,rep,name=ReceivedResourceVersions,proto3" json:"ReceivedResourceVersions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// UpdatedAt is the time when the status was recorded by the leader.
+	// mog: func-to=TimePtrFromProto func-from=TimePtrToProto
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
 }
 
-func (x *PeeringTrustBundle) Reset() {
-	*x = PeeringTrustBundle{}
+func (x *StreamStatus) Reset() {
+	*x = StreamStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_pbpeering_peering_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,13 +346,13 @@ func (x *PeeringTrustBundle) Reset() {
 	}
 }
 
-func (x *PeeringTrustBundle) String() string {
+func (x *StreamStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringTrustBundle) ProtoMessage() {}
+func (*StreamStatus) ProtoMessage() {}
 
-func (x *PeeringTrustBundle) ProtoReflect() protoreflect.Message {
+func (x *StreamStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pbpeering_peering_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -329,72 +364,109 @@ func (x *PeeringTrustBundle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringTrustBundle.ProtoReflect.Descriptor instead.
-func (*PeeringTrustBundle) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamStatus.ProtoReflect.Descriptor instead.
+func (*StreamStatus) Descriptor() ([]byte, []int) {
 	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *PeeringTrustBundle) GetTrustDomain() string {
+func (x *StreamStatus) GetLastHeartbeat() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TrustDomain
+		return x.LastHeartbeat
 	}
-	return ""
+	return nil
 }
 
-func (x *PeeringTrustBundle) GetPeerName() string {
+func (x *StreamStatus) GetLastReceive() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PeerName
+		return x.LastReceive
 	}
-	return ""
+	return nil
 }
 
-func (x *PeeringTrustBundle) GetPartition() string {
+func (x *StreamStatus) GetLastSend() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Partition
+		return x.LastSend
+	}
+	return nil
+}
+
+func (x *StreamStatus) GetLastError() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastError
+	}
+	return nil
+}
+
+func (x *StreamStatus) GetLastErrorMessage() string {
+	if x != nil {
+		return x.LastErrorMessage
 	}
 	return ""
 }
 
-func (x *PeeringTrustBundle) GetRootPEMs() []string {
+func (x *StreamStatus) GetDisconnectTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RootPEMs
+		return x.DisconnectTime
 	}
 	return nil
 }
 
-func (x *PeeringTrustBundle) GetExportedPartition() string {
+func (x *StreamStatus) GetDisconnectErrorMessage() string {
 	if x != nil {
-		return x.ExportedPartition
+		return x.DisconnectErrorMessage
 	}
 	return ""
 }
 
-func (x *PeeringTrustBundle) GetCreateIndex() uint64 {
+func (x *StreamStatus) GetImportedServices() []string {
 	if x != nil {
-		return x.CreateIndex
+		return x.ImportedServices
 	}
-	return 0
+	return nil
 }
 
-func (x *PeeringTrustBundle) GetModifyIndex() uint64 {
+func (x *StreamStatus) GetExportedServices() []string {
 	if x != nil {
-		return x.ModifyIndex
+		return x.ExportedServices
 	}
-	return 0
+	return nil
 }
 
-// @consul-rpc-glue: LeaderReadTODO
-type PeeringReadRequest struct {
+func (x *StreamStatus) GetSentResourceVersions() map[string]uint64 {
+	if x != nil {
+		return x.SentResourceVersions
+	}
+	return nil
+}
+
+func (x *StreamStatus) GetReceivedResourceVersions() map[string]uint64 {
+	if x != nil {
+		return x.ReceivedResourceVersions
+	}
+	return nil
+}
+
+func (x *StreamStatus) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PeeringStreamStatus is the last known status of the replication stream of
+// a peering. It is persisted periodically by the leader so that it survives
+// leader changes.
+type PeeringStreamStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	PeeringID string        `protobuf:"bytes,1,opt,name=PeeringID,proto3" json:"PeeringID,omitempty"`
+	Status    *StreamStatus `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
 }
 
-func (x *PeeringReadRequest) Reset() {
-	*x = PeeringReadRequest{}
+func (x *PeeringStreamStatus) Reset() {
+	*x = PeeringStreamStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_pbpeering_peering_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,13 +474,13 @@ func (x *PeeringReadRequest) Reset() {
 	}
 }
 
-func (x *PeeringReadRequest) String() string {
+func (x *PeeringStreamStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringReadRequest) ProtoMessage() {}
+func (*PeeringStreamStatus) ProtoMessage() {}
 
-func (x *PeeringReadRequest) ProtoReflect() protoreflect.Message {
+func (x *PeeringStreamStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pbpeering_peering_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -420,35 +492,35 @@ func (x *PeeringReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringReadRequest.ProtoReflect.Descriptor instead.
-func (*PeeringReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PeeringStreamStatus.ProtoReflect.Descriptor instead.
+func (*PeeringStreamStatus) Descriptor() ([]byte, []int) {
 	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PeeringReadRequest) GetName() string {
+func (x *PeeringStreamStatus) GetPeeringID() string {
 	if x != nil {
-		return x.Name
+		return x.PeeringID
 	}
 	return ""
 }
 
-func (x *PeeringReadRequest) GetPartition() string {
+func (x *PeeringStreamStatus) GetStatus() *StreamStatus {
 	if x != nil {
-		return x.Partition
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type PeeringReadResponse struct {
+type PeeringStreamStatusWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peering *Peering `protobuf:"bytes,1,opt,name=Peering,proto3" json:"Peering,omitempty"`
+	Statuses []*PeeringStreamStatus `protobuf:"bytes,1,rep,name=Statuses,proto3" json:"Statuses,omitempty"`
 }
 
-func (x *PeeringReadResponse) Reset() {
-	*x = PeeringReadResponse{}
+func (x *PeeringStreamStatusWriteRequest) Reset() {
+	*x = PeeringStreamStatusWriteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_pbpeering_peering_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -456,13 +528,13 @@ func (x *PeeringReadResponse) Reset() {
 	}
 }
 
-func (x *PeeringReadResponse) String() string {
+func (x *PeeringStreamStatusWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringReadResponse) ProtoMessage() {}
+func (*PeeringStreamStatusWriteRequest) ProtoMessage() {}
 
-func (x *PeeringReadResponse) ProtoReflect() protoreflect.Message {
+func (x *PeeringStreamStatusWriteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pbpeering_peering_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -474,29 +546,37 @@ func (x *PeeringReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringReadResponse.ProtoReflect.Descriptor instead.
-func (*PeeringReadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PeeringStreamStatusWriteRequest.ProtoReflect.Descriptor instead.
+func (*PeeringStreamStatusWriteRequest) Descriptor() ([]byte, []int) {
 	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PeeringReadResponse) GetPeering() *Peering {
+func (x *PeeringStreamStatusWriteRequest) GetStatuses() []*PeeringStreamStatus {
 	if x != nil {
-		return x.Peering
+		return x.Statuses
 	}
 	return nil
 }
 
-// @consul-rpc-glue: LeaderReadTODO
-type PeeringListRequest struct {
+// PeeringSecrets holds the secrets authenticating a peer, first when it
+// exchanges its peering token and then when it opens replication streams.
+type PeeringSecrets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Partition string `protobuf:"bytes,1,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	// PeeringID is the local UUID of the peering the secrets belong to.
+	PeeringID string `protobuf:"bytes,1,opt,name=PeeringID,proto3" json:"PeeringID,omitempty"`
+	// Establishment is only set on the accepting side while a peering token
+	// has been generated but not yet used to establish the peering.
+	Establishment *PeeringSecrets_Establishment `protobuf:"bytes,2,opt,name=establishment,proto3" json:"establishment,omitempty"`
+	// Stream holds the long-lived secrets of the replication stream. The dialing
+	// side only stores the secret it presents as the active one.
+	Stream *PeeringSecrets_Stream `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
-func (x *PeeringListRequest) Reset() {
-	*x = PeeringListRequest{}
+func (x *PeeringSecrets) Reset() {
+	*x = PeeringSecrets{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_pbpeering_peering_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,13 +584,13 @@ func (x *PeeringListRequest) Reset() {
 	}
 }
 
-func (x *PeeringListRequest) String() string {
+func (x *PeeringSecrets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringListRequest) ProtoMessage() {}
+func (*PeeringSecrets) ProtoMessage() {}
 
-func (x *PeeringListRequest) ProtoReflect() protoreflect.Message {
+func (x *PeeringSecrets) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pbpeering_peering_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -522,28 +602,54 @@ func (x *PeeringListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringListRequest.ProtoReflect.Descriptor instead.
-func (*PeeringListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PeeringSecrets.ProtoReflect.Descriptor instead.
+func (*PeeringSecrets) Descriptor() ([]byte, []int) {
 	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PeeringListRequest) GetPartition() string {
+func (x *PeeringSecrets) GetPeeringID() string {
 	if x != nil {
-		return x.Partition
+		return x.PeeringID
 	}
 	return ""
 }
 
-type PeeringListResponse struct {
+func (x *PeeringSecrets) GetEstablishment() *PeeringSecrets_Establishment {
+	if x != nil {
+		return x.Establishment
+	}
+	return nil
+}
+
+func (x *PeeringSecrets) GetStream() *PeeringSecrets_Stream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// PeeringSecretsWriteRequest describes an operation on the secrets of a
+// peering. Operations are checked against the stored secrets when applied so
+// that a secret cannot be consumed twice.
+type PeeringSecretsWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peerings []*Peering `protobuf:"bytes,1,rep,name=Peerings,proto3" json:"Peerings,omitempty"`
+	// PeeringID is the local UUID of the peering the secrets belong to.
+	PeeringID string `protobuf:"bytes,1,opt,name=PeeringID,proto3" json:"PeeringID,omitempty"`
+	// Types that are assignable to Request:
+	//	*PeeringSecretsWriteRequest_GenerateToken
+	//	*PeeringSecretsWriteRequest_ExchangeSecret
+	//	*PeeringSecretsWriteRequest_PromotePending
+	//	*PeeringSecretsWriteRequest_RotateStreamSecret
+	//	*PeeringSecretsWriteRequest_StoreStreamSecret
+	//	*PeeringSecretsWriteRequest_RevokeToken
+	Request isPeeringSecretsWriteRequest_Request `protobuf_oneof:"Request"`
 }
 
-func (x *PeeringListResponse) Reset() {
-	*x = PeeringListResponse{}
+func (x *PeeringSecretsWriteRequest) Reset() {
+	*x = PeeringSecretsWriteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_pbpeering_peering_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,13 +657,13 @@ func (x *PeeringListResponse) Reset() {
 	}
 }
 
-func (x *PeeringListResponse) String() string {
+func (x *PeeringSecretsWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringListResponse) ProtoMessage() {}
+func (*PeeringSecretsWriteRequest) ProtoMessage() {}
 
-func (x *PeeringListResponse) ProtoReflect() protoreflect.Message {
+func (x *PeeringSecretsWriteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pbpeering_peering_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -569,139 +675,130 @@ func (x *PeeringListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringListResponse.ProtoReflect.Descriptor instead.
-func (*PeeringListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PeeringSecretsWriteRequest.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteRequest) Descriptor() ([]byte, []int) {
 	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PeeringListResponse) GetPeerings() []*Peering {
+func (x *PeeringSecretsWriteRequest) GetPeeringID() string {
 	if x != nil {
-		return x.Peerings
+		return x.PeeringID
 	}
-	return nil
+	return ""
 }
 
-type PeeringWriteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Peering *Peering `protobuf:"bytes,1,opt,name=Peering,proto3" json:"Peering,omitempty"`
-	// Meta is a mapping of some string value to any other string value
-	Meta map[string]string `protobuf:"bytes,2,rep,name=Meta,proto3" json:"Meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *PeeringSecretsWriteRequest) GetRequest() isPeeringSecretsWriteRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func (x *PeeringWriteRequest) Reset() {
-	*x = PeeringWriteRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PeeringSecretsWriteRequest) GetGenerateToken() *PeeringSecretsWriteRequest_GenerateTokenRequest {
+	if x, ok := x.GetRequest().(*PeeringSecretsWriteRequest_GenerateToken); ok {
+		return x.GenerateToken
 	}
+	return nil
 }
 
-func (x *PeeringWriteRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PeeringSecretsWriteRequest) GetExchangeSecret() *PeeringSecretsWriteRequest_ExchangeSecretRequest {
+	if x, ok := x.GetRequest().(*PeeringSecretsWriteRequest_ExchangeSecret); ok {
+		return x.ExchangeSecret
+	}
+	return nil
 }
 
-func (*PeeringWriteRequest) ProtoMessage() {}
-
-func (x *PeeringWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PeeringSecretsWriteRequest) GetPromotePending() *PeeringSecretsWriteRequest_PromotePendingRequest {
+	if x, ok := x.GetRequest().(*PeeringSecretsWriteRequest_PromotePending); ok {
+		return x.PromotePending
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PeeringWriteRequest.ProtoReflect.Descriptor instead.
-func (*PeeringWriteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{6}
+func (x *PeeringSecretsWriteRequest) GetRotateStreamSecret() *PeeringSecretsWriteRequest_RotateStreamSecretRequest {
+	if x, ok := x.GetRequest().(*PeeringSecretsWriteRequest_RotateStreamSecret); ok {
+		return x.RotateStreamSecret
+	}
+	return nil
 }
 
-func (x *PeeringWriteRequest) GetPeering() *Peering {
-	if x != nil {
-		return x.Peering
+func (x *PeeringSecretsWriteRequest) GetStoreStreamSecret() *PeeringSecretsWriteRequest_StoreStreamSecretRequest {
+	if x, ok := x.GetRequest().(*PeeringSecretsWriteRequest_StoreStreamSecret); ok {
+		return x.StoreStreamSecret
 	}
 	return nil
 }
 
-func (x *PeeringWriteRequest) GetMeta() map[string]string {
-	if x != nil {
-		return x.Meta
+func (x *PeeringSecretsWriteRequest) GetRevokeToken() *PeeringSecretsWriteRequest_RevokeTokenRequest {
+	if x, ok := x.GetRequest().(*PeeringSecretsWriteRequest_RevokeToken); ok {
+		return x.RevokeToken
 	}
 	return nil
 }
 
-// TODO(peering): Consider returning Peering if we keep this endpoint around
-type PeeringWriteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type isPeeringSecretsWriteRequest_Request interface {
+	isPeeringSecretsWriteRequest_Request()
 }
 
-func (x *PeeringWriteResponse) Reset() {
-	*x = PeeringWriteResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type PeeringSecretsWriteRequest_GenerateToken struct {
+	GenerateToken *PeeringSecretsWriteRequest_GenerateTokenRequest `protobuf:"bytes,2,opt,name=generate_token,json=generateToken,proto3,oneof"`
 }
 
-func (x *PeeringWriteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type PeeringSecretsWriteRequest_ExchangeSecret struct {
+	ExchangeSecret *PeeringSecretsWriteRequest_ExchangeSecretRequest `protobuf:"bytes,3,opt,name=exchange_secret,json=exchangeSecret,proto3,oneof"`
 }
 
-func (*PeeringWriteResponse) ProtoMessage() {}
+type PeeringSecretsWriteRequest_PromotePending struct {
+	PromotePending *PeeringSecretsWriteRequest_PromotePendingRequest `protobuf:"bytes,4,opt,name=promote_pending,json=promotePending,proto3,oneof"`
+}
 
-func (x *PeeringWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type PeeringSecretsWriteRequest_RotateStreamSecret struct {
+	RotateStreamSecret *PeeringSecretsWriteRequest_RotateStreamSecretRequest `protobuf:"bytes,5,opt,name=rotate_stream_secret,json=rotateStreamSecret,proto3,oneof"`
 }
 
-// Deprecated: Use PeeringWriteResponse.ProtoReflect.Descriptor instead.
-func (*PeeringWriteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{7}
+type PeeringSecretsWriteRequest_StoreStreamSecret struct {
+	StoreStreamSecret *PeeringSecretsWriteRequest_StoreStreamSecretRequest `protobuf:"bytes,6,opt,name=store_stream_secret,json=storeStreamSecret,proto3,oneof"`
 }
 
-type PeeringDeleteRequest struct {
+type PeeringSecretsWriteRequest_RevokeToken struct {
+	RevokeToken *PeeringSecretsWriteRequest_RevokeTokenRequest `protobuf:"bytes,7,opt,name=revoke_token,json=revokeToken,proto3,oneof"`
+}
+
+func (*PeeringSecretsWriteRequest_GenerateToken) isPeeringSecretsWriteRequest_Request() {}
+
+func (*PeeringSecretsWriteRequest_ExchangeSecret) isPeeringSecretsWriteRequest_Request() {}
+
+func (*PeeringSecretsWriteRequest_PromotePending) isPeeringSecretsWriteRequest_Request() {}
+
+func (*PeeringSecretsWriteRequest_RotateStreamSecret) isPeeringSecretsWriteRequest_Request() {}
+
+func (*PeeringSecretsWriteRequest_StoreStreamSecret) isPeeringSecretsWriteRequest_Request() {}
+
+func (*PeeringSecretsWriteRequest_RevokeToken) isPeeringSecretsWriteRequest_Request() {}
+
+type PeeringSecretsWriteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
 }
 
-func (x *PeeringDeleteRequest) Reset() {
-	*x = PeeringDeleteRequest{}
+func (x *PeeringSecretsWriteResponse) Reset() {
+	*x = PeeringSecretsWriteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[8]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeeringDeleteRequest) String() string {
+func (x *PeeringSecretsWriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringDeleteRequest) ProtoMessage() {}
+func (*PeeringSecretsWriteResponse) ProtoMessage() {}
 
-func (x *PeeringDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[8]
+func (x *PeeringSecretsWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,33 +809,183 @@ func (x *PeeringDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringDeleteRequest.ProtoReflect.Descriptor instead.
-func (*PeeringDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PeeringSecretsWriteResponse.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PeeringDeleteRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
+// PeeringTrustBundle holds the trust information for validating requests from a peer.
+type PeeringTrustBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *PeeringDeleteRequest) GetPartition() string {
+	// TrustDomain is the domain for the bundle, example.com, foo.bar.gov for example. Note that this must not have a prefix such as "spiffe://".
+	TrustDomain string `protobuf:"bytes,1,opt,name=TrustDomain,proto3" json:"TrustDomain,omitempty"`
+	// PeerName associates the trust bundle with a peer.
+	PeerName string `protobuf:"bytes,2,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
+	// Partition isolates the bundle from other trust bundles in separate local partitions.
+	Partition string `protobuf:"bytes,3,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	// RootPEMs holds ASN.1 DER encoded X.509 certificate data for the trust bundle.
+	RootPEMs []string `protobuf:"bytes,4,rep,name=RootPEMs,proto3" json:"RootPEMs,omitempty"`
+	// ExportedPartition references the remote partition of the peer
+	// which sent this trust bundle. Used for generating SpiffeIDs.
+	ExportedPartition string `protobuf:"bytes,5,opt,name=ExportedPartition,proto3" json:"ExportedPartition,omitempty"`
+	// CreateIndex is the Raft index at which the trust domain was created.
+	// @gotags: bexpr:"-"
+	CreateIndex uint64 `protobuf:"varint,6,opt,name=CreateIndex,proto3" json:"CreateIndex,omitempty" bexpr:"-"`
+	// ModifyIndex is the latest Raft index at which the trust bundle was modified.
+	// @gotags: bexpr:"-"
+	ModifyIndex uint64 `protobuf:"varint,7,opt,name=ModifyIndex,proto3" json:"ModifyIndex,omitempty" bexpr:"-"`
+}
+
+func (x *PeeringTrustBundle) Reset() {
+	*x = PeeringTrustBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringTrustBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringTrustBundle) ProtoMessage() {}
+
+func (x *PeeringTrustBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringTrustBundle.ProtoReflect.Descriptor instead.
+func (*PeeringTrustBundle) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PeeringTrustBundle) GetTrustDomain() string {
+	if x != nil {
+		return x.TrustDomain
+	}
+	return ""
+}
+
+func (x *PeeringTrustBundle) GetPeerName() string {
+	if x != nil {
+		return x.PeerName
+	}
+	return ""
+}
+
+func (x *PeeringTrustBundle) GetPartition() string {
 	if x != nil {
 		return x.Partition
 	}
 	return ""
 }
 
-type PeeringDeleteResponse struct {
+func (x *PeeringTrustBundle) GetRootPEMs() []string {
+	if x != nil {
+		return x.RootPEMs
+	}
+	return nil
+}
+
+func (x *PeeringTrustBundle) GetExportedPartition() string {
+	if x != nil {
+		return x.ExportedPartition
+	}
+	return ""
+}
+
+func (x *PeeringTrustBundle) GetCreateIndex() uint64 {
+	if x != nil {
+		return x.CreateIndex
+	}
+	return 0
+}
+
+func (x *PeeringTrustBundle) GetModifyIndex() uint64 {
+	if x != nil {
+		return x.ModifyIndex
+	}
+	return 0
+}
+
+// @consul-rpc-glue: LeaderReadTODO
+type PeeringReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
 }
 
-func (x *PeeringDeleteResponse) Reset() {
-	*x = PeeringDeleteResponse{}
+func (x *PeeringReadRequest) Reset() {
+	*x = PeeringReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringReadRequest) ProtoMessage() {}
+
+func (x *PeeringReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringReadRequest.ProtoReflect.Descriptor instead.
+func (*PeeringReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PeeringReadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeeringReadRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+type PeeringReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peering *Peering `protobuf:"bytes,1,opt,name=Peering,proto3" json:"Peering,omitempty"`
+}
+
+func (x *PeeringReadResponse) Reset() {
+	*x = PeeringReadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_pbpeering_peering_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,13 +993,13 @@ func (x *PeeringDeleteResponse) Reset() {
 	}
 }
 
-func (x *PeeringDeleteResponse) String() string {
+func (x *PeeringReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringDeleteResponse) ProtoMessage() {}
+func (*PeeringReadResponse) ProtoMessage() {}
 
-func (x *PeeringDeleteResponse) ProtoReflect() protoreflect.Message {
+func (x *PeeringReadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pbpeering_peering_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -764,39 +1011,1003 @@ func (x *PeeringDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringDeleteResponse.ProtoReflect.Descriptor instead.
-func (*PeeringDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PeeringReadResponse.ProtoReflect.Descriptor instead.
+func (*PeeringReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PeeringReadResponse) GetPeering() *Peering {
+	if x != nil {
+		return x.Peering
+	}
+	return nil
+}
+
+// @consul-rpc-glue: LeaderReadTODO
+type PeeringListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partition string `protobuf:"bytes,1,opt,name=Partition,proto3" json:"Partition,omitempty"`
+}
+
+func (x *PeeringListRequest) Reset() {
+	*x = PeeringListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringListRequest) ProtoMessage() {}
+
+func (x *PeeringListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringListRequest.ProtoReflect.Descriptor instead.
+func (*PeeringListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PeeringListRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+type PeeringListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peerings []*Peering `protobuf:"bytes,1,rep,name=Peerings,proto3" json:"Peerings,omitempty"`
+	Index    uint64     `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+}
+
+func (x *PeeringListResponse) Reset() {
+	*x = PeeringListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringListResponse) ProtoMessage() {}
+
+func (x *PeeringListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringListResponse.ProtoReflect.Descriptor instead.
+func (*PeeringListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PeeringListResponse) GetPeerings() []*Peering {
+	if x != nil {
+		return x.Peerings
+	}
+	return nil
+}
+
+func (x *PeeringListResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type PeeringWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peering *Peering `protobuf:"bytes,1,opt,name=Peering,proto3" json:"Peering,omitempty"`
+	// Meta is a mapping of some string value to any other string value
+	Meta map[string]string `protobuf:"bytes,2,rep,name=Meta,proto3" json:"Meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// SecretsRequest is an optional operation on the secrets of the peering
+	// applied atomically with the peering write.
+	SecretsRequest *PeeringSecretsWriteRequest `protobuf:"bytes,3,opt,name=SecretsRequest,proto3" json:"SecretsRequest,omitempty"`
+}
+
+func (x *PeeringWriteRequest) Reset() {
+	*x = PeeringWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringWriteRequest) ProtoMessage() {}
+
+func (x *PeeringWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringWriteRequest.ProtoReflect.Descriptor instead.
+func (*PeeringWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PeeringWriteRequest) GetPeering() *Peering {
+	if x != nil {
+		return x.Peering
+	}
+	return nil
+}
+
+func (x *PeeringWriteRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PeeringWriteRequest) GetSecretsRequest() *PeeringSecretsWriteRequest {
+	if x != nil {
+		return x.SecretsRequest
+	}
+	return nil
+}
+
+// TODO(peering): Consider returning Peering if we keep this endpoint around
+type PeeringWriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PeeringWriteResponse) Reset() {
+	*x = PeeringWriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringWriteResponse) ProtoMessage() {}
+
+func (x *PeeringWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringWriteResponse.ProtoReflect.Descriptor instead.
+func (*PeeringWriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{13}
+}
+
+type PeeringDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
+}
+
+func (x *PeeringDeleteRequest) Reset() {
+	*x = PeeringDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringDeleteRequest) ProtoMessage() {}
+
+func (x *PeeringDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringDeleteRequest.ProtoReflect.Descriptor instead.
+func (*PeeringDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PeeringDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeeringDeleteRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+type PeeringDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PeeringDeleteResponse) Reset() {
+	*x = PeeringDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringDeleteResponse) ProtoMessage() {}
+
+func (x *PeeringDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringDeleteResponse.ProtoReflect.Descriptor instead.
+func (*PeeringDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{15}
+}
+
+type TrustBundleListByServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceName string `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	Partition   string `protobuf:"bytes,3,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	Kind        string `protobuf:"bytes,4,opt,name=Kind,proto3" json:"Kind,omitempty"`
+}
+
+func (x *TrustBundleListByServiceRequest) Reset() {
+	*x = TrustBundleListByServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustBundleListByServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustBundleListByServiceRequest) ProtoMessage() {}
+
+func (x *TrustBundleListByServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustBundleListByServiceRequest.ProtoReflect.Descriptor instead.
+func (*TrustBundleListByServiceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TrustBundleListByServiceRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TrustBundleListByServiceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TrustBundleListByServiceRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *TrustBundleListByServiceRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type TrustBundleListByServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   uint64                `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Bundles []*PeeringTrustBundle `protobuf:"bytes,2,rep,name=Bundles,proto3" json:"Bundles,omitempty"`
+}
+
+func (x *TrustBundleListByServiceResponse) Reset() {
+	*x = TrustBundleListByServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustBundleListByServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustBundleListByServiceResponse) ProtoMessage() {}
+
+func (x *TrustBundleListByServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustBundleListByServiceResponse.ProtoReflect.Descriptor instead.
+func (*TrustBundleListByServiceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TrustBundleListByServiceResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TrustBundleListByServiceResponse) GetBundles() []*PeeringTrustBundle {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+type TrustBundleReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
+}
+
+func (x *TrustBundleReadRequest) Reset() {
+	*x = TrustBundleReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustBundleReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustBundleReadRequest) ProtoMessage() {}
+
+func (x *TrustBundleReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustBundleReadRequest.ProtoReflect.Descriptor instead.
+func (*TrustBundleReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TrustBundleReadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrustBundleReadRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+type TrustBundleReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint64              `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Bundle *PeeringTrustBundle `protobuf:"bytes,2,opt,name=Bundle,proto3" json:"Bundle,omitempty"`
+}
+
+func (x *TrustBundleReadResponse) Reset() {
+	*x = TrustBundleReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustBundleReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustBundleReadResponse) ProtoMessage() {}
+
+func (x *TrustBundleReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustBundleReadResponse.ProtoReflect.Descriptor instead.
+func (*TrustBundleReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TrustBundleReadResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TrustBundleReadResponse) GetBundle() *PeeringTrustBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+// This is a purely internal type and does not require query metadata.
+type PeeringTerminateByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *PeeringTerminateByIDRequest) Reset() {
+	*x = PeeringTerminateByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringTerminateByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringTerminateByIDRequest) ProtoMessage() {}
+
+func (x *PeeringTerminateByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringTerminateByIDRequest.ProtoReflect.Descriptor instead.
+func (*PeeringTerminateByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PeeringTerminateByIDRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type PeeringTerminateByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PeeringTerminateByIDResponse) Reset() {
+	*x = PeeringTerminateByIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringTerminateByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringTerminateByIDResponse) ProtoMessage() {}
+
+func (x *PeeringTerminateByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringTerminateByIDResponse.ProtoReflect.Descriptor instead.
+func (*PeeringTerminateByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{21}
+}
+
+type PeeringTrustBundleWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeeringTrustBundle *PeeringTrustBundle `protobuf:"bytes,1,opt,name=PeeringTrustBundle,proto3" json:"PeeringTrustBundle,omitempty"`
+}
+
+func (x *PeeringTrustBundleWriteRequest) Reset() {
+	*x = PeeringTrustBundleWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringTrustBundleWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringTrustBundleWriteRequest) ProtoMessage() {}
+
+func (x *PeeringTrustBundleWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringTrustBundleWriteRequest.ProtoReflect.Descriptor instead.
+func (*PeeringTrustBundleWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PeeringTrustBundleWriteRequest) GetPeeringTrustBundle() *PeeringTrustBundle {
+	if x != nil {
+		return x.PeeringTrustBundle
+	}
+	return nil
+}
+
+type PeeringTrustBundleWriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PeeringTrustBundleWriteResponse) Reset() {
+	*x = PeeringTrustBundleWriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringTrustBundleWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringTrustBundleWriteResponse) ProtoMessage() {}
+
+func (x *PeeringTrustBundleWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringTrustBundleWriteResponse.ProtoReflect.Descriptor instead.
+func (*PeeringTrustBundleWriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{23}
+}
+
+type PeeringTrustBundleDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
+}
+
+func (x *PeeringTrustBundleDeleteRequest) Reset() {
+	*x = PeeringTrustBundleDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringTrustBundleDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringTrustBundleDeleteRequest) ProtoMessage() {}
+
+func (x *PeeringTrustBundleDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringTrustBundleDeleteRequest.ProtoReflect.Descriptor instead.
+func (*PeeringTrustBundleDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PeeringTrustBundleDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeeringTrustBundleDeleteRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+type PeeringTrustBundleDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PeeringTrustBundleDeleteResponse) Reset() {
+	*x = PeeringTrustBundleDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeeringTrustBundleDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeeringTrustBundleDeleteResponse) ProtoMessage() {}
+
+func (x *PeeringTrustBundleDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeeringTrustBundleDeleteResponse.ProtoReflect.Descriptor instead.
+func (*PeeringTrustBundleDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{25}
+}
+
+// mog annotation:
+//
+// target=github.com/hashicorp/consul/api.PeeringGenerateTokenRequest
+// output=peering.gen.go
+// name=API
+type GenerateTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the remote peer.
+	PeerName string `protobuf:"bytes,1,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
+	// Partition is the local partition being peered.
+	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	// Meta is a mapping of some string value to any other string value
+	Meta map[string]string `protobuf:"bytes,5,rep,name=Meta,proto3" json:"Meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ServerExternalAddresses is a list of addresses to put into the generated token. This could be used to specify
+	// load balancer(s) or external IPs to reach the servers from the dialing side, and will override any server
+	// addresses obtained from the "consul" service.
+	ServerExternalAddresses []string `protobuf:"bytes,6,rep,name=ServerExternalAddresses,proto3" json:"ServerExternalAddresses,omitempty"`
+	// ExpiresAfter is how long the generated token can be used to establish the
+	// peering. The default is used when it is not set.
+	// mog: func-to=structs.DurationFromProto func-from=structs.DurationToProto
+	ExpiresAfter *durationpb.Duration `protobuf:"bytes,7,opt,name=ExpiresAfter,proto3" json:"ExpiresAfter,omitempty"`
+}
+
+func (x *GenerateTokenRequest) Reset() {
+	*x = GenerateTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTokenRequest) ProtoMessage() {}
+
+func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GenerateTokenRequest) GetPeerName() string {
+	if x != nil {
+		return x.PeerName
+	}
+	return ""
+}
+
+func (x *GenerateTokenRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *GenerateTokenRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateTokenRequest) GetServerExternalAddresses() []string {
+	if x != nil {
+		return x.ServerExternalAddresses
+	}
+	return nil
+}
+
+func (x *GenerateTokenRequest) GetExpiresAfter() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresAfter
+	}
+	return nil
+}
+
+// mog annotation:
+//
+// target=github.com/hashicorp/consul/api.PeeringGenerateTokenResponse
+// output=peering.gen.go
+// name=API
+type GenerateTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PeeringToken is an opaque string provided to the remote peer for it to complete
+	// the peering initialization handshake.
+	PeeringToken string `protobuf:"bytes,1,opt,name=PeeringToken,proto3" json:"PeeringToken,omitempty"`
+}
+
+func (x *GenerateTokenResponse) Reset() {
+	*x = GenerateTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTokenResponse) ProtoMessage() {}
+
+func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{27}
 }
 
-type TrustBundleListByServiceRequest struct {
+func (x *GenerateTokenResponse) GetPeeringToken() string {
+	if x != nil {
+		return x.PeeringToken
+	}
+	return ""
+}
+
+// mog annotation:
+//
+// target=github.com/hashicorp/consul/api.PeeringEstablishRequest
+// output=peering.gen.go
+// name=API
+type EstablishRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceName string `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
-	Namespace   string `protobuf:"bytes,2,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
-	Partition   string `protobuf:"bytes,3,opt,name=Partition,proto3" json:"Partition,omitempty"`
-	Kind        string `protobuf:"bytes,4,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	// Name of the remote peer.
+	PeerName string `protobuf:"bytes,1,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
+	// The peering token returned from the peer's GenerateToken endpoint.
+	PeeringToken string `protobuf:"bytes,2,opt,name=PeeringToken,proto3" json:"PeeringToken,omitempty"`
+	// Partition is the local partition being peered.
+	Partition string `protobuf:"bytes,3,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	// Meta is a mapping of some string value to any other string value
+	Meta map[string]string `protobuf:"bytes,4,rep,name=Meta,proto3" json:"Meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *TrustBundleListByServiceRequest) Reset() {
-	*x = TrustBundleListByServiceRequest{}
+func (x *EstablishRequest) Reset() {
+	*x = EstablishRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[10]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrustBundleListByServiceRequest) String() string {
+func (x *EstablishRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrustBundleListByServiceRequest) ProtoMessage() {}
+func (*EstablishRequest) ProtoMessage() {}
 
-func (x *TrustBundleListByServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[10]
+func (x *EstablishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,65 +2018,67 @@ func (x *TrustBundleListByServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrustBundleListByServiceRequest.ProtoReflect.Descriptor instead.
-func (*TrustBundleListByServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use EstablishRequest.ProtoReflect.Descriptor instead.
+func (*EstablishRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TrustBundleListByServiceRequest) GetServiceName() string {
+func (x *EstablishRequest) GetPeerName() string {
 	if x != nil {
-		return x.ServiceName
+		return x.PeerName
 	}
 	return ""
 }
 
-func (x *TrustBundleListByServiceRequest) GetNamespace() string {
+func (x *EstablishRequest) GetPeeringToken() string {
 	if x != nil {
-		return x.Namespace
+		return x.PeeringToken
 	}
 	return ""
 }
 
-func (x *TrustBundleListByServiceRequest) GetPartition() string {
+func (x *EstablishRequest) GetPartition() string {
 	if x != nil {
 		return x.Partition
 	}
 	return ""
 }
 
-func (x *TrustBundleListByServiceRequest) GetKind() string {
+func (x *EstablishRequest) GetMeta() map[string]string {
 	if x != nil {
-		return x.Kind
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type TrustBundleListByServiceResponse struct {
+// mog annotation:
+//
+// target=github.com/hashicorp/consul/api.PeeringEstablishResponse
+// output=peering.gen.go
+// name=API
+type EstablishResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Index   uint64                `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
-	Bundles []*PeeringTrustBundle `protobuf:"bytes,2,rep,name=Bundles,proto3" json:"Bundles,omitempty"`
 }
 
-func (x *TrustBundleListByServiceResponse) Reset() {
-	*x = TrustBundleListByServiceResponse{}
+func (x *EstablishResponse) Reset() {
+	*x = EstablishResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[11]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrustBundleListByServiceResponse) String() string {
+func (x *EstablishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrustBundleListByServiceResponse) ProtoMessage() {}
+func (*EstablishResponse) ProtoMessage() {}
 
-func (x *TrustBundleListByServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[11]
+func (x *EstablishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,51 +2089,84 @@ func (x *TrustBundleListByServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrustBundleListByServiceResponse.ProtoReflect.Descriptor instead.
-func (*TrustBundleListByServiceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EstablishResponse.ProtoReflect.Descriptor instead.
+func (*EstablishResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *TrustBundleListByServiceResponse) GetIndex() uint64 {
-	if x != nil {
-		return x.Index
+type TokenListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Partition is the local partition whose outstanding tokens are listed.
+	Partition string `protobuf:"bytes,1,opt,name=Partition,proto3" json:"Partition,omitempty"`
+}
+
+func (x *TokenListRequest) Reset() {
+	*x = TokenListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TrustBundleListByServiceResponse) GetBundles() []*PeeringTrustBundle {
+func (x *TokenListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenListRequest) ProtoMessage() {}
+
+func (x *TokenListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenListRequest.ProtoReflect.Descriptor instead.
+func (*TokenListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TokenListRequest) GetPartition() string {
 	if x != nil {
-		return x.Bundles
+		return x.Partition
 	}
-	return nil
+	return ""
 }
 
-type TrustBundleReadRequest struct {
+type TokenListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	Tokens []*PeeringTokenInfo `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty"`
 }
 
-func (x *TrustBundleReadRequest) Reset() {
-	*x = TrustBundleReadRequest{}
+func (x *TokenListResponse) Reset() {
+	*x = TokenListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[12]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrustBundleReadRequest) String() string {
+func (x *TokenListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrustBundleReadRequest) ProtoMessage() {}
+func (*TokenListResponse) ProtoMessage() {}
 
-func (x *TrustBundleReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[12]
+func (x *TokenListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,51 +2177,62 @@ func (x *TrustBundleReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrustBundleReadRequest.ProtoReflect.Descriptor instead.
-func (*TrustBundleReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *TrustBundleReadRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use TokenListResponse.ProtoReflect.Descriptor instead.
+func (*TokenListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *TrustBundleReadRequest) GetPartition() string {
+func (x *TokenListResponse) GetTokens() []*PeeringTokenInfo {
 	if x != nil {
-		return x.Partition
+		return x.Tokens
 	}
-	return ""
+	return nil
 }
 
-type TrustBundleReadResponse struct {
+// PeeringTokenInfo describes a peering token that was generated but not yet
+// used to establish the peering. It never contains the secret of the token.
+//
+// mog annotation:
+//
+// target=github.com/hashicorp/consul/api.PeeringTokenInfo
+// output=peering.gen.go
+// name=API
+type PeeringTokenInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index  uint64              `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
-	Bundle *PeeringTrustBundle `protobuf:"bytes,2,opt,name=Bundle,proto3" json:"Bundle,omitempty"`
+	// PeerName is the local name of the peering the token was generated for.
+	PeerName string `protobuf:"bytes,1,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
+	// PeeringID is the local UUID of the peering.
+	PeeringID string `protobuf:"bytes,2,opt,name=PeeringID,proto3" json:"PeeringID,omitempty"`
+	// Partition is the local partition of the peering.
+	Partition string `protobuf:"bytes,3,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	// CreatedAt is the time when the token was generated.
+	// mog: func-to=structs.TimeFromProto func-from=structs.TimeToProto
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	// ExpiresAt is the time after which the token can no longer be used.
+	// mog: func-to=structs.TimeFromProto func-from=structs.TimeToProto
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *TrustBundleReadResponse) Reset() {
-	*x = TrustBundleReadResponse{}
+func (x *PeeringTokenInfo) Reset() {
+	*x = PeeringTokenInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[13]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrustBundleReadResponse) String() string {
+func (x *PeeringTokenInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrustBundleReadResponse) ProtoMessage() {}
+func (*PeeringTokenInfo) ProtoMessage() {}
 
-func (x *TrustBundleReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[13]
+func (x *PeeringTokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,51 +2243,74 @@ func (x *TrustBundleReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrustBundleReadResponse.ProtoReflect.Descriptor instead.
-func (*TrustBundleReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PeeringTokenInfo.ProtoReflect.Descriptor instead.
+func (*PeeringTokenInfo) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *TrustBundleReadResponse) GetIndex() uint64 {
+func (x *PeeringTokenInfo) GetPeerName() string {
 	if x != nil {
-		return x.Index
+		return x.PeerName
 	}
-	return 0
+	return ""
 }
 
-func (x *TrustBundleReadResponse) GetBundle() *PeeringTrustBundle {
+func (x *PeeringTokenInfo) GetPeeringID() string {
 	if x != nil {
-		return x.Bundle
+		return x.PeeringID
+	}
+	return ""
+}
+
+func (x *PeeringTokenInfo) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *PeeringTokenInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// This is a purely internal type and does not require query metadata.
-type PeeringTerminateByIDRequest struct {
+func (x *PeeringTokenInfo) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type TokenRevokeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// PeerName is the local name of the peering whose token is revoked.
+	PeerName string `protobuf:"bytes,1,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
+	// Partition is the local partition of the peering.
+	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
 }
 
-func (x *PeeringTerminateByIDRequest) Reset() {
-	*x = PeeringTerminateByIDRequest{}
+func (x *TokenRevokeRequest) Reset() {
+	*x = TokenRevokeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[14]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeeringTerminateByIDRequest) String() string {
+func (x *TokenRevokeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringTerminateByIDRequest) ProtoMessage() {}
+func (*TokenRevokeRequest) ProtoMessage() {}
 
-func (x *PeeringTerminateByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[14]
+func (x *TokenRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,41 +2321,48 @@ func (x *PeeringTerminateByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringTerminateByIDRequest.ProtoReflect.Descriptor instead.
-func (*PeeringTerminateByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use TokenRevokeRequest.ProtoReflect.Descriptor instead.
+func (*TokenRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *PeeringTerminateByIDRequest) GetID() string {
+func (x *TokenRevokeRequest) GetPeerName() string {
 	if x != nil {
-		return x.ID
+		return x.PeerName
 	}
 	return ""
 }
 
-type PeeringTerminateByIDResponse struct {
+func (x *TokenRevokeRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+type TokenRevokeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PeeringTerminateByIDResponse) Reset() {
-	*x = PeeringTerminateByIDResponse{}
+func (x *TokenRevokeResponse) Reset() {
+	*x = TokenRevokeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[15]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeeringTerminateByIDResponse) String() string {
+func (x *TokenRevokeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringTerminateByIDResponse) ProtoMessage() {}
+func (*TokenRevokeResponse) ProtoMessage() {}
 
-func (x *PeeringTerminateByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[15]
+func (x *TokenRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,36 +2373,41 @@ func (x *PeeringTerminateByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringTerminateByIDResponse.ProtoReflect.Descriptor instead.
-func (*PeeringTerminateByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TokenRevokeResponse.ProtoReflect.Descriptor instead.
+func (*TokenRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{34}
 }
 
-type PeeringTrustBundleWriteRequest struct {
+type PeeringSecrets_Establishment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeeringTrustBundle *PeeringTrustBundle `protobuf:"bytes,1,opt,name=PeeringTrustBundle,proto3" json:"PeeringTrustBundle,omitempty"`
+	// SecretID is the single-use secret embedded in the peering token.
+	SecretID string `protobuf:"bytes,1,opt,name=SecretID,proto3" json:"SecretID,omitempty"`
+	// CreatedAt is the time when the peering token was generated.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	// ExpiresAt is the time after which the secret can no longer be exchanged.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *PeeringTrustBundleWriteRequest) Reset() {
-	*x = PeeringTrustBundleWriteRequest{}
+func (x *PeeringSecrets_Establishment) Reset() {
+	*x = PeeringSecrets_Establishment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[16]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeeringTrustBundleWriteRequest) String() string {
+func (x *PeeringSecrets_Establishment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringTrustBundleWriteRequest) ProtoMessage() {}
+func (*PeeringSecrets_Establishment) ProtoMessage() {}
 
-func (x *PeeringTrustBundleWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[16]
+func (x *PeeringSecrets_Establishment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,41 +2418,61 @@ func (x *PeeringTrustBundleWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringTrustBundleWriteRequest.ProtoReflect.Descriptor instead.
-func (*PeeringTrustBundleWriteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PeeringSecrets_Establishment.ProtoReflect.Descriptor instead.
+func (*PeeringSecrets_Establishment) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *PeeringTrustBundleWriteRequest) GetPeeringTrustBundle() *PeeringTrustBundle {
+func (x *PeeringSecrets_Establishment) GetSecretID() string {
 	if x != nil {
-		return x.PeeringTrustBundle
+		return x.SecretID
+	}
+	return ""
+}
+
+func (x *PeeringSecrets_Establishment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type PeeringTrustBundleWriteResponse struct {
+func (x *PeeringSecrets_Establishment) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type PeeringSecrets_Stream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ActiveSecretID is the secret used to open replication streams.
+	ActiveSecretID string `protobuf:"bytes,1,opt,name=ActiveSecretID,proto3" json:"ActiveSecretID,omitempty"`
+	// PendingSecretID is a secret issued by the accepting side that replaces
+	// the active secret once a stream is opened with it.
+	PendingSecretID string `protobuf:"bytes,2,opt,name=PendingSecretID,proto3" json:"PendingSecretID,omitempty"`
 }
 
-func (x *PeeringTrustBundleWriteResponse) Reset() {
-	*x = PeeringTrustBundleWriteResponse{}
+func (x *PeeringSecrets_Stream) Reset() {
+	*x = PeeringSecrets_Stream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[17]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeeringTrustBundleWriteResponse) String() string {
+func (x *PeeringSecrets_Stream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringTrustBundleWriteResponse) ProtoMessage() {}
+func (*PeeringSecrets_Stream) ProtoMessage() {}
 
-func (x *PeeringTrustBundleWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[17]
+func (x *PeeringSecrets_Stream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,37 +2483,53 @@ func (x *PeeringTrustBundleWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringTrustBundleWriteResponse.ProtoReflect.Descriptor instead.
-func (*PeeringTrustBundleWriteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use PeeringSecrets_Stream.ProtoReflect.Descriptor instead.
+func (*PeeringSecrets_Stream) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{4, 1}
 }
 
-type PeeringTrustBundleDeleteRequest struct {
+func (x *PeeringSecrets_Stream) GetActiveSecretID() string {
+	if x != nil {
+		return x.ActiveSecretID
+	}
+	return ""
+}
+
+func (x *PeeringSecrets_Stream) GetPendingSecretID() string {
+	if x != nil {
+		return x.PendingSecretID
+	}
+	return ""
+}
+
+// GenerateToken replaces the establishment secret of the peering.
+type PeeringSecretsWriteRequest_GenerateTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	EstablishmentSecret string                 `protobuf:"bytes,1,opt,name=EstablishmentSecret,proto3" json:"EstablishmentSecret,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *PeeringTrustBundleDeleteRequest) Reset() {
-	*x = PeeringTrustBundleDeleteRequest{}
+func (x *PeeringSecretsWriteRequest_GenerateTokenRequest) Reset() {
+	*x = PeeringSecretsWriteRequest_GenerateTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[18]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeeringTrustBundleDeleteRequest) String() string {
+func (x *PeeringSecretsWriteRequest_GenerateTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringTrustBundleDeleteRequest) ProtoMessage() {}
+func (*PeeringSecretsWriteRequest_GenerateTokenRequest) ProtoMessage() {}
 
-func (x *PeeringTrustBundleDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[18]
+func (x *PeeringSecretsWriteRequest_GenerateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,48 +2540,60 @@ func (x *PeeringTrustBundleDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringTrustBundleDeleteRequest.ProtoReflect.Descriptor instead.
-func (*PeeringTrustBundleDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PeeringSecretsWriteRequest_GenerateTokenRequest.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteRequest_GenerateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *PeeringTrustBundleDeleteRequest) GetName() string {
+func (x *PeeringSecretsWriteRequest_GenerateTokenRequest) GetEstablishmentSecret() string {
 	if x != nil {
-		return x.Name
+		return x.EstablishmentSecret
 	}
 	return ""
 }
 
-func (x *PeeringTrustBundleDeleteRequest) GetPartition() string {
+func (x *PeeringSecretsWriteRequest_GenerateTokenRequest) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Partition
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type PeeringTrustBundleDeleteResponse struct {
+func (x *PeeringSecretsWriteRequest_GenerateTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// ExchangeSecret consumes the establishment secret and issues a pending
+// stream secret.
+type PeeringSecretsWriteRequest_ExchangeSecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	EstablishmentSecret string `protobuf:"bytes,1,opt,name=EstablishmentSecret,proto3" json:"EstablishmentSecret,omitempty"`
+	PendingStreamSecret string `protobuf:"bytes,2,opt,name=PendingStreamSecret,proto3" json:"PendingStreamSecret,omitempty"`
 }
 
-func (x *PeeringTrustBundleDeleteResponse) Reset() {
-	*x = PeeringTrustBundleDeleteResponse{}
+func (x *PeeringSecretsWriteRequest_ExchangeSecretRequest) Reset() {
+	*x = PeeringSecretsWriteRequest_ExchangeSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[19]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeeringTrustBundleDeleteResponse) String() string {
+func (x *PeeringSecretsWriteRequest_ExchangeSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeeringTrustBundleDeleteResponse) ProtoMessage() {}
+func (*PeeringSecretsWriteRequest_ExchangeSecretRequest) ProtoMessage() {}
 
-func (x *PeeringTrustBundleDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[19]
+func (x *PeeringSecretsWriteRequest_ExchangeSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,50 +2604,51 @@ func (x *PeeringTrustBundleDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeeringTrustBundleDeleteResponse.ProtoReflect.Descriptor instead.
-func (*PeeringTrustBundleDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PeeringSecretsWriteRequest_ExchangeSecretRequest.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteRequest_ExchangeSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{5, 1}
 }
 
-// mog annotation:
-//
-// target=github.com/hashicorp/consul/api.PeeringGenerateTokenRequest
-// output=peering.gen.go
-// name=API
-type GenerateTokenRequest struct {
+func (x *PeeringSecretsWriteRequest_ExchangeSecretRequest) GetEstablishmentSecret() string {
+	if x != nil {
+		return x.EstablishmentSecret
+	}
+	return ""
+}
+
+func (x *PeeringSecretsWriteRequest_ExchangeSecretRequest) GetPendingStreamSecret() string {
+	if x != nil {
+		return x.PendingStreamSecret
+	}
+	return ""
+}
+
+// PromotePending makes the pending stream secret the active one.
+type PeeringSecretsWriteRequest_PromotePendingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of the remote peer.
-	PeerName string `protobuf:"bytes,1,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
-	// Partition is the local partition being peered.
-	Partition string `protobuf:"bytes,2,opt,name=Partition,proto3" json:"Partition,omitempty"`
-	// Meta is a mapping of some string value to any other string value
-	Meta map[string]string `protobuf:"bytes,5,rep,name=Meta,proto3" json:"Meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// ServerExternalAddresses is a list of addresses to put into the generated token. This could be used to specify
-	// load balancer(s) or external IPs to reach the servers from the dialing side, and will override any server
-	// addresses obtained from the "consul" service.
-	ServerExternalAddresses []string `protobuf:"bytes,6,rep,name=ServerExternalAddresses,proto3" json:"ServerExternalAddresses,omitempty"`
+	ActiveStreamSecret string `protobuf:"bytes,1,opt,name=ActiveStreamSecret,proto3" json:"ActiveStreamSecret,omitempty"`
 }
 
-func (x *GenerateTokenRequest) Reset() {
-	*x = GenerateTokenRequest{}
+func (x *PeeringSecretsWriteRequest_PromotePendingRequest) Reset() {
+	*x = PeeringSecretsWriteRequest_PromotePendingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[20]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateTokenRequest) String() string {
+func (x *PeeringSecretsWriteRequest_PromotePendingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenRequest) ProtoMessage() {}
+func (*PeeringSecretsWriteRequest_PromotePendingRequest) ProtoMessage() {}
 
-func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[20]
+func (x *PeeringSecretsWriteRequest_PromotePendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,71 +2659,44 @@ func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTokenRequest.ProtoReflect.Descriptor instead.
-func (*GenerateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GenerateTokenRequest) GetPeerName() string {
-	if x != nil {
-		return x.PeerName
-	}
-	return ""
+// Deprecated: Use PeeringSecretsWriteRequest_PromotePendingRequest.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteRequest_PromotePendingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{5, 2}
 }
 
-func (x *GenerateTokenRequest) GetPartition() string {
+func (x *PeeringSecretsWriteRequest_PromotePendingRequest) GetActiveStreamSecret() string {
 	if x != nil {
-		return x.Partition
+		return x.ActiveStreamSecret
 	}
 	return ""
 }
 
-func (x *GenerateTokenRequest) GetMeta() map[string]string {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *GenerateTokenRequest) GetServerExternalAddresses() []string {
-	if x != nil {
-		return x.ServerExternalAddresses
-	}
-	return nil
-}
-
-// mog annotation:
-//
-// target=github.com/hashicorp/consul/api.PeeringGenerateTokenResponse
-// output=peering.gen.go
-// name=API
-type GenerateTokenResponse struct {
+// RotateStreamSecret issues a pending stream secret replacing the active one.
+type PeeringSecretsWriteRequest_RotateStreamSecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// PeeringToken is an opaque string provided to the remote peer for it to complete
-	// the peering initialization handshake.
-	PeeringToken string `protobuf:"bytes,1,opt,name=PeeringToken,proto3" json:"PeeringToken,omitempty"`
+	PendingStreamSecret string `protobuf:"bytes,1,opt,name=PendingStreamSecret,proto3" json:"PendingStreamSecret,omitempty"`
 }
 
-func (x *GenerateTokenResponse) Reset() {
-	*x = GenerateTokenResponse{}
+func (x *PeeringSecretsWriteRequest_RotateStreamSecretRequest) Reset() {
+	*x = PeeringSecretsWriteRequest_RotateStreamSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[21]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateTokenResponse) String() string {
+func (x *PeeringSecretsWriteRequest_RotateStreamSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenResponse) ProtoMessage() {}
+func (*PeeringSecretsWriteRequest_RotateStreamSecretRequest) ProtoMessage() {}
 
-func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[21]
+func (x *PeeringSecretsWriteRequest_RotateStreamSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1393,55 +2707,44 @@ func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTokenResponse.ProtoReflect.Descriptor instead.
-func (*GenerateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PeeringSecretsWriteRequest_RotateStreamSecretRequest.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteRequest_RotateStreamSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{5, 3}
 }
 
-func (x *GenerateTokenResponse) GetPeeringToken() string {
+func (x *PeeringSecretsWriteRequest_RotateStreamSecretRequest) GetPendingStreamSecret() string {
 	if x != nil {
-		return x.PeeringToken
+		return x.PendingStreamSecret
 	}
 	return ""
 }
 
-// mog annotation:
-//
-// target=github.com/hashicorp/consul/api.PeeringEstablishRequest
-// output=peering.gen.go
-// name=API
-type EstablishRequest struct {
+// StoreStreamSecret stores the stream secret a dialing peer presents.
+type PeeringSecretsWriteRequest_StoreStreamSecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of the remote peer.
-	PeerName string `protobuf:"bytes,1,opt,name=PeerName,proto3" json:"PeerName,omitempty"`
-	// The peering token returned from the peer's GenerateToken endpoint.
-	PeeringToken string `protobuf:"bytes,2,opt,name=PeeringToken,proto3" json:"PeeringToken,omitempty"`
-	// Partition is the local partition being peered.
-	Partition string `protobuf:"bytes,3,opt,name=Partition,proto3" json:"Partition,omitempty"`
-	// Meta is a mapping of some string value to any other string value
-	Meta map[string]string `protobuf:"bytes,4,rep,name=Meta,proto3" json:"Meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ActiveStreamSecret string `protobuf:"bytes,1,opt,name=ActiveStreamSecret,proto3" json:"ActiveStreamSecret,omitempty"`
 }
 
-func (x *EstablishRequest) Reset() {
-	*x = EstablishRequest{}
+func (x *PeeringSecretsWriteRequest_StoreStreamSecretRequest) Reset() {
+	*x = PeeringSecretsWriteRequest_StoreStreamSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[22]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EstablishRequest) String() string {
+func (x *PeeringSecretsWriteRequest_StoreStreamSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstablishRequest) ProtoMessage() {}
+func (*PeeringSecretsWriteRequest_StoreStreamSecretRequest) ProtoMessage() {}
 
-func (x *EstablishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[22]
+func (x *PeeringSecretsWriteRequest_StoreStreamSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1452,67 +2755,42 @@ func (x *EstablishRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstablishRequest.ProtoReflect.Descriptor instead.
-func (*EstablishRequest) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *EstablishRequest) GetPeerName() string {
-	if x != nil {
-		return x.PeerName
-	}
-	return ""
-}
-
-func (x *EstablishRequest) GetPeeringToken() string {
-	if x != nil {
-		return x.PeeringToken
-	}
-	return ""
+// Deprecated: Use PeeringSecretsWriteRequest_StoreStreamSecretRequest.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteRequest_StoreStreamSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{5, 4}
 }
 
-func (x *EstablishRequest) GetPartition() string {
+func (x *PeeringSecretsWriteRequest_StoreStreamSecretRequest) GetActiveStreamSecret() string {
 	if x != nil {
-		return x.Partition
+		return x.ActiveStreamSecret
 	}
 	return ""
 }
 
-func (x *EstablishRequest) GetMeta() map[string]string {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-// mog annotation:
-//
-// target=github.com/hashicorp/consul/api.PeeringEstablishResponse
-// output=peering.gen.go
-// name=API
-type EstablishResponse struct {
+// RevokeToken deletes the establishment secret of the peering.
+type PeeringSecretsWriteRequest_RevokeTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *EstablishResponse) Reset() {
-	*x = EstablishResponse{}
+func (x *PeeringSecretsWriteRequest_RevokeTokenRequest) Reset() {
+	*x = PeeringSecretsWriteRequest_RevokeTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_pbpeering_peering_proto_msgTypes[23]
+		mi := &file_proto_pbpeering_peering_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EstablishResponse) String() string {
+func (x *PeeringSecretsWriteRequest_RevokeTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstablishResponse) ProtoMessage() {}
+func (*PeeringSecretsWriteRequest_RevokeTokenRequest) ProtoMessage() {}
 
-func (x *EstablishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pbpeering_peering_proto_msgTypes[23]
+func (x *PeeringSecretsWriteRequest_RevokeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pbpeering_peering_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,9 +2801,9 @@ func (x *EstablishResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstablishResponse.ProtoReflect.Descriptor instead.
-func (*EstablishResponse) Descriptor() ([]byte, []int) {
-	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PeeringSecretsWriteRequest_RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*PeeringSecretsWriteRequest_RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pbpeering_peering_proto_rawDescGZIP(), []int{5, 5}
 }
 
 var File_proto_pbpeering_peering_proto protoreflect.FileDescriptor
@@ -1535,9 +2813,11 @@ var file_proto_pbpeering_peering_proto_rawDesc = []byte{
 	0x67, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x21, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
 	0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x6e, 0x67, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x05, 0x0a, 0x07, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x05, 0x0a, 0x07, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12,
 	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
 	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
 	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
@@ -1570,50 +2850,255 @@ var file_proto_pbpeering_peering_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65,
-	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xfe, 0x01, 0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54,
-	0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72,
-	0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x45,
-	0x4d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x45,
-	0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x13,
-	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x07, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x32, 0x0a, 0x12, 0x50, 0x65, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a,
-	0x13, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a,
+	0x0b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a,
+	0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb, 0x07, 0x0a, 0x0c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x61, 0x73,
+	0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x4c, 0x61,
+	0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x4c,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x4c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6e,
+	0x64, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x4c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x7d, 0x0a, 0x14, 0x53,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x53, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x18, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x1a, 0x47, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x1d, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x13, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x75, 0x0a, 0x1f, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xe5, 0x03, 0x0a, 0x0e,
+	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x65, 0x0a, 0x0d,
+	0x65, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x9f, 0x01, 0x0a, 0x0d, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x5a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x44, 0x22, 0x88, 0x0b, 0x0a, 0x1a, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0x12, 0x7b, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x7e, 0x0a,
+	0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x53, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
 	0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
 	0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x08, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xea, 0x01, 0x0a,
+	0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x7e, 0x0a,
+	0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x53, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x8b, 0x01,
+	0x0a, 0x14, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x57, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x13,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x56, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x75, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0b, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xbc, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x7b, 0x0a, 0x15,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x47, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x1a, 0x4d, 0x0a, 0x19, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x1a, 0x4a, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x14, 0x0a,
+	0x12, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d,
+	0x0a, 0x1b, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfe, 0x01,
+	0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x45, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x45, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x11,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x46,
+	0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x13, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x07, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x50, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x22, 0x32, 0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x13, 0x50, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x50, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xd1, 0x02, 0x0a,
 	0x13, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
@@ -1625,204 +3110,259 @@ var file_proto_pbpeering_peering_proto_rawDesc = []byte{
 	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65,
 	0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x4d, 0x65, 0x74, 0x61,
-	0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x65, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x48, 0x0a, 0x14, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x50,
-	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x1f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x20, 0x54,
-	0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4f, 0x0a, 0x07, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x07, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x16, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x65, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x16, 0x0a, 0x14, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x14, 0x50, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x17, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x4d, 0x0a, 0x06, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54,
-	0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x42, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x22, 0x2d, 0x0a, 0x1b, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x44, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x87, 0x01, 0x0a, 0x1e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75,
-	0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54,
-	0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
-	0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73,
-	0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x12, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x50,
+	0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x1f,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x22, 0x89, 0x01, 0x0a, 0x20, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4f, 0x0a, 0x07,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x52, 0x07, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x22, 0x4a, 0x0a,
+	0x16, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x17, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4d, 0x0a, 0x06, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x06, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x2d, 0x0a, 0x1b, 0x50, 0x65, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x1e, 0x50, 0x65, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x12, 0x50,
 	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53,
-	0x0a, 0x1f, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x20, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72,
-	0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x12,
+	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x1f, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
 	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x04, 0x4d, 0x65,
-	0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x20, 0x50, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd9,
+	0x02, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x55, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x15, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x10, 0x45, 0x73, 0x74, 0x61,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x04, 0x4d, 0x65,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
 	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x4d, 0x65, 0x74,
-	0x61, 0x12, 0x38, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x17, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x4d,
-	0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x10, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x1a, 0x37, 0x0a,
+	0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x10, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a,
+	0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22,
+	0xde, 0x01, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x4e, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x15, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x73, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a,
+	0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x32, 0xb6, 0x0a, 0x0a,
+	0x0e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x82, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x37, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x09, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x12, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0b,
+	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x12, 0x35, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63,
 	0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70,
-	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x13, 0x0a, 0x11, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x73, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x49, 0x4e,
-	0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12,
-	0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08,
-	0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x45,
-	0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x32, 0xc0, 0x08, 0x0a, 0x0e, 0x50,
-	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01,
-	0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x37, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
-	0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x76, 0x0a, 0x09, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12,
-	0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
-	0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0b, 0x50, 0x65,
-	0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x12, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0b, 0x50, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68,
 	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74,
 	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65,
-	0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x36, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e,
 	0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36,
-	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
-	0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x37, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x38, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f,
-	0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x0c, 0x50,
-	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x36, 0x2e, 0x68, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x37, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a,
+	0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x36, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa3,
+	0x01, 0x0a, 0x18, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x43, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x39, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
 	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa3, 0x01, 0x0a,
-	0x18, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72,
-	0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x76, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x34, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x39, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74,
-	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x3a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f,
-	0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x8a, 0x02,
-	0x0a, 0x25, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x63, 0x6f,
-	0x6e, 0x73, 0x75, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x70, 0x65, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0xa2, 0x02, 0x04, 0x48, 0x43, 0x49, 0x50, 0xaa, 0x02, 0x21, 0x48, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0xca,
-	0x02, 0x21, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x5c, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6c, 0x5c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x50, 0x65, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0xe2, 0x02, 0x2d, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x5c,
-	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x5c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c,
-	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x24, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x3a,
-	0x3a, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x3a, 0x3a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x3a, 0x3a, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x67, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x8a, 0x02, 0x0a, 0x25, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x0c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x70, 0x62, 0x70, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0xa2, 0x02, 0x04, 0x48,
+	0x43, 0x49, 0x50, 0xaa, 0x02, 0x21, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0xca, 0x02, 0x21, 0x48, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x5c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x5c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0xe2, 0x02, 0x2d, 0x48, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x5c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x5c, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x50, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x24, 0x48, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x3a,
+	0x3a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x3a, 0x3a, 0x50, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1838,73 +3378,127 @@ func file_proto_pbpeering_peering_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_pbpeering_peering_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_pbpeering_peering_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_proto_pbpeering_peering_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_proto_pbpeering_peering_proto_goTypes = []interface{}{
-	(PeeringState)(0),                        // 0: hashicorp.consul.internal.peering.PeeringState
-	(*Peering)(nil),                          // 1: hashicorp.consul.internal.peering.Peering
-	(*PeeringTrustBundle)(nil),               // 2: hashicorp.consul.internal.peering.PeeringTrustBundle
-	(*PeeringReadRequest)(nil),               // 3: hashicorp.consul.internal.peering.PeeringReadRequest
-	(*PeeringReadResponse)(nil),              // 4: hashicorp.consul.internal.peering.PeeringReadResponse
-	(*PeeringListRequest)(nil),               // 5: hashicorp.consul.internal.peering.PeeringListRequest
-	(*PeeringListResponse)(nil),              // 6: hashicorp.consul.internal.peering.PeeringListResponse
-	(*PeeringWriteRequest)(nil),              // 7: hashicorp.consul.internal.peering.PeeringWriteRequest
-	(*PeeringWriteResponse)(nil),             // 8: hashicorp.consul.internal.peering.PeeringWriteResponse
-	(*PeeringDeleteRequest)(nil),             // 9: hashicorp.consul.internal.peering.PeeringDeleteRequest
-	(*PeeringDeleteResponse)(nil),            // 10: hashicorp.consul.internal.peering.PeeringDeleteResponse
-	(*TrustBundleListByServiceRequest)(nil),  // 11: hashicorp.consul.internal.peering.TrustBundleListByServiceRequest
-	(*TrustBundleListByServiceResponse)(nil), // 12: hashicorp.consul.internal.peering.TrustBundleListByServiceResponse
-	(*TrustBundleReadRequest)(nil),           // 13: hashicorp.consul.internal.peering.TrustBundleReadRequest
-	(*TrustBundleReadResponse)(nil),          // 14: hashicorp.consul.internal.peering.TrustBundleReadResponse
-	(*PeeringTerminateByIDRequest)(nil),      // 15: hashicorp.consul.internal.peering.PeeringTerminateByIDRequest
-	(*PeeringTerminateByIDResponse)(nil),     // 16: hashicorp.consul.internal.peering.PeeringTerminateByIDResponse
-	(*PeeringTrustBundleWriteRequest)(nil),   // 17: hashicorp.consul.internal.peering.PeeringTrustBundleWriteRequest
-	(*PeeringTrustBundleWriteResponse)(nil),  // 18: hashicorp.consul.internal.peering.PeeringTrustBundleWriteResponse
-	(*PeeringTrustBundleDeleteRequest)(nil),  // 19: hashicorp.consul.internal.peering.PeeringTrustBundleDeleteRequest
-	(*PeeringTrustBundleDeleteResponse)(nil), // 20: hashicorp.consul.internal.peering.PeeringTrustBundleDeleteResponse
-	(*GenerateTokenRequest)(nil),             // 21: hashicorp.consul.internal.peering.GenerateTokenRequest
-	(*GenerateTokenResponse)(nil),            // 22: hashicorp.consul.internal.peering.GenerateTokenResponse
-	(*EstablishRequest)(nil),                 // 23: hashicorp.consul.internal.peering.EstablishRequest
-	(*EstablishResponse)(nil),                // 24: hashicorp.consul.internal.peering.EstablishResponse
-	nil,                                      // 25: hashicorp.consul.internal.peering.Peering.MetaEntry
-	nil,                                      // 26: hashicorp.consul.internal.peering.PeeringWriteRequest.MetaEntry
-	nil,                                      // 27: hashicorp.consul.internal.peering.GenerateTokenRequest.MetaEntry
-	nil,                                      // 28: hashicorp.consul.internal.peering.EstablishRequest.MetaEntry
-	(*timestamppb.Timestamp)(nil),            // 29: google.protobuf.Timestamp
+	(PeeringState)(0),                                            // 0: hashicorp.consul.internal.peering.PeeringState
+	(*Peering)(nil),                                              // 1: hashicorp.consul.internal.peering.Peering
+	(*StreamStatus)(nil),                                         // 2: hashicorp.consul.internal.peering.StreamStatus
+	(*PeeringStreamStatus)(nil),                                  // 3: hashicorp.consul.internal.peering.PeeringStreamStatus
+	(*PeeringStreamStatusWriteRequest)(nil),                      // 4: hashicorp.consul.internal.peering.PeeringStreamStatusWriteRequest
+	(*PeeringSecrets)(nil),                                       // 5: hashicorp.consul.internal.peering.PeeringSecrets
+	(*PeeringSecretsWriteRequest)(nil),                           // 6: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest
+	(*PeeringSecretsWriteResponse)(nil),                          // 7: hashicorp.consul.internal.peering.PeeringSecretsWriteResponse
+	(*PeeringTrustBundle)(nil),                                   // 8: hashicorp.consul.internal.peering.PeeringTrustBundle
+	(*PeeringReadRequest)(nil),                                   // 9: hashicorp.consul.internal.peering.PeeringReadRequest
+	(*PeeringReadResponse)(nil),                                  // 10: hashicorp.consul.internal.peering.PeeringReadResponse
+	(*PeeringListRequest)(nil),                                   // 11: hashicorp.consul.internal.peering.PeeringListRequest
+	(*PeeringListResponse)(nil),                                  // 12: hashicorp.consul.internal.peering.PeeringListResponse
+	(*PeeringWriteRequest)(nil),                                  // 13: hashicorp.consul.internal.peering.PeeringWriteRequest
+	(*PeeringWriteResponse)(nil),                                 // 14: hashicorp.consul.internal.peering.PeeringWriteResponse
+	(*PeeringDeleteRequest)(nil),                                 // 15: hashicorp.consul.internal.peering.PeeringDeleteRequest
+	(*PeeringDeleteResponse)(nil),                                // 16: hashicorp.consul.internal.peering.PeeringDeleteResponse
+	(*TrustBundleListByServiceRequest)(nil),                      // 17: hashicorp.consul.internal.peering.TrustBundleListByServiceRequest
+	(*TrustBundleListByServiceResponse)(nil),                     // 18: hashicorp.consul.internal.peering.TrustBundleListByServiceResponse
+	(*TrustBundleReadRequest)(nil),                               // 19: hashicorp.consul.internal.peering.TrustBundleReadRequest
+	(*TrustBundleReadResponse)(nil),                              // 20: hashicorp.consul.internal.peering.TrustBundleReadResponse
+	(*PeeringTerminateByIDRequest)(nil),                          // 21: hashicorp.consul.internal.peering.PeeringTerminateByIDRequest
+	(*PeeringTerminateByIDResponse)(nil),                         // 22: hashicorp.consul.internal.peering.PeeringTerminateByIDResponse
+	(*PeeringTrustBundleWriteRequest)(nil),                       // 23: hashicorp.consul.internal.peering.PeeringTrustBundleWriteRequest
+	(*PeeringTrustBundleWriteResponse)(nil),                      // 24: hashicorp.consul.internal.peering.PeeringTrustBundleWriteResponse
+	(*PeeringTrustBundleDeleteRequest)(nil),                      // 25: hashicorp.consul.internal.peering.PeeringTrustBundleDeleteRequest
+	(*PeeringTrustBundleDeleteResponse)(nil),                     // 26: hashicorp.consul.internal.peering.PeeringTrustBundleDeleteResponse
+	(*GenerateTokenRequest)(nil),                                 // 27: hashicorp.consul.internal.peering.GenerateTokenRequest
+	(*GenerateTokenResponse)(nil),                                // 28: hashicorp.consul.internal.peering.GenerateTokenResponse
+	(*EstablishRequest)(nil),                                     // 29: hashicorp.consul.internal.peering.EstablishRequest
+	(*EstablishResponse)(nil),                                    // 30: hashicorp.consul.internal.peering.EstablishResponse
+	(*TokenListRequest)(nil),                                     // 31: hashicorp.consul.internal.peering.TokenListRequest
+	(*TokenListResponse)(nil),                                    // 32: hashicorp.consul.internal.peering.TokenListResponse
+	(*PeeringTokenInfo)(nil),                                     // 33: hashicorp.consul.internal.peering.PeeringTokenInfo
+	(*TokenRevokeRequest)(nil),                                   // 34: hashicorp.consul.internal.peering.TokenRevokeRequest
+	(*TokenRevokeResponse)(nil),                                  // 35: hashicorp.consul.internal.peering.TokenRevokeResponse
+	nil,                                                          // 36: hashicorp.consul.internal.peering.Peering.MetaEntry
+	nil,                                                          // 37: hashicorp.consul.internal.peering.StreamStatus.SentResourceVersionsEntry
+	nil,                                                          // 38: hashicorp.consul.internal.peering.StreamStatus.ReceivedResourceVersionsEntry
+	(*PeeringSecrets_Establishment)(nil),                         // 39: hashicorp.consul.internal.peering.PeeringSecrets.Establishment
+	(*PeeringSecrets_Stream)(nil),                                // 40: hashicorp.consul.internal.peering.PeeringSecrets.Stream
+	(*PeeringSecretsWriteRequest_GenerateTokenRequest)(nil),      // 41: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.GenerateTokenRequest
+	(*PeeringSecretsWriteRequest_ExchangeSecretRequest)(nil),     // 42: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.ExchangeSecretRequest
+	(*PeeringSecretsWriteRequest_PromotePendingRequest)(nil),     // 43: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.PromotePendingRequest
+	(*PeeringSecretsWriteRequest_RotateStreamSecretRequest)(nil), // 44: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.RotateStreamSecretRequest
+	(*PeeringSecretsWriteRequest_StoreStreamSecretRequest)(nil),  // 45: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.StoreStreamSecretRequest
+	(*PeeringSecretsWriteRequest_RevokeTokenRequest)(nil),        // 46: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.RevokeTokenRequest
+	nil,                           // 47: hashicorp.consul.internal.peering.PeeringWriteRequest.MetaEntry
+	nil,                           // 48: hashicorp.consul.internal.peering.GenerateTokenRequest.MetaEntry
+	nil,                           // 49: hashicorp.consul.internal.peering.EstablishRequest.MetaEntry
+	(*timestamppb.Timestamp)(nil), // 50: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 51: google.protobuf.Duration
 }
 var file_proto_pbpeering_peering_proto_depIdxs = []int32{
-	29, // 0: hashicorp.consul.internal.peering.Peering.DeletedAt:type_name -> google.protobuf.Timestamp
-	25, // 1: hashicorp.consul.internal.peering.Peering.Meta:type_name -> hashicorp.consul.internal.peering.Peering.MetaEntry
+	50, // 0: hashicorp.consul.internal.peering.Peering.DeletedAt:type_name -> google.protobuf.Timestamp
+	36, // 1: hashicorp.consul.internal.peering.Peering.Meta:type_name -> hashicorp.consul.internal.peering.Peering.MetaEntry
 	0,  // 2: hashicorp.consul.internal.peering.Peering.State:type_name -> hashicorp.consul.internal.peering.PeeringState
-	1,  // 3: hashicorp.consul.internal.peering.PeeringReadResponse.Peering:type_name -> hashicorp.consul.internal.peering.Peering
-	1,  // 4: hashicorp.consul.internal.peering.PeeringListResponse.Peerings:type_name -> hashicorp.consul.internal.peering.Peering
-	1,  // 5: hashicorp.consul.internal.peering.PeeringWriteRequest.Peering:type_name -> hashicorp.consul.internal.peering.Peering
-	26, // 6: hashicorp.consul.internal.peering.PeeringWriteRequest.Meta:type_name -> hashicorp.consul.internal.peering.PeeringWriteRequest.MetaEntry
-	2,  // 7: hashicorp.consul.internal.peering.TrustBundleListByServiceResponse.Bundles:type_name -> hashicorp.consul.internal.peering.PeeringTrustBundle
-	2,  // 8: hashicorp.consul.internal.peering.TrustBundleReadResponse.Bundle:type_name -> hashicorp.consul.internal.peering.PeeringTrustBundle
-	2,  // 9: hashicorp.consul.internal.peering.PeeringTrustBundleWriteRequest.PeeringTrustBundle:type_name -> hashicorp.consul.internal.peering.PeeringTrustBundle
-	27, // 10: hashicorp.consul.internal.peering.GenerateTokenRequest.Meta:type_name -> hashicorp.consul.internal.peering.GenerateTokenRequest.MetaEntry
-	28, // 11: hashicorp.consul.internal.peering.EstablishRequest.Meta:type_name -> hashicorp.consul.internal.peering.EstablishRequest.MetaEntry
-	21, // 12: hashicorp.consul.internal.peering.PeeringService.GenerateToken:input_type -> hashicorp.consul.internal.peering.GenerateTokenRequest
-	23, // 13: hashicorp.consul.internal.peering.PeeringService.Establish:input_type -> hashicorp.consul.internal.peering.EstablishRequest
-	3,  // 14: hashicorp.consul.internal.peering.PeeringService.PeeringRead:input_type -> hashicorp.consul.internal.peering.PeeringReadRequest
-	5,  // 15: hashicorp.consul.internal.peering.PeeringService.PeeringList:input_type -> hashicorp.consul.internal.peering.PeeringListRequest
-	9,  // 16: hashicorp.consul.internal.peering.PeeringService.PeeringDelete:input_type -> hashicorp.consul.internal.peering.PeeringDeleteRequest
-	7,  // 17: hashicorp.consul.internal.peering.PeeringService.PeeringWrite:input_type -> hashicorp.consul.internal.peering.PeeringWriteRequest
-	11, // 18: hashicorp.consul.internal.peering.PeeringService.TrustBundleListByService:input_type -> hashicorp.consul.internal.peering.TrustBundleListByServiceRequest
-	13, // 19: hashicorp.consul.internal.peering.PeeringService.TrustBundleRead:input_type -> hashicorp.consul.internal.peering.TrustBundleReadRequest
-	22, // 20: hashicorp.consul.internal.peering.PeeringService.GenerateToken:output_type -> hashicorp.consul.internal.peering.GenerateTokenResponse
-	24, // 21: hashicorp.consul.internal.peering.PeeringService.Establish:output_type -> hashicorp.consul.internal.peering.EstablishResponse
-	4,  // 22: hashicorp.consul.internal.peering.PeeringService.PeeringRead:output_type -> hashicorp.consul.internal.peering.PeeringReadResponse
-	6,  // 23: hashicorp.consul.internal.peering.PeeringService.PeeringList:output_type -> hashicorp.consul.internal.peering.PeeringListResponse
-	10, // 24: hashicorp.consul.internal.peering.PeeringService.PeeringDelete:output_type -> hashicorp.consul.internal.peering.PeeringDeleteResponse
-	8,  // 25: hashicorp.consul.internal.peering.PeeringService.PeeringWrite:output_type -> hashicorp.consul.internal.peering.PeeringWriteResponse
-	12, // 26: hashicorp.consul.internal.peering.PeeringService.TrustBundleListByService:output_type -> hashicorp.consul.internal.peering.TrustBundleListByServiceResponse
-	14, // 27: hashicorp.consul.internal.peering.PeeringService.TrustBundleRead:output_type -> hashicorp.consul.internal.peering.TrustBundleReadResponse
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 3: hashicorp.consul.internal.peering.Peering.StreamStatus:type_name -> hashicorp.consul.internal.peering.StreamStatus
+	50, // 4: hashicorp.consul.internal.peering.StreamStatus.LastHeartbeat:type_name -> google.protobuf.Timestamp
+	50, // 5: hashicorp.consul.internal.peering.StreamStatus.LastReceive:type_name -> google.protobuf.Timestamp
+	50, // 6: hashicorp.consul.internal.peering.StreamStatus.LastSend:type_name -> google.protobuf.Timestamp
+	50, // 7: hashicorp.consul.internal.peering.StreamStatus.LastError:type_name -> google.protobuf.Timestamp
+	50, // 8: hashicorp.consul.internal.peering.StreamStatus.DisconnectTime:type_name -> google.protobuf.Timestamp
+	37, // 9: hashicorp.consul.internal.peering.StreamStatus.SentResourceVersions:type_name -> hashicorp.consul.internal.peering.StreamStatus.SentResourceVersionsEntry
+	38, // 10: hashicorp.consul.internal.peering.StreamStatus.ReceivedResourceVersions:type_name -> hashicorp.consul.internal.peering.StreamStatus.ReceivedResourceVersionsEntry
+	50, // 11: hashicorp.consul.internal.peering.StreamStatus.UpdatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 12: hashicorp.consul.internal.peering.PeeringStreamStatus.Status:type_name -> hashicorp.consul.internal.peering.StreamStatus
+	3,  // 13: hashicorp.consul.internal.peering.PeeringStreamStatusWriteRequest.Statuses:type_name -> hashicorp.consul.internal.peering.PeeringStreamStatus
+	39, // 14: hashicorp.consul.internal.peering.PeeringSecrets.establishment:type_name -> hashicorp.consul.internal.peering.PeeringSecrets.Establishment
+	40, // 15: hashicorp.consul.internal.peering.PeeringSecrets.stream:type_name -> hashicorp.consul.internal.peering.PeeringSecrets.Stream
+	41, // 16: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.generate_token:type_name -> hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.GenerateTokenRequest
+	42, // 17: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.exchange_secret:type_name -> hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.ExchangeSecretRequest
+	43, // 18: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.promote_pending:type_name -> hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.PromotePendingRequest
+	44, // 19: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.rotate_stream_secret:type_name -> hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.RotateStreamSecretRequest
+	45, // 20: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.store_stream_secret:type_name -> hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.StoreStreamSecretRequest
+	46, // 21: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.revoke_token:type_name -> hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.RevokeTokenRequest
+	1,  // 22: hashicorp.consul.internal.peering.PeeringReadResponse.Peering:type_name -> hashicorp.consul.internal.peering.Peering
+	1,  // 23: hashicorp.consul.internal.peering.PeeringListResponse.Peerings:type_name -> hashicorp.consul.internal.peering.Peering
+	1,  // 24: hashicorp.consul.internal.peering.PeeringWriteRequest.Peering:type_name -> hashicorp.consul.internal.peering.Peering
+	47, // 25: hashicorp.consul.internal.peering.PeeringWriteRequest.Meta:type_name -> hashicorp.consul.internal.peering.PeeringWriteRequest.MetaEntry
+	6,  // 26: hashicorp.consul.internal.peering.PeeringWriteRequest.SecretsRequest:type_name -> hashicorp.consul.internal.peering.PeeringSecretsWriteRequest
+	8,  // 27: hashicorp.consul.internal.peering.TrustBundleListByServiceResponse.Bundles:type_name -> hashicorp.consul.internal.peering.PeeringTrustBundle
+	8,  // 28: hashicorp.consul.internal.peering.TrustBundleReadResponse.Bundle:type_name -> hashicorp.consul.internal.peering.PeeringTrustBundle
+	8,  // 29: hashicorp.consul.internal.peering.PeeringTrustBundleWriteRequest.PeeringTrustBundle:type_name -> hashicorp.consul.internal.peering.PeeringTrustBundle
+	48, // 30: hashicorp.consul.internal.peering.GenerateTokenRequest.Meta:type_name -> hashicorp.consul.internal.peering.GenerateTokenRequest.MetaEntry
+	51, // 31: hashicorp.consul.internal.peering.GenerateTokenRequest.ExpiresAfter:type_name -> google.protobuf.Duration
+	49, // 32: hashicorp.consul.internal.peering.EstablishRequest.Meta:type_name -> hashicorp.consul.internal.peering.EstablishRequest.MetaEntry
+	33, // 33: hashicorp.consul.internal.peering.TokenListResponse.Tokens:type_name -> hashicorp.consul.internal.peering.PeeringTokenInfo
+	50, // 34: hashicorp.consul.internal.peering.PeeringTokenInfo.CreatedAt:type_name -> google.protobuf.Timestamp
+	50, // 35: hashicorp.consul.internal.peering.PeeringTokenInfo.ExpiresAt:type_name -> google.protobuf.Timestamp
+	50, // 36: hashicorp.consul.internal.peering.PeeringSecrets.Establishment.CreatedAt:type_name -> google.protobuf.Timestamp
+	50, // 37: hashicorp.consul.internal.peering.PeeringSecrets.Establishment.ExpiresAt:type_name -> google.protobuf.Timestamp
+	50, // 38: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.GenerateTokenRequest.CreatedAt:type_name -> google.protobuf.Timestamp
+	50, // 39: hashicorp.consul.internal.peering.PeeringSecretsWriteRequest.GenerateTokenRequest.ExpiresAt:type_name -> google.protobuf.Timestamp
+	27, // 40: hashicorp.consul.internal.peering.PeeringService.GenerateToken:input_type -> hashicorp.consul.internal.peering.GenerateTokenRequest
+	29, // 41: hashicorp.consul.internal.peering.PeeringService.Establish:input_type -> hashicorp.consul.internal.peering.EstablishRequest
+	9,  // 42: hashicorp.consul.internal.peering.PeeringService.PeeringRead:input_type -> hashicorp.consul.internal.peering.PeeringReadRequest
+	11, // 43: hashicorp.consul.internal.peering.PeeringService.PeeringList:input_type -> hashicorp.consul.internal.peering.PeeringListRequest
+	15, // 44: hashicorp.consul.internal.peering.PeeringService.PeeringDelete:input_type -> hashicorp.consul.internal.peering.PeeringDeleteRequest
+	13, // 45: hashicorp.consul.internal.peering.PeeringService.PeeringWrite:input_type -> hashicorp.consul.internal.peering.PeeringWriteRequest
+	17, // 46: hashicorp.consul.internal.peering.PeeringService.TrustBundleListByService:input_type -> hashicorp.consul.internal.peering.TrustBundleListByServiceRequest
+	19, // 47: hashicorp.consul.internal.peering.PeeringService.TrustBundleRead:input_type -> hashicorp.consul.internal.peering.TrustBundleReadRequest
+	31, // 48: hashicorp.consul.internal.peering.PeeringService.TokenList:input_type -> hashicorp.consul.internal.peering.TokenListRequest
+	34, // 49: hashicorp.consul.internal.peering.PeeringService.TokenRevoke:input_type -> hashicorp.consul.internal.peering.TokenRevokeRequest
+	28, // 50: hashicorp.consul.internal.peering.PeeringService.GenerateToken:output_type -> hashicorp.consul.internal.peering.GenerateTokenResponse
+	30, // 51: hashicorp.consul.internal.peering.PeeringService.Establish:output_type -> hashicorp.consul.internal.peering.EstablishResponse
+	10, // 52: hashicorp.consul.internal.peering.PeeringService.PeeringRead:output_type -> hashicorp.consul.internal.peering.PeeringReadResponse
+	12, // 53: hashicorp.consul.internal.peering.PeeringService.PeeringList:output_type -> hashicorp.consul.internal.peering.PeeringListResponse
+	16, // 54: hashicorp.consul.internal.peering.PeeringService.PeeringDelete:output_type -> hashicorp.consul.internal.peering.PeeringDeleteResponse
+	14, // 55: hashicorp.consul.internal.peering.PeeringService.PeeringWrite:output_type -> hashicorp.consul.internal.peering.PeeringWriteResponse
+	18, // 56: hashicorp.consul.internal.peering.PeeringService.TrustBundleListByService:output_type -> hashicorp.consul.internal.peering.TrustBundleListByServiceResponse
+	20, // 57: hashicorp.consul.internal.peering.PeeringService.TrustBundleRead:output_type -> hashicorp.consul.internal.peering.TrustBundleReadResponse
+	32, // 58: hashicorp.consul.internal.peering.PeeringService.TokenList:output_type -> hashicorp.consul.internal.peering.TokenListResponse
+	35, // 59: hashicorp.consul.internal.peering.PeeringService.TokenRevoke:output_type -> hashicorp.consul.internal.peering.TokenRevokeResponse
+	50, // [50:60] is the sub-list for method output_type
+	40, // [40:50] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_proto_pbpeering_peering_proto_init() }
@@ -1926,7 +3520,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringTrustBundle); i {
+			switch v := v.(*StreamStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1938,7 +3532,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringReadRequest); i {
+			switch v := v.(*PeeringStreamStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1950,7 +3544,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringReadResponse); i {
+			switch v := v.(*PeeringStreamStatusWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1962,7 +3556,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringListRequest); i {
+			switch v := v.(*PeeringSecrets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1974,7 +3568,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringListResponse); i {
+			switch v := v.(*PeeringSecretsWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1986,7 +3580,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringWriteRequest); i {
+			switch v := v.(*PeeringSecretsWriteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1998,7 +3592,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringWriteResponse); i {
+			switch v := v.(*PeeringTrustBundle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2010,7 +3604,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringDeleteRequest); i {
+			switch v := v.(*PeeringReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2022,7 +3616,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringDeleteResponse); i {
+			switch v := v.(*PeeringReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2034,7 +3628,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrustBundleListByServiceRequest); i {
+			switch v := v.(*PeeringListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2046,7 +3640,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrustBundleListByServiceResponse); i {
+			switch v := v.(*PeeringListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2058,7 +3652,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrustBundleReadRequest); i {
+			switch v := v.(*PeeringWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2070,7 +3664,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrustBundleReadResponse); i {
+			switch v := v.(*PeeringWriteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2082,7 +3676,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringTerminateByIDRequest); i {
+			switch v := v.(*PeeringDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2094,7 +3688,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringTerminateByIDResponse); i {
+			switch v := v.(*PeeringDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2106,7 +3700,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringTrustBundleWriteRequest); i {
+			switch v := v.(*TrustBundleListByServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2118,7 +3712,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringTrustBundleWriteResponse); i {
+			switch v := v.(*TrustBundleListByServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2130,7 +3724,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringTrustBundleDeleteRequest); i {
+			switch v := v.(*TrustBundleReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2142,7 +3736,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeeringTrustBundleDeleteResponse); i {
+			switch v := v.(*TrustBundleReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2154,7 +3748,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateTokenRequest); i {
+			switch v := v.(*PeeringTerminateByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2166,7 +3760,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateTokenResponse); i {
+			switch v := v.(*PeeringTerminateByIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2178,7 +3772,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstablishRequest); i {
+			switch v := v.(*PeeringTrustBundleWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2190,6 +3784,78 @@ func file_proto_pbpeering_peering_proto_init() {
 			}
 		}
 		file_proto_pbpeering_peering_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringTrustBundleWriteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringTrustBundleDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringTrustBundleDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstablishRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstablishResponse); i {
 			case 0:
 				return &v.state
@@ -2201,6 +3867,170 @@ func file_proto_pbpeering_peering_proto_init() {
 				return nil
 			}
 		}
+		file_proto_pbpeering_peering_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringTokenInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenRevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenRevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecrets_Establishment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecrets_Stream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecretsWriteRequest_GenerateTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecretsWriteRequest_ExchangeSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecretsWriteRequest_PromotePendingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecretsWriteRequest_RotateStreamSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecretsWriteRequest_StoreStreamSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pbpeering_peering_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeeringSecretsWriteRequest_RevokeTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_pbpeering_peering_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*PeeringSecretsWriteRequest_GenerateToken)(nil),
+		(*PeeringSecretsWriteRequest_ExchangeSecret)(nil),
+		(*PeeringSecretsWriteRequest_PromotePending)(nil),
+		(*PeeringSecretsWriteRequest_RotateStreamSecret)(nil),
+		(*PeeringSecretsWriteRequest_StoreStreamSecret)(nil),
+		(*PeeringSecretsWriteRequest_RevokeToken)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2208,7 +4038,7 @@ func file_proto_pbpeering_peering_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_pbpeering_peering_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   28,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,8 @@ type PeeringServiceClient interface {
 	// TODO(peering): Rename this to PeeredServiceRoots? or something like that?
 	TrustBundleListByService(ctx context.Context, in *TrustBundleListByServiceRequest, opts ...grpc.CallOption) (*TrustBundleListByServiceResponse, error)
 	TrustBundleRead(ctx context.Context, in *TrustBundleReadRequest, opts ...grpc.CallOption) (*TrustBundleReadResponse, error)
+	TokenList(ctx context.Context, in *TokenListRequest, opts ...grpc.CallOption) (*TokenListResponse, error)
+	TokenRevoke(ctx context.Context, in *TokenRevokeRequest, opts ...grpc.CallOption) (*TokenRevokeResponse, error)
 }
 
 type peeringServiceClient struct {
@@ -115,6 +117,24 @@ func (c *peeringServiceClient) TrustBundleRead(ctx context.Context, in *TrustBun
 	return out, nil
 }
 
+func (c *peeringServiceClient) TokenList(ctx context.Context, in *TokenListRequest, opts ...grpc.CallOption) (*TokenListResponse, error) {
+	out := new(TokenListResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/TokenList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peeringServiceClient) TokenRevoke(ctx context.Context, in *TokenRevokeRequest, opts ...grpc.CallOption) (*TokenRevokeResponse, error) {
+	out := new(TokenRevokeResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/TokenRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PeeringServiceServer is the server API for PeeringService service.
 // All implementations should embed UnimplementedPeeringServiceServer
 // for forward compatibility
@@ -130,6 +150,8 @@ type PeeringServiceServer interface {
 	// TODO(peering): Rename this to PeeredServiceRoots? or something like that?
 	TrustBundleListByService(context.Context, *TrustBundleListByServiceRequest) (*TrustBundleListByServiceResponse, error)
 	TrustBundleRead(context.Context, *TrustBundleReadRequest) (*TrustBundleReadResponse, error)
+	TokenList(context.Context, *TokenListRequest) (*TokenListResponse, error)
+	TokenRevoke(context.Context, *TokenRevokeRequest) (*TokenRevokeResponse, error)
 }
 
 // UnimplementedPeeringServiceServer should be embedded to have forward compatible implementations.
@@ -160,6 +182,12 @@ func (UnimplementedPeeringServiceServer) TrustBundleListByService(context.Contex
 func (UnimplementedPeeringServiceServer) TrustBundleRead(context.Context, *TrustBundleReadRequest) (*TrustBundleReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrustBundleRead not implemented")
 }
+func (UnimplementedPeeringServiceServer) TokenList(context.Context, *TokenListRequest) (*TokenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenList not implemented")
+}
+func (UnimplementedPeeringServiceServer) TokenRevoke(context.Context, *TokenRevokeRequest) (*TokenRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenRevoke not implemented")
+}
 
 // UnsafePeeringServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PeeringServiceServer will
@@ -316,6 +344,42 @@ func _PeeringService_TrustBundleRead_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeeringService_TokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeeringServiceServer).TokenList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.peering.PeeringService/TokenList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeeringServiceServer).TokenList(ctx, req.(*TokenListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeeringService_TokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeeringServiceServer).TokenRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.peering.PeeringService/TokenRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeeringServiceServer).TokenRevoke(ctx, req.(*TokenRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PeeringService_ServiceDesc is the grpc.ServiceDesc for PeeringService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,6 +419,14 @@ var PeeringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TrustBundleRead",
 			Handler:    _PeeringService_TrustBundleRead_Handler,
 		},
+		{
+			MethodName: "TokenList",
+			Handler:    _PeeringService_TokenList_Handler,
+		},
+		{
+			MethodName: "TokenRevoke",
+			Handler:    _PeeringService_TokenRevoke_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/pbpeering/peering.proto",
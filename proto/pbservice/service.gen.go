@@ -4,6 +4,28 @@ package pbservice
 
 import "github.com/hashicorp/consul/agent/structs"
 
+func AccessLogsConfigToStructs(s *AccessLogsConfig, t *structs.AccessLogsConfig) {
+	if s == nil {
+		return
+	}
+	t.Enabled = s.Enabled
+	t.DisableListenerLogs = s.DisableListenerLogs
+	t.Type = structs.LogSinkType(s.Type)
+	t.Path = s.Path
+	t.JSONFormat = s.JSONFormat
+	t.TextFormat = s.TextFormat
+}
+func AccessLogsConfigFromStructs(t *structs.AccessLogsConfig, s *AccessLogsConfig) {
+	if s == nil {
+		return
+	}
+	s.Enabled = t.Enabled
+	s.DisableListenerLogs = t.DisableListenerLogs
+	s.Type = string(t.Type)
+	s.Path = t.Path
+	s.JSONFormat = t.JSONFormat
+	s.TextFormat = t.TextFormat
+}
 func ConnectProxyConfigToStructs(s *ConnectProxyConfig, t *structs.ConnectProxyConfig) {
 	if s == nil {
 		return
@@ -25,6 +47,15 @@ func ConnectProxyConfigToStructs(s *ConnectProxyConfig, t *structs.ConnectProxyC
 	if s.TransparentProxy != nil {
 		TransparentProxyConfigToStructs(s.TransparentProxy, &t.TransparentProxy)
 	}
+	if s.AccessLogs != nil {
+		AccessLogsConfigToStructs(s.AccessLogs, &t.AccessLogs)
+	}
+	if s.Tracing != nil {
+		TracingConfigToStructs(s.Tracing, &t.Tracing)
+	}
+	if s.Metrics != nil {
+		MetricsConfigToStructs(s.Metrics, &t.Metrics)
+	}
 }
 func ConnectProxyConfigFromStructs(t *structs.ConnectProxyConfig, s *ConnectProxyConfig) {
 	if s == nil {
@@ -53,6 +84,21 @@ func ConnectProxyConfigFromStructs(t *structs.ConnectProxyConfig, s *ConnectProx
 		TransparentProxyConfigFromStructs(&t.TransparentProxy, &x)
 		s.TransparentProxy = &x
 	}
+	{
+		var x AccessLogsConfig
+		AccessLogsConfigFromStructs(&t.AccessLogs, &x)
+		s.AccessLogs = &x
+	}
+	{
+		var x TracingConfig
+		TracingConfigFromStructs(&t.Tracing, &x)
+		s.Tracing = &x
+	}
+	{
+		var x MetricsConfig
+		MetricsConfigFromStructs(&t.Metrics, &x)
+		s.Metrics = &x
+	}
 }
 func ExposeConfigToStructs(s *ExposeConfig, t *structs.ExposeConfig) {
 	if s == nil {
@@ -100,6 +146,28 @@ func MeshGatewayConfigFromStructs(t *structs.MeshGatewayConfig, s *MeshGatewayCo
 	}
 	s.Mode = string(t.Mode)
 }
+func MetricsConfigToStructs(s *MetricsConfig, t *structs.MetricsConfig) {
+	if s == nil {
+		return
+	}
+	t.StatsdURL = s.StatsdURL
+	t.DogstatsdURL = s.DogstatsdURL
+	t.PrometheusBindAddr = s.PrometheusBindAddr
+	t.StatsBindAddr = s.StatsBindAddr
+	t.StatsTags = s.StatsTags
+	t.StatsFlushInterval = s.StatsFlushInterval
+}
+func MetricsConfigFromStructs(t *structs.MetricsConfig, s *MetricsConfig) {
+	if s == nil {
+		return
+	}
+	s.StatsdURL = t.StatsdURL
+	s.DogstatsdURL = t.DogstatsdURL
+	s.PrometheusBindAddr = t.PrometheusBindAddr
+	s.StatsBindAddr = t.StatsBindAddr
+	s.StatsTags = t.StatsTags
+	s.StatsFlushInterval = t.StatsFlushInterval
+}
 func PeeringServiceMetaToStructs(s *PeeringServiceMeta, t *structs.PeeringServiceMeta) {
 	if s == nil {
 		return
@@ -190,6 +258,60 @@ func ServiceDefinitionFromStructs(t *structs.ServiceDefinition, s *ServiceDefini
 	s.EnterpriseMeta = NewEnterpriseMetaFromStructs(t.EnterpriseMeta)
 	s.Connect = NewServiceConnectPtrFromStructs(t.Connect)
 }
+func TracingConfigToStructs(s *TracingConfig, t *structs.TracingConfig) {
+	if s == nil {
+		return
+	}
+	t.Provider = structs.TracingProvider(s.Provider)
+	t.CollectorAddress = s.CollectorAddress
+	t.CollectorPath = s.CollectorPath
+	t.SamplingPercentage = s.SamplingPercentage
+	{
+		t.CustomTags = make([]structs.TracingCustomTag, len(s.CustomTags))
+		for i := range s.CustomTags {
+			if s.CustomTags[i] != nil {
+				TracingCustomTagToStructs(s.CustomTags[i], &t.CustomTags[i])
+			}
+		}
+	}
+}
+func TracingConfigFromStructs(t *structs.TracingConfig, s *TracingConfig) {
+	if s == nil {
+		return
+	}
+	s.Provider = string(t.Provider)
+	s.CollectorAddress = t.CollectorAddress
+	s.CollectorPath = t.CollectorPath
+	s.SamplingPercentage = t.SamplingPercentage
+	{
+		s.CustomTags = make([]*TracingCustomTag, len(t.CustomTags))
+		for i := range t.CustomTags {
+			{
+				var x TracingCustomTag
+				TracingCustomTagFromStructs(&t.CustomTags[i], &x)
+				s.CustomTags[i] = &x
+			}
+		}
+	}
+}
+func TracingCustomTagToStructs(s *TracingCustomTag, t *structs.TracingCustomTag) {
+	if s == nil {
+		return
+	}
+	t.Tag = s.Tag
+	t.Literal = s.Literal
+	t.RequestHeader = s.RequestHeader
+	t.Environment = s.Environment
+}
+func TracingCustomTagFromStructs(t *structs.TracingCustomTag, s *TracingCustomTag) {
+	if s == nil {
+		return
+	}
+	s.Tag = t.Tag
+	s.Literal = t.Literal
+	s.RequestHeader = t.RequestHeader
+	s.Environment = t.Environment
+}
 func TransparentProxyConfigToStructs(s *TransparentProxyConfig, t *structs.TransparentProxyConfig) {
 	if s == nil {
 		return